@@ -53,6 +53,7 @@ const (
 	Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS         Permission = 140
 	Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS            Permission = 142
 	Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN             Permission = 147
+	Permission_CLUSTER_AUTH_MODIFY_ROLES                  Permission = 149
 	Permission_CLUSTER_ENTERPRISE_ACTIVATE                Permission = 114
 	Permission_CLUSTER_ENTERPRISE_HEARTBEAT               Permission = 115
 	Permission_CLUSTER_ENTERPRISE_GET_CODE                Permission = 116
@@ -77,27 +78,30 @@ const (
 	Permission_CLUSTER_LICENSE_DELETE_CLUSTER             Permission = 136
 	Permission_CLUSTER_LICENSE_LIST_CLUSTERS              Permission = 137
 	// TODO(actgardner): Make k8s secrets into nouns and add an Update RPC
-	Permission_CLUSTER_CREATE_SECRET       Permission = 143
-	Permission_CLUSTER_LIST_SECRETS        Permission = 144
-	Permission_SECRET_DELETE               Permission = 145
-	Permission_SECRET_INSPECT              Permission = 146
-	Permission_CLUSTER_DELETE_ALL          Permission = 138
-	Permission_REPO_READ                   Permission = 200
-	Permission_REPO_WRITE                  Permission = 201
-	Permission_REPO_MODIFY_BINDINGS        Permission = 202
-	Permission_REPO_DELETE                 Permission = 203
-	Permission_REPO_INSPECT_COMMIT         Permission = 204
-	Permission_REPO_LIST_COMMIT            Permission = 205
-	Permission_REPO_DELETE_COMMIT          Permission = 206
-	Permission_REPO_CREATE_BRANCH          Permission = 207
-	Permission_REPO_LIST_BRANCH            Permission = 208
-	Permission_REPO_DELETE_BRANCH          Permission = 209
-	Permission_REPO_INSPECT_FILE           Permission = 210
-	Permission_REPO_LIST_FILE              Permission = 211
-	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
-	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
-	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
-	Permission_PIPELINE_LIST_JOB           Permission = 301
+	Permission_CLUSTER_CREATE_SECRET         Permission = 143
+	Permission_CLUSTER_LIST_SECRETS          Permission = 144
+	Permission_SECRET_DELETE                 Permission = 145
+	Permission_SECRET_INSPECT                Permission = 146
+	Permission_CLUSTER_DELETE_ALL            Permission = 138
+	Permission_REPO_READ                     Permission = 200
+	Permission_REPO_WRITE                    Permission = 201
+	Permission_REPO_MODIFY_BINDINGS          Permission = 202
+	Permission_REPO_DELETE                   Permission = 203
+	Permission_REPO_INSPECT_COMMIT           Permission = 204
+	Permission_REPO_LIST_COMMIT              Permission = 205
+	Permission_REPO_DELETE_COMMIT            Permission = 206
+	Permission_REPO_CREATE_BRANCH            Permission = 207
+	Permission_REPO_LIST_BRANCH              Permission = 208
+	Permission_REPO_DELETE_BRANCH            Permission = 209
+	Permission_REPO_INSPECT_FILE             Permission = 210
+	Permission_REPO_LIST_FILE                Permission = 211
+	Permission_REPO_ADD_PIPELINE_READER      Permission = 212
+	Permission_REPO_REMOVE_PIPELINE_READER   Permission = 213
+	Permission_REPO_ADD_PIPELINE_WRITER      Permission = 214
+	Permission_REPO_MODIFY_BRANCH_PROTECTION Permission = 215
+	Permission_REPO_CREATE_TAG               Permission = 216
+	Permission_REPO_DELETE_TAG               Permission = 217
+	Permission_PIPELINE_LIST_JOB             Permission = 301
 )
 
 var Permission_name = map[int32]string{
@@ -119,6 +123,7 @@ var Permission_name = map[int32]string{
 	140: "CLUSTER_AUTH_DELETE_EXPIRED_TOKENS",
 	142: "CLUSTER_AUTH_REVOKE_USER_TOKENS",
 	147: "CLUSTER_AUTH_ROTATE_ROOT_TOKEN",
+	149: "CLUSTER_AUTH_MODIFY_ROLES",
 	114: "CLUSTER_ENTERPRISE_ACTIVATE",
 	115: "CLUSTER_ENTERPRISE_HEARTBEAT",
 	116: "CLUSTER_ENTERPRISE_GET_CODE",
@@ -162,6 +167,9 @@ var Permission_name = map[int32]string{
 	212: "REPO_ADD_PIPELINE_READER",
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
+	215: "REPO_MODIFY_BRANCH_PROTECTION",
+	216: "REPO_CREATE_TAG",
+	217: "REPO_DELETE_TAG",
 	301: "PIPELINE_LIST_JOB",
 }
 
@@ -184,6 +192,7 @@ var Permission_value = map[string]int32{
 	"CLUSTER_AUTH_DELETE_EXPIRED_TOKENS":         140,
 	"CLUSTER_AUTH_REVOKE_USER_TOKENS":            142,
 	"CLUSTER_AUTH_ROTATE_ROOT_TOKEN":             147,
+	"CLUSTER_AUTH_MODIFY_ROLES":                  149,
 	"CLUSTER_ENTERPRISE_ACTIVATE":                114,
 	"CLUSTER_ENTERPRISE_HEARTBEAT":               115,
 	"CLUSTER_ENTERPRISE_GET_CODE":                116,
@@ -227,6 +236,9 @@ var Permission_value = map[string]int32{
 	"REPO_ADD_PIPELINE_READER":                   212,
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
+	"REPO_MODIFY_BRANCH_PROTECTION":              215,
+	"REPO_CREATE_TAG":                            216,
+	"REPO_DELETE_TAG":                            217,
 	"PIPELINE_LIST_JOB":                          301,
 }
 
@@ -1425,12 +1437,15 @@ func (m *Groups) GetGroups() map[string]bool {
 }
 
 type Role struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Permissions          []Permission   `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
-	ResourceTypes        []ResourceType `protobuf:"varint,3,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=auth_v2.ResourceType" json:"resource_types,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   []Permission   `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	ResourceTypes []ResourceType `protobuf:"varint,3,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=auth_v2.ResourceType" json:"resource_types,omitempty"`
+	// builtin is true for the roles that are defined by Pachyderm, which can't
+	// be modified or deleted, and false for custom roles
+	Builtin              bool     `protobuf:"varint,4,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Role) Reset()         { *m = Role{} }
@@ -1487,6 +1502,13 @@ func (m *Role) GetResourceTypes() []ResourceType {
 	return nil
 }
 
+func (m *Role) GetBuiltin() bool {
+	if m != nil {
+		return m.Builtin
+	}
+	return false
+}
+
 type AuthorizeRequest struct {
 	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	// permissions are the operations the caller is attempting to perform
@@ -1980,6 +2002,357 @@ func (m *GetRoleBindingResponse) GetBinding() *RoleBinding {
 	return nil
 }
 
+// CreateRole defines a new custom role, which can be bound to principals in
+// the same way as the builtin roles
+type CreateRoleRequest struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+}
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type CreateRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleResponse) Reset()         { *m = CreateRoleResponse{} }
+func (m *CreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleResponse) ProtoMessage()    {}
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+}
+func (m *CreateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleResponse.Merge(m, src)
+}
+func (m *CreateRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleResponse proto.InternalMessageInfo
+
+// UpdateRole replaces the permissions and resource types of an existing custom
+// role
+type UpdateRoleRequest struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleRequest) Reset()         { *m = UpdateRoleRequest{} }
+func (m *UpdateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest) ProtoMessage()    {}
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+}
+func (m *UpdateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest.Merge(m, src)
+}
+func (m *UpdateRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleRequest proto.InternalMessageInfo
+
+func (m *UpdateRoleRequest) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type UpdateRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleResponse) Reset()         { *m = UpdateRoleResponse{} }
+func (m *UpdateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleResponse) ProtoMessage()    {}
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{36}
+}
+func (m *UpdateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleResponse.Merge(m, src)
+}
+func (m *UpdateRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleResponse proto.InternalMessageInfo
+
+// DeleteRole deletes a custom role. It fails if the role is still bound to
+// any principal.
+type DeleteRoleRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRoleRequest) Reset()         { *m = DeleteRoleRequest{} }
+func (m *DeleteRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleRequest) ProtoMessage()    {}
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+}
+func (m *DeleteRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleRequest.Merge(m, src)
+}
+func (m *DeleteRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRoleRequest proto.InternalMessageInfo
+
+func (m *DeleteRoleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRoleResponse) Reset()         { *m = DeleteRoleResponse{} }
+func (m *DeleteRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleResponse) ProtoMessage()    {}
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+}
+func (m *DeleteRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleResponse.Merge(m, src)
+}
+func (m *DeleteRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRoleResponse proto.InternalMessageInfo
+
+type ListRolesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRolesRequest) Reset()         { *m = ListRolesRequest{} }
+func (m *ListRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRolesRequest) ProtoMessage()    {}
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+}
+func (m *ListRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesRequest.Merge(m, src)
+}
+func (m *ListRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolesRequest proto.InternalMessageInfo
+
+type ListRolesResponse struct {
+	// roles contains both the builtin and the custom roles, sorted by name
+	Roles                []*Role  `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRolesResponse) Reset()         { *m = ListRolesResponse{} }
+func (m *ListRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRolesResponse) ProtoMessage()    {}
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+}
+func (m *ListRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesResponse.Merge(m, src)
+}
+func (m *ListRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolesResponse proto.InternalMessageInfo
+
+func (m *ListRolesResponse) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 // SessionInfo stores information associated with one OIDC authentication
 // session (i.e. a single instance of a single user logging in). Sessions are
 // short-lived and stored in the 'oidc-authns' collection, keyed by the OIDC
@@ -2019,7 +2392,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+	return fileDescriptor_712ec48c1eaf43a2, []int{41}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2079,7 +2452,7 @@ func (m *GetOIDCLoginRequest) Reset()         { *m = GetOIDCLoginRequest{} }
 func (m *GetOIDCLoginRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginRequest) ProtoMessage()    {}
 func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+	return fileDescriptor_712ec48c1eaf43a2, []int{42}
 }
 func (m *GetOIDCLoginRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2121,7 +2494,7 @@ func (m *GetOIDCLoginResponse) Reset()         { *m = GetOIDCLoginResponse{} }
 func (m *GetOIDCLoginResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginResponse) ProtoMessage()    {}
 func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+	return fileDescriptor_712ec48c1eaf43a2, []int{43}
 }
 func (m *GetOIDCLoginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2180,7 +2553,7 @@ func (m *GetRobotTokenRequest) Reset()         { *m = GetRobotTokenRequest{} }
 func (m *GetRobotTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenRequest) ProtoMessage()    {}
 func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{36}
+	return fileDescriptor_712ec48c1eaf43a2, []int{44}
 }
 func (m *GetRobotTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2235,7 +2608,7 @@ func (m *GetRobotTokenResponse) Reset()         { *m = GetRobotTokenResponse{} }
 func (m *GetRobotTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenResponse) ProtoMessage()    {}
 func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+	return fileDescriptor_712ec48c1eaf43a2, []int{45}
 }
 func (m *GetRobotTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2282,7 +2655,7 @@ func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{}
 func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenRequest) ProtoMessage()    {}
 func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+	return fileDescriptor_712ec48c1eaf43a2, []int{46}
 }
 func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2328,7 +2701,7 @@ func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse
 func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenResponse) ProtoMessage()    {}
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+	return fileDescriptor_712ec48c1eaf43a2, []int{47}
 }
 func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2369,7 +2742,7 @@ func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest
 func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserRequest) ProtoMessage()    {}
 func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+	return fileDescriptor_712ec48c1eaf43a2, []int{48}
 }
 func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2422,7 +2795,7 @@ func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserRespon
 func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserResponse) ProtoMessage()    {}
 func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{41}
+	return fileDescriptor_712ec48c1eaf43a2, []int{49}
 }
 func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +2837,7 @@ func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
 func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersRequest) ProtoMessage()    {}
 func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{42}
+	return fileDescriptor_712ec48c1eaf43a2, []int{50}
 }
 func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2524,7 +2897,7 @@ func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
 func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersResponse) ProtoMessage()    {}
 func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{43}
+	return fileDescriptor_712ec48c1eaf43a2, []int{51}
 }
 func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2563,7 +2936,7 @@ func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
 func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsRequest) ProtoMessage()    {}
 func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{44}
+	return fileDescriptor_712ec48c1eaf43a2, []int{52}
 }
 func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2603,7 +2976,7 @@ func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrinci
 func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
 func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{45}
+	return fileDescriptor_712ec48c1eaf43a2, []int{53}
 }
 func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +3023,7 @@ func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
 func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsResponse) ProtoMessage()    {}
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{46}
+	return fileDescriptor_712ec48c1eaf43a2, []int{54}
 }
 func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2697,7 +3070,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{47}
+	return fileDescriptor_712ec48c1eaf43a2, []int{55}
 }
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2744,7 +3117,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{48}
+	return fileDescriptor_712ec48c1eaf43a2, []int{56}
 }
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2792,7 +3165,7 @@ func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensReque
 func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensRequest) ProtoMessage()    {}
 func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{49}
+	return fileDescriptor_712ec48c1eaf43a2, []int{57}
 }
 func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2832,7 +3205,7 @@ func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResp
 func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensResponse) ProtoMessage()    {}
 func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{50}
+	return fileDescriptor_712ec48c1eaf43a2, []int{58}
 }
 func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2880,7 +3253,7 @@ func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest
 func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenRequest) ProtoMessage()    {}
 func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{51}
+	return fileDescriptor_712ec48c1eaf43a2, []int{59}
 }
 func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2926,7 +3299,7 @@ func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenRespon
 func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenResponse) ProtoMessage()    {}
 func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+	return fileDescriptor_712ec48c1eaf43a2, []int{60}
 }
 func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2966,7 +3339,7 @@ func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokens
 func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{53}
+	return fileDescriptor_712ec48c1eaf43a2, []int{61}
 }
 func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3385,7 @@ func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthToken
 func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{54}
+	return fileDescriptor_712ec48c1eaf43a2, []int{62}
 }
 func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3051,7 +3424,7 @@ func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAut
 func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{55}
+	return fileDescriptor_712ec48c1eaf43a2, []int{63}
 }
 func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3090,7 +3463,7 @@ func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAu
 func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{56}
+	return fileDescriptor_712ec48c1eaf43a2, []int{64}
 }
 func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3159,6 +3532,14 @@ func init() {
 	proto.RegisterType((*ModifyRoleBindingResponse)(nil), "auth_v2.ModifyRoleBindingResponse")
 	proto.RegisterType((*GetRoleBindingRequest)(nil), "auth_v2.GetRoleBindingRequest")
 	proto.RegisterType((*GetRoleBindingResponse)(nil), "auth_v2.GetRoleBindingResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "auth_v2.CreateRoleRequest")
+	proto.RegisterType((*CreateRoleResponse)(nil), "auth_v2.CreateRoleResponse")
+	proto.RegisterType((*UpdateRoleRequest)(nil), "auth_v2.UpdateRoleRequest")
+	proto.RegisterType((*UpdateRoleResponse)(nil), "auth_v2.UpdateRoleResponse")
+	proto.RegisterType((*DeleteRoleRequest)(nil), "auth_v2.DeleteRoleRequest")
+	proto.RegisterType((*DeleteRoleResponse)(nil), "auth_v2.DeleteRoleResponse")
+	proto.RegisterType((*ListRolesRequest)(nil), "auth_v2.ListRolesRequest")
+	proto.RegisterType((*ListRolesResponse)(nil), "auth_v2.ListRolesResponse")
 	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
 	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
 	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
@@ -3188,181 +3569,192 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xc6,
-	0xf5, 0x37, 0x24, 0x4b, 0x22, 0xaf, 0x36, 0x78, 0x44, 0x49, 0x14, 0x6c, 0x89, 0x12, 0x1c, 0xc7,
-	0xcb, 0xff, 0x1f, 0x29, 0x51, 0x9a, 0xd6, 0x49, 0xfc, 0xc2, 0x05, 0xa2, 0x91, 0x50, 0x24, 0xcf,
-	0x00, 0xb4, 0xe3, 0x9e, 0x9e, 0xa2, 0x14, 0x39, 0x96, 0x50, 0x4b, 0x04, 0x03, 0x80, 0xaa, 0x95,
-	0x36, 0x6d, 0xd3, 0x7d, 0x4f, 0xba, 0x9c, 0xbe, 0xf7, 0x03, 0xf4, 0xa5, 0xfd, 0x12, 0xe9, 0x9e,
-	0xae, 0x8f, 0x6e, 0x8f, 0x3e, 0x42, 0x4f, 0x4f, 0x9f, 0x7b, 0x30, 0x18, 0x00, 0x03, 0x10, 0x94,
-	0x1d, 0xe7, 0xe4, 0x45, 0xc2, 0xdc, 0xfb, 0xbb, 0xcb, 0xdc, 0xb9, 0x33, 0xb8, 0x73, 0x41, 0x98,
-	0x6f, 0x0f, 0xdc, 0x83, 0x2d, 0xef, 0xcf, 0x66, 0xdf, 0xb6, 0x5c, 0x0b, 0x4d, 0x79, 0xcf, 0xc6,
-	0xf1, 0xb6, 0x94, 0xdb, 0xb7, 0xf6, 0x2d, 0x4a, 0xdb, 0xf2, 0x9e, 0x7c, 0xb6, 0x54, 0xd8, 0xb7,
-	0xac, 0xfd, 0x43, 0xb2, 0x45, 0x47, 0x7b, 0x83, 0xfb, 0x5b, 0xae, 0x79, 0x44, 0x1c, 0xb7, 0x7d,
-	0xd4, 0xf7, 0x01, 0xf2, 0xf3, 0x30, 0x5f, 0xec, 0xb8, 0xe6, 0x71, 0xdb, 0x25, 0x98, 0xbc, 0x39,
-	0x20, 0x8e, 0x8b, 0x56, 0x01, 0x6c, 0xcb, 0x72, 0x0d, 0xd7, 0x7a, 0x40, 0x7a, 0x79, 0x61, 0x5d,
-	0xb8, 0x96, 0xc5, 0x59, 0x8f, 0xa2, 0x7b, 0x04, 0xf9, 0x05, 0x10, 0x23, 0x09, 0xa7, 0x6f, 0xf5,
-	0x1c, 0xe2, 0x89, 0xf4, 0xdb, 0x9d, 0x83, 0xb8, 0x88, 0x47, 0xf1, 0x45, 0x16, 0xe0, 0x42, 0x85,
-	0xb4, 0xe3, 0x66, 0xe4, 0x1c, 0x20, 0x9e, 0xe8, 0x6b, 0x92, 0x3f, 0x05, 0x4b, 0xd8, 0x72, 0x3d,
-	0x4a, 0x60, 0xf0, 0x09, 0xdd, 0xba, 0x09, 0xcb, 0x43, 0x82, 0x91, 0x77, 0x67, 0x49, 0xfe, 0x62,
-	0x0c, 0xa0, 0xa1, 0x56, 0xca, 0x65, 0xab, 0x77, 0xdf, 0xdc, 0x47, 0x4b, 0x30, 0x69, 0x3a, 0xce,
-	0x80, 0xd8, 0x0c, 0xc9, 0x46, 0xe8, 0x3a, 0x64, 0x3b, 0x87, 0x26, 0xe9, 0xb9, 0x86, 0xd9, 0xcd,
-	0x8f, 0x79, 0xac, 0xd2, 0xcc, 0xe9, 0xa3, 0x42, 0xa6, 0x4c, 0x89, 0x6a, 0x05, 0x67, 0x7c, 0xb6,
-	0xda, 0x45, 0x97, 0x61, 0x96, 0x41, 0x1d, 0xd2, 0xb1, 0x89, 0x9b, 0x1f, 0xa7, 0x9a, 0x66, 0x7c,
-	0xa2, 0x46, 0x69, 0x68, 0x1b, 0x66, 0x6c, 0xd2, 0x35, 0x6d, 0xd2, 0x71, 0x8d, 0x81, 0x6d, 0xe6,
-	0xcf, 0x53, 0x95, 0xf3, 0xa7, 0x8f, 0x0a, 0xd3, 0x98, 0xd1, 0x5b, 0x58, 0xc5, 0xd3, 0x01, 0xa8,
-	0x65, 0x9b, 0x9e, 0x6f, 0x4e, 0xc7, 0xea, 0x13, 0x27, 0x3f, 0xb1, 0x3e, 0xee, 0xf9, 0xe6, 0x8f,
-	0xd0, 0x27, 0x60, 0xc9, 0x26, 0x6f, 0x0e, 0x4c, 0x9b, 0x18, 0xe4, 0xa8, 0x6d, 0x1e, 0x1a, 0xc7,
-	0xc4, 0x36, 0xef, 0x9b, 0xa4, 0x9b, 0x9f, 0x5c, 0x17, 0xae, 0x65, 0x70, 0x8e, 0x71, 0x15, 0x8f,
-	0x79, 0x87, 0xf1, 0xd0, 0x75, 0x10, 0x0f, 0xad, 0x4e, 0xfb, 0xf0, 0xc0, 0x72, 0x5c, 0x83, 0xcd,
-	0x79, 0x8a, 0xe2, 0xe7, 0x43, 0xba, 0x4a, 0xc9, 0xf2, 0x0a, 0x2c, 0x57, 0x89, 0xeb, 0x47, 0x68,
-	0x60, 0xb7, 0x5d, 0xd3, 0x0a, 0xd6, 0x45, 0x6e, 0x41, 0x7e, 0x98, 0xc5, 0x22, 0xff, 0x32, 0xcc,
-	0x76, 0x78, 0x06, 0x0d, 0xe9, 0xf4, 0xf6, 0xc2, 0x26, 0xcb, 0xda, 0xcd, 0x28, 0xee, 0x38, 0x8e,
-	0x94, 0x75, 0x58, 0xd6, 0xd2, 0x2d, 0x7e, 0x14, 0xad, 0x12, 0xe4, 0xb5, 0x11, 0xce, 0xca, 0xbf,
-	0x12, 0x20, 0x4b, 0x33, 0x42, 0xed, 0xdd, 0xb7, 0x50, 0x1e, 0xa6, 0x9c, 0xc1, 0xde, 0xe7, 0x49,
-	0xc7, 0x65, 0x79, 0x10, 0x0c, 0x91, 0x06, 0x40, 0x1e, 0xf6, 0x4d, 0x66, 0x7b, 0x8c, 0xda, 0x96,
-	0x36, 0xfd, 0x8d, 0xb6, 0x19, 0x6c, 0xb4, 0x4d, 0x3d, 0xd8, 0x68, 0xa5, 0xe5, 0x7f, 0x3f, 0x2a,
-	0xcc, 0x77, 0xf7, 0x5e, 0x91, 0x23, 0x29, 0xf9, 0xbd, 0x7f, 0x16, 0x04, 0xcc, 0xa9, 0x41, 0x9f,
-	0x84, 0x99, 0x83, 0xb6, 0x73, 0x40, 0xba, 0x2c, 0x4b, 0x69, 0xc6, 0x94, 0x16, 0x02, 0x51, 0x4a,
-	0x34, 0x3c, 0x84, 0x8c, 0xa7, 0x7d, 0xa0, 0x9f, 0xbc, 0x9f, 0x85, 0x85, 0xe2, 0xc0, 0x3d, 0x20,
-	0x3d, 0xd7, 0xec, 0x70, 0x7b, 0xf8, 0xff, 0x01, 0x2c, 0xb3, 0xdb, 0x31, 0x1c, 0x6f, 0x47, 0xf8,
-	0x13, 0x28, 0xcd, 0x9e, 0x3e, 0x2a, 0x64, 0xbd, 0xd0, 0x68, 0x74, 0x9b, 0x64, 0x3d, 0x00, 0x7d,
-	0x44, 0x2b, 0x90, 0x31, 0x03, 0xc3, 0x63, 0xfe, 0x64, 0x4d, 0xa6, 0xff, 0x25, 0xc8, 0xc5, 0xf5,
-	0x3f, 0xd9, 0x8e, 0x9f, 0x87, 0xd9, 0xbb, 0x07, 0x56, 0xf1, 0x48, 0x0d, 0xb2, 0xe4, 0x1d, 0x01,
-	0xe6, 0x02, 0x0a, 0x53, 0x21, 0x41, 0x66, 0xe0, 0x10, 0xbb, 0xd7, 0x3e, 0x62, 0x1e, 0xe2, 0x70,
-	0xfc, 0xb1, 0xc4, 0x58, 0xd6, 0xe0, 0x52, 0x95, 0xb8, 0xd8, 0x3a, 0x24, 0xce, 0x8e, 0x65, 0x37,
-	0x89, 0x7d, 0x64, 0x3a, 0x0e, 0x97, 0x57, 0x2f, 0x02, 0xf4, 0x43, 0x22, 0x75, 0x69, 0x8e, 0x4b,
-	0x2a, 0x0e, 0xcf, 0xc1, 0xe4, 0x0a, 0xac, 0x8e, 0x50, 0xca, 0xa6, 0x79, 0x19, 0x26, 0x6c, 0x8f,
-	0x9b, 0x17, 0xd6, 0xc7, 0xaf, 0x4d, 0x6f, 0xcf, 0x86, 0x0a, 0x3d, 0x19, 0xec, 0xf3, 0x64, 0x1b,
-	0x26, 0xa8, 0x0a, 0xb4, 0x15, 0x47, 0xaf, 0xc4, 0xd0, 0x8e, 0xff, 0x57, 0xe9, 0xb9, 0xf6, 0x09,
-	0x93, 0x94, 0x6e, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xf8, 0x03, 0x72, 0xc2, 0xc2, 0xe9, 0x3d, 0xa2,
-	0x1c, 0x4c, 0x1c, 0xb7, 0x0f, 0x07, 0x84, 0x06, 0x31, 0x83, 0xfd, 0xc1, 0x2b, 0x63, 0x37, 0x05,
-	0xf9, 0xe7, 0x02, 0x4c, 0x7b, 0xa2, 0x25, 0xb3, 0xd7, 0x35, 0x7b, 0xfb, 0xe8, 0x55, 0x98, 0x22,
-	0x3d, 0xd7, 0x36, 0x43, 0xe3, 0x1b, 0x31, 0xe3, 0x0c, 0xb6, 0xa9, 0xf8, 0x18, 0xdf, 0x89, 0x40,
-	0x42, 0x7a, 0x0d, 0x66, 0x78, 0x46, 0x8a, 0x23, 0xcf, 0xf0, 0x8e, 0x4c, 0x6f, 0xcf, 0xc5, 0x67,
-	0xc6, 0x3b, 0xa6, 0x42, 0x06, 0x13, 0xc7, 0x1a, 0xd8, 0x1d, 0x82, 0xae, 0xc3, 0x79, 0xf7, 0xa4,
-	0x4f, 0xd8, 0x6a, 0x2c, 0x46, 0x42, 0x0c, 0xa0, 0x9f, 0xf4, 0x09, 0xa6, 0x10, 0x84, 0xe0, 0x3c,
-	0xcd, 0x25, 0x3f, 0x83, 0xe9, 0xb3, 0xfc, 0x35, 0x01, 0x26, 0x5a, 0x0e, 0xb1, 0x1d, 0xf4, 0x2a,
-	0x64, 0x83, 0xec, 0x0a, 0xe6, 0xb7, 0x1a, 0x6a, 0xa3, 0x10, 0xfa, 0x97, 0xf2, 0xfd, 0xb9, 0x45,
-	0x78, 0xe9, 0x16, 0xcc, 0xc5, 0x99, 0x1f, 0x2a, 0xd0, 0x0f, 0x61, 0xb2, 0x6a, 0x5b, 0x83, 0xbe,
-	0x83, 0x5e, 0x84, 0xc9, 0x7d, 0xfa, 0xc4, 0x3c, 0xb8, 0x18, 0x7a, 0xe0, 0x03, 0xd8, 0x3f, 0xdf,
-	0x3e, 0x83, 0x4a, 0x2f, 0xc3, 0x34, 0x47, 0xfe, 0x50, 0x96, 0xdf, 0x15, 0xe0, 0xbc, 0x17, 0xde,
-	0x30, 0x36, 0x42, 0x14, 0x1b, 0xf4, 0x12, 0x4c, 0x47, 0x79, 0xec, 0xe4, 0xc7, 0xd6, 0xc7, 0x47,
-	0xe5, 0x3b, 0x8f, 0x43, 0xb7, 0x60, 0xce, 0x66, 0xc1, 0x37, 0xbc, 0xb8, 0x3b, 0xf9, 0x71, 0x2a,
-	0x39, 0x62, 0x6d, 0x66, 0x6d, 0x6e, 0xe4, 0xc8, 0x0f, 0x41, 0xf4, 0xce, 0x13, 0xcb, 0x36, 0xdf,
-	0x0a, 0x0f, 0xab, 0xe7, 0x20, 0x13, 0x80, 0xd8, 0x51, 0x7e, 0x61, 0x48, 0x17, 0x0e, 0x21, 0x4f,
-	0xe9, 0xb7, 0xfc, 0x6b, 0x01, 0x2e, 0x70, 0xa6, 0xd9, 0xee, 0x5c, 0x03, 0x68, 0x07, 0xc4, 0x2e,
-	0xb5, 0x9e, 0xc1, 0x1c, 0x05, 0xbd, 0x00, 0x59, 0xa7, 0xed, 0x9a, 0x0e, 0x7d, 0x99, 0x9e, 0x61,
-	0x2a, 0x42, 0xa1, 0xe7, 0x60, 0x8a, 0x52, 0x7b, 0xfb, 0x2c, 0x32, 0xa9, 0x02, 0x01, 0x06, 0x5d,
-	0x82, 0x6c, 0xdf, 0x36, 0x7b, 0x1d, 0xb3, 0xdf, 0x3e, 0xf4, 0x8b, 0x00, 0x1c, 0x11, 0xe4, 0x1d,
-	0x58, 0xac, 0x12, 0x37, 0x92, 0x73, 0x9e, 0x2e, 0x68, 0x72, 0x1f, 0x36, 0xe2, 0x7a, 0xbc, 0xc3,
-	0x2a, 0xb0, 0xf2, 0x94, 0x0b, 0x11, 0xf3, 0x7c, 0x2c, 0xe9, 0x39, 0x81, 0xa5, 0xa4, 0xe7, 0x2c,
-	0xe6, 0x89, 0x05, 0x14, 0x9e, 0x30, 0xf1, 0x72, 0xc1, 0xd1, 0x38, 0x46, 0x6b, 0x1f, 0x76, 0x72,
-	0xbe, 0x0d, 0xf9, 0x5d, 0xab, 0x6b, 0xde, 0x3f, 0xe1, 0xce, 0xa8, 0x8f, 0x63, 0x3e, 0x91, 0xf9,
-	0x71, 0xde, 0xfc, 0x45, 0x58, 0x49, 0x31, 0xcf, 0x2a, 0x0a, 0x7f, 0xf1, 0x3e, 0xb2, 0x63, 0xf2,
-	0x6d, 0x1a, 0xca, 0x14, 0x0b, 0x68, 0x13, 0xa6, 0xf6, 0x7c, 0x12, 0xd3, 0x93, 0x4b, 0x3b, 0xb3,
-	0x71, 0x00, 0x92, 0x3f, 0x07, 0xd3, 0x1a, 0xa1, 0xf1, 0xa4, 0x45, 0x4e, 0x0e, 0x26, 0x7a, 0x56,
-	0xaf, 0x13, 0x9c, 0x0b, 0xfe, 0xc0, 0xa3, 0xd2, 0x2a, 0x92, 0xc5, 0xc0, 0x1f, 0xa0, 0x2b, 0x30,
-	0xd7, 0xb1, 0x7a, 0xc7, 0xc4, 0xf6, 0xa4, 0x0d, 0x62, 0xdb, 0xb4, 0x46, 0xc9, 0xd0, 0x0a, 0x8b,
-	0x51, 0x15, 0xdb, 0x96, 0x17, 0x61, 0xa1, 0x4a, 0x5c, 0xaf, 0xcc, 0xa8, 0x59, 0xfb, 0x66, 0x58,
-	0x25, 0xde, 0x85, 0x5c, 0x9c, 0xcc, 0x26, 0x70, 0x1d, 0xb2, 0x87, 0x1e, 0xc1, 0x18, 0xd8, 0x87,
-	0xac, 0x4e, 0xa1, 0x55, 0x35, 0x45, 0xb5, 0x70, 0x0d, 0x67, 0x28, 0xbb, 0x65, 0xd3, 0x05, 0xf0,
-	0xcb, 0x19, 0xe6, 0x16, 0x1d, 0xc8, 0x55, 0xaa, 0x18, 0x5b, 0x7b, 0x89, 0xeb, 0x02, 0x5d, 0xae,
-	0x3d, 0x2b, 0xa8, 0xde, 0xfc, 0x01, 0x5a, 0x81, 0x71, 0xd7, 0xf5, 0x27, 0x36, 0x5e, 0x9a, 0x3a,
-	0x7d, 0x54, 0x18, 0xd7, 0xf5, 0x1a, 0xf6, 0x68, 0xf2, 0x73, 0x6c, 0xb1, 0xf6, 0x92, 0xd7, 0x87,
-	0x1c, 0x4c, 0xf0, 0x55, 0x8e, 0x3f, 0x90, 0x37, 0x61, 0x09, 0x93, 0x63, 0xeb, 0x01, 0xf1, 0xce,
-	0x94, 0xa4, 0xe5, 0x14, 0xfc, 0x0a, 0x2c, 0x0f, 0xe1, 0x59, 0x9a, 0xec, 0xd2, 0x52, 0xd7, 0x3f,
-	0xe3, 0x77, 0x2c, 0xdb, 0x7b, 0xd3, 0x04, 0xba, 0xce, 0xaa, 0x91, 0x96, 0xc2, 0x97, 0x89, 0xbf,
-	0x21, 0xd8, 0x88, 0xd5, 0xb8, 0x09, 0x75, 0xcc, 0xd4, 0x1d, 0xc8, 0xf9, 0xe9, 0xba, 0x4b, 0x8e,
-	0xf6, 0x88, 0xed, 0x70, 0x3e, 0x53, 0xe9, 0xc0, 0x67, 0x3a, 0xf0, 0x5e, 0x35, 0xed, 0x6e, 0x97,
-	0xa9, 0xf7, 0x1e, 0x3d, 0x9b, 0x36, 0x39, 0xb2, 0x8e, 0x09, 0xdb, 0x05, 0x6c, 0x24, 0x2f, 0xc3,
-	0x62, 0x42, 0x2f, 0x33, 0x88, 0x40, 0xac, 0x06, 0xce, 0x04, 0xb9, 0x70, 0x8b, 0xd6, 0x61, 0xa1,
-	0x83, 0x43, 0xc7, 0x50, 0x6c, 0x1f, 0x0a, 0xc9, 0x73, 0xe5, 0xff, 0xe0, 0x02, 0xa7, 0x91, 0xad,
-	0xd1, 0x52, 0xec, 0xc5, 0x1a, 0xc5, 0xe2, 0x2a, 0xcc, 0x57, 0x89, 0x4b, 0x5f, 0xef, 0x67, 0x4e,
-	0x55, 0x7e, 0x9e, 0xfa, 0xc9, 0x80, 0x4c, 0xe9, 0xa5, 0x64, 0xc9, 0x90, 0xe5, 0x6a, 0x02, 0x2f,
-	0xcc, 0xca, 0x43, 0xd7, 0x6e, 0x77, 0xdc, 0x70, 0x45, 0xc3, 0x19, 0x56, 0x61, 0x25, 0x85, 0xc7,
-	0xd4, 0xde, 0x80, 0x49, 0x9a, 0x12, 0x41, 0x11, 0x80, 0xc2, 0x2d, 0x1b, 0xde, 0x3e, 0x30, 0x43,
-	0xc8, 0x65, 0x2f, 0x6b, 0x1c, 0xd7, 0xb2, 0x87, 0xd3, 0xec, 0x1a, 0x9f, 0x66, 0xe9, 0x5a, 0x58,
-	0xea, 0x49, 0x90, 0x1f, 0x56, 0xc2, 0xd6, 0xe7, 0x16, 0xac, 0x25, 0xd2, 0xf2, 0x43, 0xa4, 0xa0,
-	0xbc, 0x01, 0x85, 0x91, 0xd2, 0xcc, 0xc0, 0x3a, 0xac, 0x55, 0xc8, 0x21, 0x71, 0x89, 0xe2, 0x15,
-	0xe2, 0xa4, 0x3b, 0x1c, 0xac, 0x0d, 0x28, 0x8c, 0x44, 0xf8, 0x4a, 0x6e, 0xfc, 0x67, 0x0e, 0x20,
-	0x7a, 0x2d, 0xa0, 0x25, 0x40, 0x4d, 0x05, 0xef, 0xaa, 0x9a, 0xa6, 0x36, 0xea, 0x46, 0xab, 0xfe,
-	0x7a, 0xbd, 0x71, 0xb7, 0x2e, 0x9e, 0x43, 0x17, 0x61, 0xb9, 0x5c, 0x6b, 0x69, 0xba, 0x82, 0x8d,
-	0xdd, 0x46, 0x45, 0xdd, 0xb9, 0x67, 0x94, 0xd4, 0x7a, 0x45, 0xad, 0x57, 0x35, 0xb1, 0x8b, 0xf2,
-	0x90, 0x0b, 0x98, 0x55, 0x45, 0x8f, 0x38, 0x04, 0x5d, 0x84, 0x25, 0x9e, 0xd3, 0x2c, 0x96, 0x6f,
-	0x57, 0x8c, 0x5a, 0xa3, 0xaa, 0x89, 0x3f, 0x13, 0xd0, 0x0a, 0x2c, 0x06, 0xcc, 0x62, 0x4b, 0xbf,
-	0x6d, 0x14, 0xcb, 0xba, 0x7a, 0xa7, 0xa8, 0x2b, 0xe2, 0x7d, 0xde, 0x1c, 0x65, 0x55, 0x94, 0x90,
-	0xb9, 0x3f, 0xc4, 0xf4, 0x34, 0x97, 0x1b, 0xf5, 0x1d, 0xb5, 0x2a, 0x1e, 0x0c, 0x31, 0xb5, 0x88,
-	0x69, 0xa2, 0x0d, 0xb8, 0x34, 0x24, 0x89, 0x1b, 0xa5, 0x86, 0x6e, 0xe8, 0x8d, 0xd7, 0x95, 0xba,
-	0xf8, 0x7d, 0x01, 0x5d, 0x81, 0x8d, 0x18, 0x84, 0xcd, 0xb6, 0x8a, 0x1b, 0xad, 0xa6, 0xb1, 0xab,
-	0xec, 0x96, 0x14, 0xac, 0x89, 0x47, 0xa9, 0x3e, 0x50, 0x8c, 0x26, 0xf6, 0xd0, 0x7a, 0x8a, 0x19,
-	0x5f, 0x41, 0x4b, 0xf3, 0xc4, 0x2d, 0x54, 0x80, 0x8b, 0x31, 0x84, 0xf2, 0x86, 0x8e, 0x8b, 0x65,
-	0xe6, 0x86, 0x26, 0xf6, 0xd1, 0x1a, 0x48, 0x31, 0x00, 0x56, 0x34, 0xbd, 0x81, 0x15, 0xe6, 0xe7,
-	0x9b, 0x68, 0x0b, 0x6e, 0x0c, 0x99, 0x88, 0x16, 0x4e, 0x33, 0x76, 0x1a, 0xd8, 0x68, 0x62, 0xb5,
-	0x5e, 0x56, 0x9b, 0xc5, 0x9a, 0xf8, 0x43, 0x01, 0x5d, 0x05, 0x39, 0x11, 0xd1, 0x9a, 0xa2, 0x2b,
-	0x86, 0xf2, 0x46, 0x53, 0xc5, 0x4a, 0x25, 0x30, 0xfc, 0x03, 0x01, 0x3d, 0x03, 0x85, 0x84, 0xe5,
-	0x3b, 0x8d, 0xd7, 0x15, 0xea, 0x79, 0x80, 0xfa, 0x91, 0x80, 0x2e, 0xc3, 0x5a, 0x1c, 0xd5, 0xd0,
-	0x8b, 0xba, 0x62, 0xe0, 0x46, 0x18, 0xcb, 0x9f, 0x0a, 0xfc, 0x2c, 0x95, 0xba, 0xae, 0xe0, 0x26,
-	0x56, 0x35, 0x25, 0x5a, 0x66, 0x9b, 0x0f, 0x14, 0x07, 0xb8, 0xad, 0x14, 0xb1, 0x5e, 0x52, 0x8a,
-	0xba, 0xe8, 0x8c, 0x50, 0xe1, 0xaf, 0x78, 0x45, 0x11, 0x5d, 0xb4, 0x01, 0xab, 0x29, 0x00, 0x2e,
-	0x5f, 0x06, 0xbc, 0x0e, 0xb5, 0xa2, 0xd4, 0x75, 0x55, 0xbf, 0xc7, 0xa7, 0xc5, 0x71, 0x2a, 0x80,
-	0x4b, 0xaa, 0x2f, 0xa4, 0x02, 0xca, 0x58, 0xf1, 0x66, 0xac, 0x56, 0x9a, 0xe2, 0xc3, 0x54, 0x40,
-	0xab, 0x59, 0x09, 0x00, 0x27, 0xfc, 0x7a, 0x86, 0x80, 0x9a, 0xaa, 0xe9, 0x1e, 0x5b, 0x13, 0xdf,
-	0x42, 0x97, 0x20, 0x9f, 0xea, 0x82, 0x27, 0xfd, 0xc5, 0x54, 0xf5, 0x6c, 0x01, 0x3d, 0xc0, 0x97,
-	0xd0, 0x55, 0xb8, 0x3c, 0xca, 0x41, 0xaf, 0x30, 0x30, 0xca, 0x35, 0x55, 0xa9, 0xeb, 0xe2, 0xdb,
-	0xa9, 0x40, 0xe6, 0x28, 0x0f, 0xfc, 0x32, 0x7a, 0x36, 0xca, 0x97, 0xb8, 0xc3, 0x1c, 0x4c, 0x13,
-	0xbf, 0x82, 0xae, 0xc0, 0x7a, 0xaa, 0xe3, 0xbc, 0xb6, 0xaf, 0x0a, 0xe8, 0x5a, 0x8a, 0x5d, 0x36,
-	0x03, 0x1e, 0xf9, 0x8e, 0x80, 0x96, 0x01, 0x05, 0xc8, 0x8a, 0x52, 0x6a, 0x55, 0x8d, 0x4a, 0x6b,
-	0xb7, 0x29, 0x7e, 0x5d, 0x40, 0xab, 0x51, 0x88, 0x6a, 0x6a, 0x59, 0xa9, 0xf3, 0xa9, 0xf4, 0x8d,
-	0x54, 0x76, 0x98, 0x26, 0xdf, 0x14, 0xd0, 0x7a, 0x14, 0xc2, 0x50, 0xba, 0x52, 0x31, 0x18, 0x4d,
-	0xfc, 0x56, 0x2c, 0xa5, 0x03, 0x04, 0x8b, 0x4c, 0x00, 0xfa, 0x76, 0x2a, 0x88, 0x4d, 0x23, 0x00,
-	0x7d, 0x47, 0x40, 0x72, 0x94, 0x93, 0x01, 0x88, 0x86, 0x8e, 0x11, 0x35, 0xf1, 0xbb, 0x02, 0x92,
-	0xa2, 0xc3, 0x8f, 0x2d, 0x94, 0xa6, 0x94, 0xb1, 0xa2, 0x8b, 0xef, 0x7a, 0x07, 0x63, 0x2e, 0x92,
-	0xd7, 0x74, 0xc6, 0xd1, 0xc4, 0xf7, 0x04, 0x84, 0x60, 0xd6, 0x1f, 0x31, 0xb3, 0xe2, 0x8f, 0x05,
-	0xb4, 0x00, 0x73, 0x8c, 0xa6, 0xd6, 0xb5, 0xa6, 0x52, 0xd6, 0xc5, 0x9f, 0x24, 0xc2, 0x48, 0x1d,
-	0x2c, 0xd6, 0x6a, 0xe2, 0xf7, 0x04, 0x34, 0x07, 0x59, 0xac, 0x34, 0x1b, 0x06, 0x56, 0x8a, 0x15,
-	0xf1, 0x7d, 0x01, 0xcd, 0x03, 0xd0, 0xf1, 0x5d, 0xac, 0xea, 0x8a, 0xf8, 0x1b, 0x6a, 0x9d, 0x12,
-	0x92, 0xe7, 0xfc, 0x6f, 0x05, 0x24, 0xc2, 0x34, 0x65, 0x31, 0xdb, 0xbf, 0x13, 0x50, 0x1e, 0x16,
-	0x28, 0x85, 0x59, 0x36, 0xca, 0x8d, 0xdd, 0x5d, 0x55, 0x17, 0x7f, 0x2f, 0xa0, 0x45, 0x10, 0x29,
-	0xc7, 0x9f, 0xb9, 0x4f, 0xfe, 0x03, 0xf5, 0x8b, 0x53, 0x11, 0x30, 0xfe, 0x18, 0x31, 0x58, 0x34,
-	0x4a, 0xb8, 0x58, 0x2f, 0xdf, 0x16, 0xff, 0x94, 0x50, 0xc4, 0xc8, 0x1f, 0x0c, 0x29, 0x62, 0x8c,
-	0x3f, 0x0b, 0x68, 0x09, 0x2e, 0xc4, 0x5c, 0xda, 0x51, 0x6b, 0x8a, 0xf8, 0x17, 0x1a, 0xa6, 0x48,
-	0x0f, 0x25, 0xfe, 0x95, 0x66, 0x0d, 0x25, 0x7a, 0xb9, 0xd0, 0x54, 0x9b, 0x4a, 0x4d, 0xad, 0x2b,
-	0x34, 0x34, 0x0a, 0x16, 0xff, 0x46, 0xb3, 0x86, 0x05, 0x6b, 0xb7, 0x71, 0x47, 0x19, 0x42, 0xfc,
-	0x7d, 0x84, 0x02, 0x1a, 0x4b, 0x2c, 0xfe, 0x83, 0x3a, 0x13, 0x52, 0xa9, 0xe1, 0xd7, 0x1a, 0x25,
-	0xf1, 0x97, 0x63, 0x37, 0x1a, 0x30, 0xc3, 0xdf, 0xe5, 0xbd, 0x77, 0x21, 0x56, 0xb4, 0x46, 0x0b,
-	0x97, 0x15, 0x43, 0xbf, 0xd7, 0x54, 0xb8, 0x57, 0xef, 0x34, 0x4c, 0x05, 0xb9, 0x25, 0xa0, 0x0c,
-	0x9c, 0xf7, 0xcc, 0x89, 0x63, 0x68, 0x16, 0xb2, 0xde, 0xfc, 0x0c, 0x3a, 0x1c, 0xdf, 0xfe, 0xaf,
-	0x08, 0xe3, 0xc5, 0xa6, 0x8a, 0x8a, 0x90, 0x09, 0xbe, 0x21, 0xa0, 0x7c, 0x58, 0xb8, 0x24, 0x3e,
-	0x44, 0x48, 0x2b, 0x29, 0x1c, 0x56, 0x55, 0x9c, 0x43, 0x55, 0x80, 0xe8, 0xf3, 0x01, 0x92, 0x42,
-	0xe8, 0xd0, 0x87, 0x06, 0xe9, 0x62, 0x2a, 0x2f, 0x54, 0x74, 0x8f, 0x56, 0x7e, 0xb1, 0x96, 0x30,
-	0x5a, 0x8f, 0xfa, 0x32, 0xe9, 0x3d, 0x68, 0x69, 0xe3, 0x0c, 0x04, 0xaf, 0x5a, 0x1b, 0xad, 0x5a,
-	0x7b, 0xac, 0x6a, 0x6d, 0xb4, 0xea, 0x5d, 0x98, 0xe1, 0xfb, 0xb2, 0xe8, 0x52, 0x14, 0xab, 0xe1,
-	0x76, 0xb0, 0xb4, 0x3a, 0x82, 0x1b, 0xaa, 0xab, 0x40, 0x36, 0xec, 0x8d, 0xa0, 0x95, 0x18, 0x9a,
-	0x6f, 0xd5, 0x48, 0x52, 0x1a, 0x2b, 0xd4, 0xa2, 0xc1, 0x5c, 0xfc, 0xca, 0x8f, 0xd6, 0xf8, 0x30,
-	0x0d, 0x77, 0x31, 0xa4, 0xc2, 0x48, 0x7e, 0xa8, 0xf4, 0x01, 0x48, 0xa3, 0x3b, 0x17, 0xe8, 0xc6,
-	0x08, 0x05, 0x29, 0xf7, 0x8a, 0x27, 0x31, 0xf6, 0x2a, 0x4c, 0xfa, 0x5d, 0x6a, 0xb4, 0x14, 0x82,
-	0x63, 0x8d, 0x6c, 0x69, 0x79, 0x88, 0x1e, 0x0a, 0x1f, 0x84, 0xd7, 0xfd, 0x78, 0x2b, 0x18, 0x5d,
-	0xe1, 0x0d, 0x8f, 0xec, 0x3f, 0x4b, 0xcf, 0x3e, 0x0e, 0x16, 0x5a, 0xfa, 0x0c, 0x5c, 0x18, 0xea,
-	0x3a, 0xa0, 0x28, 0x6f, 0x46, 0x35, 0x44, 0x24, 0xf9, 0x2c, 0x48, 0x62, 0x19, 0x79, 0xd5, 0x6b,
-	0x49, 0xcf, 0x12, 0x7a, 0x0b, 0x23, 0xf9, 0x7c, 0xc2, 0xf2, 0x0d, 0x00, 0x2e, 0x61, 0x53, 0xda,
-	0x05, 0x5c, 0xc2, 0xa6, 0x75, 0x0d, 0xe4, 0x73, 0xa8, 0x09, 0xb3, 0xb1, 0xdb, 0x3a, 0x5a, 0x8d,
-	0xbb, 0x90, 0x68, 0x07, 0x48, 0x6b, 0xa3, 0xd8, 0xa1, 0xc6, 0x3b, 0x30, 0x9f, 0xb8, 0xcb, 0xa0,
-	0x02, 0xd7, 0x94, 0x49, 0xbb, 0xea, 0x4b, 0xeb, 0xa3, 0x01, 0xa1, 0xde, 0xde, 0xd0, 0xc5, 0x3f,
-	0xb8, 0x23, 0xa1, 0xab, 0xa3, 0xc4, 0x13, 0x77, 0x30, 0xe9, 0xda, 0xe3, 0x81, 0x89, 0x43, 0x27,
-	0x76, 0xfd, 0x8f, 0x1f, 0x3a, 0x69, 0x8d, 0x86, 0xf8, 0xa1, 0x93, 0xde, 0x3b, 0xa0, 0x41, 0x8f,
-	0xdd, 0xf2, 0xb9, 0xa0, 0xa7, 0x75, 0x15, 0xb8, 0xa0, 0xa7, 0x37, 0x07, 0xe8, 0xb9, 0x13, 0x5e,
-	0xe6, 0xb9, 0x73, 0x27, 0xd9, 0x32, 0xe0, 0xce, 0x9d, 0xa1, 0xbb, 0x3f, 0xdd, 0x0e, 0x8b, 0xa9,
-	0x0d, 0x85, 0xf8, 0xc6, 0x1b, 0xd9, 0x70, 0x78, 0x8c, 0xf6, 0x22, 0x64, 0x82, 0xd6, 0x00, 0xf7,
-	0xb2, 0x4a, 0xb4, 0x15, 0xa4, 0x95, 0x14, 0x0e, 0xbf, 0x5f, 0x87, 0xfa, 0x01, 0xdc, 0x7e, 0x1d,
-	0xd5, 0x47, 0xe0, 0xf6, 0xeb, 0xc8, 0x76, 0x82, 0xbf, 0xe2, 0xc9, 0xfb, 0x3d, 0xe2, 0x33, 0x33,
-	0xb5, 0x7f, 0xc0, 0xad, 0xf8, 0xc8, 0xe6, 0x00, 0x4d, 0xde, 0x11, 0x77, 0x73, 0x2e, 0x79, 0xcf,
-	0xbe, 0xdf, 0x73, 0xc9, 0xfb, 0x98, 0x6b, 0x3e, 0xdb, 0x84, 0xf1, 0xaf, 0xf8, 0xfc, 0x26, 0x4c,
-	0xfd, 0x61, 0x00, 0xbf, 0x09, 0xd3, 0x7f, 0x00, 0x20, 0x9f, 0x2b, 0xdd, 0x7c, 0xff, 0x74, 0x4d,
-	0xf8, 0xe0, 0x74, 0x4d, 0xf8, 0xd7, 0xe9, 0x9a, 0xf0, 0xe9, 0x1b, 0xfb, 0xa6, 0x7b, 0x30, 0xd8,
-	0xdb, 0xec, 0x58, 0x47, 0x5b, 0xfd, 0x76, 0xe7, 0xe0, 0xa4, 0x4b, 0x6c, 0xfe, 0xe9, 0x78, 0x7b,
-	0xcb, 0xb1, 0x3b, 0xf4, 0x67, 0x16, 0x7b, 0x93, 0xf4, 0x6b, 0xe3, 0x8b, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xc2, 0x55, 0x0d, 0x17, 0x7a, 0x21, 0x00, 0x00,
+	// 2946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xd9, 0x77, 0xdb, 0xc6,
+	0xd5, 0x0f, 0x24, 0xdb, 0xa2, 0xae, 0x2c, 0x09, 0x1a, 0x6d, 0x14, 0xb4, 0x50, 0x82, 0xe3, 0x78,
+	0xf9, 0xbe, 0x48, 0x89, 0xf3, 0x25, 0x9f, 0x93, 0xf8, 0x85, 0x0b, 0x4c, 0x23, 0xa1, 0x48, 0x1e,
+	0x00, 0xb4, 0xe3, 0x9e, 0x9e, 0xa2, 0x14, 0x39, 0x96, 0x50, 0x53, 0x04, 0x03, 0x80, 0xaa, 0x9d,
+	0x36, 0x6d, 0xd3, 0x7d, 0x6f, 0xba, 0x3f, 0xf7, 0xb1, 0x0f, 0x7d, 0x69, 0xff, 0x89, 0x74, 0x4f,
+	0xf7, 0xf6, 0xc5, 0xed, 0xd1, 0xe9, 0x5f, 0xd0, 0xbf, 0xa0, 0x67, 0x06, 0x03, 0x60, 0x00, 0x02,
+	0xf2, 0x92, 0x93, 0x17, 0x9b, 0x73, 0xef, 0xef, 0xfe, 0xee, 0x9d, 0x3b, 0x77, 0x06, 0x83, 0x0b,
+	0xc1, 0x6c, 0x7b, 0xe8, 0x1d, 0xec, 0x90, 0x7f, 0xb6, 0x07, 0x8e, 0xed, 0xd9, 0x68, 0x82, 0xfc,
+	0x36, 0x8f, 0xae, 0x48, 0x0b, 0xfb, 0xf6, 0xbe, 0x4d, 0x65, 0x3b, 0xe4, 0x97, 0xaf, 0x96, 0x0a,
+	0xfb, 0xb6, 0xbd, 0xdf, 0xc3, 0x3b, 0x74, 0xb4, 0x37, 0xbc, 0xb3, 0xe3, 0x59, 0x87, 0xd8, 0xf5,
+	0xda, 0x87, 0x03, 0x1f, 0x20, 0x3f, 0x07, 0xb3, 0xc5, 0x8e, 0x67, 0x1d, 0xb5, 0x3d, 0xac, 0xe1,
+	0x37, 0x87, 0xd8, 0xf5, 0xd0, 0x3a, 0x80, 0x63, 0xdb, 0x9e, 0xe9, 0xd9, 0x77, 0x71, 0x3f, 0x2f,
+	0x6c, 0x0a, 0x17, 0x27, 0xb5, 0x49, 0x22, 0x31, 0x88, 0x40, 0x7e, 0x1e, 0xc4, 0xc8, 0xc2, 0x1d,
+	0xd8, 0x7d, 0x17, 0x13, 0x93, 0x41, 0xbb, 0x73, 0x10, 0x37, 0x21, 0x12, 0xdf, 0x64, 0x1e, 0xe6,
+	0x2a, 0xb8, 0x1d, 0x77, 0x23, 0x2f, 0x00, 0xe2, 0x85, 0x3e, 0x93, 0xfc, 0xff, 0xb0, 0xa4, 0xd9,
+	0x1e, 0x91, 0x04, 0x0e, 0x1f, 0x31, 0xac, 0xab, 0xb0, 0x3c, 0x62, 0x18, 0x45, 0x77, 0x92, 0xe5,
+	0x4f, 0xc6, 0x00, 0x1a, 0x6a, 0xa5, 0x5c, 0xb6, 0xfb, 0x77, 0xac, 0x7d, 0xb4, 0x04, 0x67, 0x2c,
+	0xd7, 0x1d, 0x62, 0x87, 0x21, 0xd9, 0x08, 0x5d, 0x82, 0xc9, 0x4e, 0xcf, 0xc2, 0x7d, 0xcf, 0xb4,
+	0xba, 0xf9, 0x31, 0xa2, 0x2a, 0x9d, 0x3d, 0x7e, 0x50, 0xc8, 0x95, 0xa9, 0x50, 0xad, 0x68, 0x39,
+	0x5f, 0xad, 0x76, 0xd1, 0x39, 0x98, 0x66, 0x50, 0x17, 0x77, 0x1c, 0xec, 0xe5, 0xc7, 0x29, 0xd3,
+	0x59, 0x5f, 0xa8, 0x53, 0x19, 0xba, 0x02, 0x67, 0x1d, 0xdc, 0xb5, 0x1c, 0xdc, 0xf1, 0xcc, 0xa1,
+	0x63, 0xe5, 0x4f, 0x51, 0xca, 0xd9, 0xe3, 0x07, 0x85, 0x29, 0x8d, 0xc9, 0x5b, 0x9a, 0xaa, 0x4d,
+	0x05, 0xa0, 0x96, 0x63, 0x91, 0xd8, 0xdc, 0x8e, 0x3d, 0xc0, 0x6e, 0xfe, 0xf4, 0xe6, 0x38, 0x89,
+	0xcd, 0x1f, 0xa1, 0xff, 0x83, 0x25, 0x07, 0xbf, 0x39, 0xb4, 0x1c, 0x6c, 0xe2, 0xc3, 0xb6, 0xd5,
+	0x33, 0x8f, 0xb0, 0x63, 0xdd, 0xb1, 0x70, 0x37, 0x7f, 0x66, 0x53, 0xb8, 0x98, 0xd3, 0x16, 0x98,
+	0x56, 0x21, 0xca, 0x9b, 0x4c, 0x87, 0x2e, 0x81, 0xd8, 0xb3, 0x3b, 0xed, 0xde, 0x81, 0xed, 0x7a,
+	0x26, 0x9b, 0xf3, 0x04, 0xc5, 0xcf, 0x86, 0x72, 0x95, 0x8a, 0xe5, 0x15, 0x58, 0xae, 0x62, 0xcf,
+	0xcf, 0xd0, 0xd0, 0x69, 0x7b, 0x96, 0x1d, 0xac, 0x8b, 0xdc, 0x82, 0xfc, 0xa8, 0x8a, 0x65, 0xfe,
+	0x65, 0x98, 0xee, 0xf0, 0x0a, 0x9a, 0xd2, 0xa9, 0x2b, 0xf3, 0xdb, 0xac, 0x6a, 0xb7, 0xa3, 0xbc,
+	0x6b, 0x71, 0xa4, 0x6c, 0xc0, 0xb2, 0x9e, 0xee, 0xf1, 0x83, 0xb0, 0x4a, 0x90, 0xd7, 0x33, 0x82,
+	0x95, 0x7f, 0x2e, 0xc0, 0x24, 0xad, 0x08, 0xb5, 0x7f, 0xc7, 0x46, 0x79, 0x98, 0x70, 0x87, 0x7b,
+	0x9f, 0xc0, 0x1d, 0x8f, 0xd5, 0x41, 0x30, 0x44, 0x3a, 0x00, 0xbe, 0x37, 0xb0, 0x98, 0xef, 0x31,
+	0xea, 0x5b, 0xda, 0xf6, 0x37, 0xda, 0x76, 0xb0, 0xd1, 0xb6, 0x8d, 0x60, 0xa3, 0x95, 0x96, 0xff,
+	0xf3, 0xa0, 0x30, 0xdb, 0xdd, 0x7b, 0x45, 0x8e, 0xac, 0xe4, 0x77, 0xff, 0x59, 0x10, 0x34, 0x8e,
+	0x06, 0xbd, 0x04, 0x67, 0x0f, 0xda, 0xee, 0x01, 0xee, 0xb2, 0x2a, 0xa5, 0x15, 0x53, 0x9a, 0x0f,
+	0x4c, 0xa9, 0xd0, 0x24, 0x08, 0x59, 0x9b, 0xf2, 0x81, 0x7e, 0xf1, 0x7e, 0x0c, 0xe6, 0x8b, 0x43,
+	0xef, 0x00, 0xf7, 0x3d, 0xab, 0xc3, 0xed, 0xe1, 0xff, 0x05, 0xb0, 0xad, 0x6e, 0xc7, 0x74, 0xc9,
+	0x8e, 0xf0, 0x27, 0x50, 0x9a, 0x3e, 0x7e, 0x50, 0x98, 0x24, 0xa9, 0xd1, 0x89, 0x50, 0x9b, 0x24,
+	0x00, 0xfa, 0x13, 0xad, 0x40, 0xce, 0x0a, 0x1c, 0x8f, 0xf9, 0x93, 0xb5, 0x18, 0xff, 0x8b, 0xb0,
+	0x10, 0xe7, 0x7f, 0xb4, 0x1d, 0x3f, 0x0b, 0xd3, 0xb7, 0x0e, 0xec, 0xe2, 0xa1, 0x1a, 0x54, 0xc9,
+	0x3b, 0x02, 0xcc, 0x04, 0x12, 0x46, 0x21, 0x41, 0x6e, 0xe8, 0x62, 0xa7, 0xdf, 0x3e, 0x64, 0x11,
+	0x6a, 0xe1, 0xf8, 0x43, 0xc9, 0xb1, 0xac, 0xc3, 0x5a, 0x15, 0x7b, 0x9a, 0xdd, 0xc3, 0xee, 0x75,
+	0xdb, 0x69, 0x62, 0xe7, 0xd0, 0x72, 0x5d, 0xae, 0xae, 0x5e, 0x00, 0x18, 0x84, 0x42, 0x1a, 0xd2,
+	0x0c, 0x57, 0x54, 0x1c, 0x9e, 0x83, 0xc9, 0x15, 0x58, 0xcf, 0x20, 0x65, 0xd3, 0x3c, 0x07, 0xa7,
+	0x1d, 0xa2, 0xcd, 0x0b, 0x9b, 0xe3, 0x17, 0xa7, 0xae, 0x4c, 0x87, 0x84, 0xc4, 0x46, 0xf3, 0x75,
+	0xb2, 0x03, 0xa7, 0x29, 0x05, 0xda, 0x89, 0xa3, 0x57, 0x62, 0x68, 0xd7, 0xff, 0x57, 0xe9, 0x7b,
+	0xce, 0x7d, 0x66, 0x29, 0x5d, 0x05, 0x88, 0x84, 0x48, 0x84, 0xf1, 0xbb, 0xf8, 0x3e, 0x4b, 0x27,
+	0xf9, 0x89, 0x16, 0xe0, 0xf4, 0x51, 0xbb, 0x37, 0xc4, 0x34, 0x89, 0x39, 0xcd, 0x1f, 0xbc, 0x32,
+	0x76, 0x55, 0x90, 0x7f, 0x24, 0xc0, 0x14, 0x31, 0x2d, 0x59, 0xfd, 0xae, 0xd5, 0xdf, 0x47, 0xaf,
+	0xc2, 0x04, 0xee, 0x7b, 0x8e, 0x15, 0x3a, 0xdf, 0x8a, 0x39, 0x67, 0xb0, 0x6d, 0xc5, 0xc7, 0xf8,
+	0x41, 0x04, 0x16, 0xd2, 0x6b, 0x70, 0x96, 0x57, 0xa4, 0x04, 0xf2, 0x34, 0x1f, 0xc8, 0xd4, 0x95,
+	0x99, 0xf8, 0xcc, 0xf8, 0xc0, 0x54, 0xc8, 0x69, 0xd8, 0xb5, 0x87, 0x4e, 0x07, 0xa3, 0x4b, 0x70,
+	0xca, 0xbb, 0x3f, 0xc0, 0x6c, 0x35, 0x16, 0x23, 0x23, 0x06, 0x30, 0xee, 0x0f, 0xb0, 0x46, 0x21,
+	0x08, 0xc1, 0x29, 0x5a, 0x4b, 0x7e, 0x05, 0xd3, 0xdf, 0xf2, 0xe7, 0x05, 0x38, 0xdd, 0x72, 0xb1,
+	0xe3, 0xa2, 0x57, 0x61, 0x32, 0xa8, 0xae, 0x60, 0x7e, 0xeb, 0x21, 0x1b, 0x85, 0x6c, 0xb7, 0x02,
+	0xbd, 0x3f, 0xb7, 0x08, 0x2f, 0x5d, 0x83, 0x99, 0xb8, 0xf2, 0xb1, 0x12, 0x7d, 0x0f, 0xce, 0x54,
+	0x1d, 0x7b, 0x38, 0x70, 0xd1, 0x0b, 0x70, 0x66, 0x9f, 0xfe, 0x62, 0x11, 0xac, 0x86, 0x11, 0xf8,
+	0x00, 0xf6, 0x9f, 0xef, 0x9f, 0x41, 0xa5, 0x97, 0x61, 0x8a, 0x13, 0x3f, 0x96, 0xe7, 0x9f, 0x0a,
+	0x70, 0x8a, 0xa4, 0x37, 0xcc, 0x8d, 0x10, 0xe5, 0x06, 0xbd, 0x08, 0x53, 0x51, 0x1d, 0xbb, 0xf9,
+	0xb1, 0xcd, 0xf1, 0xac, 0x7a, 0xe7, 0x71, 0xe8, 0x1a, 0xcc, 0x38, 0x2c, 0xf9, 0x26, 0xc9, 0xbb,
+	0x9b, 0x1f, 0xdf, 0x1c, 0xcf, 0x5e, 0x9b, 0x69, 0x87, 0x1b, 0xb9, 0xe4, 0x58, 0xdd, 0x1b, 0x5a,
+	0x3d, 0xcf, 0xea, 0xd3, 0x07, 0x5e, 0x4e, 0x0b, 0x86, 0xf2, 0x3d, 0x10, 0xc9, 0x49, 0x63, 0x3b,
+	0xd6, 0x5b, 0xe1, 0x31, 0xf6, 0x2c, 0xe4, 0x02, 0x73, 0x76, 0xc8, 0xcf, 0x8d, 0x78, 0xd1, 0x42,
+	0xc8, 0x13, 0xce, 0x48, 0xfe, 0x85, 0x00, 0x73, 0x9c, 0x6b, 0xb6, 0x6f, 0x37, 0x00, 0xda, 0x81,
+	0xb0, 0x4b, 0xbd, 0xe7, 0x34, 0x4e, 0x82, 0x9e, 0x87, 0x49, 0xb7, 0xed, 0x59, 0x2e, 0x7d, 0xcc,
+	0x9e, 0xe0, 0x2a, 0x42, 0xa1, 0x67, 0x61, 0x82, 0x4a, 0xfb, 0xfb, 0xf9, 0xf1, 0x6c, 0x83, 0x00,
+	0x83, 0xd6, 0x60, 0x72, 0xe0, 0x58, 0xfd, 0x8e, 0x35, 0x68, 0xf7, 0xfc, 0xeb, 0x81, 0x16, 0x09,
+	0xe4, 0xeb, 0xb0, 0x58, 0xc5, 0x5e, 0x64, 0xe7, 0x3e, 0x59, 0xd2, 0xe4, 0x01, 0x6c, 0xc5, 0x79,
+	0xc8, 0x31, 0x16, 0x78, 0x79, 0xc2, 0x85, 0x88, 0x45, 0x3e, 0x96, 0x8c, 0x1c, 0xc3, 0x52, 0x32,
+	0x72, 0x96, 0xf3, 0xc4, 0x02, 0x0a, 0x8f, 0x58, 0x92, 0x0b, 0xc1, 0xa1, 0x39, 0x46, 0x6f, 0x45,
+	0xfe, 0x40, 0x7e, 0x1b, 0xf2, 0xbb, 0x76, 0xd7, 0xba, 0x73, 0x9f, 0x3b, 0xbd, 0x3e, 0x8c, 0xf9,
+	0x44, 0xee, 0xc7, 0x79, 0xf7, 0xab, 0xb0, 0x92, 0xe2, 0x9e, 0xdd, 0x35, 0xfc, 0xc5, 0xfb, 0xc0,
+	0x81, 0xc9, 0x37, 0x60, 0x29, 0xc9, 0xc3, 0x52, 0xb9, 0x0d, 0x13, 0x7b, 0xbe, 0x88, 0xf1, 0x2c,
+	0xa4, 0x9d, 0xe6, 0x5a, 0x00, 0x92, 0x5f, 0x82, 0xb9, 0xb2, 0x83, 0xe9, 0xfd, 0xb9, 0x17, 0xee,
+	0xbf, 0x2d, 0x38, 0x45, 0x26, 0xc3, 0x18, 0x12, 0x8f, 0x2e, 0xaa, 0x22, 0xd7, 0x78, 0xde, 0x8e,
+	0xcd, 0xef, 0x25, 0x98, 0x6b, 0x0d, 0xba, 0x4f, 0xc4, 0xc6, 0xdb, 0x31, 0xb6, 0x0b, 0xe4, 0xfd,
+	0xa1, 0x87, 0xe3, 0x6c, 0x29, 0x47, 0x9a, 0xff, 0x4e, 0xd1, 0xc3, 0x09, 0x73, 0x04, 0x62, 0xcd,
+	0x72, 0xfd, 0x67, 0x74, 0x70, 0x1f, 0xb9, 0x0a, 0x73, 0x9c, 0xec, 0x71, 0x1e, 0xd5, 0x1f, 0x87,
+	0x29, 0x1d, 0xd3, 0xc2, 0xa3, 0xf7, 0xc4, 0x05, 0x38, 0xdd, 0xb7, 0xfb, 0x9d, 0x20, 0x0e, 0x7f,
+	0x40, 0xa4, 0xf4, 0x22, 0xce, 0x8a, 0xc5, 0x1f, 0xa0, 0xf3, 0x30, 0xd3, 0xb1, 0xfb, 0x47, 0xd8,
+	0x21, 0xd6, 0x26, 0x76, 0x1c, 0x7a, 0xcd, 0xcb, 0x69, 0xd3, 0x91, 0x54, 0x71, 0x1c, 0x79, 0x11,
+	0xe6, 0xab, 0xd8, 0x23, 0x37, 0xb5, 0x9a, 0xbd, 0x6f, 0x85, 0x17, 0xed, 0x5b, 0xb0, 0x10, 0x17,
+	0xb3, 0xa8, 0x2f, 0xc1, 0x64, 0x8f, 0x08, 0xcc, 0xa1, 0xd3, 0xcb, 0x0b, 0xd1, 0x8b, 0x09, 0x45,
+	0xb5, 0xb4, 0x9a, 0x96, 0xa3, 0xea, 0x96, 0x43, 0x2b, 0xd5, 0xbf, 0x11, 0xb2, 0xb0, 0xe8, 0x40,
+	0xae, 0x52, 0x62, 0xcd, 0xde, 0x4b, 0xbc, 0x71, 0xd1, 0xba, 0xde, 0xb3, 0x83, 0x0b, 0xb0, 0x3f,
+	0x40, 0x2b, 0x30, 0xee, 0x79, 0xfe, 0xc4, 0xc6, 0x4b, 0x13, 0xc7, 0x0f, 0x0a, 0xe3, 0x86, 0x51,
+	0xd3, 0x88, 0x4c, 0x7e, 0x16, 0x16, 0x13, 0x44, 0x2c, 0xc4, 0x05, 0x38, 0xcd, 0x5f, 0x14, 0xfd,
+	0x81, 0xbc, 0x0d, 0x4b, 0x1a, 0x3e, 0xb2, 0xef, 0x62, 0x72, 0xf8, 0x26, 0x3d, 0xa7, 0xe0, 0x57,
+	0x60, 0x79, 0x04, 0xcf, 0x96, 0x78, 0x97, 0xbe, 0x2d, 0xf8, 0x8f, 0xc9, 0xeb, 0xb6, 0x43, 0x1e,
+	0xd6, 0x01, 0xd7, 0x49, 0xd7, 0xcc, 0xa5, 0xf0, 0x79, 0xec, 0x9f, 0x1c, 0x6c, 0xc4, 0x5e, 0x13,
+	0x12, 0x74, 0xcc, 0xd5, 0x4d, 0x58, 0xf0, 0xf7, 0xf5, 0x2e, 0x3e, 0xdc, 0xc3, 0x8e, 0xcb, 0xc5,
+	0x4c, 0xad, 0x83, 0x98, 0xe9, 0x80, 0x3c, 0xad, 0xdb, 0xdd, 0x2e, 0xa3, 0x27, 0x3f, 0x89, 0x4f,
+	0x07, 0x1f, 0xda, 0x47, 0x98, 0x1d, 0x17, 0x6c, 0x24, 0x2f, 0xc3, 0x62, 0x82, 0x37, 0x2a, 0xdf,
+	0x6a, 0x10, 0x4c, 0x50, 0x0b, 0xd7, 0x60, 0x2d, 0x94, 0xa5, 0x9d, 0xd7, 0xb1, 0x03, 0x4b, 0x48,
+	0x1e, 0xc0, 0xff, 0x03, 0x73, 0x1c, 0x23, 0x5b, 0xa3, 0xa5, 0xd8, 0xdd, 0x24, 0xca, 0xc5, 0x05,
+	0x98, 0xad, 0x62, 0x8f, 0xde, 0x90, 0x4e, 0x9c, 0xaa, 0xfc, 0x1c, 0x88, 0x11, 0x90, 0x91, 0xae,
+	0x25, 0x6f, 0x5d, 0x93, 0xdc, 0xb5, 0x8a, 0xa4, 0x59, 0xb9, 0xe7, 0x39, 0xed, 0x8e, 0x17, 0xae,
+	0x68, 0x38, 0xc3, 0x2a, 0xac, 0xa4, 0xe8, 0x18, 0xed, 0x65, 0x38, 0x43, 0x4b, 0x22, 0xd8, 0xa9,
+	0x28, 0xdc, 0xa9, 0xe1, 0x0b, 0x9c, 0xc6, 0x10, 0x72, 0x99, 0x54, 0x8d, 0xeb, 0xd9, 0xce, 0x68,
+	0x99, 0x5d, 0xe4, 0xcb, 0x2c, 0x9d, 0x85, 0x95, 0x9e, 0x04, 0xf9, 0x51, 0x12, 0xb6, 0x3e, 0xd7,
+	0x60, 0x23, 0x51, 0x96, 0x8f, 0x51, 0x82, 0xf2, 0x16, 0x14, 0x32, 0xad, 0x99, 0x83, 0x4d, 0xd8,
+	0xf0, 0x4f, 0x35, 0x85, 0xbc, 0xcb, 0xe0, 0xee, 0x68, 0xb2, 0xb6, 0xa0, 0x90, 0x89, 0xf0, 0x49,
+	0x2e, 0xff, 0x7b, 0x16, 0x20, 0x7a, 0x7e, 0xa2, 0x25, 0x40, 0x4d, 0x45, 0xdb, 0x55, 0x75, 0x5d,
+	0x6d, 0xd4, 0xcd, 0x56, 0xfd, 0xf5, 0x7a, 0xe3, 0x56, 0x5d, 0x7c, 0x0a, 0xad, 0xc2, 0x72, 0xb9,
+	0xd6, 0xd2, 0x0d, 0x45, 0x33, 0x77, 0x1b, 0x15, 0xf5, 0xfa, 0x6d, 0xb3, 0xa4, 0xd6, 0x2b, 0x6a,
+	0xbd, 0xaa, 0x8b, 0x5d, 0x94, 0x87, 0x85, 0x40, 0x59, 0x55, 0x8c, 0x48, 0x83, 0xd1, 0x2a, 0x2c,
+	0xf1, 0x9a, 0x66, 0xb1, 0x7c, 0xa3, 0x62, 0xd6, 0x1a, 0x55, 0x5d, 0xfc, 0x81, 0x80, 0x56, 0x60,
+	0x31, 0x50, 0x16, 0x5b, 0xc6, 0x0d, 0xb3, 0x58, 0x36, 0xd4, 0x9b, 0x45, 0x43, 0x11, 0xef, 0xf0,
+	0xee, 0xa8, 0xaa, 0xa2, 0x84, 0xca, 0xfd, 0x11, 0x25, 0x61, 0x2e, 0x37, 0xea, 0xd7, 0xd5, 0xaa,
+	0x78, 0x30, 0xa2, 0xd4, 0x23, 0xa5, 0x85, 0xb6, 0x60, 0x6d, 0xc4, 0x52, 0x6b, 0x94, 0x1a, 0x86,
+	0x69, 0x34, 0x5e, 0x57, 0xea, 0xe2, 0x37, 0x04, 0x74, 0x1e, 0xb6, 0x62, 0x10, 0x36, 0xdb, 0xaa,
+	0xd6, 0x68, 0x35, 0xcd, 0x5d, 0x65, 0xb7, 0xa4, 0x68, 0xba, 0x78, 0x98, 0x1a, 0x03, 0xc5, 0xe8,
+	0x62, 0x1f, 0x6d, 0xc2, 0x5a, 0xba, 0xd2, 0x6c, 0xe9, 0xc4, 0xdc, 0x46, 0x05, 0x58, 0x8d, 0x21,
+	0x94, 0x37, 0x0c, 0xad, 0x58, 0x66, 0x61, 0xe8, 0xe2, 0x00, 0x6d, 0x80, 0x14, 0x03, 0x68, 0x8a,
+	0x6e, 0x34, 0x34, 0x85, 0xc5, 0xf9, 0x26, 0xda, 0x81, 0xcb, 0x23, 0x2e, 0xa2, 0x85, 0xd3, 0xcd,
+	0xeb, 0x0d, 0xcd, 0x6c, 0x6a, 0x6a, 0xbd, 0xac, 0x36, 0x8b, 0x35, 0xf1, 0x5b, 0x02, 0xba, 0x00,
+	0x72, 0x22, 0xa3, 0x35, 0xc5, 0x50, 0x4c, 0xe5, 0x8d, 0xa6, 0xaa, 0x29, 0x95, 0xc0, 0xf1, 0x37,
+	0x05, 0xf4, 0x34, 0x14, 0x12, 0x9e, 0x6f, 0x36, 0x5e, 0x57, 0x68, 0xe4, 0x01, 0xea, 0xdb, 0x02,
+	0x3a, 0x07, 0x1b, 0x71, 0x54, 0xc3, 0x28, 0x1a, 0x8a, 0xa9, 0x35, 0xc2, 0x5c, 0x7e, 0x5f, 0x40,
+	0x1b, 0xb0, 0x92, 0x96, 0x4b, 0xad, 0x51, 0x53, 0x74, 0xf1, 0x87, 0x02, 0x9f, 0x05, 0xa5, 0x6e,
+	0x28, 0x5a, 0x53, 0x53, 0x75, 0x25, 0x2a, 0x03, 0x87, 0x4f, 0x24, 0x07, 0xb8, 0xa1, 0x14, 0x35,
+	0xa3, 0xa4, 0x14, 0x0d, 0xd1, 0xcd, 0xa0, 0xf0, 0x2b, 0xa2, 0xa2, 0x88, 0xe4, 0x72, 0xb1, 0x9e,
+	0x02, 0xe0, 0xea, 0x69, 0xc8, 0x73, 0xa8, 0x15, 0xa5, 0x6e, 0xa8, 0xc6, 0x6d, 0xbe, 0x6c, 0x8e,
+	0x52, 0x01, 0x5c, 0xd1, 0x7d, 0x32, 0x15, 0x50, 0xd6, 0x14, 0x92, 0x11, 0xb5, 0xd2, 0x14, 0xef,
+	0xa5, 0x02, 0x5a, 0xcd, 0x4a, 0x00, 0xb8, 0xcf, 0xaf, 0x77, 0x08, 0xa8, 0xa9, 0xba, 0x41, 0xd4,
+	0xba, 0xf8, 0x16, 0x5a, 0x83, 0x7c, 0x6a, 0x08, 0xc4, 0xfa, 0x53, 0xa9, 0xf4, 0x6c, 0x81, 0x09,
+	0xe0, 0xd3, 0xe8, 0x02, 0x9c, 0xcb, 0x0a, 0x90, 0x5c, 0x1c, 0xcc, 0x72, 0x4d, 0x55, 0xea, 0x86,
+	0xf8, 0x76, 0x2a, 0x90, 0x05, 0xca, 0x03, 0x3f, 0x83, 0x9e, 0x01, 0x79, 0x04, 0x48, 0x03, 0xe6,
+	0x60, 0xba, 0xf8, 0x59, 0x74, 0x1e, 0x36, 0x53, 0x03, 0xe7, 0xd9, 0x3e, 0x27, 0xa0, 0x8b, 0x70,
+	0x2e, 0x6b, 0x06, 0x3c, 0xf2, 0x1d, 0x01, 0x2d, 0x03, 0x0a, 0x90, 0x15, 0xa5, 0xd4, 0xaa, 0x9a,
+	0x95, 0xd6, 0x6e, 0x53, 0xfc, 0x82, 0x80, 0xd6, 0xa3, 0x14, 0xd5, 0xd4, 0xb2, 0x52, 0xe7, 0x4b,
+	0xe9, 0x8b, 0xa9, 0xea, 0xb0, 0x4c, 0xbe, 0x24, 0xa0, 0x4d, 0x58, 0x4d, 0xaa, 0x8b, 0x95, 0x8a,
+	0xc9, 0x64, 0xe2, 0x97, 0x63, 0x25, 0x1f, 0x20, 0x58, 0x66, 0x02, 0xd0, 0x57, 0x52, 0x41, 0x6c,
+	0x1a, 0x01, 0xe8, 0xab, 0x02, 0x92, 0x61, 0x3d, 0x09, 0xa2, 0xa9, 0x63, 0x42, 0x5d, 0xfc, 0x9a,
+	0x80, 0xa4, 0xe8, 0x70, 0x64, 0x0b, 0xa5, 0x2b, 0x65, 0x4d, 0x31, 0xc4, 0xef, 0x90, 0x83, 0x73,
+	0x21, 0xb2, 0xd7, 0x0d, 0xa6, 0xd1, 0xc5, 0x77, 0x05, 0x84, 0x60, 0xda, 0x1f, 0x31, 0xb7, 0xe2,
+	0x77, 0x05, 0x34, 0x0f, 0x33, 0x4c, 0xa6, 0xd6, 0xf5, 0xa6, 0x52, 0x36, 0xc4, 0xef, 0x25, 0xd2,
+	0x48, 0x03, 0x2c, 0xd6, 0x6a, 0xe2, 0xd7, 0x05, 0x34, 0x03, 0x93, 0x9a, 0xd2, 0x6c, 0x98, 0x9a,
+	0x52, 0xac, 0x88, 0xef, 0x09, 0x68, 0x16, 0x80, 0x8e, 0x6f, 0x69, 0xaa, 0xa1, 0x88, 0xbf, 0xa4,
+	0xde, 0xa9, 0x20, 0xf9, 0x1c, 0xf8, 0x95, 0x80, 0x44, 0x98, 0xa2, 0x2a, 0xe6, 0xfb, 0xd7, 0x02,
+	0xca, 0xc3, 0x3c, 0x95, 0x30, 0xcf, 0x66, 0xb9, 0xb1, 0xbb, 0xab, 0x1a, 0xe2, 0x6f, 0x04, 0xb4,
+	0x08, 0x22, 0xd5, 0xf8, 0x33, 0xf7, 0xc5, 0xbf, 0xa5, 0x71, 0x71, 0x14, 0x81, 0xe2, 0x77, 0x91,
+	0x82, 0x65, 0xa3, 0xa4, 0x15, 0xeb, 0xe5, 0x1b, 0xe2, 0xef, 0x13, 0x44, 0x4c, 0xfc, 0xfe, 0x08,
+	0x11, 0x53, 0xfc, 0x41, 0x40, 0x4b, 0x30, 0x17, 0x0b, 0xe9, 0xba, 0x5a, 0x53, 0xc4, 0x3f, 0xd2,
+	0x34, 0x45, 0x3c, 0x54, 0xf8, 0x27, 0x5a, 0x35, 0x54, 0x48, 0x6a, 0xa1, 0xa9, 0x36, 0x95, 0x9a,
+	0x5a, 0x57, 0x68, 0x6a, 0x14, 0x4d, 0xfc, 0x33, 0xad, 0x1a, 0x96, 0xac, 0xdd, 0xc6, 0x4d, 0x65,
+	0x04, 0xf1, 0x97, 0x0c, 0x02, 0x9a, 0x4b, 0x4d, 0xfc, 0x2b, 0x2d, 0x85, 0x58, 0x32, 0x69, 0x94,
+	0x66, 0x53, 0x6b, 0x18, 0x4a, 0xd9, 0x50, 0x1b, 0x75, 0xf1, 0x6f, 0x02, 0x5a, 0x80, 0x59, 0x7e,
+	0xe6, 0x46, 0xb1, 0x2a, 0xfe, 0x3d, 0x92, 0xb2, 0xf9, 0x11, 0xe9, 0x3f, 0xe8, 0xe4, 0x42, 0x2f,
+	0x74, 0x22, 0xaf, 0x35, 0x4a, 0xe2, 0xcf, 0xc6, 0x2e, 0x37, 0xe0, 0x2c, 0xdf, 0x7e, 0x21, 0xcf,
+	0x5e, 0x4d, 0xd1, 0x1b, 0x2d, 0xad, 0xac, 0x98, 0xc6, 0xed, 0xa6, 0xc2, 0x3d, 0xea, 0xa7, 0x60,
+	0x22, 0xa8, 0x55, 0x01, 0xe5, 0xe0, 0x14, 0xf1, 0x22, 0x8e, 0xa1, 0x69, 0x98, 0x24, 0xf9, 0x32,
+	0xe9, 0x70, 0xfc, 0xca, 0x8f, 0xe7, 0x61, 0xbc, 0xd8, 0x54, 0x51, 0x11, 0x72, 0xc1, 0x67, 0x1f,
+	0x94, 0x0f, 0x2f, 0x4a, 0x89, 0x6f, 0x47, 0xd2, 0x4a, 0x8a, 0x86, 0xdd, 0x62, 0x9e, 0x42, 0x55,
+	0x80, 0xe8, 0x8b, 0x0f, 0x92, 0x42, 0xe8, 0xc8, 0xb7, 0x21, 0x69, 0x35, 0x55, 0x17, 0x12, 0xdd,
+	0xa6, 0x37, 0xcd, 0x58, 0x17, 0x1f, 0x6d, 0x86, 0x26, 0x19, 0x1f, 0x2a, 0xa4, 0xad, 0x13, 0x10,
+	0x3c, 0xb5, 0x9e, 0x4d, 0xad, 0x3f, 0x94, 0x5a, 0xcf, 0xa6, 0xde, 0x85, 0xb3, 0x7c, 0x2b, 0x1d,
+	0xad, 0x45, 0xb9, 0x1a, 0xed, 0xe0, 0x4b, 0xeb, 0x19, 0xda, 0x90, 0xae, 0x02, 0x93, 0x61, 0xd3,
+	0x0a, 0xad, 0xc4, 0xd0, 0x7c, 0x0f, 0x4d, 0x92, 0xd2, 0x54, 0x21, 0x8b, 0x0e, 0x33, 0xf1, 0x5e,
+	0x0c, 0xda, 0xe0, 0xd3, 0x34, 0xda, 0x5e, 0x92, 0x0a, 0x99, 0xfa, 0x90, 0xf4, 0x2e, 0x48, 0xd9,
+	0x2d, 0x25, 0x74, 0x39, 0x83, 0x20, 0xe5, 0x3d, 0xe6, 0x51, 0x9c, 0xbd, 0x0a, 0x67, 0xfc, 0x0f,
+	0x0b, 0x68, 0x29, 0x04, 0xc7, 0xbe, 0x3d, 0x48, 0xcb, 0x23, 0xf2, 0xd0, 0xf8, 0x20, 0xec, 0xc3,
+	0xc4, 0xbb, 0xf7, 0xe8, 0x3c, 0xef, 0x38, 0xf3, 0x93, 0x81, 0xf4, 0xcc, 0xc3, 0x60, 0xa1, 0xa7,
+	0x8f, 0xc2, 0xdc, 0x48, 0x3b, 0x08, 0x45, 0x75, 0x93, 0xd5, 0xa9, 0x92, 0xe4, 0x93, 0x20, 0x89,
+	0x65, 0xe4, 0xa9, 0x37, 0x92, 0x91, 0x25, 0x78, 0x0b, 0x99, 0x7a, 0x7e, 0xbf, 0x46, 0xad, 0x1d,
+	0x6e, 0xbf, 0x8e, 0xf4, 0x89, 0xa4, 0xd5, 0x54, 0x1d, 0x4f, 0x14, 0x75, 0x75, 0x38, 0xa2, 0x91,
+	0x16, 0x91, 0xb4, 0x9a, 0xaa, 0x8b, 0x9f, 0x20, 0x3d, 0x3c, 0x42, 0x34, 0xd2, 0x1d, 0x92, 0x56,
+	0x53, 0x75, 0xfc, 0xe6, 0x09, 0xdb, 0x3f, 0xdc, 0xe6, 0x49, 0xb6, 0x89, 0x24, 0x29, 0x4d, 0xc5,
+	0xef, 0x68, 0xbe, 0x23, 0xc3, 0xed, 0xe8, 0x94, 0xfe, 0x8d, 0xb4, 0x9e, 0xa1, 0x0d, 0xe9, 0x9a,
+	0x30, 0x1d, 0x6b, 0x9f, 0xa0, 0xf5, 0xf8, 0x1a, 0x25, 0xfa, 0x33, 0xd2, 0x46, 0x96, 0x3a, 0x64,
+	0xbc, 0x09, 0xb3, 0x89, 0x97, 0x4b, 0x54, 0xe0, 0xda, 0x89, 0x69, 0xbd, 0x17, 0x69, 0x33, 0x1b,
+	0x10, 0xf2, 0xf6, 0x47, 0x3a, 0x31, 0xc1, 0x4b, 0x2b, 0xba, 0x90, 0x65, 0x9e, 0x78, 0x29, 0x96,
+	0x2e, 0x3e, 0x1c, 0x98, 0x38, 0x95, 0x63, 0xfd, 0x98, 0xf8, 0xa9, 0x9c, 0xd6, 0xf9, 0x91, 0xb6,
+	0x4e, 0x40, 0xf0, 0x49, 0x8f, 0xb5, 0x5d, 0xb8, 0xa4, 0xa7, 0xb5, 0x79, 0xa4, 0x8d, 0x2c, 0x35,
+	0x5f, 0x5b, 0x61, 0x77, 0x85, 0xab, 0xad, 0x64, 0x0f, 0x47, 0x92, 0xd2, 0x54, 0xdc, 0x79, 0xb1,
+	0x98, 0xda, 0xe1, 0x89, 0x9f, 0x4c, 0x99, 0x1d, 0xa0, 0x87, 0xb0, 0x17, 0x21, 0x17, 0xf4, 0x6a,
+	0xb8, 0xa7, 0x79, 0xa2, 0xcf, 0x23, 0xad, 0xa4, 0x68, 0xf8, 0x03, 0x6d, 0xa4, 0x41, 0xc3, 0x1d,
+	0x68, 0x59, 0x8d, 0x1d, 0x49, 0x3e, 0x09, 0xc2, 0xaf, 0x78, 0xb2, 0xe1, 0x82, 0xf8, 0xca, 0x4c,
+	0x6d, 0xe8, 0x48, 0x5b, 0x27, 0x20, 0xf8, 0xe2, 0xcd, 0x68, 0x96, 0x70, 0xc5, 0x7b, 0x72, 0xc3,
+	0x45, 0xba, 0xf8, 0x70, 0x60, 0x6c, 0x13, 0xc6, 0xff, 0x32, 0x85, 0xdf, 0x84, 0xa9, 0x7f, 0xec,
+	0x22, 0x6d, 0x66, 0x03, 0x02, 0xde, 0xd2, 0xd5, 0xf7, 0x8e, 0x37, 0x84, 0xf7, 0x8f, 0x37, 0x84,
+	0x7f, 0x1d, 0x6f, 0x08, 0x1f, 0xb9, 0xbc, 0x6f, 0x79, 0x07, 0xc3, 0xbd, 0xed, 0x8e, 0x7d, 0xb8,
+	0x43, 0xbe, 0xc3, 0xdf, 0xef, 0x62, 0x87, 0xff, 0x75, 0x74, 0x65, 0xc7, 0x75, 0x3a, 0xf4, 0x4f,
+	0x87, 0xf6, 0xce, 0xd0, 0x2f, 0xe8, 0x2f, 0xfc, 0x77, 0x00, 0x6d, 0xbd, 0xd2, 0x2d, 0x4e, 0x24,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3392,6 +3784,10 @@ type APIClient interface {
 	GetRolesForPermission(ctx context.Context, in *GetRolesForPermissionRequest, opts ...grpc.CallOption) (*GetRolesForPermissionResponse, error)
 	ModifyRoleBinding(ctx context.Context, in *ModifyRoleBindingRequest, opts ...grpc.CallOption) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(ctx context.Context, in *GetRoleBindingRequest, opts ...grpc.CallOption) (*GetRoleBindingResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
 	GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error)
 	GetRobotToken(ctx context.Context, in *GetRobotTokenRequest, opts ...grpc.CallOption) (*GetRobotTokenResponse, error)
 	RevokeAuthToken(ctx context.Context, in *RevokeAuthTokenRequest, opts ...grpc.CallOption) (*RevokeAuthTokenResponse, error)
@@ -3523,6 +3919,42 @@ func (c *aPIClient) GetRoleBinding(ctx context.Context, in *GetRoleBindingReques
 	return out, nil
 }
 
+func (c *aPIClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) GetOIDCLogin(ctx context.Context, in *GetOIDCLoginRequest, opts ...grpc.CallOption) (*GetOIDCLoginResponse, error) {
 	out := new(GetOIDCLoginResponse)
 	err := c.cc.Invoke(ctx, "/auth_v2.API/GetOIDCLogin", in, out, opts...)
@@ -3657,6 +4089,10 @@ type APIServer interface {
 	GetRolesForPermission(context.Context, *GetRolesForPermissionRequest) (*GetRolesForPermissionResponse, error)
 	ModifyRoleBinding(context.Context, *ModifyRoleBindingRequest) (*ModifyRoleBindingResponse, error)
 	GetRoleBinding(context.Context, *GetRoleBindingRequest) (*GetRoleBindingResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
 	GetOIDCLogin(context.Context, *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error)
 	GetRobotToken(context.Context, *GetRobotTokenRequest) (*GetRobotTokenResponse, error)
 	RevokeAuthToken(context.Context, *RevokeAuthTokenRequest) (*RevokeAuthTokenResponse, error)
@@ -3712,6 +4148,18 @@ func (*UnimplementedAPIServer) ModifyRoleBinding(ctx context.Context, req *Modif
 func (*UnimplementedAPIServer) GetRoleBinding(ctx context.Context, req *GetRoleBindingRequest) (*GetRoleBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBinding not implemented")
 }
+func (*UnimplementedAPIServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedAPIServer) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (*UnimplementedAPIServer) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (*UnimplementedAPIServer) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
 func (*UnimplementedAPIServer) GetOIDCLogin(ctx context.Context, req *GetOIDCLoginRequest) (*GetOIDCLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOIDCLogin not implemented")
 }
@@ -3972,6 +4420,78 @@ func _API_GetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_GetOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOIDCLoginRequest)
 	if err := dec(in); err != nil {
@@ -4258,6 +4778,22 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoleBinding",
 			Handler:    _API_GetRoleBinding_Handler,
 		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _API_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _API_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _API_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _API_ListRoles_Handler,
+		},
 		{
 			MethodName: "GetOIDCLogin",
 			Handler:    _API_GetOIDCLogin_Handler,
@@ -5254,6 +5790,16 @@ func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Builtin {
+		i--
+		if m.Builtin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ResourceTypes) > 0 {
 		dAtA7 := make([]byte, len(m.ResourceTypes)*10)
 		var j6 int
@@ -5736,7 +6282,7 @@ func (m *GetRoleBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
+func (m *CreateRoleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5746,12 +6292,12 @@ func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRoleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5760,34 +6306,22 @@ func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ConversionErr {
-		i--
-		if m.ConversionErr {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Role != nil {
+		{
+			size, err := m.Role.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Nonce)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetOIDCLoginRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5797,12 +6331,12 @@ func (m *GetOIDCLoginRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOIDCLoginRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOIDCLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5814,7 +6348,7 @@ func (m *GetOIDCLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRoleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5824,12 +6358,12 @@ func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOIDCLoginResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRoleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5838,24 +6372,22 @@ func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LoginURL) > 0 {
-		i -= len(m.LoginURL)
-		copy(dAtA[i:], m.LoginURL)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.LoginURL)))
+	if m.Role != nil {
+		{
+			size, err := m.Role.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5865,12 +6397,12 @@ func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRobotTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5879,22 +6411,10 @@ func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TTL != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Robot) > 0 {
-		i -= len(m.Robot)
-		copy(dAtA[i:], m.Robot)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Robot)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRoleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5904,12 +6424,12 @@ func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRobotTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRoleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5918,17 +6438,17 @@ func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRoleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5938,12 +6458,12 @@ func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5952,17 +6472,10 @@ func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListRolesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5972,12 +6485,12 @@ func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRolesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5989,7 +6502,7 @@ func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListRolesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5999,12 +6512,12 @@ func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetGroupsForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRolesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6013,26 +6526,24 @@ func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6042,12 +6553,12 @@ func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetGroupsForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6056,62 +6567,34 @@ func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ModifyMembersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ConversionErr {
+		i--
+		if m.ConversionErr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ModifyMembersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Remove) > 0 {
-		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Remove[iNdEx])
-			copy(dAtA[i:], m.Remove[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Remove[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Add) > 0 {
-		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Add[iNdEx])
-			copy(dAtA[i:], m.Add[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Add[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Nonce)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetOIDCLoginRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6121,12 +6604,12 @@ func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModifyMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetOIDCLoginRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetOIDCLoginRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6138,7 +6621,7 @@ func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetOIDCLoginResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6148,12 +6631,12 @@ func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetOIDCLoginResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetOIDCLoginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6162,10 +6645,24 @@ func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LoginURL) > 0 {
+		i -= len(m.LoginURL)
+		copy(dAtA[i:], m.LoginURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LoginURL)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRobotTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6175,12 +6672,12 @@ func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRobotTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6189,17 +6686,22 @@ func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Principal) > 0 {
-		i -= len(m.Principal)
-		copy(dAtA[i:], m.Principal)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+	if m.TTL != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Robot) > 0 {
+		i -= len(m.Robot)
+		copy(dAtA[i:], m.Robot)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Robot)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRobotTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6209,12 +6711,12 @@ func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRobotTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetRobotTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6223,19 +6725,17 @@ func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
+func (m *RevokeAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6245,12 +6745,12 @@ func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevokeAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevokeAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6259,17 +6759,17 @@ func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Token)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
+func (m *RevokeAuthTokenResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6279,12 +6779,12 @@ func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUsersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevokeAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevokeAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6293,19 +6793,10 @@ func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Usernames) > 0 {
-		for iNdEx := len(m.Usernames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Usernames[iNdEx])
-			copy(dAtA[i:], m.Usernames[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Usernames[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetGroupsForUserRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6315,12 +6806,12 @@ func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExtractAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetGroupsForUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetGroupsForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6329,10 +6820,26 @@ func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExtractAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetGroupsForUserResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6342,12 +6849,12 @@ func (m *ExtractAuthTokensResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExtractAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetGroupsForUserResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetGroupsForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6356,24 +6863,10 @@ func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+func (m *ModifyMembersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6383,12 +6876,12 @@ func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModifyMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModifyMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6397,22 +6890,35 @@ func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *ModifyMembersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6422,12 +6928,12 @@ func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ModifyMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ModifyMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6439,7 +6945,7 @@ func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6449,12 +6955,12 @@ func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokensForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6463,17 +6969,10 @@ func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetGroupsForPrincipalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6483,12 +6982,12 @@ func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RevokeAuthTokensForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetGroupsForPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetGroupsForPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6497,10 +6996,17 @@ func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Principal) > 0 {
+		i -= len(m.Principal)
+		copy(dAtA[i:], m.Principal)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Principal)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6510,12 +7016,12 @@ func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteExpiredAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6524,8 +7030,309 @@ func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usernames) > 0 {
+		for iNdEx := len(m.Usernames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Usernames[iNdEx])
+			copy(dAtA[i:], m.Usernames[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Usernames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtractAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtractAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtractAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtractAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokensForUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokensForUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokensForUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokensForUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokensForUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokensForUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteExpiredAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteExpiredAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteExpiredAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *DeleteExpiredAuthTokensResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -7002,6 +7809,9 @@ func (m *Role) Size() (n int) {
 		}
 		n += 1 + sovAuth(uint64(l)) + l
 	}
+	if m.Builtin {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7195,6 +8005,120 @@ func (m *GetRoleBindingResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SessionInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9218,7 +10142,173 @@ func (m *GetRolesForPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Roles) Unmarshal(dAtA []byte) error {
+func (m *Roles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Roles == nil {
+				m.Roles = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Roles[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9241,15 +10331,15 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9276,11 +10366,11 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Roles == nil {
-				m.Roles = make(map[string]bool)
+			if m.Entries == nil {
+				m.Entries = make(map[string]*Roles)
 			}
 			var mapkey string
-			var mapvalue bool
+			var mapvalue *Roles
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9329,7 +10419,7 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9339,12 +10429,26 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Roles{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9360,7 +10464,7 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Roles[mapkey] = mapvalue
+			m.Entries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9384,7 +10488,7 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleBinding) Unmarshal(dAtA []byte) error {
+func (m *Resource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9407,15 +10511,117 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9442,11 +10648,11 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Entries == nil {
-				m.Entries = make(map[string]*Roles)
+			if m.Usernames == nil {
+				m.Usernames = make(map[string]bool)
 			}
 			var mapkey string
-			var mapvalue *Roles
+			var mapvalue bool
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9495,7 +10701,7 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var mapvaluetemp int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9505,26 +10711,12 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvaluetemp |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Roles{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = bool(mapvaluetemp != 0)
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9540,7 +10732,7 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Entries[mapkey] = mapvalue
+			m.Usernames[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9564,7 +10756,7 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *Groups) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9587,17 +10779,17 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9607,42 +10799,106 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ResourceType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9666,7 +10922,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *Role) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9689,17 +10945,17 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9709,29 +10965,27 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Usernames == nil {
-				m.Usernames = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Permission
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowAuth
@@ -9741,14 +10995,44 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= Permission(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9758,26 +11042,66 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						v |= Permission(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v ResourceType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
-					if postStringIndexmapkey > l {
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResourceType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ResourceTypes = append(m.ResourceTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ResourceTypes) == 0 {
+					m.ResourceTypes = make([]ResourceType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResourceType
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9787,29 +11111,36 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						v |= ResourceType(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.ResourceTypes = append(m.ResourceTypes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTypes", wireType)
 			}
-			m.Usernames[mapkey] = mapvalue
-			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Builtin = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9832,7 +11163,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Groups) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9855,15 +11186,15 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9890,14 +11221,16 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[string]bool)
+			if m.Resource == nil {
+				m.Resource = &Resource{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Permission
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowAuth
@@ -9907,43 +11240,44 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= Permission(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
 					}
-					if postStringIndexmapkey > l {
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9953,29 +11287,16 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						v |= Permission(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.Permissions = append(m.Permissions, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			m.Groups[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9998,7 +11319,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Role) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10021,17 +11342,17 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10041,24 +11362,12 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Authorized = bool(v != 0)
 		case 2:
 			if wireType == 0 {
 				var v Permission
@@ -10076,7 +11385,7 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Permissions = append(m.Permissions, v)
+				m.Satisfied = append(m.Satisfied, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10104,8 +11413,8 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
+				if elementCount != 0 && len(m.Satisfied) == 0 {
+					m.Satisfied = make([]Permission, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v Permission
@@ -10123,14 +11432,14 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Permissions = append(m.Permissions, v)
+					m.Satisfied = append(m.Satisfied, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
 			}
 		case 3:
 			if wireType == 0 {
-				var v ResourceType
+				var v Permission
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowAuth
@@ -10140,12 +11449,12 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= ResourceType(b&0x7F) << shift
+					v |= Permission(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.ResourceTypes = append(m.ResourceTypes, v)
+				m.Missing = append(m.Missing, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10173,11 +11482,11 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
-				if elementCount != 0 && len(m.ResourceTypes) == 0 {
-					m.ResourceTypes = make([]ResourceType, 0, elementCount)
+				if elementCount != 0 && len(m.Missing) == 0 {
+					m.Missing = make([]Permission, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v ResourceType
+					var v Permission
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -10187,16 +11496,135 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= ResourceType(b&0x7F) << shift
+						v |= Permission(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.ResourceTypes = append(m.ResourceTypes, v)
+					m.Missing = append(m.Missing, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10219,7 +11647,7 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10242,10 +11670,10 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10285,6 +11713,89 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType == 0 {
 				var v Permission
 				for shift := uint(0); ; shift += 7 {
@@ -10353,6 +11864,38 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10375,7 +11918,7 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
+func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10398,171 +11941,49 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Authorized = bool(v != 0)
-		case 2:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Satisfied = append(m.Satisfied, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Satisfied) == 0 {
-					m.Satisfied = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Satisfied = append(m.Satisfied, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
-			}
-		case 3:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Missing = append(m.Missing, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Missing) == 0 {
-					m.Missing = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Missing = append(m.Missing, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
@@ -10594,6 +12015,38 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10616,7 +12069,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10639,10 +12092,61 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10703,7 +12207,7 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10726,15 +12230,15 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10761,18 +12265,69 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
+			if m.Binding == nil {
+				m.Binding = &RoleBinding{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10782,23 +12337,27 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			if m.Role == nil {
+				m.Role = &Role{}
+			}
+			if err := m.Role.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10822,7 +12381,58 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRoleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10845,86 +12455,17 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRoleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10934,23 +12475,27 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			if m.Role == nil {
+				m.Role = &Role{}
+			}
+			if err := m.Role.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10974,7 +12519,7 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10997,83 +12542,66 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11101,7 +12629,7 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11125,7 +12653,7 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11148,10 +12676,10 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11176,7 +12704,7 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *ListRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11199,48 +12727,12 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11263,7 +12755,7 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *ListRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11286,15 +12778,15 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11321,10 +12813,8 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Binding == nil {
-				m.Binding = &RoleBinding{}
-			}
-			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,6 +5,7 @@ import "github.com/pachyderm/pachyderm/v2/src/pfs"
 type putFileConfig struct {
 	datum  string
 	append bool
+	split  *pfs.AddFile
 }
 
 // PutFileOption configures a PutFile call.
@@ -24,6 +25,22 @@ func WithDatumPutFile(datum string) PutFileOption {
 	}
 }
 
+// WithSplitPutFile configures the PutFile call to split the data into records
+// delimited by delimiter, and write them to numbered files under the path.
+// Each file holds up to targetDatums records or targetBytes bytes, or a single
+// record if both are zero. The first headerRecords records are written to the
+// start of every file.
+func WithSplitPutFile(delimiter pfs.Delimiter, targetDatums, targetBytes, headerRecords int64) PutFileOption {
+	return func(pf *putFileConfig) {
+		pf.split = &pfs.AddFile{
+			Delimiter:        delimiter,
+			TargetFileDatums: targetDatums,
+			TargetFileBytes:  targetBytes,
+			HeaderRecords:    headerRecords,
+		}
+	}
+}
+
 type deleteFileConfig struct {
 	datum     string
 	recursive bool
@@ -78,3 +95,13 @@ func WithOffset(offset int64) GetFileOption {
 		gf.Offset = offset
 	}
 }
+
+// WithSinceGetFile configures a GetFileURL call to only write the files that
+// differ from the same paths in since, and to delete the files that were
+// removed since then if deleteRemoved is true.
+func WithSinceGetFile(since *pfs.Commit, deleteRemoved bool) GetFileOption {
+	return func(gf *pfs.GetFileRequest) {
+		gf.Since = since
+		gf.DeleteRemoved = deleteRemoved
+	}
+}
@@ -34,6 +34,14 @@ func NewBranch(repoName string, branchName string) *pfs.Branch {
 	}
 }
 
+// NewTag creates a pfs.Tag
+func NewTag(repoName string, tagName string) *pfs.Tag {
+	return &pfs.Tag{
+		Repo: NewRepo(repoName),
+		Name: tagName,
+	}
+}
+
 // NewCommit creates a pfs.Commit.
 func NewCommit(repoName string, branchName string, commitID string) *pfs.Commit {
 	return &pfs.Commit{
@@ -89,6 +97,23 @@ func (c APIClient) InspectRepo(repoName string) (_ *pfs.RepoInfo, retErr error)
 	)
 }
 
+// InspectRepoStorage returns the deduplicated storage usage of a Repo.
+// If `from` or `to` is given, only the commits that ListCommit would return
+// for them are considered.
+func (c APIClient) InspectRepoStorage(repoName string, from, to *pfs.Commit) (_ *pfs.RepoStorageInfo, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.InspectRepoStorage(
+		c.Ctx(),
+		&pfs.InspectRepoStorageRequest{
+			Repo: NewRepo(repoName),
+			From: from,
+			To:   to,
+		},
+	)
+}
+
 // ListRepo returns info about user Repos
 func (c APIClient) ListRepo() ([]*pfs.RepoInfo, error) {
 	return c.ListRepoByType(pfs.UserRepoType)
@@ -366,6 +391,91 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateTag creates an immutable tag named tagName in repoName, pointing at
+// the commit given by commitBranch and commitID.
+func (c APIClient) CreateTag(repoName string, tagName string, commitBranch string, commitID string, description string) error {
+	_, err := c.PfsAPIClient.CreateTag(
+		c.Ctx(),
+		&pfs.CreateTagRequest{
+			Tag:         NewTag(repoName, tagName),
+			Commit:      NewCommit(repoName, commitBranch, commitID),
+			Description: description,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectTag returns information on a specific PFS tag.
+func (c APIClient) InspectTag(repoName string, tagName string) (*pfs.TagInfo, error) {
+	tagInfo, err := c.PfsAPIClient.InspectTag(
+		c.Ctx(),
+		&pfs.InspectTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return tagInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListTag lists the tags in a Repo.
+func (c APIClient) ListTag(repoName string) ([]*pfs.TagInfo, error) {
+	ctx, cf := context.WithCancel(c.Ctx())
+	defer cf()
+	client, err := c.PfsAPIClient.ListTag(
+		ctx,
+		&pfs.ListTagRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	tagInfos, err := clientsdk.ListTagInfo(client)
+	return tagInfos, grpcutil.ScrubGRPC(err)
+}
+
+// DeleteTag deletes a tag, but leaves the commit it points to intact.
+func (c APIClient) DeleteTag(repoName string, tagName string) error {
+	_, err := c.PfsAPIClient.DeleteTag(
+		c.Ctx(),
+		&pfs.DeleteTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// MergeBranch merges the changes made on sourceBranch since its common
+// ancestor with targetBranch into targetBranch, in a new commit. Paths changed
+// on both branches are resolved according to resolution. If any conflict is
+// left unresolved, no commit is made and the conflicts are returned.
+func (c APIClient) MergeBranch(repoName, sourceBranch, targetBranch string, resolution pfs.MergeResolution) (*pfs.MergeBranchResponse, error) {
+	response, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source:     NewBranch(repoName, sourceBranch),
+			Target:     NewBranch(repoName, targetBranch),
+			Resolution: resolution,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
+// SetBranchProtection sets the protection rules on a branch. Passing a nil
+// protection removes any existing protection from the branch.
+func (c APIClient) SetBranchProtection(repoName string, branchName string, protection *pfs.BranchProtection) error {
+	_, err := c.PfsAPIClient.SetBranchProtection(
+		c.Ctx(),
+		&pfs.SetBranchProtectionRequest{
+			Branch:     NewBranch(repoName, branchName),
+			Protection: protection,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 func (c APIClient) inspectCommitSet(id string, wait bool, cb func(*pfs.CommitInfo) error) error {
 	req := &pfs.InspectCommitSetRequest{
 		CommitSet: NewCommitSet(id),
@@ -489,6 +599,24 @@ func (c APIClient) SubscribeCommit(repo *pfs.Repo, branchName string, from strin
 	}
 }
 
+// RevertCommit makes a new commit on the commit's branch that undoes the
+// changes made by the commit. Paths changed again after the commit are
+// resolved according to resolution. If any conflict is left unresolved, no
+// commit is made and the conflicts are returned.
+func (c APIClient) RevertCommit(repoName string, branchName string, commitID string, resolution pfs.MergeResolution) (*pfs.RevertCommitResponse, error) {
+	response, err := c.PfsAPIClient.RevertCommit(
+		c.Ctx(),
+		&pfs.RevertCommitRequest{
+			Commit:     NewCommit(repoName, branchName, commitID),
+			Resolution: resolution,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
 // ClearCommit clears the state of an open commit.
 func (c APIClient) ClearCommit(repoName string, branchName string, commitID string) (retErr error) {
 	defer func() {
@@ -530,6 +658,19 @@ func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
 	return nil
 }
 
+// GarbageCollect runs a single pass of storage garbage collection. If
+// dryRun is set, nothing is deleted and the response describes what the pass
+// would have deleted.
+func (c APIClient) GarbageCollect(dryRun bool) (_ *pfs.GarbageCollectResponse, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.GarbageCollect(
+		c.Ctx(),
+		&pfs.GarbageCollectRequest{DryRun: dryRun},
+	)
+}
+
 // FsckFastExit performs checks on pfs, similar to Fsck, except that it returns the
 // first fsck error it encounters and exits.
 func (c APIClient) FsckFastExit() error {
@@ -73,8 +73,27 @@ type ModifyFile interface {
 }
 
 // WithModifyFileClient creates a new ModifyFileClient that is scoped to the passed in callback.
+// If the client has an active transaction, the modifications are written to a
+// temporary file set, which is added to the commit when the transaction is
+// finished.
 // TODO: Context should be a parameter, not stored in the pach client.
 func (c APIClient) WithModifyFileClient(commit *pfs.Commit, cb func(ModifyFile) error) (retErr error) {
+	if c.inTransaction() {
+		resp, err := c.WithCreateFileSetClient(func(mf ModifyFile) error {
+			return cb(txnModifyFile{mf})
+		})
+		if err != nil {
+			return err
+		}
+		_, err = c.PfsAPIClient.AddFileSet(
+			c.Ctx(),
+			&pfs.AddFileSetRequest{
+				Commit:    commit,
+				FileSetId: resp.FileSetId,
+			},
+		)
+		return grpcutil.ScrubGRPC(err)
+	}
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
 	mfc, err := c.WithCtx(cancelCtx).NewModifyFileClient(commit)
@@ -89,6 +108,24 @@ func (c APIClient) WithModifyFileClient(commit *pfs.Commit, cb func(ModifyFile)
 	return cb(mfc)
 }
 
+// txnModifyFile is the ModifyFile used within a transaction. Its
+// modifications are written to a temporary file set that doesn't include the
+// commit's existing files, so it can't delete the files in a directory.
+type txnModifyFile struct {
+	ModifyFile
+}
+
+func (mf txnModifyFile) DeleteFile(path string, opts ...DeleteFileOption) error {
+	config := &deleteFileConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.recursive {
+		return errors.Errorf("cannot recursively delete %q within a transaction", path)
+	}
+	return mf.ModifyFile.DeleteFile(path, opts...)
+}
+
 // NewModifyFileClient creates a new ModifyFileClient.
 func (c APIClient) NewModifyFileClient(commit *pfs.Commit) (_ *ModifyFileClient, retErr error) {
 	defer func() {
@@ -130,8 +167,13 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 	}
 	return mfc.maybeError(func() error {
 		if !config.append {
+			deletePath := path
+			if config.split != nil {
+				// Split files are written to a directory at path.
+				deletePath = strings.TrimRight(path, "/") + "/"
+			}
 			if err := mfc.sendDeleteFile(&pfs.DeleteFile{
-				Path:  path,
+				Path:  deletePath,
 				Datum: config.datum,
 			}); err != nil {
 				return err
@@ -140,17 +182,24 @@ func (mfc *modifyFileCore) PutFile(path string, r io.Reader, opts ...PutFileOpti
 		emptyFile := true
 		if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
 			emptyFile = false
-			return mfc.sendPutFile(&pfs.AddFile{
+			af := &pfs.AddFile{
 				Path:  path,
 				Datum: config.datum,
 				Source: &pfs.AddFile_Raw{
 					Raw: &types.BytesValue{Value: data},
 				},
-			})
+			}
+			if config.split != nil {
+				af.Delimiter = config.split.Delimiter
+				af.TargetFileDatums = config.split.TargetFileDatums
+				af.TargetFileBytes = config.split.TargetFileBytes
+				af.HeaderRecords = config.split.HeaderRecords
+			}
+			return mfc.sendPutFile(af)
 		}); err != nil {
 			return err
 		}
-		if emptyFile {
+		if emptyFile && config.split == nil {
 			return mfc.sendPutFile(&pfs.AddFile{
 				Path:  path,
 				Datum: config.datum,
@@ -253,6 +302,10 @@ func (mfc *modifyFileCore) PutFileURL(path, url string, recursive bool, opts ...
 				},
 			},
 		}
+		if config.split != nil {
+			// The server rejects splitting data from a URL.
+			pf.Delimiter = config.split.Delimiter
+		}
 		return mfc.sendPutFile(pf)
 	})
 }
@@ -327,9 +380,16 @@ func (c APIClient) WithRenewer(cb func(context.Context, *renew.StringSet) error)
 
 // WithCreateFileSetClient provides a scoped fileset client.
 func (c APIClient) WithCreateFileSetClient(cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
+	return c.WithCreateFileSetClientForCommit(nil, cb)
+}
+
+// WithCreateFileSetClientForCommit provides a scoped fileset client for a
+// fileset that will be added to commit, so that the storage settings of
+// commit's repo (e.g. compression) are applied to it.
+func (c APIClient) WithCreateFileSetClientForCommit(commit *pfs.Commit, cb func(ModifyFile) error) (resp *pfs.CreateFileSetResponse, retErr error) {
 	cancelCtx, cancel := context.WithCancel(c.Ctx())
 	defer cancel()
-	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClient()
+	ctfsc, err := c.WithCtx(cancelCtx).NewCreateFileSetClientForCommit(commit)
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +409,13 @@ type CreateFileSetClient struct {
 
 // NewCreateFileSetClient returns a CreateFileSetClient instance backed by this client
 func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr error) {
+	return c.NewCreateFileSetClientForCommit(nil)
+}
+
+// NewCreateFileSetClientForCommit returns a CreateFileSetClient instance
+// backed by this client, which applies the storage settings of commit's repo
+// to the fileset. The commit itself is not modified.
+func (c APIClient) NewCreateFileSetClientForCommit(commit *pfs.Commit) (_ *CreateFileSetClient, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -356,6 +423,13 @@ func (c APIClient) NewCreateFileSetClient() (_ *CreateFileSetClient, retErr erro
 	if err != nil {
 		return nil, err
 	}
+	if commit != nil {
+		if err := client.Send(&pfs.ModifyFileRequest{
+			Body: &pfs.ModifyFileRequest_SetCommit{SetCommit: commit},
+		}); err != nil {
+			return nil, err
+		}
+	}
 	return &CreateFileSetClient{
 		client: client,
 		modifyFileCore: modifyFileCore{
@@ -547,7 +621,7 @@ func (gfrs *getFileReadSeeker) Seek(offset int64, whence int) (int64, error) {
 }
 
 // GetFileURL gets the file at the specified URL
-func (c APIClient) GetFileURL(commit *pfs.Commit, path, URL string) (retErr error) {
+func (c APIClient) GetFileURL(commit *pfs.Commit, path, URL string, opts ...GetFileOption) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
@@ -555,6 +629,9 @@ func (c APIClient) GetFileURL(commit *pfs.Commit, path, URL string) (retErr erro
 		File: commit.NewFile(path),
 		URL:  URL,
 	}
+	for _, opt := range opts {
+		opt(req)
+	}
 	client, err := c.PfsAPIClient.GetFileTAR(c.Ctx(), req)
 	if err != nil {
 		return err
@@ -34,6 +34,9 @@ const (
 	// workers are running (if the workers belong to an orphan job, rather than a
 	// pipeline).
 	PPSJobIDEnv = "PPS_JOB_ID"
+	// PPSEgressSQLPasswordEnv is the env var that holds the password of the
+	// database that a pipeline's output is egressed to.
+	PPSEgressSQLPasswordEnv = "PPS_EGRESS_SQL_PASSWORD"
 	// PPSSpecCommitEnv is the namespace in which pachyderm is deployed
 	PPSSpecCommitEnv = "PPS_SPEC_COMMIT"
 	// PPSInputPrefix is the prefix of the path where datums are downloaded
@@ -186,6 +189,22 @@ func NewCronInputOpts(name string, repo string, spec string, overwrite bool) *pp
 	}
 }
 
+// NewURLInput returns an input which keeps a repo in sync with an object
+// storage prefix, e.g. `s3://bucket/dir`. The prefix is polled on the
+// schedule given by spec, which uses cron syntax, and the objects that
+// changed are committed to the repo. The objects will be exposed to jobs as
+// `/pfs/<name>/<path under the prefix>`.
+func NewURLInput(name string, url string, spec string, glob string) *pps.Input {
+	return &pps.Input{
+		URL: &pps.URLInput{
+			Name: name,
+			URL:  url,
+			Spec: spec,
+			Glob: glob,
+		},
+	}
+}
+
 // NewJobInput creates a pps.JobInput.
 func NewJobInput(repoName string, branchName string, commitID string, glob string) *pps.JobInput {
 	return &pps.JobInput{
@@ -444,6 +463,24 @@ func (c APIClient) ListDatum(pipelineName string, jobID string, cb func(*pps.Dat
 	return c.listDatum(req, cb)
 }
 
+// ListDatumPage returns info about datums in a job that are in one of the
+// given states (or in any state, if none are given). At most `number` datums
+// are returned (all, if `number` is 0), starting after the datum with ID
+// `paginationMarker`, so the listing can be resumed by passing the ID of the
+// last datum received.
+func (c APIClient) ListDatumPage(pipelineName string, jobID string, states []pps.DatumState, paginationMarker string, number int64, cb func(*pps.DatumInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pps.ListDatumRequest{
+		Job:              NewJob(pipelineName, jobID),
+		Filter:           &pps.ListDatumRequest_Filter{State: states},
+		PaginationMarker: paginationMarker,
+		Number:           number,
+	}
+	return c.listDatum(req, cb)
+}
+
 // ListDatumAll returns info about datums in a job.
 func (c APIClient) ListDatumAll(pipelineName string, jobID string) (_ []*pps.DatumInfo, retErr error) {
 	defer func() {
@@ -471,6 +508,21 @@ func (c APIClient) ListDatumInput(input *pps.Input, cb func(*pps.DatumInfo) erro
 	return c.listDatum(req, cb)
 }
 
+// ListDatumInputPage returns info about at most `number` datums for a
+// pipeline with input, starting after the datum with ID `paginationMarker`.
+// The pipeline doesn't need to exist.
+func (c APIClient) ListDatumInputPage(input *pps.Input, paginationMarker string, number int64, cb func(*pps.DatumInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &pps.ListDatumRequest{
+		Input:            input,
+		PaginationMarker: paginationMarker,
+		Number:           number,
+	}
+	return c.listDatum(req, cb)
+}
+
 // ListDatumInputAll returns info about datums for a pipeline with input. The
 // pipeline doesn't need to exist.
 func (c APIClient) ListDatumInputAll(input *pps.Input) (_ []*pps.DatumInfo, retErr error) {
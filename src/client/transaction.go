@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -83,12 +84,25 @@ func (c APIClient) GetTransaction() (*transaction.Transaction, error) {
 	return GetTransaction(c.Ctx())
 }
 
+// inTransaction returns true if the client will run write operations within a
+// transaction, as set by WithTransaction.
+func (c APIClient) inTransaction() bool {
+	md, _ := metadata.FromOutgoingContext(c.Ctx())
+	return len(md.Get(transactionMetadataKey)) > 0
+}
+
 // ListTransaction is an RPC that fetches a list of all open transactions in the
 // Pachyderm cluster.
 func (c APIClient) ListTransaction() ([]*transaction.TransactionInfo, error) {
+	return c.ListTransactionByOwner("")
+}
+
+// ListTransactionByOwner is an RPC that fetches a list of the open
+// transactions in the Pachyderm cluster that were started by owner.
+func (c APIClient) ListTransactionByOwner(owner string) ([]*transaction.TransactionInfo, error) {
 	response, err := c.TransactionAPIClient.ListTransaction(
 		c.Ctx(),
-		&transaction.ListTransactionRequest{},
+		&transaction.ListTransactionRequest{Owner: owner},
 	)
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
@@ -99,10 +113,18 @@ func (c APIClient) ListTransaction() ([]*transaction.TransactionInfo, error) {
 // StartTransaction is an RPC that registers a new transaction with the
 // Pachyderm cluster and returns the identifier of the new transaction.
 func (c APIClient) StartTransaction() (*transaction.Transaction, error) {
-	response, err := c.TransactionAPIClient.StartTransaction(
-		c.Ctx(),
-		&transaction.StartTransactionRequest{},
-	)
+	return c.StartTransactionWithTTL(0)
+}
+
+// StartTransactionWithTTL is like StartTransaction, but the transaction is
+// deleted if it hasn't been finished after ttl. A ttl of 0 uses the server's
+// default.
+func (c APIClient) StartTransactionWithTTL(ttl time.Duration) (*transaction.Transaction, error) {
+	request := &transaction.StartTransactionRequest{}
+	if ttl != 0 {
+		request.TTL = types.DurationProto(ttl)
+	}
+	response, err := c.TransactionAPIClient.StartTransaction(c.Ctx(), request)
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
@@ -137,6 +159,22 @@ func (c APIClient) DeleteTransaction(txn *transaction.Transaction) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ValidateTransaction is an RPC that runs an existing transaction without
+// persisting its changes, and reports the error (if any) that finishing it
+// would currently produce.
+func (c APIClient) ValidateTransaction(txn *transaction.Transaction) (*transaction.ValidateTransactionResponse, error) {
+	response, err := c.TransactionAPIClient.ValidateTransaction(
+		c.Ctx(),
+		&transaction.ValidateTransactionRequest{
+			Transaction: txn,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
 // InspectTransaction is an RPC that fetches the detailed information for an
 // existing transaction in the Pachyderm cluster.
 func (c APIClient) InspectTransaction(txn *transaction.Transaction) (*transaction.TransactionInfo, error) {
@@ -314,6 +352,10 @@ func (c *pfsBuilderClient) DeleteBranch(ctx context.Context, req *pfs.DeleteBran
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteBranch: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{AddFileSet: req})
+	return nil, nil
+}
 func (c *ppsBuilderClient) StopJob(ctx context.Context, req *pps.StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopJob: req})
 	return nil, nil
@@ -326,6 +368,21 @@ func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePi
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreatePipeline: req})
 	return nil, nil
 }
+func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	if req.All {
+		return nil, errors.New("deleting all pipelines is not supported in transactions")
+	}
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeletePipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopPipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StartPipeline: req})
+	return nil, nil
+}
 
 // Boilerplate for making unsupported API requests error when used on a TransactionBuilder
 func unsupportedError(name string) error {
@@ -338,6 +395,12 @@ func (c *pfsBuilderClient) ActivateAuth(ctx context.Context, req *pfs.ActivateAu
 func (c *pfsBuilderClient) InspectRepo(ctx context.Context, req *pfs.InspectRepoRequest, opts ...grpc.CallOption) (*pfs.RepoInfo, error) {
 	return nil, unsupportedError("InspectRepo")
 }
+func (c *pfsBuilderClient) GarbageCollect(ctx context.Context, req *pfs.GarbageCollectRequest, opts ...grpc.CallOption) (*pfs.GarbageCollectResponse, error) {
+	return nil, unsupportedError("GarbageCollect")
+}
+func (c *pfsBuilderClient) InspectRepoStorage(ctx context.Context, req *pfs.InspectRepoStorageRequest, opts ...grpc.CallOption) (*pfs.RepoStorageInfo, error) {
+	return nil, unsupportedError("InspectRepoStorage")
+}
 func (c *pfsBuilderClient) ListRepo(ctx context.Context, req *pfs.ListRepoRequest, opts ...grpc.CallOption) (pfs.API_ListRepoClient, error) {
 	return nil, unsupportedError("ListRepo")
 }
@@ -359,6 +422,9 @@ func (c *pfsBuilderClient) DropCommitSet(ctx context.Context, req *pfs.DropCommi
 func (c *pfsBuilderClient) SubscribeCommit(ctx context.Context, req *pfs.SubscribeCommitRequest, opts ...grpc.CallOption) (pfs.API_SubscribeCommitClient, error) {
 	return nil, unsupportedError("SubscribeCommit")
 }
+func (c *pfsBuilderClient) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest, opts ...grpc.CallOption) (*pfs.RevertCommitResponse, error) {
+	return nil, unsupportedError("RevertCommit")
+}
 func (c *pfsBuilderClient) ClearCommit(ctx context.Context, req *pfs.ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("ClearCommit")
 }
@@ -368,6 +434,24 @@ func (c *pfsBuilderClient) InspectBranch(ctx context.Context, req *pfs.InspectBr
 func (c *pfsBuilderClient) ListBranch(ctx context.Context, req *pfs.ListBranchRequest, opts ...grpc.CallOption) (pfs.API_ListBranchClient, error) {
 	return nil, unsupportedError("ListBranch")
 }
+func (c *pfsBuilderClient) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest, opts ...grpc.CallOption) (*pfs.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
+func (c *pfsBuilderClient) SetBranchProtection(ctx context.Context, req *pfs.SetBranchProtectionRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetBranchProtection")
+}
+func (c *pfsBuilderClient) CreateTag(ctx context.Context, req *pfs.CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+func (c *pfsBuilderClient) InspectTag(ctx context.Context, req *pfs.InspectTagRequest, opts ...grpc.CallOption) (*pfs.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+func (c *pfsBuilderClient) ListTag(ctx context.Context, req *pfs.ListTagRequest, opts ...grpc.CallOption) (pfs.API_ListTagClient, error) {
+	return nil, unsupportedError("ListTag")
+}
+func (c *pfsBuilderClient) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
 func (c *pfsBuilderClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (pfs.API_ModifyFileClient, error) {
 	return nil, unsupportedError("ModifyFile")
 }
@@ -404,9 +488,6 @@ func (c *pfsBuilderClient) CreateFileSet(ctx context.Context, opts ...grpc.CallO
 func (c *pfsBuilderClient) RenewFileSet(ctx context.Context, req *pfs.RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RenewFileSet")
 }
-func (c *pfsBuilderClient) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("AddFileSet")
-}
 func (c *pfsBuilderClient) GetFileSet(ctx context.Context, req *pfs.GetFileSetRequest, opts ...grpc.CallOption) (*pfs.CreateFileSetResponse, error) {
 	return nil, unsupportedError("GetFileSet")
 }
@@ -450,15 +531,6 @@ func (c *ppsBuilderClient) InspectPipeline(ctx context.Context, req *pps.Inspect
 func (c *ppsBuilderClient) ListPipeline(ctx context.Context, req *pps.ListPipelineRequest, opts ...grpc.CallOption) (pps.API_ListPipelineClient, error) {
 	return nil, unsupportedError("ListPipeline")
 }
-func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("DeletePipeline")
-}
-func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("StartPipeline")
-}
-func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("StopPipeline")
-}
 func (c *ppsBuilderClient) RunPipeline(ctx context.Context, req *pps.RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RunPipeline")
 }
@@ -526,6 +598,18 @@ func (c *authBuilderClient) Authorize(ctx context.Context, req *auth.AuthorizeRe
 func (c *authBuilderClient) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest, opts ...grpc.CallOption) (*auth.WhoAmIResponse, error) {
 	return nil, unsupportedError("WhoAmI")
 }
+func (c *authBuilderClient) CreateRole(ctx context.Context, req *auth.CreateRoleRequest, opts ...grpc.CallOption) (*auth.CreateRoleResponse, error) {
+	return nil, unsupportedError("CreateRole")
+}
+func (c *authBuilderClient) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest, opts ...grpc.CallOption) (*auth.UpdateRoleResponse, error) {
+	return nil, unsupportedError("UpdateRole")
+}
+func (c *authBuilderClient) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest, opts ...grpc.CallOption) (*auth.DeleteRoleResponse, error) {
+	return nil, unsupportedError("DeleteRole")
+}
+func (c *authBuilderClient) ListRoles(ctx context.Context, req *auth.ListRolesRequest, opts ...grpc.CallOption) (*auth.ListRolesResponse, error) {
+	return nil, unsupportedError("ListRoles")
+}
 func (c *authBuilderClient) GetRolesForPermission(ctx context.Context, req *auth.GetRolesForPermissionRequest, opts ...grpc.CallOption) (*auth.GetRolesForPermissionResponse, error) {
 	return nil, unsupportedError("GetRolesForPermission")
 }
@@ -605,6 +689,9 @@ func (c *transactionBuilderClient) ListTransaction(ctx context.Context, req *tra
 func (c *transactionBuilderClient) FinishTransaction(ctx context.Context, req *transaction.FinishTransactionRequest, opts ...grpc.CallOption) (*transaction.TransactionInfo, error) {
 	return nil, unsupportedError("FinishTransaction")
 }
+func (c *transactionBuilderClient) ValidateTransaction(ctx context.Context, req *transaction.ValidateTransactionRequest, opts ...grpc.CallOption) (*transaction.ValidateTransactionResponse, error) {
+	return nil, unsupportedError("ValidateTransaction")
+}
 func (c *transactionBuilderClient) DeleteAll(ctx context.Context, req *transaction.DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -37,6 +37,36 @@ func ListBranchInfo(client pfs.API_ListBranchClient) ([]*pfs.BranchInfo, error)
 	return results, nil
 }
 
+func ForEachTagInfo(client pfs.API_ListTagClient, cb func(*pfs.TagInfo) error) error {
+	for {
+		x, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := cb(x); err != nil {
+			if err == pacherr.ErrBreak {
+				err = nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func ListTagInfo(client pfs.API_ListTagClient) ([]*pfs.TagInfo, error) {
+	var results []*pfs.TagInfo
+	if err := ForEachTagInfo(client, func(x *pfs.TagInfo) error {
+		results = append(results, x)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func ForEachRepoInfo(client pfs.API_ListRepoClient, cb func(*pfs.RepoInfo) error) error {
 	for {
 		x, err := client.Recv()
@@ -1,5 +1,20 @@
 package clusterstate
 
+import (
+	"context"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+)
+
 // DesiredClusterState is the set of migrations to apply to run pachd at the current version.
 // New migrations should be appended to the end.
-var DesiredClusterState = state_2_0_0
+var DesiredClusterState = state_2_0_0.
+	Apply("create pfs tags collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.CollectionsV1()...)
+	}).
+	Apply("create auth roles collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, authserver.CollectionsV1()...)
+	})
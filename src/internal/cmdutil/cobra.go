@@ -136,6 +136,8 @@ func ParseRepo(name string) *pfs.Repo {
 //   repo@branch=commit:path
 //   repo@commit
 //   repo@commit:path
+// A tag may be given anywhere a branch is accepted, and is resolved to the
+// tagged commit by pachd.
 func parseFile(arg string) (*pfs.File, int, error) {
 	var repo, branch, commit, path string
 	parts := strings.SplitN(arg, "@", 2)
@@ -169,7 +171,8 @@ func parseFile(arg string) (*pfs.File, int, error) {
 }
 
 // ParseCommit takes an argument of the form "repo[@branch-or-commit]" and
-// returns the corresponding *pfs.Commit.
+// returns the corresponding *pfs.Commit. A tag is returned as a branch with
+// the tag's name, which pachd resolves to the tagged commit.
 func ParseCommit(arg string) (*pfs.Commit, error) {
 	file, numFields, err := parseFile(arg)
 	if err != nil {
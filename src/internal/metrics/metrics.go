@@ -148,7 +148,9 @@ func (r *Reporter) reportClusterMetrics() {
 		time.Sleep(reportingInterval)
 		metrics := &Metrics{}
 		r.internalMetrics(metrics)
-		externalMetrics(r.env.GetKubeClient(), metrics)
+		if r.env.Config().WorkerRuntime != serviceenv.LocalWorkerRuntime {
+			externalMetrics(r.env.GetKubeClient(), metrics)
+		}
 		metrics.ClusterID = r.clusterID
 		metrics.PodID = uuid.NewWithoutDashes()
 		metrics.Version = version.PrettyPrintVersion(version.Version)
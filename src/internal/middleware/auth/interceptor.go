@@ -45,6 +45,7 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/GetGroups":             authenticated,
 	"/auth_v2.API/GetPermissions":        authenticated,
 	"/auth_v2.API/GetRolesForPermission": authenticated,
+	"/auth_v2.API/ListRoles":             authenticated,
 
 	"/auth_v2.API/GetGroupsForPrincipal":      clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_GROUPS),
 	"/auth_v2.API/GetPermissionsForPrincipal": clusterPermissions(auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL),
@@ -60,6 +61,9 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/DeleteExpiredAuthTokens":    clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS),
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
+	"/auth_v2.API/CreateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/UpdateRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
+	"/auth_v2.API/DeleteRole":                 clusterPermissions(auth.Permission_CLUSTER_AUTH_MODIFY_ROLES),
 
 	//
 	// Debug API
@@ -121,41 +125,50 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs_v2.API/ActivateAuth":       clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs_v2.API/CreateRepo":         authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectRepo":        authDisabledOr(authenticated),
-	"/pfs_v2.API/ListRepo":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteRepo":         authDisabledOr(authenticated),
-	"/pfs_v2.API/StartCommit":        authDisabledOr(authenticated),
-	"/pfs_v2.API/FinishCommit":       authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommit":      authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommit":         authDisabledOr(authenticated),
-	"/pfs_v2.API/SubscribeCommit":    authDisabledOr(authenticated),
-	"/pfs_v2.API/ClearCommit":        authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectCommitSet":   authDisabledOr(authenticated),
-	"/pfs_v2.API/ListCommitSet":      authDisabledOr(authenticated),
-	"/pfs_v2.API/SquashCommitSet":    authDisabledOr(authenticated),
-	"/pfs_v2.API/DropCommitSet":      authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateBranch":       authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectBranch":      authDisabledOr(authenticated),
-	"/pfs_v2.API/ListBranch":         authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteBranch":       authDisabledOr(authenticated),
-	"/pfs_v2.API/ModifyFile":         authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFile":            authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFileTAR":         authDisabledOr(authenticated),
-	"/pfs_v2.API/InspectFile":        authDisabledOr(authenticated),
-	"/pfs_v2.API/ListFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/WalkFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/GlobFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DiffFile":           authDisabledOr(authenticated),
-	"/pfs_v2.API/DeleteAll":          authDisabledOr(authenticated),
-	"/pfs_v2.API/Fsck":               authDisabledOr(authenticated),
-	"/pfs_v2.API/CreateFileSet":      authDisabledOr(authenticated),
-	"/pfs_v2.API/GetFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/AddFileSet":         authDisabledOr(authenticated),
-	"/pfs_v2.API/RenewFileSet":       authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTest":        authDisabledOr(authenticated),
-	"/pfs_v2.API/RunLoadTestDefault": authDisabledOr(authenticated),
+	"/pfs_v2.API/ActivateAuth":        clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs_v2.API/CreateRepo":          authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectRepo":         authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectRepoStorage":  authDisabledOr(authenticated),
+	"/pfs_v2.API/ListRepo":            authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteRepo":          authDisabledOr(authenticated),
+	"/pfs_v2.API/StartCommit":         authDisabledOr(authenticated),
+	"/pfs_v2.API/FinishCommit":        authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommit":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommit":          authDisabledOr(authenticated),
+	"/pfs_v2.API/SubscribeCommit":     authDisabledOr(authenticated),
+	"/pfs_v2.API/ClearCommit":         authDisabledOr(authenticated),
+	"/pfs_v2.API/RevertCommit":        authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectCommitSet":    authDisabledOr(authenticated),
+	"/pfs_v2.API/ListCommitSet":       authDisabledOr(authenticated),
+	"/pfs_v2.API/SquashCommitSet":     authDisabledOr(authenticated),
+	"/pfs_v2.API/DropCommitSet":       authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateBranch":        authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectBranch":       authDisabledOr(authenticated),
+	"/pfs_v2.API/ListBranch":          authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteBranch":        authDisabledOr(authenticated),
+	"/pfs_v2.API/SetBranchProtection": authDisabledOr(authenticated),
+	"/pfs_v2.API/MergeBranch":         authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateTag":           authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectTag":          authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTag":             authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteTag":           authDisabledOr(authenticated),
+	"/pfs_v2.API/ModifyFile":          authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFile":             authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileTAR":          authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectFile":         authDisabledOr(authenticated),
+	"/pfs_v2.API/ListFile":            authDisabledOr(authenticated),
+	"/pfs_v2.API/WalkFile":            authDisabledOr(authenticated),
+	"/pfs_v2.API/GlobFile":            authDisabledOr(authenticated),
+	"/pfs_v2.API/DiffFile":            authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteAll":           authDisabledOr(authenticated),
+	"/pfs_v2.API/Fsck":                authDisabledOr(authenticated),
+	"/pfs_v2.API/GarbageCollect":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
+	"/pfs_v2.API/CreateFileSet":       authDisabledOr(authenticated),
+	"/pfs_v2.API/GetFileSet":          authDisabledOr(authenticated),
+	"/pfs_v2.API/AddFileSet":          authDisabledOr(authenticated),
+	"/pfs_v2.API/RenewFileSet":        authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTest":         authDisabledOr(authenticated),
+	"/pfs_v2.API/RunLoadTestDefault":  authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -199,13 +212,14 @@ var authHandlers = map[string]authHandler{
 	// TransactionAPI
 	//
 
-	"/transaction_v2.API/BatchTransaction":   authDisabledOr(authenticated),
-	"/transaction_v2.API/StartTransaction":   authDisabledOr(authenticated),
-	"/transaction_v2.API/InspectTransaction": authDisabledOr(authenticated),
-	"/transaction_v2.API/DeleteTransaction":  authDisabledOr(authenticated),
-	"/transaction_v2.API/ListTransaction":    authDisabledOr(authenticated),
-	"/transaction_v2.API/FinishTransaction":  authDisabledOr(authenticated),
-	"/transaction_v2.API/DeleteAll":          authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
+	"/transaction_v2.API/BatchTransaction":    authDisabledOr(authenticated),
+	"/transaction_v2.API/StartTransaction":    authDisabledOr(authenticated),
+	"/transaction_v2.API/InspectTransaction":  authDisabledOr(authenticated),
+	"/transaction_v2.API/DeleteTransaction":   authDisabledOr(authenticated),
+	"/transaction_v2.API/ListTransaction":     authDisabledOr(authenticated),
+	"/transaction_v2.API/FinishTransaction":   authDisabledOr(authenticated),
+	"/transaction_v2.API/ValidateTransaction": authDisabledOr(authenticated),
+	"/transaction_v2.API/DeleteAll":           authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
 	//
 	// Version API
@@ -5,6 +5,16 @@ import (
 	"io"
 )
 
+// ObjectInfo describes an object in object storage.
+type ObjectInfo struct {
+	Name string
+	Size int64
+	// ETag identifies the contents of the object. Its format depends on the
+	// storage backend, so it should only be compared to ETags from the same
+	// backend.
+	ETag string
+}
+
 // Client is an interface to object storage.
 type Client interface {
 	// Put writes the data from r to an object at name
@@ -26,6 +36,10 @@ type Client interface {
 	// Walk calls `fn` with the names of objects which can be found under `prefix`.
 	Walk(ctx context.Context, prefix string, fn func(name string) error) error
 
+	// WalkInfo is like Walk, but calls `fn` with the size and ETag of each
+	// object as well as its name.
+	WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) error
+
 	// Exists checks if a given object already exists
 	Exists(ctx context.Context, name string) (bool, error)
 }
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -77,7 +78,15 @@ func (c *localClient) Delete(_ context.Context, path string) (retErr error) {
 	return os.Remove(c.normPath(path))
 }
 
-func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name string) error) error {
+func (c *localClient) Walk(ctx context.Context, dir string, walkFn func(name string) error) error {
+	return c.WalkInfo(ctx, dir, func(info *ObjectInfo) error {
+		return walkFn(info.Name)
+	})
+}
+
+// WalkInfo uses the modification time of each file as its ETag, since the
+// filesystem doesn't track content hashes.
+func (c *localClient) WalkInfo(_ context.Context, dir string, walkFn func(info *ObjectInfo) error) error {
 	dir = c.normPath(dir)
 	fi, _ := os.Stat(dir)
 	prefix := ""
@@ -98,7 +107,11 @@ func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name strin
 		if !strings.HasPrefix(filepath.Base(relPath), prefix) {
 			return nil
 		}
-		return walkFn(relPath)
+		return walkFn(&ObjectInfo{
+			Name: relPath,
+			Size: fileInfo.Size(),
+			ETag: strconv.FormatInt(fileInfo.ModTime().UnixNano(), 16),
+		})
 	})
 	return err
 }
@@ -76,8 +76,14 @@ func (c *microsoftClient) Delete(_ context.Context, name string) error {
 	return err
 }
 
+func (c *microsoftClient) Walk(ctx context.Context, name string, f func(name string) error) error {
+	return c.WalkInfo(ctx, name, func(info *ObjectInfo) error {
+		return f(info.Name)
+	})
+}
+
 // TODO: should respect context
-func (c *microsoftClient) Walk(_ context.Context, name string, f func(name string) error) error {
+func (c *microsoftClient) WalkInfo(_ context.Context, name string, f func(info *ObjectInfo) error) error {
 	var marker string
 	for {
 		blobList, err := c.container.ListBlobs(storage.ListBlobsParameters{
@@ -88,7 +94,11 @@ func (c *microsoftClient) Walk(_ context.Context, name string, f func(name strin
 			return err
 		}
 		for _, file := range blobList.Blobs {
-			if err := f(file.Name); err != nil {
+			if err := f(&ObjectInfo{
+				Name: file.Name,
+				Size: file.Properties.ContentLength,
+				ETag: file.Properties.Etag,
+			}); err != nil {
 				return err
 			}
 		}
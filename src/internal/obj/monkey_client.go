@@ -79,6 +79,14 @@ func (c *monkeyClient) Walk(ctx context.Context, dir string, walkFn func(name st
 	return c.c.Walk(ctx, dir, walkFn)
 }
 
+// WalkInfo wraps the walk info operation.
+func (c *monkeyClient) WalkInfo(ctx context.Context, dir string, walkFn func(info *ObjectInfo) error) error {
+	if enabled && localRand.Float64() < failProb {
+		return errMsg
+	}
+	return c.c.WalkInfo(ctx, dir, walkFn)
+}
+
 // Exists wraps the existance check.
 func (c *monkeyClient) Exists(ctx context.Context, path string) (bool, error) {
 	if enabled && localRand.Float64() < failProb {
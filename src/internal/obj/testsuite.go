@@ -65,6 +65,27 @@ func TestSuite(t *testing.T, newClient func(t testing.TB) Client) {
 		actualHash := pachhash.Sum(buf.Bytes())
 		require.Equal(t, expectedHash, actualHash)
 	})
+
+	t.Run("TestWalkInfo", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t)
+		prefix := randutil.UniqueString("test-walk-info-")
+		data := map[string]string{
+			path.Join(prefix, "a"): "foo",
+			path.Join(prefix, "b"): "foo bar",
+		}
+		for name, content := range data {
+			require.NoError(t, client.Put(ctx, name, bytes.NewReader([]byte(content))))
+		}
+		etags := make(map[string]string)
+		require.NoError(t, client.WalkInfo(ctx, prefix, func(info *ObjectInfo) error {
+			require.Equal(t, int64(len(data[info.Name])), info.Size)
+			require.NotEqual(t, "", info.ETag)
+			etags[info.Name] = info.ETag
+			return nil
+		}))
+		require.Equal(t, len(data), len(etags))
+	})
 }
 
 func TestEmptyWrite(t *testing.T, client Client) {
@@ -56,6 +56,13 @@ func (cc *uniformClient) Walk(ctx context.Context, prefix string, fn func(name s
 	return cc.c.Walk(ctx, prefix, fn)
 }
 
+func (cc *uniformClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return cc.c.WalkInfo(ctx, prefix, fn)
+}
+
 func (uc *uniformClient) Exists(ctx context.Context, p string) (_ bool, retErr error) {
 	defer func() {
 		retErr = errors.EnsureStack(retErr)
@@ -0,0 +1,119 @@
+package pfsdb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// CommitFilter selects commits from the commits collection by the filters in
+// a ListCommitRequest. A nil CommitFilter matches every commit.
+type CommitFilter struct {
+	startedAfter, startedBefore   time.Time
+	finishedAfter, finishedBefore time.Time
+	description                   string
+	errorFilter                   pfs.ListCommitRequest_ErrorFilter
+	minSizeBytes, maxSizeBytes    int64
+	metadata                      map[string]string
+}
+
+// NewCommitFilter validates the filters in request and returns a CommitFilter
+// for them.
+func NewCommitFilter(request *pfs.ListCommitRequest) (*CommitFilter, error) {
+	f := &CommitFilter{
+		description:  request.Description,
+		errorFilter:  request.ErrorFilter,
+		minSizeBytes: request.MinSizeBytes,
+		maxSizeBytes: request.MaxSizeBytes,
+		metadata:     request.Metadata,
+	}
+	for _, bound := range []struct {
+		ts *types.Timestamp
+		t  *time.Time
+	}{
+		{request.StartedAfter, &f.startedAfter},
+		{request.StartedBefore, &f.startedBefore},
+		{request.FinishedAfter, &f.finishedAfter},
+		{request.FinishedBefore, &f.finishedBefore},
+	} {
+		if bound.ts == nil {
+			continue
+		}
+		t, err := types.TimestampFromProto(bound.ts)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		*bound.t = t
+	}
+	switch {
+	case f.minSizeBytes < 0 || f.maxSizeBytes < 0:
+		return nil, errors.Errorf("commit size bounds cannot be negative")
+	case f.maxSizeBytes != 0 && f.minSizeBytes > f.maxSizeBytes:
+		return nil, errors.Errorf("min_size_bytes (%d) cannot be greater than max_size_bytes (%d)", f.minSizeBytes, f.maxSizeBytes)
+	case !f.startedBefore.IsZero() && !f.startedAfter.Before(f.startedBefore):
+		return nil, errors.Errorf("started_after must be before started_before")
+	case !f.finishedBefore.IsZero() && !f.finishedAfter.Before(f.finishedBefore):
+		return nil, errors.Errorf("finished_after must be before finished_before")
+	}
+	return f, nil
+}
+
+// Matches returns true if commitInfo passes every filter in f.
+func (f *CommitFilter) Matches(commitInfo *pfs.CommitInfo) bool {
+	if f == nil {
+		return true
+	}
+	if !inWindow(commitInfo.Started, f.startedAfter, f.startedBefore) ||
+		!inWindow(commitInfo.Finished, f.finishedAfter, f.finishedBefore) {
+		return false
+	}
+	if !strings.Contains(commitInfo.Description, f.description) {
+		return false
+	}
+	switch f.errorFilter {
+	case pfs.ListCommitRequest_ERRORED:
+		if commitInfo.Error == "" {
+			return false
+		}
+	case pfs.ListCommitRequest_NOT_ERRORED:
+		if commitInfo.Error != "" {
+			return false
+		}
+	}
+	if f.minSizeBytes != 0 || f.maxSizeBytes != 0 {
+		// Only finished commits have a known size.
+		if commitInfo.Finished == nil || commitInfo.Details == nil {
+			return false
+		}
+		size := commitInfo.Details.SizeBytes
+		if size < f.minSizeBytes || f.maxSizeBytes != 0 && size > f.maxSizeBytes {
+			return false
+		}
+	}
+	for k, v := range f.metadata {
+		if actual, ok := commitInfo.Metadata[k]; !ok || v != "" && actual != v {
+			return false
+		}
+	}
+	return true
+}
+
+// inWindow returns true if ts is in [after, before), where a zero bound is
+// unset. A nil ts only matches if both bounds are unset.
+func inWindow(ts *types.Timestamp, after, before time.Time) bool {
+	if after.IsZero() && before.IsZero() {
+		return true
+	}
+	if ts == nil {
+		return false
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return false
+	}
+	return !t.Before(after) && (before.IsZero() || t.Before(before))
+}
@@ -0,0 +1,66 @@
+package pfsdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func TestCommitFilter(t *testing.T) {
+	ts := func(t time.Time) *types.Timestamp {
+		result, _ := types.TimestampProto(t)
+		return result
+	}
+	now := time.Now()
+	finished := &pfs.CommitInfo{
+		Description: "nightly load",
+		Started:     ts(now.Add(-2 * time.Hour)),
+		Finished:    ts(now.Add(-time.Hour)),
+		Details:     &pfs.CommitInfo_Details{SizeBytes: 100},
+		Metadata:    map[string]string{"source": "crm"},
+	}
+	open := &pfs.CommitInfo{
+		Started: ts(now.Add(-time.Minute)),
+	}
+	errored := &pfs.CommitInfo{
+		Started:  ts(now.Add(-3 * time.Hour)),
+		Finished: ts(now.Add(-3 * time.Hour)),
+		Details:  &pfs.CommitInfo_Details{SizeBytes: 10},
+		Error:    "validation failed",
+	}
+	matches := func(request *pfs.ListCommitRequest) []*pfs.CommitInfo {
+		filter, err := NewCommitFilter(request)
+		require.NoError(t, err)
+		var result []*pfs.CommitInfo
+		for _, ci := range []*pfs.CommitInfo{finished, open, errored} {
+			if filter.Matches(ci) {
+				result = append(result, ci)
+			}
+		}
+		return result
+	}
+
+	require.Equal(t, []*pfs.CommitInfo{finished, open, errored}, matches(&pfs.ListCommitRequest{}))
+	require.Equal(t, []*pfs.CommitInfo{finished, open}, matches(&pfs.ListCommitRequest{StartedAfter: ts(now.Add(-150 * time.Minute))}))
+	require.Equal(t, []*pfs.CommitInfo{finished, errored}, matches(&pfs.ListCommitRequest{StartedBefore: ts(now.Add(-time.Hour))}))
+	require.Equal(t, []*pfs.CommitInfo{finished}, matches(&pfs.ListCommitRequest{FinishedAfter: ts(now.Add(-2 * time.Hour))}))
+	require.Equal(t, []*pfs.CommitInfo{finished}, matches(&pfs.ListCommitRequest{Description: "nightly"}))
+	require.Equal(t, []*pfs.CommitInfo{errored}, matches(&pfs.ListCommitRequest{ErrorFilter: pfs.ListCommitRequest_ERRORED}))
+	require.Equal(t, []*pfs.CommitInfo{finished, open}, matches(&pfs.ListCommitRequest{ErrorFilter: pfs.ListCommitRequest_NOT_ERRORED}))
+	require.Equal(t, []*pfs.CommitInfo{finished}, matches(&pfs.ListCommitRequest{MinSizeBytes: 50}))
+	require.Equal(t, []*pfs.CommitInfo{errored}, matches(&pfs.ListCommitRequest{MaxSizeBytes: 50}))
+	require.Equal(t, []*pfs.CommitInfo{finished}, matches(&pfs.ListCommitRequest{Metadata: map[string]string{"source": ""}}))
+
+	for _, request := range []*pfs.ListCommitRequest{
+		{MinSizeBytes: -1},
+		{MinSizeBytes: 10, MaxSizeBytes: 5},
+		{StartedAfter: ts(now), StartedBefore: ts(now.Add(-time.Hour))},
+	} {
+		_, err := NewCommitFilter(request)
+		require.YesError(t, err)
+	}
+}
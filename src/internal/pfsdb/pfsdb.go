@@ -18,6 +18,7 @@ const (
 	reposCollectionName    = "repos"
 	branchesCollectionName = "branches"
 	commitsCollectionName  = "commits"
+	tagsCollectionName     = "tags"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -175,6 +176,53 @@ func Branches(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection
 	)
 }
 
+var TagsRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.TagInfo).Tag.Repo)
+	},
+}
+
+var tagsIndexes = []*col.Index{TagsRepoIndex}
+
+func TagKey(tag *pfs.Tag) string {
+	return RepoKey(tag.Repo) + "@" + tag.Name
+}
+
+// Tags returns a collection of tags
+func Tags(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		tagsCollectionName,
+		db,
+		listener,
+		&pfs.TagInfo{},
+		tagsIndexes,
+		col.WithKeyGen(func(key interface{}) (string, error) {
+			if tag, ok := key.(*pfs.Tag); !ok {
+				return "", errors.New("key must be a tag")
+			} else {
+				return TagKey(tag), nil
+			}
+		}),
+		col.WithKeyCheck(func(key string) error {
+			keyParts := strings.Split(key, "@")
+			if len(keyParts) != 2 {
+				return errors.Errorf("tag key %s isn't valid, use TagKey to generate it", key)
+			}
+			if uuid.IsUUIDWithoutDashes(keyParts[1]) {
+				return errors.Errorf("tag name cannot be a UUID V4")
+			}
+			return repoKeyCheck(keyParts[0])
+		}),
+		col.WithNotFoundMessage(func(key interface{}) string {
+			return pfsserver.ErrTagNotFound{Tag: key.(*pfs.Tag)}.Error()
+		}),
+		col.WithExistsMessage(func(key interface{}) string {
+			return pfsserver.ErrTagExists{Tag: key.(*pfs.Tag)}.Error()
+		}),
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -187,3 +235,12 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(branchesCollectionName, nil, nil, nil, branchesIndexes),
 	}
 }
+
+// CollectionsV1 returns the PFS collections added after CollectionsV0, for
+// postgres-initialization purposes. It's applied by a migration, so once that
+// migration is released this function must not change.
+func CollectionsV1() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(tagsCollectionName, nil, nil, nil, tagsIndexes),
+	}
+}
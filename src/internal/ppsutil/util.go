@@ -241,6 +241,9 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 		if input.Cron != nil {
 			input.Cron.Commit = commitsetID
 		}
+		if input.URL != nil {
+			input.URL.Commit = commitsetID
+		}
 		return nil
 	})
 	return jobInput
@@ -249,30 +252,32 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommit *pfs.Commit) *pps.Inp
 // PipelineReqFromInfo converts a PipelineInfo into a CreatePipelineRequest.
 func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequest {
 	return &pps.CreatePipelineRequest{
-		Pipeline:              pipelineInfo.Pipeline,
-		Transform:             pipelineInfo.Details.Transform,
-		ParallelismSpec:       pipelineInfo.Details.ParallelismSpec,
-		Egress:                pipelineInfo.Details.Egress,
-		OutputBranch:          pipelineInfo.Details.OutputBranch,
-		ResourceRequests:      pipelineInfo.Details.ResourceRequests,
-		ResourceLimits:        pipelineInfo.Details.ResourceLimits,
-		SidecarResourceLimits: pipelineInfo.Details.SidecarResourceLimits,
-		Input:                 pipelineInfo.Details.Input,
-		Description:           pipelineInfo.Details.Description,
-		Service:               pipelineInfo.Details.Service,
-		DatumSetSpec:          pipelineInfo.Details.DatumSetSpec,
-		DatumTimeout:          pipelineInfo.Details.DatumTimeout,
-		JobTimeout:            pipelineInfo.Details.JobTimeout,
-		Salt:                  pipelineInfo.Details.Salt,
-		PodSpec:               pipelineInfo.Details.PodSpec,
-		PodPatch:              pipelineInfo.Details.PodPatch,
-		Spout:                 pipelineInfo.Details.Spout,
-		SchedulingSpec:        pipelineInfo.Details.SchedulingSpec,
-		DatumTries:            pipelineInfo.Details.DatumTries,
-		S3Out:                 pipelineInfo.Details.S3Out,
-		Metadata:              pipelineInfo.Details.Metadata,
-		ReprocessSpec:         pipelineInfo.Details.ReprocessSpec,
-		Autoscaling:           pipelineInfo.Details.Autoscaling,
+		Pipeline:               pipelineInfo.Pipeline,
+		Transform:              pipelineInfo.Details.Transform,
+		ParallelismSpec:        pipelineInfo.Details.ParallelismSpec,
+		Egress:                 pipelineInfo.Details.Egress,
+		OutputBranch:           pipelineInfo.Details.OutputBranch,
+		ResourceRequests:       pipelineInfo.Details.ResourceRequests,
+		ResourceLimits:         pipelineInfo.Details.ResourceLimits,
+		SidecarResourceLimits:  pipelineInfo.Details.SidecarResourceLimits,
+		Input:                  pipelineInfo.Details.Input,
+		Description:            pipelineInfo.Details.Description,
+		Service:                pipelineInfo.Details.Service,
+		DatumSetSpec:           pipelineInfo.Details.DatumSetSpec,
+		DatumTimeout:           pipelineInfo.Details.DatumTimeout,
+		JobTimeout:             pipelineInfo.Details.JobTimeout,
+		Salt:                   pipelineInfo.Details.Salt,
+		PodSpec:                pipelineInfo.Details.PodSpec,
+		PodPatch:               pipelineInfo.Details.PodPatch,
+		Spout:                  pipelineInfo.Details.Spout,
+		SchedulingSpec:         pipelineInfo.Details.SchedulingSpec,
+		DatumTries:             pipelineInfo.Details.DatumTries,
+		DatumRetryPolicy:       pipelineInfo.Details.DatumRetryPolicy,
+		QuarantineFailedDatums: pipelineInfo.Details.QuarantineFailedDatums,
+		S3Out:                  pipelineInfo.Details.S3Out,
+		Metadata:               pipelineInfo.Details.Metadata,
+		ReprocessSpec:          pipelineInfo.Details.ReprocessSpec,
+		Autoscaling:            pipelineInfo.Details.Autoscaling,
 	}
 }
 
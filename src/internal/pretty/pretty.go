@@ -2,6 +2,7 @@ package pretty
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -56,6 +57,21 @@ func Size(size int64) string {
 	return units.BytesSize(float64(size))
 }
 
+// Metadata pretty-prints key/value metadata as comma separated key=value
+// pairs, sorted by key.
+func Metadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + metadata[k]
+	}
+	return strings.Join(pairs, ", ")
+}
+
 // ProgressBar pretty prints a progress bar with given width and green, yellow
 // and red segments.  green, yellow and red need not add to width, they will be
 // normalized. If red is nonzero there will always be at least one red segment,
@@ -83,7 +83,7 @@ type PachdSpecificConfiguration struct {
 	StorageBackend             string `env:"STORAGE_BACKEND,required"`
 	StorageHostPath            string `env:"STORAGE_HOST_PATH,default="`
 	PFSEtcdPrefix              string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
-	KubeAddress                string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
+	KubeAddress                string `env:"KUBERNETES_PORT_443_TCP_ADDR"`
 	Init                       bool   `env:"INIT,default=false"`
 	WorkerImage                string `env:"WORKER_IMAGE,default="`
 	WorkerSidecarImage         string `env:"WORKER_SIDECAR_IMAGE,default="`
@@ -92,6 +92,14 @@ type PachdSpecificConfiguration struct {
 	MemoryRequest              string `env:"PACHD_MEMORY_REQUEST,default=1T"`
 	WorkerUsesRoot             bool   `env:"WORKER_USES_ROOT,default=false"`
 	RequireCriticalServersOnly bool   `env:"REQUIRE_CRITICAL_SERVERS_ONLY,default=false"`
+	// WorkerRuntime selects how pipeline workers are run, either
+	// KubeWorkerRuntime or LocalWorkerRuntime.
+	WorkerRuntime string `env:"WORKER_RUNTIME,default=kubernetes"`
+	// LocalWorkerBinary and LocalWorkerRoot are only used by the local worker
+	// runtime. They are the worker binary that pachd launches, and the
+	// directory under which each worker gets its own scratch space.
+	LocalWorkerBinary string `env:"LOCAL_WORKER_BINARY,default=worker"`
+	LocalWorkerRoot   string `env:"LOCAL_WORKER_ROOT,default=/tmp/pach/workers"`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
 }
@@ -109,6 +117,7 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize         int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageCompression             string `env:"STORAGE_COMPRESSION"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -124,6 +133,10 @@ type WorkerSpecificConfiguration struct {
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// The directory that the worker uses as the root of its filesystem, i.e.
+	// where it places its inputs and runs the user's code. Workers run by the
+	// local worker runtime share a host, so each gets its own directory.
+	PPSWorkerRoot string `env:"PPS_WORKER_ROOT,default=/"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -136,6 +149,15 @@ type FeatureFlags struct {
 	IdentityServerEnabled        bool `env:"IDENTITY_SERVER_ENABLED,default=false"`
 }
 
+const (
+	// KubeWorkerRuntime runs pipeline workers as pods in the kubernetes cluster
+	// that pachd is deployed in. This is the default.
+	KubeWorkerRuntime = "kubernetes"
+	// LocalWorkerRuntime runs pipeline workers as processes on the same host as
+	// pachd, so that pipelines can run without kubernetes.
+	LocalWorkerRuntime = "local"
+)
+
 // NewConfiguration creates a generic configuration from a specific type of configuration.
 func NewConfiguration(config interface{}) *Configuration {
 	configuration := &Configuration{}
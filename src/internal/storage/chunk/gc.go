@@ -2,11 +2,68 @@ package chunk
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
 )
 
+var (
+	gcPassesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_chunk_gc",
+		Name:      "pass_count",
+		Help:      "Count of chunk garbage collection passes, by outcome ('error', 'ok').",
+	}, []string{"outcome"})
+	gcDeletedMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_chunk_gc",
+		Name:      "deleted_count",
+		Help:      "Count of chunk objects deleted from object storage by garbage collection.",
+	})
+	gcDeletedBytesMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_chunk_gc",
+		Name:      "deleted_bytes",
+		Help:      "Size in object storage of the chunks deleted by garbage collection.",
+	})
+	gcPassDurationMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_chunk_gc",
+		Name:      "pass_duration_seconds",
+		Help:      "Time taken for a chunk garbage collection pass.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	})
+)
+
+// GCStats describes the chunk objects deleted, or that would be deleted, by a pass of garbage collection.
+type GCStats struct {
+	Objects int64
+	// SizeBytes is the size of the chunks in object storage, after compression
+	// and encryption.
+	SizeBytes int64
+	// Oldest is the creation time of the oldest object, or the zero time if there were none.
+	Oldest time.Time
+}
+
+func (s *GCStats) add(objects, sizeBytes int64, oldest time.Time) {
+	s.Objects += objects
+	s.SizeBytes += sizeBytes
+	if objects > 0 && (s.Oldest.IsZero() || oldest.Before(s.Oldest)) {
+		s.Oldest = oldest
+	}
+}
+
+type gcEntry struct {
+	Entry
+	Size      int64     `db:"size"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 // GarbageCollector removes unused chunks from object storage
 type GarbageCollector struct {
 	s   *Storage
@@ -23,7 +80,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
 	for {
-		if err := gc.RunOnce(ctx); err != nil {
+		if _, err := gc.RunOnce(ctx); err != nil {
 			select {
 			case <-ctx.Done():
 				return err
@@ -39,14 +96,23 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	}
 }
 
-// RunOnce runs 1 cycle of garbage collection.
-func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
+// RunOnce runs 1 cycle of garbage collection, and returns what it deleted.
+func (gc *GarbageCollector) RunOnce(ctx context.Context) (stats *GCStats, retErr error) {
+	stats = &GCStats{}
+	defer func(start time.Time) {
+		outcome := "ok"
+		if retErr != nil {
+			outcome = "error"
+		}
+		gcPassesMetric.WithLabelValues(outcome).Inc()
+		gcPassDurationMetric.Observe(time.Since(start).Seconds())
+	}(time.Now())
 	rows, err := gc.s.db.QueryxContext(ctx, `
-	SELECT chunk_id, gen, uploaded FROM storage.chunk_objects
+	SELECT chunk_id, gen, uploaded, size, created_at FROM storage.chunk_objects
 	WHERE tombstone = true
 	`)
 	if err != nil {
-		return err
+		return stats, err
 	}
 	defer func() {
 		if err := rows.Close(); retErr == nil {
@@ -54,22 +120,65 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (retErr error) {
 		}
 	}()
 	for rows.Next() {
-		var ent Entry
+		var ent gcEntry
 		if err := rows.StructScan(&ent); err != nil {
-			return err
+			return stats, err
 		}
 		if !ent.Uploaded {
 			gc.log.Warnf("possibility for untracked chunk %s", chunkPath(ent.ChunkID, ent.Gen))
 		}
-		if err := gc.deleteOne(ctx, ent); err != nil {
-			return err
+		if err := gc.deleteOne(ctx, ent.Entry); err != nil {
+			return stats, err
 		}
+		stats.add(1, ent.Size, ent.CreatedAt)
+		gcDeletedMetric.Inc()
+		gcDeletedBytesMetric.Add(float64(ent.Size))
 		gc.log.WithFields(logrus.Fields{
 			"chunk_id": ent.ChunkID,
 			"gen":      ent.Gen,
 		}).Infof("deleting object for chunk entry")
 	}
-	return rows.Err()
+	return stats, rows.Err()
+}
+
+// DryRun returns what RunOnce would delete, without deleting anything.
+// The chunks in pending are included as well, for when they are about to be deleted from the tracker.
+func (gc *GarbageCollector) DryRun(ctx context.Context, pending []ID) (*GCStats, error) {
+	stats := &GCStats{}
+	if err := gc.summarize(ctx, stats, `WHERE tombstone = TRUE`); err != nil {
+		return nil, err
+	}
+	for len(pending) > 0 {
+		n := sizeOfBatchSize
+		if len(pending) < n {
+			n = len(pending)
+		}
+		batch := make(pq.ByteaArray, n)
+		for i, id := range pending[:n] {
+			batch[i] = id
+		}
+		if err := gc.summarize(ctx, stats, `WHERE tombstone = FALSE AND chunk_id = ANY($1)`, batch); err != nil {
+			return nil, err
+		}
+		pending = pending[n:]
+	}
+	return stats, nil
+}
+
+func (gc *GarbageCollector) summarize(ctx context.Context, stats *GCStats, where string, args ...interface{}) error {
+	var x struct {
+		Objects   int64        `db:"objects"`
+		SizeBytes int64        `db:"size_bytes"`
+		Oldest    sql.NullTime `db:"oldest"`
+	}
+	if err := gc.s.db.GetContext(ctx, &x, `
+	SELECT COUNT(*) AS objects, COALESCE(SUM(size), 0) AS size_bytes, MIN(created_at) AS oldest
+	FROM storage.chunk_objects
+	`+where, args...); err != nil {
+		return errors.EnsureStack(err)
+	}
+	stats.add(x.Objects, x.SizeBytes, x.Oldest.Time)
+	return nil
 }
 
 func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
@@ -40,11 +40,18 @@ func TestGC(t *testing.T) {
 		}
 	})
 	tgc := track.NewGarbageCollector(tracker, time.Minute, deleter)
-	require.NoError(t, tgc.RunUntilEmpty(ctx))
+	_, err = tgc.RunUntilEmpty(ctx)
+	require.NoError(t, err)
 
 	// run the chunk GC
 	gc := NewGC(s)
-	require.NoError(t, gc.RunOnce(ctx))
+	dryRun, err := gc.DryRun(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(count), dryRun.Objects)
+	stats, err := gc.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, dryRun.Objects, stats.Objects)
+	require.Equal(t, dryRun.SizeBytes, stats.SizeBytes)
 
 	// make sure there are no objects
 	count, err = countObjects(ctx, oc)
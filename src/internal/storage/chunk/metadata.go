@@ -48,6 +48,8 @@ func (id ID) TrackerID() string {
 
 // Metadata holds metadata about a chunk
 type Metadata struct {
+	// Size is the size of the chunk in object storage, after compression and
+	// encryption.
 	Size     int
 	PointsTo []ID
 }
@@ -71,6 +71,22 @@ func WithNoUpload() WriterOption {
 	}
 }
 
+// WithDefaultWriterCompression sets the compression algorithm used by the
+// storage's writers, unless they set their own with WithWriterCompression.
+func WithDefaultWriterCompression(algo CompressionAlgo) StorageOption {
+	return func(s *Storage) {
+		s.writerCompression = &algo
+	}
+}
+
+// WithWriterCompression sets the compression algorithm for the chunks created
+// by the writer.
+func WithWriterCompression(algo CompressionAlgo) WriterOption {
+	return func(w *Writer) {
+		w.compression = &algo
+	}
+}
+
 // StorageOptions returns the chunk storage options for the config.
 func StorageOptions(conf *serviceenv.Configuration) ([]StorageOption, error) {
 	var opts []StorageOption
@@ -85,5 +101,12 @@ func StorageOptions(conf *serviceenv.Configuration) ([]StorageOption, error) {
 		diskCache = obj.TracingObjClient("DiskCache", diskCache)
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageCompression != "" {
+		algo, err := ParseCompressionAlgo(conf.StorageCompression)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithDefaultWriterCompression(algo))
+	}
 	return opts, nil
 }
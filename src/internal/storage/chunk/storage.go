@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
@@ -15,6 +17,8 @@ const (
 	TrackerPrefix   = "chunk/"
 	prefix          = "chunk"
 	defaultChunkTTL = 30 * time.Minute
+	// sizeOfBatchSize is the number of chunks summed per query by SizeOf
+	sizeOfBatchSize = 1000
 )
 
 // Storage is the abstraction that manages chunk storage.
@@ -26,6 +30,9 @@ type Storage struct {
 	db        *sqlx.DB
 
 	createOpts CreateOptions
+	// writerCompression is the compression algorithm used by writers that
+	// don't set their own (see WithDefaultWriterCompression)
+	writerCompression *CompressionAlgo
 }
 
 // NewStorage creates a new Storage.
@@ -62,6 +69,9 @@ func (s *Storage) NewWriter(ctx context.Context, name string, cb WriterCallback,
 		panic("name must not be empty")
 	}
 	client := NewClient(s.store, s.db, s.tracker, name)
+	if s.writerCompression != nil {
+		opts = append([]WriterOption{WithWriterCompression(*s.writerCompression)}, opts...)
+	}
 	return newWriter(ctx, client, s.memCache, s.createOpts, cb, opts...)
 }
 
@@ -72,6 +82,35 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	})
 }
 
+// SizeOf returns the total size of the chunks with ids.
+// Each chunk is counted once, at its size in object storage (after compression
+// and encryption).
+func (s *Storage) SizeOf(ctx context.Context, ids []ID) (int64, error) {
+	var total int64
+	for len(ids) > 0 {
+		n := sizeOfBatchSize
+		if len(ids) < n {
+			n = len(ids)
+		}
+		batch := make(pq.ByteaArray, n)
+		for i, id := range ids[:n] {
+			batch[i] = id
+		}
+		var size int64
+		if err := s.db.GetContext(ctx, &size, `
+		SELECT COALESCE(SUM(size), 0) FROM (
+			SELECT DISTINCT ON (chunk_id) size FROM storage.chunk_objects
+			WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = ANY($1)
+		) AS chunks
+		`, batch); err != nil {
+			return 0, errors.EnsureStack(err)
+		}
+		total += size
+		ids = ids[n:]
+	}
+	return total, nil
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
@@ -7,10 +7,13 @@ import (
 	"crypto/cipher"
 	io "io"
 	"io/ioutil"
+	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pierrec/lz4/v4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/chacha20"
 )
@@ -77,6 +80,28 @@ func Get(ctx context.Context, client Client, cache kv.GetPut, ref *Ref, cb kv.Va
 	})
 }
 
+// ParseCompressionAlgo parses the name of a compression algorithm, as used in
+// configuration, into a CompressionAlgo.
+func ParseCompressionAlgo(name string) (CompressionAlgo, error) {
+	switch strings.ToLower(name) {
+	case "none":
+		return CompressionAlgo_NONE, nil
+	case "gzip":
+		return CompressionAlgo_GZIP_BEST_SPEED, nil
+	case "zstd":
+		return CompressionAlgo_ZSTD, nil
+	case "lz4":
+		return CompressionAlgo_LZ4, nil
+	default:
+		return 0, errors.Errorf("unrecognized compression algorithm %q, must be one of none, gzip, zstd or lz4", name)
+	}
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
 // compress attempts to compress src using algo. If the compressed data is bigger
 // then no compression is used.
 // compress returns the compression algorithm used (algo or NONE), the number of bytes written to dst
@@ -87,32 +112,50 @@ func compress(algo CompressionAlgo, dst, src []byte) (CompressionAlgo, int, erro
 		copy(dst, src)
 		return CompressionAlgo_NONE, len(src), nil
 	case CompressionAlgo_GZIP_BEST_SPEED:
-		lw := newLimitWriter(dst)
-		err := func() (retErr error) {
-			gw, err := gzip.NewWriterLevel(lw, gzip.BestSpeed)
-			if err != nil {
-				return err
-			}
-			defer func() {
-				if err := gw.Close(); retErr == nil {
-					retErr = err
-				}
-			}()
-			_, err = gw.Write(src)
-			if err != nil {
-				return err
-			}
-			return gw.Close()
-		}()
-		if err == io.ErrShortWrite {
+		return compressStream(algo, dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, gzip.BestSpeed)
+		})
+	case CompressionAlgo_ZSTD:
+		ctext := zstdEncoder.EncodeAll(src, make([]byte, 0, len(dst)))
+		if len(ctext) > len(dst) {
 			return compress(CompressionAlgo_NONE, dst, src)
 		}
-		return CompressionAlgo_GZIP_BEST_SPEED, lw.pos, err
+		return CompressionAlgo_ZSTD, copy(dst, ctext), nil
+	case CompressionAlgo_LZ4:
+		return compressStream(algo, dst, src, func(w io.Writer) (io.WriteCloser, error) {
+			return lz4.NewWriter(w), nil
+		})
 	default:
 		return 0, 0, errors.Errorf("unrecognized compression: %v", algo)
 	}
 }
 
+// compressStream compresses src into dst with a streaming compressor created
+// by newWriter, falling back to no compression if the output does not fit.
+func compressStream(algo CompressionAlgo, dst, src []byte, newWriter func(io.Writer) (io.WriteCloser, error)) (CompressionAlgo, int, error) {
+	lw := newLimitWriter(dst)
+	err := func() (retErr error) {
+		cw, err := newWriter(lw)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := cw.Close(); retErr == nil {
+				retErr = err
+			}
+		}()
+		_, err = cw.Write(src)
+		if err != nil {
+			return err
+		}
+		return cw.Close()
+	}()
+	if errors.Is(err, io.ErrShortWrite) {
+		return compress(CompressionAlgo_NONE, dst, src)
+	}
+	return algo, lw.pos, err
+}
+
 func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 	switch algo {
 	case CompressionAlgo_NONE:
@@ -123,6 +166,18 @@ func decompress(algo CompressionAlgo, r io.Reader) (io.Reader, error) {
 			return nil, err
 		}
 		return gr, nil
+	case CompressionAlgo_ZSTD:
+		ctext, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		ptext, err := zstdDecoder.DecodeAll(ctext, nil)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewReader(ptext), nil
+	case CompressionAlgo_LZ4:
+		return lz4.NewReader(r), nil
 	default:
 		return nil, errors.Errorf("unrecognized compression: %v", algo)
 	}
@@ -0,0 +1,59 @@
+package chunk
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestCompression(t *testing.T) {
+	compressible := bytes.Repeat([]byte("pachyderm"), units.MB)
+	incompressible := randutil.Bytes(rand.New(rand.NewSource(0)), units.MB)
+	for _, algo := range []CompressionAlgo{CompressionAlgo_NONE, CompressionAlgo_GZIP_BEST_SPEED, CompressionAlgo_ZSTD, CompressionAlgo_LZ4} {
+		t.Run(algo.String(), func(t *testing.T) {
+			var stored []byte
+			createFunc := func(_ context.Context, data []byte) (ID, error) {
+				stored = append([]byte{}, data...)
+				return Hash(data), nil
+			}
+			ref, err := Create(context.Background(), CreateOptions{Compression: algo}, compressible, createFunc)
+			require.NoError(t, err)
+			require.Equal(t, algo, ref.CompressionAlgo)
+			if algo != CompressionAlgo_NONE {
+				require.True(t, len(stored) < len(compressible))
+			}
+			requireRoundTrip(t, ref, stored, compressible)
+
+			// Data that doesn't compress should be stored uncompressed.
+			ref, err = Create(context.Background(), CreateOptions{Compression: algo}, incompressible, createFunc)
+			require.NoError(t, err)
+			require.Equal(t, CompressionAlgo_NONE, ref.CompressionAlgo)
+			requireRoundTrip(t, ref, stored, incompressible)
+		})
+	}
+}
+
+func requireRoundTrip(t *testing.T, ref *Ref, ctext, expected []byte) {
+	t.Helper()
+	r, err := decrypt(ref.Dek, bytes.NewReader(ctext))
+	require.NoError(t, err)
+	r, err = decompress(ref.CompressionAlgo, r)
+	require.NoError(t, err)
+	ptext, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(expected, ptext))
+}
+
+func TestParseCompressionAlgo(t *testing.T) {
+	algo, err := ParseCompressionAlgo("ZSTD")
+	require.NoError(t, err)
+	require.Equal(t, CompressionAlgo_ZSTD, algo)
+	_, err = ParseCompressionAlgo("brotli")
+	require.YesError(t, err)
+}
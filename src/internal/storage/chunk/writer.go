@@ -57,6 +57,9 @@ type Writer struct {
 	splitMask  uint64
 	noUpload   bool
 	createOpts CreateOptions
+	// compression is set only if the writer's chunks should be compressed
+	// (see WithWriterCompression)
+	compression *CompressionAlgo
 
 	ctx                     context.Context
 	cancel                  context.CancelFunc
@@ -253,10 +256,6 @@ func (w *Writer) processChunk(ctx context.Context, chunkBytes []byte, edge bool,
 }
 
 func (w *Writer) maybeUpload(ctx context.Context, chunkBytes []byte, pointsTo []ID) (*Ref, error) {
-	md := Metadata{
-		PointsTo: pointsTo,
-		Size:     len(chunkBytes),
-	}
 	// Skip the upload if no upload is configured.
 	var createFunc func(context.Context, []byte) (ID, error)
 	if w.noUpload {
@@ -265,10 +264,22 @@ func (w *Writer) maybeUpload(ctx context.Context, chunkBytes []byte, pointsTo []
 		}
 	} else {
 		createFunc = func(ctx context.Context, data []byte) (ID, error) {
+			// The size recorded for the chunk is its size in object storage.
+			md := Metadata{
+				PointsTo: pointsTo,
+				Size:     len(data),
+			}
 			return w.client.Create(ctx, md, data)
 		}
 	}
-	return Create(ctx, CreateOptions{}, chunkBytes, createFunc)
+	// Chunks are written in their original format (uncompressed, with no
+	// secret) unless compression was requested, so that their IDs, and
+	// therefore deduplication, are unaffected for data that doesn't opt in.
+	createOpts := CreateOptions{}
+	if w.compression != nil {
+		createOpts.Compression = *w.compression
+	}
+	return Create(ctx, createOpts, chunkBytes, createFunc)
 }
 
 func (w *Writer) getPointsTo(annotations []*Annotation) (pointsTo []ID) {
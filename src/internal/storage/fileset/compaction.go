@@ -41,11 +41,18 @@ func indexOfCompacted(factor int64, inputs []*Primitive) int {
 // Compact compacts the contents of ids into a new fileset with the specified ttl and returns the ID.
 // Compact always returns the ID of a primitive fileset.
 func (s *Storage) Compact(ctx context.Context, ids []ID, ttl time.Duration, opts ...index.Option) (*ID, error) {
+	return s.CompactWithWriterOptions(ctx, ids, ttl, nil, opts...)
+}
+
+// CompactWithWriterOptions is like Compact, but applies wOpts to the writer
+// of the compacted file set.
+func (s *Storage) CompactWithWriterOptions(ctx context.Context, ids []ID, ttl time.Duration, wOpts []WriterOption, opts ...index.Option) (*ID, error) {
 	var size int64
-	w := s.newWriter(ctx, WithTTL(ttl), WithIndexCallback(func(idx *index.Index) error {
+	wOpts = append([]WriterOption{WithTTL(ttl), WithIndexCallback(func(idx *index.Index) error {
 		size += index.SizeBytes(idx)
 		return nil
-	}))
+	})}, wOpts...)
+	w := s.newWriter(ctx, wOpts...)
 	fs, err := s.Open(ctx, ids, opts...)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 )
 
@@ -71,6 +72,14 @@ func WithValidator(validator func(string) error) UnorderedWriterOption {
 	}
 }
 
+// WithChunkCompression sets the compression algorithm used for the chunks of
+// the file sets created by the unordered writer.
+func WithChunkCompression(algo chunk.CompressionAlgo) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.writerOpts = append(uw.writerOpts, WithCompression(algo))
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
@@ -89,6 +98,14 @@ func WithTTL(ttl time.Duration) WriterOption {
 	}
 }
 
+// WithCompression sets the compression algorithm used for the chunks created
+// by the writer.
+func WithCompression(algo chunk.CompressionAlgo) WriterOption {
+	return func(w *Writer) {
+		w.chunkWriterOpts = append(w.chunkWriterOpts, chunk.WithWriterCompression(algo))
+	}
+}
+
 // StorageOptions returns the fileset storage options for the config.
 func StorageOptions(conf *serviceenv.Configuration) []StorageOption {
 	var opts []StorageOption
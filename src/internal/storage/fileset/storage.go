@@ -289,12 +289,13 @@ func (s *Storage) WithRenewer(ctx context.Context, ttl time.Duration, cb func(co
 	return cb(r.Context(), r)
 }
 
-// GC creates a track.GarbageCollector with a Deleter that can handle deleting filesets and chunks
+// GC runs the garbage collector returned by NewGC until the context is cancelled
 func (s *Storage) GC(ctx context.Context) error {
-	return s.newGC().RunForever(ctx)
+	return s.NewGC().RunForever(ctx)
 }
 
-func (s *Storage) newGC() *track.GarbageCollector {
+// NewGC returns a track.GarbageCollector with a Deleter that can handle deleting filesets and chunks
+func (s *Storage) NewGC() *track.GarbageCollector {
 	const period = 10 * time.Second
 	tmpDeleter := track.NewTmpDeleter()
 	chunkDeleter := s.chunks.NewDeleter()
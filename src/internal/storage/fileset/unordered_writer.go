@@ -22,6 +22,7 @@ type UnorderedWriter struct {
 	ids                        []ID
 	getParentID                func() (*ID, error)
 	validator                  func(string) error
+	writerOpts                 []WriterOption
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold int64, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -100,7 +101,7 @@ func (uw *UnorderedWriter) serialize() error {
 
 func (uw *UnorderedWriter) withWriter(cb func(*Writer) error) error {
 	// Serialize file set.
-	var writerOpts []WriterOption
+	writerOpts := append([]WriterOption{}, uw.writerOpts...)
 	if uw.ttl > 0 {
 		writerOpts = append(writerOpts, WithTTL(uw.ttl))
 	}
@@ -31,6 +31,7 @@ type Writer struct {
 	lastIdx            *index.Index
 	indexFunc          func(*index.Index) error
 	ttl                time.Duration
+	chunkWriterOpts    []chunk.WriterOption
 }
 
 func newWriter(ctx context.Context, storage *Storage, tracker track.Tracker, chunks *chunk.Storage, opts ...WriterOption) *Writer {
@@ -42,10 +43,9 @@ func newWriter(ctx context.Context, storage *Storage, tracker track.Tracker, chu
 	for _, opt := range opts {
 		opt(w)
 	}
-	var chunkWriterOpts []chunk.WriterOption
 	w.additive = index.NewWriter(ctx, chunks, "additive-index-writer")
 	w.deletive = index.NewWriter(ctx, chunks, "deletive-index-writer")
-	w.cw = chunks.NewWriter(ctx, "chunk-writer", w.callback, chunkWriterOpts...)
+	w.cw = chunks.NewWriter(ctx, "chunk-writer", w.callback, w.chunkWriterOpts...)
 	return w
 }
 
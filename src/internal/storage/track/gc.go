@@ -7,9 +7,39 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
 )
 
+var (
+	gcPassesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_tracker_gc",
+		Name:      "pass_count",
+		Help:      "Count of tracker garbage collection passes, by outcome ('error', 'ok').",
+	}, []string{"outcome"})
+	gcDeletedMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_tracker_gc",
+		Name:      "deleted_count",
+		Help:      "Count of tracker objects deleted by garbage collection.",
+	})
+	gcPassDurationMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_tracker_gc",
+		Name:      "pass_duration_seconds",
+		Help:      "Time taken for a tracker garbage collection pass.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	})
+	gcLastPassDeletedMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pachyderm",
+		Subsystem: "storage_tracker_gc",
+		Name:      "last_pass_deleted_count",
+		Help:      "Number of tracker objects deleted by the most recent garbage collection pass.",
+	})
+)
+
 // Deleter is used to delete external data associated with a tracked object
 type Deleter interface {
 	DeleteTx(tx *sqlx.Tx, id string) error
@@ -49,7 +79,7 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 	ticker := time.NewTicker(gc.period)
 	defer ticker.Stop()
 	for {
-		if err := gc.RunUntilEmpty(ctx); err != nil {
+		if _, err := gc.RunUntilEmpty(ctx); err != nil {
 			logrus.Errorf("gc: %v", err)
 		}
 		select {
@@ -61,17 +91,33 @@ func (gc *GarbageCollector) RunForever(ctx context.Context) error {
 }
 
 // RunUntilEmpty calls RunOnce repeatedly until it returns an error or 0.
-func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context) error {
+// It is a single pass of garbage collection, and returns the number of objects deleted.
+func (gc *GarbageCollector) RunUntilEmpty(ctx context.Context) (total int, retErr error) {
+	defer func(start time.Time) {
+		outcome := "ok"
+		if retErr != nil {
+			outcome = "error"
+		}
+		gcPassesMetric.WithLabelValues(outcome).Inc()
+		gcPassDurationMetric.Observe(time.Since(start).Seconds())
+		gcLastPassDeletedMetric.Set(float64(total))
+	}(time.Now())
 	for {
 		n, err := gc.RunOnce(ctx)
+		total += n
 		if err != nil {
-			return err
+			return total, err
 		}
 		if n == 0 {
 			break
 		}
 	}
-	return nil
+	return total, nil
+}
+
+// DryRun calls cb with the objects which RunUntilEmpty would delete, without deleting them.
+func (gc *GarbageCollector) DryRun(ctx context.Context, cb func(id string) error) error {
+	return gc.tracker.IterateCollectable(ctx, cb)
 }
 
 // RunOnce run's one cycle of garbage collection.
@@ -82,6 +128,7 @@ func (gc *GarbageCollector) RunOnce(ctx context.Context) (int, error) {
 			logrus.Errorf("error deleting object (%s): %v", id, err)
 		} else {
 			n++
+			gcDeletedMetric.Inc()
 		}
 		return nil
 	})
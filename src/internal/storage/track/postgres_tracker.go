@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
@@ -14,6 +16,9 @@ import (
 
 var _ Tracker = &postgresTracker{}
 
+// likeEscaper escapes the wildcards in a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type postgresTracker struct {
 	db *sqlx.DB
 }
@@ -242,6 +247,82 @@ func (t *postgresTracker) IterateDeletable(ctx context.Context, cb func(id strin
 	return rows.Err()
 }
 
+func (t *postgresTracker) IterateCollectable(ctx context.Context, cb func(id string) error) (retErr error) {
+	rows, err := t.db.QueryxContext(ctx,
+		`WITH RECURSIVE live(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects
+			WHERE expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP
+			UNION
+			SELECT to_id FROM storage.tracker_refs JOIN live ON from_id = live.int_id
+		)
+		SELECT str_id FROM storage.tracker_objects
+		WHERE int_id NOT IN (SELECT int_id FROM live)`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		if err := cb(id); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (t *postgresTracker) IterateReachable(ctx context.Context, prefixes []string, cb func(id string, shared bool) error) (retErr error) {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	patterns := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		patterns[i] = likeEscaper.Replace(prefix) + "%"
+	}
+	// reachable is the closure of the objects matching the prefixes.
+	// shared is the closure of the reachable objects referenced from outside of reachable.
+	rows, err := t.db.QueryxContext(ctx,
+		`WITH RECURSIVE reachable(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects WHERE str_id LIKE ANY($1)
+			UNION
+			SELECT to_id FROM storage.tracker_refs JOIN reachable ON from_id = reachable.int_id
+		), shared(int_id) AS (
+			SELECT to_id FROM storage.tracker_refs
+			WHERE to_id IN (SELECT int_id FROM reachable)
+			AND from_id NOT IN (SELECT int_id FROM reachable)
+			UNION
+			SELECT to_id FROM storage.tracker_refs JOIN shared ON from_id = shared.int_id
+		)
+		SELECT str_id, int_id IN (SELECT int_id FROM shared) AS shared
+		FROM storage.tracker_objects
+		WHERE int_id IN (SELECT int_id FROM reachable)`, pq.StringArray(patterns))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id string
+		var shared bool
+		if err := rows.Scan(&id, &shared); err != nil {
+			return err
+		}
+		if err := cb(id, shared); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (t *postgresTracker) getDownstream(tx *sqlx.Tx, intID int) ([]string, error) {
 	dwn := []string{}
 	if err := tx.Select(&dwn, `
@@ -60,6 +60,14 @@ type Tracker interface {
 
 	// IterateDeletable calls cb with all the objects objects which are no longer referenced and have expired
 	IterateDeletable(ctx context.Context, cb func(id string) error) error
+
+	// IterateCollectable calls cb with all the objects which garbage collection would eventually delete:
+	// the expired objects which are not reachable from an object that has not expired.
+	IterateCollectable(ctx context.Context, cb func(id string) error) error
+
+	// IterateReachable calls cb with every object reachable from the objects with ids starting with one of prefixes, including those objects.
+	// shared is true if the object is also reachable from an object that is not itself reachable from prefixes.
+	IterateReachable(ctx context.Context, prefixes []string, cb func(id string, shared bool) error) error
 }
 
 // TestTracker runs a TestSuite to ensure Tracker is properly implemented
@@ -210,6 +218,71 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				shouldNotExist(t, tracker, "1")
 			},
 		},
+		{
+			"IterateCollectable",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "1", []string{}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "2", []string{"1"}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "3", []string{}, ExpireNow))
+				require.NoError(t, Create(ctx, tracker, "4", []string{"3"}, time.Hour))
+				require.NoError(t, Create(ctx, tracker, "5", []string{}, time.Hour))
+
+				var collectable []string
+				require.NoError(t, tracker.IterateCollectable(ctx, func(id string) error {
+					collectable = append(collectable, id)
+					return nil
+				}))
+				require.ElementsEqual(t, []string{"1", "2"}, collectable)
+				// a pass of GC should delete exactly the collectable objects
+				for runGC(t, tracker) > 0 {
+				}
+				shouldNotExist(t, tracker, "1")
+				shouldNotExist(t, tracker, "2")
+				for _, id := range []string{"3", "4", "5"} {
+					_, err := tracker.GetExpiresAt(ctx, id)
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
+			"IterateReachable",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "chunk/1", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "chunk/2", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "chunk/3", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "fileset/1", []string{"chunk/1", "chunk/2"}, 0))
+				require.NoError(t, Create(ctx, tracker, "fileset/2", []string{"chunk/2", "chunk/3"}, 0))
+				require.NoError(t, Create(ctx, tracker, "commit/a/1", []string{"fileset/1"}, 0))
+				require.NoError(t, Create(ctx, tracker, "commit/a/2", []string{"fileset/1"}, 0))
+				require.NoError(t, Create(ctx, tracker, "commit/b/1", []string{"fileset/2"}, 0))
+
+				iterate := func(prefixes ...string) map[string]bool {
+					reachable := make(map[string]bool)
+					require.NoError(t, tracker.IterateReachable(ctx, prefixes, func(id string, shared bool) error {
+						reachable[id] = shared
+						return nil
+					}))
+					return reachable
+				}
+				require.Equal(t, map[string]bool{
+					"commit/a/1": false,
+					"commit/a/2": false,
+					"fileset/1":  false,
+					"chunk/1":    false,
+					"chunk/2":    true,
+				}, iterate("commit/a/"))
+				require.Equal(t, map[string]bool{
+					"commit/a/1": false,
+					"fileset/1":  true,
+					"chunk/1":    true,
+					"chunk/2":    true,
+				}, iterate("commit/a/1"))
+				require.Equal(t, 8, len(iterate("commit/a/", "commit/b/", "chunk/")))
+				require.Equal(t, 0, len(iterate("none/")))
+				// prefixes are matched literally
+				require.Equal(t, 0, len(iterate("commit/a_")))
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
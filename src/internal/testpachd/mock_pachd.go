@@ -76,6 +76,10 @@ type setConfigurationFunc func(context.Context, *auth.SetConfigurationRequest) (
 
 type modifyRoleBindingFunc func(context.Context, *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error)
 type getRoleBindingFunc func(context.Context, *auth.GetRoleBindingRequest) (*auth.GetRoleBindingResponse, error)
+type createRoleFunc func(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error)
+type updateRoleFunc func(context.Context, *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error)
+type deleteRoleFunc func(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error)
+type listRolesFunc func(context.Context, *auth.ListRolesRequest) (*auth.ListRolesResponse, error)
 
 type authenticateFunc func(context.Context, *auth.AuthenticateRequest) (*auth.AuthenticateResponse, error)
 type authorizeFunc func(context.Context, *auth.AuthorizeRequest) (*auth.AuthorizeResponse, error)
@@ -103,6 +107,10 @@ type mockGetConfiguration struct{ handler getConfigurationFunc }
 type mockSetConfiguration struct{ handler setConfigurationFunc }
 type mockModifyRoleBinding struct{ handler modifyRoleBindingFunc }
 type mockGetRoleBinding struct{ handler getRoleBindingFunc }
+type mockCreateRole struct{ handler createRoleFunc }
+type mockUpdateRole struct{ handler updateRoleFunc }
+type mockDeleteRole struct{ handler deleteRoleFunc }
+type mockListRoles struct{ handler listRolesFunc }
 
 type mockAuthenticate struct{ handler authenticateFunc }
 type mockAuthorize struct{ handler authorizeFunc }
@@ -132,6 +140,10 @@ func (mock *mockGetConfiguration) Use(cb getConfigurationFunc)
 func (mock *mockSetConfiguration) Use(cb setConfigurationFunc)                     { mock.handler = cb }
 func (mock *mockModifyRoleBinding) Use(cb modifyRoleBindingFunc)                   { mock.handler = cb }
 func (mock *mockGetRoleBinding) Use(cb getRoleBindingFunc)                         { mock.handler = cb }
+func (mock *mockCreateRole) Use(cb createRoleFunc)                                 { mock.handler = cb }
+func (mock *mockUpdateRole) Use(cb updateRoleFunc)                                 { mock.handler = cb }
+func (mock *mockDeleteRole) Use(cb deleteRoleFunc)                                 { mock.handler = cb }
+func (mock *mockListRoles) Use(cb listRolesFunc)                                   { mock.handler = cb }
 func (mock *mockAuthenticate) Use(cb authenticateFunc)                             { mock.handler = cb }
 func (mock *mockAuthorize) Use(cb authorizeFunc)                                   { mock.handler = cb }
 func (mock *mockWhoAmI) Use(cb whoAmIFunc)                                         { mock.handler = cb }
@@ -164,6 +176,10 @@ type mockAuthServer struct {
 	SetConfiguration           mockSetConfiguration
 	ModifyRoleBinding          mockModifyRoleBinding
 	GetRoleBinding             mockGetRoleBinding
+	CreateRole                 mockCreateRole
+	UpdateRole                 mockUpdateRole
+	DeleteRole                 mockDeleteRole
+	ListRoles                  mockListRoles
 	Authenticate               mockAuthenticate
 	Authorize                  mockAuthorize
 	GetPermissions             mockGetPermissions
@@ -215,6 +231,30 @@ func (api *authServerAPI) GetRoleBinding(ctx context.Context, req *auth.GetRoleB
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetRoleBinding")
 }
+func (api *authServerAPI) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	if api.mock.CreateRole.handler != nil {
+		return api.mock.CreateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRole")
+}
+func (api *authServerAPI) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (*auth.UpdateRoleResponse, error) {
+	if api.mock.UpdateRole.handler != nil {
+		return api.mock.UpdateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.UpdateRole")
+}
+func (api *authServerAPI) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	if api.mock.DeleteRole.handler != nil {
+		return api.mock.DeleteRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRole")
+}
+func (api *authServerAPI) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (*auth.ListRolesResponse, error) {
+	if api.mock.ListRoles.handler != nil {
+		return api.mock.ListRoles.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRoles")
+}
 func (api *authServerAPI) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
 	if api.mock.ModifyRoleBinding.handler != nil {
 		return api.mock.ModifyRoleBinding.handler(ctx, req)
@@ -409,6 +449,7 @@ func (api *enterpriseServerAPI) Heartbeat(ctx context.Context, req *enterprise.H
 type activateAuthPFSFunc func(context.Context, *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error)
 type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty, error)
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
+type inspectRepoStorageFunc func(context.Context, *pfs.InspectRepoStorageRequest) (*pfs.RepoStorageInfo, error)
 type listRepoFunc func(*pfs.ListRepoRequest, pfs.API_ListRepoServer) error
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
@@ -420,11 +461,18 @@ type dropCommitSetFunc func(context.Context, *pfs.DropCommitSetRequest) (*types.
 type inspectCommitSetFunc func(*pfs.InspectCommitSetRequest, pfs.API_InspectCommitSetServer) error
 type listCommitSetFunc func(*pfs.ListCommitSetRequest, pfs.API_ListCommitSetServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
+type revertCommitFunc func(context.Context, *pfs.RevertCommitRequest) (*pfs.RevertCommitResponse, error)
 type clearCommitFunc func(context.Context, *pfs.ClearCommitRequest) (*types.Empty, error)
 type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Empty, error)
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(*pfs.ListBranchRequest, pfs.API_ListBranchServer) error
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
+type setBranchProtectionFunc func(context.Context, *pfs.SetBranchProtectionRequest) (*types.Empty, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*types.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(*pfs.ListTagRequest, pfs.API_ListTagServer) error
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*types.Empty, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileTARFunc func(*pfs.GetFileRequest, pfs.API_GetFileTARServer) error
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
@@ -435,6 +483,7 @@ type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type garbageCollectFunc func(context.Context, *pfs.GarbageCollectRequest) (*pfs.GarbageCollectResponse, error)
 type createFileSetFunc func(pfs.API_CreateFileSetServer) error
 type addFileSetFunc func(context.Context, *pfs.AddFileSetRequest) (*types.Empty, error)
 type getFileSetFunc func(context.Context, *pfs.GetFileSetRequest) (*pfs.CreateFileSetResponse, error)
@@ -445,6 +494,7 @@ type runLoadTestDefaultFunc func(context.Context, *types.Empty) (*pfs.RunLoadTes
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
+type mockInspectRepoStorage struct{ handler inspectRepoStorageFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
 type mockStartCommit struct{ handler startCommitFunc }
@@ -456,11 +506,18 @@ type mockDropCommitSet struct{ handler dropCommitSetFunc }
 type mockInspectCommitSet struct{ handler inspectCommitSetFunc }
 type mockListCommitSet struct{ handler listCommitSetFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
+type mockRevertCommit struct{ handler revertCommitFunc }
 type mockClearCommit struct{ handler clearCommitFunc }
 type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
+type mockSetBranchProtection struct{ handler setBranchProtectionFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockGetFileTAR struct{ handler getFileTARFunc }
@@ -471,6 +528,7 @@ type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockGarbageCollect struct{ handler garbageCollectFunc }
 type mockCreateFileSet struct{ handler createFileSetFunc }
 type mockAddFileSet struct{ handler addFileSetFunc }
 type mockGetFileSet struct{ handler getFileSetFunc }
@@ -478,83 +536,101 @@ type mockRenewFileSet struct{ handler renewFileSetFunc }
 type mockRunLoadTest struct{ handler runLoadTestFunc }
 type mockRunLoadTestDefault struct{ handler runLoadTestDefaultFunc }
 
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
-func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)       { mock.handler = cb }
-func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)           { mock.handler = cb }
-func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)     { mock.handler = cb }
-func (mock *mockListCommitSet) Use(cb listCommitSetFunc)           { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
-func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
-func (mock *mockCreateFileSet) Use(cb createFileSetFunc)           { mock.handler = cb }
-func (mock *mockAddFileSet) Use(cb addFileSetFunc)                 { mock.handler = cb }
-func (mock *mockGetFileSet) Use(cb getFileSetFunc)                 { mock.handler = cb }
-func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)             { mock.handler = cb }
-func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)               { mock.handler = cb }
-func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc) { mock.handler = cb }
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)         { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                   { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)                 { mock.handler = cb }
+func (mock *mockInspectRepoStorage) Use(cb inspectRepoStorageFunc)   { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                       { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                   { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)                 { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)               { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)             { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                   { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)         { mock.handler = cb }
+func (mock *mockRevertCommit) Use(cb revertCommitFunc)               { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)                 { mock.handler = cb }
+func (mock *mockSquashCommitSet) Use(cb squashCommitSetFunc)         { mock.handler = cb }
+func (mock *mockDropCommitSet) Use(cb dropCommitSetFunc)             { mock.handler = cb }
+func (mock *mockInspectCommitSet) Use(cb inspectCommitSetFunc)       { mock.handler = cb }
+func (mock *mockListCommitSet) Use(cb listCommitSetFunc)             { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)               { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)             { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                   { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)               { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)                 { mock.handler = cb }
+func (mock *mockSetBranchProtection) Use(cb setBranchProtectionFunc) { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)                     { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)                   { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                         { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)                     { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                   { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                         { mock.handler = cb }
+func (mock *mockGetFileTAR) Use(cb getFileTARFunc)                   { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)                 { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                       { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                       { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                       { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                       { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)               { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                               { mock.handler = cb }
+func (mock *mockGarbageCollect) Use(cb garbageCollectFunc)           { mock.handler = cb }
+func (mock *mockCreateFileSet) Use(cb createFileSetFunc)             { mock.handler = cb }
+func (mock *mockAddFileSet) Use(cb addFileSetFunc)                   { mock.handler = cb }
+func (mock *mockGetFileSet) Use(cb getFileSetFunc)                   { mock.handler = cb }
+func (mock *mockRenewFileSet) Use(cb renewFileSetFunc)               { mock.handler = cb }
+func (mock *mockRunLoadTest) Use(cb runLoadTestFunc)                 { mock.handler = cb }
+func (mock *mockRunLoadTestDefault) Use(cb runLoadTestDefaultFunc)   { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api                pfsServerAPI
-	ActivateAuth       mockActivateAuthPFS
-	CreateRepo         mockCreateRepo
-	InspectRepo        mockInspectRepo
-	ListRepo           mockListRepo
-	DeleteRepo         mockDeleteRepo
-	StartCommit        mockStartCommit
-	FinishCommit       mockFinishCommit
-	InspectCommit      mockInspectCommit
-	ListCommit         mockListCommit
-	SubscribeCommit    mockSubscribeCommit
-	ClearCommit        mockClearCommit
-	SquashCommitSet    mockSquashCommitSet
-	DropCommitSet      mockDropCommitSet
-	InspectCommitSet   mockInspectCommitSet
-	ListCommitSet      mockListCommitSet
-	CreateBranch       mockCreateBranch
-	InspectBranch      mockInspectBranch
-	ListBranch         mockListBranch
-	DeleteBranch       mockDeleteBranch
-	ModifyFile         mockModifyFile
-	GetFile            mockGetFile
-	GetFileTAR         mockGetFileTAR
-	InspectFile        mockInspectFile
-	ListFile           mockListFile
-	WalkFile           mockWalkFile
-	GlobFile           mockGlobFile
-	DiffFile           mockDiffFile
-	DeleteAll          mockDeleteAllPFS
-	Fsck               mockFsck
-	CreateFileSet      mockCreateFileSet
-	AddFileSet         mockAddFileSet
-	GetFileSet         mockGetFileSet
-	RenewFileSet       mockRenewFileSet
-	RunLoadTest        mockRunLoadTest
-	RunLoadTestDefault mockRunLoadTestDefault
+	api                 pfsServerAPI
+	ActivateAuth        mockActivateAuthPFS
+	CreateRepo          mockCreateRepo
+	InspectRepo         mockInspectRepo
+	InspectRepoStorage  mockInspectRepoStorage
+	ListRepo            mockListRepo
+	DeleteRepo          mockDeleteRepo
+	StartCommit         mockStartCommit
+	FinishCommit        mockFinishCommit
+	InspectCommit       mockInspectCommit
+	ListCommit          mockListCommit
+	SubscribeCommit     mockSubscribeCommit
+	ClearCommit         mockClearCommit
+	RevertCommit        mockRevertCommit
+	SquashCommitSet     mockSquashCommitSet
+	DropCommitSet       mockDropCommitSet
+	InspectCommitSet    mockInspectCommitSet
+	ListCommitSet       mockListCommitSet
+	CreateBranch        mockCreateBranch
+	InspectBranch       mockInspectBranch
+	ListBranch          mockListBranch
+	DeleteBranch        mockDeleteBranch
+	MergeBranch         mockMergeBranch
+	SetBranchProtection mockSetBranchProtection
+	CreateTag           mockCreateTag
+	InspectTag          mockInspectTag
+	ListTag             mockListTag
+	DeleteTag           mockDeleteTag
+	ModifyFile          mockModifyFile
+	GetFile             mockGetFile
+	GetFileTAR          mockGetFileTAR
+	InspectFile         mockInspectFile
+	ListFile            mockListFile
+	WalkFile            mockWalkFile
+	GlobFile            mockGlobFile
+	DiffFile            mockDiffFile
+	DeleteAll           mockDeleteAllPFS
+	Fsck                mockFsck
+	GarbageCollect      mockGarbageCollect
+	CreateFileSet       mockCreateFileSet
+	AddFileSet          mockAddFileSet
+	GetFileSet          mockGetFileSet
+	RenewFileSet        mockRenewFileSet
+	RunLoadTest         mockRunLoadTest
+	RunLoadTestDefault  mockRunLoadTestDefault
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -575,6 +651,12 @@ func (api *pfsServerAPI) InspectRepo(ctx context.Context, req *pfs.InspectRepoRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.InspectRepo")
 }
+func (api *pfsServerAPI) InspectRepoStorage(ctx context.Context, req *pfs.InspectRepoStorageRequest) (*pfs.RepoStorageInfo, error) {
+	if api.mock.InspectRepoStorage.handler != nil {
+		return api.mock.InspectRepoStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectRepoStorage")
+}
 func (api *pfsServerAPI) ListRepo(req *pfs.ListRepoRequest, srv pfs.API_ListRepoServer) error {
 	if api.mock.ListRepo.handler != nil {
 		return api.mock.ListRepo.handler(req, srv)
@@ -641,6 +723,12 @@ func (api *pfsServerAPI) SubscribeCommit(req *pfs.SubscribeCommitRequest, serv p
 	}
 	return errors.Errorf("unhandled pachd mock pfs.SubscribeCommit")
 }
+func (api *pfsServerAPI) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest) (*pfs.RevertCommitResponse, error) {
+	if api.mock.RevertCommit.handler != nil {
+		return api.mock.RevertCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RevertCommit")
+}
 func (api *pfsServerAPI) ClearCommit(ctx context.Context, req *pfs.ClearCommitRequest) (*types.Empty, error) {
 	if api.mock.ClearCommit.handler != nil {
 		return api.mock.ClearCommit.handler(ctx, req)
@@ -671,6 +759,42 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
+func (api *pfsServerAPI) SetBranchProtection(ctx context.Context, req *pfs.SetBranchProtectionRequest) (*types.Empty, error) {
+	if api.mock.SetBranchProtection.handler != nil {
+		return api.mock.SetBranchProtection.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetBranchProtection")
+}
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*types.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(req *pfs.ListTagRequest, serv pfs.API_ListTagServer) error {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*types.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -731,6 +855,12 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) GarbageCollect(ctx context.Context, req *pfs.GarbageCollectRequest) (*pfs.GarbageCollectResponse, error) {
+	if api.mock.GarbageCollect.handler != nil {
+		return api.mock.GarbageCollect.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.GarbageCollect")
+}
 func (api *pfsServerAPI) CreateFileSet(srv pfs.API_CreateFileSetServer) error {
 	if api.mock.CreateFileSet.handler != nil {
 		return api.mock.CreateFileSet.handler(srv)
@@ -1061,6 +1191,7 @@ type inspectTransactionFunc func(context.Context, *transaction.InspectTransactio
 type deleteTransactionFunc func(context.Context, *transaction.DeleteTransactionRequest) (*types.Empty, error)
 type listTransactionFunc func(context.Context, *transaction.ListTransactionRequest) (*transaction.TransactionInfos, error)
 type finishTransactionFunc func(context.Context, *transaction.FinishTransactionRequest) (*transaction.TransactionInfo, error)
+type validateTransactionFunc func(context.Context, *transaction.ValidateTransactionRequest) (*transaction.ValidateTransactionResponse, error)
 type deleteAllTransactionFunc func(context.Context, *transaction.DeleteAllRequest) (*types.Empty, error)
 
 type mockBatchTransaction struct{ handler batchTransactionFunc }
@@ -1069,6 +1200,7 @@ type mockInspectTransaction struct{ handler inspectTransactionFunc }
 type mockDeleteTransaction struct{ handler deleteTransactionFunc }
 type mockListTransaction struct{ handler listTransactionFunc }
 type mockFinishTransaction struct{ handler finishTransactionFunc }
+type mockValidateTransaction struct{ handler validateTransactionFunc }
 type mockDeleteAllTransaction struct{ handler deleteAllTransactionFunc }
 
 func (mock *mockBatchTransaction) Use(cb batchTransactionFunc)         { mock.handler = cb }
@@ -1077,6 +1209,7 @@ func (mock *mockInspectTransaction) Use(cb inspectTransactionFunc)     { mock.ha
 func (mock *mockDeleteTransaction) Use(cb deleteTransactionFunc)       { mock.handler = cb }
 func (mock *mockListTransaction) Use(cb listTransactionFunc)           { mock.handler = cb }
 func (mock *mockFinishTransaction) Use(cb finishTransactionFunc)       { mock.handler = cb }
+func (mock *mockValidateTransaction) Use(cb validateTransactionFunc)   { mock.handler = cb }
 func (mock *mockDeleteAllTransaction) Use(cb deleteAllTransactionFunc) { mock.handler = cb }
 
 type transactionServerAPI struct {
@@ -1084,14 +1217,15 @@ type transactionServerAPI struct {
 }
 
 type mockTransactionServer struct {
-	api                transactionServerAPI
-	BatchTransaction   mockBatchTransaction
-	StartTransaction   mockStartTransaction
-	InspectTransaction mockInspectTransaction
-	DeleteTransaction  mockDeleteTransaction
-	ListTransaction    mockListTransaction
-	FinishTransaction  mockFinishTransaction
-	DeleteAll          mockDeleteAllTransaction
+	api                 transactionServerAPI
+	BatchTransaction    mockBatchTransaction
+	StartTransaction    mockStartTransaction
+	InspectTransaction  mockInspectTransaction
+	DeleteTransaction   mockDeleteTransaction
+	ListTransaction     mockListTransaction
+	FinishTransaction   mockFinishTransaction
+	ValidateTransaction mockValidateTransaction
+	DeleteAll           mockDeleteAllTransaction
 }
 
 func (api *transactionServerAPI) BatchTransaction(ctx context.Context, req *transaction.BatchTransactionRequest) (*transaction.TransactionInfo, error) {
@@ -1130,6 +1264,12 @@ func (api *transactionServerAPI) FinishTransaction(ctx context.Context, req *tra
 	}
 	return nil, errors.Errorf("unhandled pachd mock transaction.FinishTransaction")
 }
+func (api *transactionServerAPI) ValidateTransaction(ctx context.Context, req *transaction.ValidateTransactionRequest) (*transaction.ValidateTransactionResponse, error) {
+	if api.mock.ValidateTransaction.handler != nil {
+		return api.mock.ValidateTransaction.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock transaction.ValidateTransaction")
+}
 func (api *transactionServerAPI) DeleteAll(ctx context.Context, req *transaction.DeleteAllRequest) (*types.Empty, error) {
 	if api.mock.DeleteAll.handler != nil {
 		return api.mock.DeleteAll.handler(ctx, req)
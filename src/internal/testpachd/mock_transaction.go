@@ -68,6 +68,36 @@ func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionF
 	mock.handler = cb
 }
 
+type deletePipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.DeletePipelineRequest) error
+
+type mockDeletePipelineInTransaction struct {
+	handler deletePipelineInTransactionFunc
+}
+
+func (mock *mockDeletePipelineInTransaction) Use(cb deletePipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type stopPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StopPipelineRequest) error
+
+type mockStopPipelineInTransaction struct {
+	handler stopPipelineInTransactionFunc
+}
+
+func (mock *mockStopPipelineInTransaction) Use(cb stopPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type startPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StartPipelineRequest) error
+
+type mockStartPipelineInTransaction struct {
+	handler startPipelineInTransactionFunc
+}
+
+func (mock *mockStartPipelineInTransaction) Use(cb startPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
 type inspectPipelineInTransactionFunc func(*txncontext.TransactionContext, string) (*pps.PipelineInfo, error)
 
 type mockInspectPipelineInTransaction struct {
@@ -93,6 +123,9 @@ type MockPPSTransactionServer struct {
 	StopJobInTransaction         mockStopJobInTransaction
 	UpdateJobStateInTransaction  mockUpdateJobStateInTransaction
 	CreatePipelineInTransaction  mockCreatePipelineInTransaction
+	DeletePipelineInTransaction  mockDeletePipelineInTransaction
+	StopPipelineInTransaction    mockStopPipelineInTransaction
+	StartPipelineInTransaction   mockStartPipelineInTransaction
 	InspectPipelineInTransaction mockInspectPipelineInTransaction
 }
 
@@ -153,6 +186,27 @@ func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txncontext.Tra
 	return fmt.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
 }
 
+func (api *ppsTransactionAPI) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.DeletePipelineRequest) error {
+	if api.mock.DeletePipelineInTransaction.handler != nil {
+		return api.mock.DeletePipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.DeletePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopPipelineRequest) error {
+	if api.mock.StopPipelineInTransaction.handler != nil {
+		return api.mock.StopPipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StopPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StartPipelineRequest) error {
+	if api.mock.StartPipelineInTransaction.handler != nil {
+		return api.mock.StartPipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StartPipelineInTransaction")
+}
+
 func (api *ppsTransactionAPI) InspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, pipeline string) (*pps.PipelineInfo, error) {
 	if api.mock.InspectPipelineInTransaction.handler != nil {
 		return api.mock.InspectPipelineInTransaction.handler(txnCtx, pipeline)
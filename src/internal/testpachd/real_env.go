@@ -23,6 +23,7 @@ import (
 	authtesting "github.com/pachyderm/pachyderm/v2/src/server/auth/testing"
 	pfsapi "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	ppsapi "github.com/pachyderm/pachyderm/v2/src/server/pps"
 	proxyserver "github.com/pachyderm/pachyderm/v2/src/server/proxy/server"
 	txnserver "github.com/pachyderm/pachyderm/v2/src/server/transaction/server"
 )
@@ -128,6 +129,14 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	return realEnv
 }
 
+// UsePPSServer sends the environment's PPS API calls, including those made in
+// transactions, to server. NewRealEnv doesn't start a PPS API server itself,
+// since running pipelines needs a worker runtime.
+func (realEnv *RealEnv) UsePPSServer(server ppsapi.APIServer) {
+	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(server)
+	linkServers(&realEnv.MockPachd.PPS, server)
+}
+
 // DefaultConfigOptions is a serviceenv config option with the defaults used for tests
 func DefaultConfigOptions(config *serviceenv.Configuration) {
 	config.StorageMemoryThreshold = units.GB
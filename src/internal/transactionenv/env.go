@@ -33,6 +33,8 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	AddFileSet(*pfs.AddFileSetRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -43,6 +45,9 @@ type PpsWrites interface {
 	StopJob(*pps.StopJobRequest) error
 	UpdateJobState(*pps.UpdateJobStateRequest) error
 	CreatePipeline(*pps.CreatePipelineRequest) error
+	DeletePipeline(*pps.DeletePipelineRequest) error
+	StopPipeline(*pps.StopPipelineRequest) error
+	StartPipeline(*pps.StartPipelineRequest) error
 }
 
 // AuthWrites is an interface providing a wrapper for each operation that
@@ -150,6 +155,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) AddFileSet(original *pfs.AddFileSetRequest) error {
+	req := proto.Clone(original).(*pfs.AddFileSetRequest)
+	return t.txnEnv.serviceEnv.PfsServer().AddFileSetInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) StopJob(original *pps.StopJobRequest) error {
 	req := proto.Clone(original).(*pps.StopJobRequest)
 	return t.txnEnv.serviceEnv.PpsServer().StopJobInTransaction(t.txnCtx, req)
@@ -170,6 +180,21 @@ func (t *directTransaction) CreatePipeline(original *pps.CreatePipelineRequest)
 	return t.txnEnv.serviceEnv.PpsServer().CreatePipelineInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) DeletePipeline(original *pps.DeletePipelineRequest) error {
+	req := proto.Clone(original).(*pps.DeletePipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().DeletePipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) StopPipeline(original *pps.StopPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StopPipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().StopPipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) StartPipeline(original *pps.StartPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StartPipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().StartPipelineInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) DeleteRoleBinding(original *auth.Resource) error {
 	req := proto.Clone(original).(*auth.Resource)
 	return t.txnEnv.serviceEnv.AuthServer().DeleteRoleBindingInTransaction(t.txnCtx, req)
@@ -227,6 +252,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return err
 }
 
+func (t *appendTransaction) AddFileSet(req *pfs.AddFileSetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{AddFileSet: req})
+	return err
+}
+
 func (t *appendTransaction) StopJob(req *pps.StopJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopJob: req})
 	return err
@@ -242,6 +272,21 @@ func (t *appendTransaction) CreatePipeline(req *pps.CreatePipelineRequest) error
 	return err
 }
 
+func (t *appendTransaction) DeletePipeline(req *pps.DeletePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeletePipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StopPipeline(req *pps.StopPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StartPipeline(req *pps.StartPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartPipeline: req})
+	return err
+}
+
 func (t *appendTransaction) ModifyRoleBinding(original *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
 	panic("ModifyRoleBinding not yet implemented in transactions")
 }
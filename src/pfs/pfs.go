@@ -76,3 +76,14 @@ func (b *Branch) NewCommit(id string) *Commit {
 func (b *Branch) String() string {
 	return b.Repo.String() + "@" + b.Name
 }
+
+func (r *Repo) NewTag(name string) *Tag {
+	return &Tag{
+		Repo: proto.Clone(r).(*Repo),
+		Name: name,
+	}
+}
+
+func (t *Tag) String() string {
+	return t.Repo.String() + "@" + t.Name
+}
@@ -29,6 +29,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Compression describes how data written to a repo is compressed in object
+// storage. Data that was written with a different algorithm remains readable.
+type Compression int32
+
+const (
+	// Use pachd's configured default (STORAGE_COMPRESSION).
+	Compression_DEFAULT_COMPRESSION Compression = 0
+	Compression_NO_COMPRESSION      Compression = 1
+	Compression_GZIP                Compression = 2
+	Compression_ZSTD                Compression = 3
+	Compression_LZ4                 Compression = 4
+)
+
+var Compression_name = map[int32]string{
+	0: "DEFAULT_COMPRESSION",
+	1: "NO_COMPRESSION",
+	2: "GZIP",
+	3: "ZSTD",
+	4: "LZ4",
+}
+
+var Compression_value = map[string]int32{
+	"DEFAULT_COMPRESSION": 0,
+	"NO_COMPRESSION":      1,
+	"GZIP":                2,
+	"ZSTD":                3,
+	"LZ4":                 4,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{0}
+}
+
 // These are the different places where a commit may be originated from
 type OriginKind int32
 
@@ -61,7 +98,7 @@ func (x OriginKind) String() string {
 }
 
 func (OriginKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{0}
+	return fileDescriptor_21a7b2476cbc6216, []int{1}
 }
 
 type FileType int32
@@ -89,7 +126,7 @@ func (x FileType) String() string {
 }
 
 func (FileType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{1}
+	return fileDescriptor_21a7b2476cbc6216, []int{2}
 }
 
 // CommitState describes the states a commit can be in.
@@ -125,7 +162,37 @@ func (x CommitState) String() string {
 }
 
 func (CommitState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{2}
+	return fileDescriptor_21a7b2476cbc6216, []int{3}
+}
+
+// MergeResolution says which side of a merge wins when both branches changed
+// the same path.
+type MergeResolution int32
+
+const (
+	MergeResolution_UNRESOLVED MergeResolution = 0
+	MergeResolution_OURS       MergeResolution = 1
+	MergeResolution_THEIRS     MergeResolution = 2
+)
+
+var MergeResolution_name = map[int32]string{
+	0: "UNRESOLVED",
+	1: "OURS",
+	2: "THEIRS",
+}
+
+var MergeResolution_value = map[string]int32{
+	"UNRESOLVED": 0,
+	"OURS":       1,
+	"THEIRS":     2,
+}
+
+func (x MergeResolution) String() string {
+	return proto.EnumName(MergeResolution_name, int32(x))
+}
+
+func (MergeResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{4}
 }
 
 type Delimiter int32
@@ -159,7 +226,35 @@ func (x Delimiter) String() string {
 }
 
 func (Delimiter) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{3}
+	return fileDescriptor_21a7b2476cbc6216, []int{5}
+}
+
+type ListCommitRequest_ErrorFilter int32
+
+const (
+	ListCommitRequest_ANY_ERROR_STATE ListCommitRequest_ErrorFilter = 0
+	ListCommitRequest_ERRORED         ListCommitRequest_ErrorFilter = 1
+	ListCommitRequest_NOT_ERRORED     ListCommitRequest_ErrorFilter = 2
+)
+
+var ListCommitRequest_ErrorFilter_name = map[int32]string{
+	0: "ANY_ERROR_STATE",
+	1: "ERRORED",
+	2: "NOT_ERRORED",
+}
+
+var ListCommitRequest_ErrorFilter_value = map[string]int32{
+	"ANY_ERROR_STATE": 0,
+	"ERRORED":         1,
+	"NOT_ERRORED":     2,
+}
+
+func (x ListCommitRequest_ErrorFilter) String() string {
+	return proto.EnumName(ListCommitRequest_ErrorFilter_name, int32(x))
+}
+
+func (ListCommitRequest_ErrorFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{25, 0}
 }
 
 type Repo struct {
@@ -343,8 +438,12 @@ type RepoInfo struct {
 	// Set by ListRepo and InspectRepo if Pachyderm's auth system is active, but
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
-	AuthInfo             *RepoAuthInfo     `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
-	Details              *RepoInfo_Details `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	AuthInfo *RepoAuthInfo     `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
+	Details  *RepoInfo_Details `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	// The algorithm used to compress data written to this repo.
+	Compression Compression `protobuf:"varint,8,opt,name=compression,proto3,enum=pfs_v2.Compression" json:"compression,omitempty"`
+	// metadata is user-provided key/value annotations describing this repo.
+	Metadata             map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -432,6 +531,20 @@ func (m *RepoInfo) GetDetails() *RepoInfo_Details {
 	return nil
 }
 
+func (m *RepoInfo) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return Compression_DEFAULT_COMPRESSION
+}
+
+func (m *RepoInfo) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // Details are only provided when explicitly requested
 type RepoInfo_Details struct {
 	SizeBytes            int64    `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -545,15 +658,16 @@ func (m *RepoAuthInfo) GetRoles() []string {
 }
 
 type BranchInfo struct {
-	Branch               *Branch   `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
-	Head                 *Commit   `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
-	Provenance           []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
-	Subvenance           []*Branch `protobuf:"bytes,4,rep,name=subvenance,proto3" json:"subvenance,omitempty"`
-	DirectProvenance     []*Branch `protobuf:"bytes,5,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
-	Trigger              *Trigger  `protobuf:"bytes,6,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Branch               *Branch           `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Head                 *Commit           `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Provenance           []*Branch         `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
+	Subvenance           []*Branch         `protobuf:"bytes,4,rep,name=subvenance,proto3" json:"subvenance,omitempty"`
+	DirectProvenance     []*Branch         `protobuf:"bytes,5,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
+	Trigger              *Trigger          `protobuf:"bytes,6,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Protection           *BranchProtection `protobuf:"bytes,7,opt,name=protection,proto3" json:"protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BranchInfo) Reset()         { *m = BranchInfo{} }
@@ -631,6 +745,220 @@ func (m *BranchInfo) GetTrigger() *Trigger {
 	return nil
 }
 
+func (m *BranchInfo) GetProtection() *BranchProtection {
+	if m != nil {
+		return m.Protection
+	}
+	return nil
+}
+
+// Tag is an immutable, human-readable name for a commit in a repo.
+type Tag struct {
+	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tag) Reset()      { *m = Tag{} }
+func (*Tag) ProtoMessage() {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{6}
+}
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TagInfo struct {
+	Tag                  *Tag             `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Commit               *Commit          `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Created              *types.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Description          string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TagInfo) Reset()         { *m = TagInfo{} }
+func (m *TagInfo) String() string { return proto.CompactTextString(m) }
+func (*TagInfo) ProtoMessage()    {}
+func (*TagInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{7}
+}
+func (m *TagInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TagInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TagInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TagInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagInfo.Merge(m, src)
+}
+func (m *TagInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TagInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagInfo proto.InternalMessageInfo
+
+func (m *TagInfo) GetTag() *Tag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *TagInfo) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *TagInfo) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *TagInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// BranchProtection restricts how a branch's head may be moved. Protection is
+// enforced for every principal, including repo owners; to bypass it, the
+// protection must first be removed with SetBranchProtection.
+type BranchProtection struct {
+	// Reject StartCommit on the branch.
+	DenyDirectCommits bool `protobuf:"varint,1,opt,name=deny_direct_commits,json=denyDirectCommits,proto3" json:"deny_direct_commits,omitempty"`
+	// Reject moving the head to a commit that doesn't descend from the current
+	// head, and reject deleting the branch.
+	DenyRewind bool `protobuf:"varint,2,opt,name=deny_rewind,json=denyRewind,proto3" json:"deny_rewind,omitempty"`
+	// Only allow StartCommit on the branch from this pipeline's principal.
+	// Requires auth to be active.
+	RequiredPipeline string `protobuf:"bytes,3,opt,name=required_pipeline,json=requiredPipeline,proto3" json:"required_pipeline,omitempty"`
+	// Only allow the head to be moved to a commit on this branch (a promotion),
+	// which implies deny_direct_commits.
+	PromoteFrom          string   `protobuf:"bytes,4,opt,name=promote_from,json=promoteFrom,proto3" json:"promote_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BranchProtection) Reset()         { *m = BranchProtection{} }
+func (m *BranchProtection) String() string { return proto.CompactTextString(m) }
+func (*BranchProtection) ProtoMessage()    {}
+func (*BranchProtection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{8}
+}
+func (m *BranchProtection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchProtection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchProtection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BranchProtection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchProtection.Merge(m, src)
+}
+func (m *BranchProtection) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchProtection) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchProtection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchProtection proto.InternalMessageInfo
+
+func (m *BranchProtection) GetDenyDirectCommits() bool {
+	if m != nil {
+		return m.DenyDirectCommits
+	}
+	return false
+}
+
+func (m *BranchProtection) GetDenyRewind() bool {
+	if m != nil {
+		return m.DenyRewind
+	}
+	return false
+}
+
+func (m *BranchProtection) GetRequiredPipeline() string {
+	if m != nil {
+		return m.RequiredPipeline
+	}
+	return ""
+}
+
+func (m *BranchProtection) GetPromoteFrom() string {
+	if m != nil {
+		return m.PromoteFrom
+	}
+	return ""
+}
+
 // Trigger defines the conditions under which a head is moved, and to which
 // branch it is moved.
 type Trigger struct {
@@ -655,7 +983,7 @@ func (m *Trigger) Reset()         { *m = Trigger{} }
 func (m *Trigger) String() string { return proto.CompactTextString(m) }
 func (*Trigger) ProtoMessage()    {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{6}
+	return fileDescriptor_21a7b2476cbc6216, []int{9}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +1058,7 @@ func (m *CommitOrigin) Reset()         { *m = CommitOrigin{} }
 func (m *CommitOrigin) String() string { return proto.CompactTextString(m) }
 func (*CommitOrigin) ProtoMessage()    {}
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{7}
+	return fileDescriptor_21a7b2476cbc6216, []int{10}
 }
 func (m *CommitOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1108,7 @@ type Commit struct {
 func (m *Commit) Reset()      { *m = Commit{} }
 func (*Commit) ProtoMessage() {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{8}
+	return fileDescriptor_21a7b2476cbc6216, []int{11}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,26 +1156,29 @@ type CommitInfo struct {
 	Commit *Commit       `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 	Origin *CommitOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 	// description is a user-provided script describing this commit
-	Description          string              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ParentCommit         *Commit             `protobuf:"bytes,4,opt,name=parent_commit,json=parentCommit,proto3" json:"parent_commit,omitempty"`
-	ChildCommits         []*Commit           `protobuf:"bytes,5,rep,name=child_commits,json=childCommits,proto3" json:"child_commits,omitempty"`
-	Started              *types.Timestamp    `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
-	Finishing            *types.Timestamp    `protobuf:"bytes,7,opt,name=finishing,proto3" json:"finishing,omitempty"`
-	Finished             *types.Timestamp    `protobuf:"bytes,8,opt,name=finished,proto3" json:"finished,omitempty"`
-	DirectProvenance     []*Branch           `protobuf:"bytes,9,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
-	Error                string              `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
-	SizeBytesUpperBound  int64               `protobuf:"varint,11,opt,name=size_bytes_upper_bound,json=sizeBytesUpperBound,proto3" json:"size_bytes_upper_bound,omitempty"`
-	Details              *CommitInfo_Details `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Description         string              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentCommit        *Commit             `protobuf:"bytes,4,opt,name=parent_commit,json=parentCommit,proto3" json:"parent_commit,omitempty"`
+	ChildCommits        []*Commit           `protobuf:"bytes,5,rep,name=child_commits,json=childCommits,proto3" json:"child_commits,omitempty"`
+	Started             *types.Timestamp    `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
+	Finishing           *types.Timestamp    `protobuf:"bytes,7,opt,name=finishing,proto3" json:"finishing,omitempty"`
+	Finished            *types.Timestamp    `protobuf:"bytes,8,opt,name=finished,proto3" json:"finished,omitempty"`
+	DirectProvenance    []*Branch           `protobuf:"bytes,9,rep,name=direct_provenance,json=directProvenance,proto3" json:"direct_provenance,omitempty"`
+	Error               string              `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	SizeBytesUpperBound int64               `protobuf:"varint,11,opt,name=size_bytes_upper_bound,json=sizeBytesUpperBound,proto3" json:"size_bytes_upper_bound,omitempty"`
+	Details             *CommitInfo_Details `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"`
+	// metadata is user-provided key/value annotations describing this commit,
+	// set by StartCommit and FinishCommit.
+	Metadata             map[string]string `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9}
+	return fileDescriptor_21a7b2476cbc6216, []int{12}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,6 +1291,13 @@ func (m *CommitInfo) GetDetails() *CommitInfo_Details {
 	return nil
 }
 
+func (m *CommitInfo) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // Details are only provided when explicitly requested
 type CommitInfo_Details struct {
 	SizeBytes            int64    `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -972,7 +1310,7 @@ func (m *CommitInfo_Details) Reset()         { *m = CommitInfo_Details{} }
 func (m *CommitInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo_Details) ProtoMessage()    {}
 func (*CommitInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{9, 0}
+	return fileDescriptor_21a7b2476cbc6216, []int{12, 0}
 }
 func (m *CommitInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1357,7 @@ func (m *CommitSet) Reset()         { *m = CommitSet{} }
 func (m *CommitSet) String() string { return proto.CompactTextString(m) }
 func (*CommitSet) ProtoMessage()    {}
 func (*CommitSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{10}
+	return fileDescriptor_21a7b2476cbc6216, []int{13}
 }
 func (m *CommitSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1405,7 @@ func (m *CommitSetInfo) Reset()         { *m = CommitSetInfo{} }
 func (m *CommitSetInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitSetInfo) ProtoMessage()    {}
 func (*CommitSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{11}
+	return fileDescriptor_21a7b2476cbc6216, []int{14}
 }
 func (m *CommitSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1463,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{12}
+	return fileDescriptor_21a7b2476cbc6216, []int{15}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,19 +1528,25 @@ func (m *FileInfo) GetHash() []byte {
 }
 
 type CreateRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Update               bool     `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
+	// When updating a repo, DEFAULT_COMPRESSION leaves the repo's existing
+	// compression algorithm in place.
+	Compression Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=pfs_v2.Compression" json:"compression,omitempty"`
+	// When updating a repo, empty metadata leaves the repo's existing metadata
+	// in place.
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{13}
+	return fileDescriptor_21a7b2476cbc6216, []int{16}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,6 +1596,20 @@ func (m *CreateRepoRequest) GetUpdate() bool {
 	return false
 }
 
+func (m *CreateRepoRequest) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return Compression_DEFAULT_COMPRESSION
+}
+
+func (m *CreateRepoRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type InspectRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1263,7 +1621,7 @@ func (m *InspectRepoRequest) Reset()         { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()    {}
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{14}
+	return fileDescriptor_21a7b2476cbc6216, []int{17}
 }
 func (m *InspectRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,27 +1657,29 @@ func (m *InspectRepoRequest) GetRepo() *Repo {
 	return nil
 }
 
-type ListRepoRequest struct {
-	// type is the type of (system) repos that should be returned
-	// an empty string requests all repos
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+type InspectRepoStorageRequest struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// from and to, if set, restrict the report to the commits that ListCommit
+	// would return for them, rather than every commit in the repo.
+	From                 *Commit  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *Commit  `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
-func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*ListRepoRequest) ProtoMessage()    {}
-func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{15}
+func (m *InspectRepoStorageRequest) Reset()         { *m = InspectRepoStorageRequest{} }
+func (m *InspectRepoStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectRepoStorageRequest) ProtoMessage()    {}
+func (*InspectRepoStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{18}
 }
-func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
+func (m *InspectRepoStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectRepoStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListRepoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectRepoStorageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1329,8 +1689,160 @@ func (m *ListRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ListRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRepoRequest.Merge(m, src)
+func (m *InspectRepoStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectRepoStorageRequest.Merge(m, src)
+}
+func (m *InspectRepoStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectRepoStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectRepoStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectRepoStorageRequest proto.InternalMessageInfo
+
+func (m *InspectRepoStorageRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *InspectRepoStorageRequest) GetFrom() *Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *InspectRepoStorageRequest) GetTo() *Commit {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+// RepoStorageInfo describes the object storage pinned by a repo, or a range
+// of its commits, after chunk deduplication.
+type RepoStorageInfo struct {
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// logical_bytes is the size of the repo, or the sum of the sizes of the
+	// commits in the range, before deduplication and compression.
+	LogicalBytes int64 `protobuf:"varint,2,opt,name=logical_bytes,json=logicalBytes,proto3" json:"logical_bytes,omitempty"`
+	// unique_chunk_bytes is the size in object storage (after compression) of
+	// the distinct chunks referenced.
+	UniqueChunkBytes int64 `protobuf:"varint,3,opt,name=unique_chunk_bytes,json=uniqueChunkBytes,proto3" json:"unique_chunk_bytes,omitempty"`
+	// shared_bytes is the size of the referenced chunks that are also
+	// referenced from outside of the repo or commit range.
+	SharedBytes int64 `protobuf:"varint,4,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+	// reclaimable_bytes is the size of the chunks that would be garbage
+	// collected if the repo or commit range were deleted.
+	ReclaimableBytes     int64    `protobuf:"varint,5,opt,name=reclaimable_bytes,json=reclaimableBytes,proto3" json:"reclaimable_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoStorageInfo) Reset()         { *m = RepoStorageInfo{} }
+func (m *RepoStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*RepoStorageInfo) ProtoMessage()    {}
+func (*RepoStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{19}
+}
+func (m *RepoStorageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoStorageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoStorageInfo.Merge(m, src)
+}
+func (m *RepoStorageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoStorageInfo proto.InternalMessageInfo
+
+func (m *RepoStorageInfo) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoStorageInfo) GetLogicalBytes() int64 {
+	if m != nil {
+		return m.LogicalBytes
+	}
+	return 0
+}
+
+func (m *RepoStorageInfo) GetUniqueChunkBytes() int64 {
+	if m != nil {
+		return m.UniqueChunkBytes
+	}
+	return 0
+}
+
+func (m *RepoStorageInfo) GetSharedBytes() int64 {
+	if m != nil {
+		return m.SharedBytes
+	}
+	return 0
+}
+
+func (m *RepoStorageInfo) GetReclaimableBytes() int64 {
+	if m != nil {
+		return m.ReclaimableBytes
+	}
+	return 0
+}
+
+type ListRepoRequest struct {
+	// type is the type of (system) repos that should be returned
+	// an empty string requests all repos
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
+func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRepoRequest) ProtoMessage()    {}
+func (*ListRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{20}
+}
+func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRepoRequest.Merge(m, src)
 }
 func (m *ListRepoRequest) XXX_Size() int {
 	return m.Size()
@@ -1360,7 +1872,7 @@ func (m *DeleteRepoRequest) Reset()         { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()    {}
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{16}
+	return fileDescriptor_21a7b2476cbc6216, []int{21}
 }
 func (m *DeleteRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,18 +1920,20 @@ type StartCommitRequest struct {
 	// If the branch does not exist, the commit will have no parent.
 	Parent *Commit `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
 	// description is a user-provided string describing this commit
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Branch               *Branch  `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Branch      *Branch `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// metadata is user-provided key/value annotations describing this commit
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *StartCommitRequest) Reset()         { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()    {}
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{17}
+	return fileDescriptor_21a7b2476cbc6216, []int{22}
 }
 func (m *StartCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,23 +1983,33 @@ func (m *StartCommitRequest) GetBranch() *Branch {
 	return nil
 }
 
+func (m *StartCommitRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type FinishCommitRequest struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 	// description is a user-provided string describing this commit. Setting this
 	// will overwrite the description set in StartCommit
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Error       string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Force       bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// metadata is merged into the metadata set in StartCommit, overwriting the
+	// values of any keys that were already set
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *FinishCommitRequest) Reset()         { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()    {}
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{18}
+	return fileDescriptor_21a7b2476cbc6216, []int{23}
 }
 func (m *FinishCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1542,6 +2066,13 @@ func (m *FinishCommitRequest) GetForce() bool {
 	return false
 }
 
+func (m *FinishCommitRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type InspectCommitRequest struct {
 	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 	// Wait causes inspect commit to wait until the commit is in the desired state.
@@ -1555,7 +2086,7 @@ func (m *InspectCommitRequest) Reset()         { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()    {}
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{19}
+	return fileDescriptor_21a7b2476cbc6216, []int{24}
 }
 func (m *InspectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,23 +2130,40 @@ func (m *InspectCommitRequest) GetWait() CommitState {
 }
 
 type ListCommitRequest struct {
-	Repo                 *Repo      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	From                 *Commit    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *Commit    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Number               int64      `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
-	Reverse              bool       `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	All                  bool       `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
-	OriginKind           OriginKind `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Repo       *Repo      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	From       *Commit    `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         *Commit    `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Number     int64      `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Reverse    bool       `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	All        bool       `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
+	OriginKind OriginKind `protobuf:"varint,7,opt,name=origin_kind,json=originKind,proto3,enum=pfs_v2.OriginKind" json:"origin_kind,omitempty"`
+	// Return only commits whose metadata has all of these pairs. A pair with an
+	// empty value matches any commit that has the key.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Return only commits started, or finished, at or after the *_after time and
+	// before the *_before time. Unfinished commits never match a finished bound.
+	StartedAfter   *types.Timestamp `protobuf:"bytes,9,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	StartedBefore  *types.Timestamp `protobuf:"bytes,10,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
+	FinishedAfter  *types.Timestamp `protobuf:"bytes,11,opt,name=finished_after,json=finishedAfter,proto3" json:"finished_after,omitempty"`
+	FinishedBefore *types.Timestamp `protobuf:"bytes,12,opt,name=finished_before,json=finishedBefore,proto3" json:"finished_before,omitempty"`
+	// Return only commits whose description contains this substring.
+	Description string `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
+	// Return only commits that did, or did not, finish with an error.
+	ErrorFilter ListCommitRequest_ErrorFilter `protobuf:"varint,14,opt,name=error_filter,json=errorFilter,proto3,enum=pfs_v2.ListCommitRequest_ErrorFilter" json:"error_filter,omitempty"`
+	// Return only finished commits at least min_size_bytes and at most
+	// max_size_bytes in size. Zero leaves a bound unset.
+	MinSizeBytes         int64    `protobuf:"varint,15,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	MaxSizeBytes         int64    `protobuf:"varint,16,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()    {}
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{20}
+	return fileDescriptor_21a7b2476cbc6216, []int{25}
 }
 func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1693,6 +2241,69 @@ func (m *ListCommitRequest) GetOriginKind() OriginKind {
 	return OriginKind_ORIGIN_KIND_UNKNOWN
 }
 
+func (m *ListCommitRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetStartedAfter() *types.Timestamp {
+	if m != nil {
+		return m.StartedAfter
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetStartedBefore() *types.Timestamp {
+	if m != nil {
+		return m.StartedBefore
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetFinishedAfter() *types.Timestamp {
+	if m != nil {
+		return m.FinishedAfter
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetFinishedBefore() *types.Timestamp {
+	if m != nil {
+		return m.FinishedBefore
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListCommitRequest) GetErrorFilter() ListCommitRequest_ErrorFilter {
+	if m != nil {
+		return m.ErrorFilter
+	}
+	return ListCommitRequest_ANY_ERROR_STATE
+}
+
+func (m *ListCommitRequest) GetMinSizeBytes() int64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
+}
+
+func (m *ListCommitRequest) GetMaxSizeBytes() int64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
+}
+
 type InspectCommitSetRequest struct {
 	CommitSet            *CommitSet `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
 	Wait                 bool       `protobuf:"varint,2,opt,name=wait,proto3" json:"wait,omitempty"`
@@ -1705,7 +2316,7 @@ func (m *InspectCommitSetRequest) Reset()         { *m = InspectCommitSetRequest
 func (m *InspectCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitSetRequest) ProtoMessage()    {}
 func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{21}
+	return fileDescriptor_21a7b2476cbc6216, []int{26}
 }
 func (m *InspectCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2369,7 @@ func (m *ListCommitSetRequest) Reset()         { *m = ListCommitSetRequest{} }
 func (m *ListCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitSetRequest) ProtoMessage()    {}
 func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{22}
+	return fileDescriptor_21a7b2476cbc6216, []int{27}
 }
 func (m *ListCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,6 +2400,7 @@ var xxx_messageInfo_ListCommitSetRequest proto.InternalMessageInfo
 
 type SquashCommitSetRequest struct {
 	CommitSet            *CommitSet `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
+	Force                bool       `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1798,7 +2410,7 @@ func (m *SquashCommitSetRequest) Reset()         { *m = SquashCommitSetRequest{}
 func (m *SquashCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*SquashCommitSetRequest) ProtoMessage()    {}
 func (*SquashCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{23}
+	return fileDescriptor_21a7b2476cbc6216, []int{28}
 }
 func (m *SquashCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1834,8 +2446,16 @@ func (m *SquashCommitSetRequest) GetCommitSet() *CommitSet {
 	return nil
 }
 
+func (m *SquashCommitSetRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type DropCommitSetRequest struct {
 	CommitSet            *CommitSet `protobuf:"bytes,1,opt,name=commit_set,json=commitSet,proto3" json:"commit_set,omitempty"`
+	Force                bool       `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1845,7 +2465,7 @@ func (m *DropCommitSetRequest) Reset()         { *m = DropCommitSetRequest{} }
 func (m *DropCommitSetRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCommitSetRequest) ProtoMessage()    {}
 func (*DropCommitSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{24}
+	return fileDescriptor_21a7b2476cbc6216, []int{29}
 }
 func (m *DropCommitSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1881,6 +2501,13 @@ func (m *DropCommitSetRequest) GetCommitSet() *CommitSet {
 	return nil
 }
 
+func (m *DropCommitSetRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type SubscribeCommitRequest struct {
 	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -1899,7 +2526,7 @@ func (m *SubscribeCommitRequest) Reset()         { *m = SubscribeCommitRequest{}
 func (m *SubscribeCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()    {}
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{25}
+	return fileDescriptor_21a7b2476cbc6216, []int{30}
 }
 func (m *SubscribeCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1981,7 +2608,7 @@ func (m *ClearCommitRequest) Reset()         { *m = ClearCommitRequest{} }
 func (m *ClearCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ClearCommitRequest) ProtoMessage()    {}
 func (*ClearCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{26}
+	return fileDescriptor_21a7b2476cbc6216, []int{31}
 }
 func (m *ClearCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,29 +2644,33 @@ func (m *ClearCommitRequest) GetCommit() *Commit {
 	return nil
 }
 
-type CreateBranchRequest struct {
-	Head                 *Commit   `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Branch               *Branch   `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Provenance           []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
-	Trigger              *Trigger  `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	NewCommitSet         bool      `protobuf:"varint,5,opt,name=new_commit_set,json=newCommitSet,proto3" json:"new_commit_set,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
-func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateBranchRequest) ProtoMessage()    {}
-func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{27}
+type RevertCommitRequest struct {
+	// The commit whose changes are reverted.
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// The branch that the revert commit is made on. Defaults to the branch of
+	// commit.
+	Branch *Branch `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// How to resolve paths that were changed again after commit. OURS keeps the
+	// branch's current version, THEIRS restores the version from before commit.
+	Resolution           MergeResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=pfs_v2.MergeResolution" json:"resolution,omitempty"`
+	Description          string          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RevertCommitRequest) Reset()         { *m = RevertCommitRequest{} }
+func (m *RevertCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertCommitRequest) ProtoMessage()    {}
+func (*RevertCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{32}
 }
-func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
+func (m *RevertCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevertCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateBranchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevertCommitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2049,49 +2680,178 @@ func (m *CreateBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateBranchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateBranchRequest.Merge(m, src)
+func (m *RevertCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertCommitRequest.Merge(m, src)
 }
-func (m *CreateBranchRequest) XXX_Size() int {
+func (m *RevertCommitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateBranchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateBranchRequest.DiscardUnknown(m)
+func (m *RevertCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertCommitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateBranchRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevertCommitRequest proto.InternalMessageInfo
 
-func (m *CreateBranchRequest) GetHead() *Commit {
+func (m *RevertCommitRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.Head
+		return m.Commit
 	}
 	return nil
 }
 
-func (m *CreateBranchRequest) GetBranch() *Branch {
+func (m *RevertCommitRequest) GetBranch() *Branch {
 	if m != nil {
 		return m.Branch
 	}
 	return nil
 }
 
-func (m *CreateBranchRequest) GetProvenance() []*Branch {
+func (m *RevertCommitRequest) GetResolution() MergeResolution {
 	if m != nil {
-		return m.Provenance
+		return m.Resolution
 	}
-	return nil
+	return MergeResolution_UNRESOLVED
 }
 
-func (m *CreateBranchRequest) GetTrigger() *Trigger {
+func (m *RevertCommitRequest) GetDescription() string {
 	if m != nil {
-		return m.Trigger
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateBranchRequest) GetNewCommitSet() bool {
-	if m != nil {
-		return m.NewCommitSet
+type RevertCommitResponse struct {
+	// The revert commit, unset if commit made no changes or if any conflict
+	// was left unresolved.
+	Commit               *Commit          `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Conflicts            []*MergeConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RevertCommitResponse) Reset()         { *m = RevertCommitResponse{} }
+func (m *RevertCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*RevertCommitResponse) ProtoMessage()    {}
+func (*RevertCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{33}
+}
+func (m *RevertCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevertCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevertCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertCommitResponse.Merge(m, src)
+}
+func (m *RevertCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertCommitResponse proto.InternalMessageInfo
+
+func (m *RevertCommitResponse) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *RevertCommitResponse) GetConflicts() []*MergeConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type CreateBranchRequest struct {
+	Head                 *Commit   `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Branch               *Branch   `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Provenance           []*Branch `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"`
+	Trigger              *Trigger  `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	NewCommitSet         bool      `protobuf:"varint,5,opt,name=new_commit_set,json=newCommitSet,proto3" json:"new_commit_set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
+func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBranchRequest) ProtoMessage()    {}
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{34}
+}
+func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBranchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBranchRequest.Merge(m, src)
+}
+func (m *CreateBranchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBranchRequest proto.InternalMessageInfo
+
+func (m *CreateBranchRequest) GetHead() *Commit {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetProvenance() []*Branch {
+	if m != nil {
+		return m.Provenance
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetTrigger() *Trigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *CreateBranchRequest) GetNewCommitSet() bool {
+	if m != nil {
+		return m.NewCommitSet
 	}
 	return false
 }
@@ -2107,7 +2867,7 @@ func (m *InspectBranchRequest) Reset()         { *m = InspectBranchRequest{} }
 func (m *InspectBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectBranchRequest) ProtoMessage()    {}
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{28}
+	return fileDescriptor_21a7b2476cbc6216, []int{35}
 }
 func (m *InspectBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2155,7 +2915,7 @@ func (m *ListBranchRequest) Reset()         { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()    {}
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{29}
+	return fileDescriptor_21a7b2476cbc6216, []int{36}
 }
 func (m *ListBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2970,7 @@ func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()    {}
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{30}
+	return fileDescriptor_21a7b2476cbc6216, []int{37}
 }
 func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,30 +3013,27 @@ func (m *DeleteBranchRequest) GetForce() bool {
 	return false
 }
 
-type AddFile struct {
-	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
-	// Types that are valid to be assigned to Source:
-	//	*AddFile_Raw
-	//	*AddFile_Url
-	Source               isAddFile_Source `protobuf_oneof:"source"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type CreateTagRequest struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Commit               *Commit  `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddFile) Reset()         { *m = AddFile{} }
-func (m *AddFile) String() string { return proto.CompactTextString(m) }
-func (*AddFile) ProtoMessage()    {}
-func (*AddFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31}
+func (m *CreateTagRequest) Reset()         { *m = CreateTagRequest{} }
+func (m *CreateTagRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTagRequest) ProtoMessage()    {}
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{38}
 }
-func (m *AddFile) XXX_Unmarshal(b []byte) error {
+func (m *CreateTagRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddFile.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateTagRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2286,97 +3043,105 @@ func (m *AddFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AddFile) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddFile.Merge(m, src)
+func (m *CreateTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTagRequest.Merge(m, src)
 }
-func (m *AddFile) XXX_Size() int {
+func (m *CreateTagRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddFile) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddFile.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AddFile proto.InternalMessageInfo
-
-type isAddFile_Source interface {
-	isAddFile_Source()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type AddFile_Raw struct {
-	Raw *types.BytesValue `protobuf:"bytes,3,opt,name=raw,proto3,oneof" json:"raw,omitempty"`
-}
-type AddFile_Url struct {
-	Url *AddFile_URLSource `protobuf:"bytes,4,opt,name=url,proto3,oneof" json:"url,omitempty"`
+func (m *CreateTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTagRequest.DiscardUnknown(m)
 }
 
-func (*AddFile_Raw) isAddFile_Source() {}
-func (*AddFile_Url) isAddFile_Source() {}
+var xxx_messageInfo_CreateTagRequest proto.InternalMessageInfo
 
-func (m *AddFile) GetSource() isAddFile_Source {
+func (m *CreateTagRequest) GetTag() *Tag {
 	if m != nil {
-		return m.Source
+		return m.Tag
 	}
 	return nil
 }
 
-func (m *AddFile) GetPath() string {
+func (m *CreateTagRequest) GetCommit() *Commit {
 	if m != nil {
-		return m.Path
+		return m.Commit
 	}
-	return ""
+	return nil
 }
 
-func (m *AddFile) GetDatum() string {
+func (m *CreateTagRequest) GetDescription() string {
 	if m != nil {
-		return m.Datum
+		return m.Description
 	}
 	return ""
 }
 
-func (m *AddFile) GetRaw() *types.BytesValue {
-	if x, ok := m.GetSource().(*AddFile_Raw); ok {
-		return x.Raw
-	}
-	return nil
+type InspectTagRequest struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddFile) GetUrl() *AddFile_URLSource {
-	if x, ok := m.GetSource().(*AddFile_Url); ok {
-		return x.Url
+func (m *InspectTagRequest) Reset()         { *m = InspectTagRequest{} }
+func (m *InspectTagRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectTagRequest) ProtoMessage()    {}
+func (*InspectTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{39}
+}
+func (m *InspectTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *InspectTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectTagRequest.Merge(m, src)
+}
+func (m *InspectTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectTagRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*AddFile) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*AddFile_Raw)(nil),
-		(*AddFile_Url)(nil),
+var xxx_messageInfo_InspectTagRequest proto.InternalMessageInfo
+
+func (m *InspectTagRequest) GetTag() *Tag {
+	if m != nil {
+		return m.Tag
 	}
+	return nil
 }
 
-type AddFile_URLSource struct {
-	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+type ListTagRequest struct {
+	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddFile_URLSource) Reset()         { *m = AddFile_URLSource{} }
-func (m *AddFile_URLSource) String() string { return proto.CompactTextString(m) }
-func (*AddFile_URLSource) ProtoMessage()    {}
-func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{31, 0}
+func (m *ListTagRequest) Reset()         { *m = ListTagRequest{} }
+func (m *ListTagRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagRequest) ProtoMessage()    {}
+func (*ListTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{40}
 }
-func (m *AddFile_URLSource) XXX_Unmarshal(b []byte) error {
+func (m *ListTagRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddFile_URLSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddFile_URLSource.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTagRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2386,52 +3151,44 @@ func (m *AddFile_URLSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AddFile_URLSource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddFile_URLSource.Merge(m, src)
+func (m *ListTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagRequest.Merge(m, src)
 }
-func (m *AddFile_URLSource) XXX_Size() int {
+func (m *ListTagRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddFile_URLSource) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddFile_URLSource.DiscardUnknown(m)
+func (m *ListTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddFile_URLSource proto.InternalMessageInfo
-
-func (m *AddFile_URLSource) GetURL() string {
-	if m != nil {
-		return m.URL
-	}
-	return ""
-}
+var xxx_messageInfo_ListTagRequest proto.InternalMessageInfo
 
-func (m *AddFile_URLSource) GetRecursive() bool {
+func (m *ListTagRequest) GetRepo() *Repo {
 	if m != nil {
-		return m.Recursive
+		return m.Repo
 	}
-	return false
+	return nil
 }
 
-type DeleteFile struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Datum                string   `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+type DeleteTagRequest struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteFile) Reset()         { *m = DeleteFile{} }
-func (m *DeleteFile) String() string { return proto.CompactTextString(m) }
-func (*DeleteFile) ProtoMessage()    {}
-func (*DeleteFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{32}
+func (m *DeleteTagRequest) Reset()         { *m = DeleteTagRequest{} }
+func (m *DeleteTagRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagRequest) ProtoMessage()    {}
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{41}
 }
-func (m *DeleteFile) XXX_Unmarshal(b []byte) error {
+func (m *DeleteTagRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteFile.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteTagRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2441,54 +3198,52 @@ func (m *DeleteFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DeleteFile) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteFile.Merge(m, src)
+func (m *DeleteTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagRequest.Merge(m, src)
 }
-func (m *DeleteFile) XXX_Size() int {
+func (m *DeleteTagRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteFile) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteFile.DiscardUnknown(m)
+func (m *DeleteTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteFile proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTagRequest proto.InternalMessageInfo
 
-func (m *DeleteFile) GetPath() string {
+func (m *DeleteTagRequest) GetTag() *Tag {
 	if m != nil {
-		return m.Path
+		return m.Tag
 	}
-	return ""
-}
-
-func (m *DeleteFile) GetDatum() string {
-	if m != nil {
-		return m.Datum
-	}
-	return ""
-}
-
-type CopyFile struct {
-	Dst                  string   `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
-	Datum                string   `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
-	Src                  *File    `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
-	Append               bool     `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return nil
 }
 
-func (m *CopyFile) Reset()         { *m = CopyFile{} }
-func (m *CopyFile) String() string { return proto.CompactTextString(m) }
-func (*CopyFile) ProtoMessage()    {}
-func (*CopyFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{33}
+type MergeBranchRequest struct {
+	// The branch whose changes are merged.
+	Source *Branch `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The branch that the merge commit is made on, in the same repo as source.
+	Target *Branch `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// How to resolve conflicts that aren't listed in resolutions.
+	Resolution MergeResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=pfs_v2.MergeResolution" json:"resolution,omitempty"`
+	// Per-path resolutions, keyed by file path.
+	Resolutions          map[string]MergeResolution `protobuf:"bytes,4,rep,name=resolutions,proto3" json:"resolutions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pfs_v2.MergeResolution"`
+	Description          string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *MergeBranchRequest) Reset()         { *m = MergeBranchRequest{} }
+func (m *MergeBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeBranchRequest) ProtoMessage()    {}
+func (*MergeBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{42}
 }
-func (m *CopyFile) XXX_Unmarshal(b []byte) error {
+func (m *MergeBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CopyFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergeBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CopyFile.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergeBranchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2498,70 +3253,76 @@ func (m *CopyFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CopyFile) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CopyFile.Merge(m, src)
+func (m *MergeBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeBranchRequest.Merge(m, src)
 }
-func (m *CopyFile) XXX_Size() int {
+func (m *MergeBranchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CopyFile) XXX_DiscardUnknown() {
-	xxx_messageInfo_CopyFile.DiscardUnknown(m)
+func (m *MergeBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeBranchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CopyFile proto.InternalMessageInfo
+var xxx_messageInfo_MergeBranchRequest proto.InternalMessageInfo
 
-func (m *CopyFile) GetDst() string {
+func (m *MergeBranchRequest) GetSource() *Branch {
 	if m != nil {
-		return m.Dst
+		return m.Source
 	}
-	return ""
+	return nil
 }
 
-func (m *CopyFile) GetDatum() string {
+func (m *MergeBranchRequest) GetTarget() *Branch {
 	if m != nil {
-		return m.Datum
+		return m.Target
 	}
-	return ""
+	return nil
 }
 
-func (m *CopyFile) GetSrc() *File {
+func (m *MergeBranchRequest) GetResolution() MergeResolution {
 	if m != nil {
-		return m.Src
+		return m.Resolution
+	}
+	return MergeResolution_UNRESOLVED
+}
+
+func (m *MergeBranchRequest) GetResolutions() map[string]MergeResolution {
+	if m != nil {
+		return m.Resolutions
 	}
 	return nil
 }
 
-func (m *CopyFile) GetAppend() bool {
+func (m *MergeBranchRequest) GetDescription() string {
 	if m != nil {
-		return m.Append
+		return m.Description
 	}
-	return false
+	return ""
 }
 
-type ModifyFileRequest struct {
-	// Types that are valid to be assigned to Body:
-	//	*ModifyFileRequest_SetCommit
-	//	*ModifyFileRequest_AddFile
-	//	*ModifyFileRequest_DeleteFile
-	//	*ModifyFileRequest_CopyFile
-	Body                 isModifyFileRequest_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type MergeConflict struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The file on each side, unset if that side deleted it.
+	Ours                 *FileInfo       `protobuf:"bytes,2,opt,name=ours,proto3" json:"ours,omitempty"`
+	Theirs               *FileInfo       `protobuf:"bytes,3,opt,name=theirs,proto3" json:"theirs,omitempty"`
+	Resolution           MergeResolution `protobuf:"varint,4,opt,name=resolution,proto3,enum=pfs_v2.MergeResolution" json:"resolution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ModifyFileRequest) Reset()         { *m = ModifyFileRequest{} }
-func (m *ModifyFileRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyFileRequest) ProtoMessage()    {}
-func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{34}
+func (m *MergeConflict) Reset()         { *m = MergeConflict{} }
+func (m *MergeConflict) String() string { return proto.CompactTextString(m) }
+func (*MergeConflict) ProtoMessage()    {}
+func (*MergeConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{43}
 }
-func (m *ModifyFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *MergeConflict) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergeConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergeConflict.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2571,108 +3332,68 @@ func (m *ModifyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ModifyFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyFileRequest.Merge(m, src)
+func (m *MergeConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeConflict.Merge(m, src)
 }
-func (m *ModifyFileRequest) XXX_Size() int {
+func (m *MergeConflict) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyFileRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ModifyFileRequest proto.InternalMessageInfo
-
-type isModifyFileRequest_Body interface {
-	isModifyFileRequest_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type ModifyFileRequest_SetCommit struct {
-	SetCommit *Commit `protobuf:"bytes,1,opt,name=set_commit,json=setCommit,proto3,oneof" json:"set_commit,omitempty"`
-}
-type ModifyFileRequest_AddFile struct {
-	AddFile *AddFile `protobuf:"bytes,2,opt,name=add_file,json=addFile,proto3,oneof" json:"add_file,omitempty"`
-}
-type ModifyFileRequest_DeleteFile struct {
-	DeleteFile *DeleteFile `protobuf:"bytes,3,opt,name=delete_file,json=deleteFile,proto3,oneof" json:"delete_file,omitempty"`
-}
-type ModifyFileRequest_CopyFile struct {
-	CopyFile *CopyFile `protobuf:"bytes,4,opt,name=copy_file,json=copyFile,proto3,oneof" json:"copy_file,omitempty"`
+func (m *MergeConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeConflict.DiscardUnknown(m)
 }
 
-func (*ModifyFileRequest_SetCommit) isModifyFileRequest_Body()  {}
-func (*ModifyFileRequest_AddFile) isModifyFileRequest_Body()    {}
-func (*ModifyFileRequest_DeleteFile) isModifyFileRequest_Body() {}
-func (*ModifyFileRequest_CopyFile) isModifyFileRequest_Body()   {}
+var xxx_messageInfo_MergeConflict proto.InternalMessageInfo
 
-func (m *ModifyFileRequest) GetBody() isModifyFileRequest_Body {
+func (m *MergeConflict) GetPath() string {
 	if m != nil {
-		return m.Body
-	}
-	return nil
-}
-
-func (m *ModifyFileRequest) GetSetCommit() *Commit {
-	if x, ok := m.GetBody().(*ModifyFileRequest_SetCommit); ok {
-		return x.SetCommit
-	}
-	return nil
-}
-
-func (m *ModifyFileRequest) GetAddFile() *AddFile {
-	if x, ok := m.GetBody().(*ModifyFileRequest_AddFile); ok {
-		return x.AddFile
+		return m.Path
 	}
-	return nil
+	return ""
 }
 
-func (m *ModifyFileRequest) GetDeleteFile() *DeleteFile {
-	if x, ok := m.GetBody().(*ModifyFileRequest_DeleteFile); ok {
-		return x.DeleteFile
+func (m *MergeConflict) GetOurs() *FileInfo {
+	if m != nil {
+		return m.Ours
 	}
 	return nil
 }
 
-func (m *ModifyFileRequest) GetCopyFile() *CopyFile {
-	if x, ok := m.GetBody().(*ModifyFileRequest_CopyFile); ok {
-		return x.CopyFile
+func (m *MergeConflict) GetTheirs() *FileInfo {
+	if m != nil {
+		return m.Theirs
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*ModifyFileRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*ModifyFileRequest_SetCommit)(nil),
-		(*ModifyFileRequest_AddFile)(nil),
-		(*ModifyFileRequest_DeleteFile)(nil),
-		(*ModifyFileRequest_CopyFile)(nil),
+func (m *MergeConflict) GetResolution() MergeResolution {
+	if m != nil {
+		return m.Resolution
 	}
+	return MergeResolution_UNRESOLVED
 }
 
-type GetFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
-	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type MergeBranchResponse struct {
+	// The merge commit, unset if there was nothing to merge or if any conflict
+	// was left unresolved.
+	Commit               *Commit          `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Conflicts            []*MergeConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
-func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFileRequest) ProtoMessage()    {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{35}
+func (m *MergeBranchResponse) Reset()         { *m = MergeBranchResponse{} }
+func (m *MergeBranchResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeBranchResponse) ProtoMessage()    {}
+func (*MergeBranchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{44}
 }
-func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *MergeBranchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MergeBranchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MergeBranchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2682,58 +3403,53 @@ func (m *GetFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *GetFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFileRequest.Merge(m, src)
+func (m *MergeBranchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeBranchResponse.Merge(m, src)
 }
-func (m *GetFileRequest) XXX_Size() int {
+func (m *MergeBranchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFileRequest.DiscardUnknown(m)
+func (m *MergeBranchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeBranchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_MergeBranchResponse proto.InternalMessageInfo
 
-func (m *GetFileRequest) GetFile() *File {
+func (m *MergeBranchResponse) GetCommit() *Commit {
 	if m != nil {
-		return m.File
+		return m.Commit
 	}
 	return nil
 }
 
-func (m *GetFileRequest) GetURL() string {
-	if m != nil {
-		return m.URL
-	}
-	return ""
-}
-
-func (m *GetFileRequest) GetOffset() int64 {
+func (m *MergeBranchResponse) GetConflicts() []*MergeConflict {
 	if m != nil {
-		return m.Offset
+		return m.Conflicts
 	}
-	return 0
+	return nil
 }
 
-type InspectFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetBranchProtectionRequest struct {
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// If unset, any existing protection is removed from the branch.
+	Protection           *BranchProtection `protobuf:"bytes,2,opt,name=protection,proto3" json:"protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
-func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectFileRequest) ProtoMessage()    {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{36}
+func (m *SetBranchProtectionRequest) Reset()         { *m = SetBranchProtectionRequest{} }
+func (m *SetBranchProtectionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBranchProtectionRequest) ProtoMessage()    {}
+func (*SetBranchProtectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{45}
 }
-func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetBranchProtectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetBranchProtectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InspectFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetBranchProtectionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2743,48 +3459,72 @@ func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *InspectFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InspectFileRequest.Merge(m, src)
+func (m *SetBranchProtectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBranchProtectionRequest.Merge(m, src)
 }
-func (m *InspectFileRequest) XXX_Size() int {
+func (m *SetBranchProtectionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *InspectFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InspectFileRequest.DiscardUnknown(m)
+func (m *SetBranchProtectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBranchProtectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InspectFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetBranchProtectionRequest proto.InternalMessageInfo
 
-func (m *InspectFileRequest) GetFile() *File {
+func (m *SetBranchProtectionRequest) GetBranch() *Branch {
 	if m != nil {
-		return m.File
+		return m.Branch
 	}
 	return nil
 }
 
-type ListFileRequest struct {
-	// File is the parent directory of the files we want to list. This sets the
-	// repo, the commit/branch, and path prefix of files we're interested in
-	// If the "path" field is omitted, a list of files at the top level of the repo
-	// is returned
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+func (m *SetBranchProtectionRequest) GetProtection() *BranchProtection {
+	if m != nil {
+		return m.Protection
+	}
+	return nil
+}
+
+type AddFile struct {
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Datum string `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//	*AddFile_Raw
+	//	*AddFile_Url
+	Source isAddFile_Source `protobuf_oneof:"source"`
+	// delimiter, if set, splits the data into records which are written to
+	// separate files under path, named by their 16 digit hex index. Consecutive
+	// AddFile requests for the same path and split settings are split as a
+	// single stream, and the index continues from the files already under path.
+	Delimiter Delimiter `protobuf:"varint,5,opt,name=delimiter,proto3,enum=pfs_v2.Delimiter" json:"delimiter,omitempty"`
+	// target_file_datums is the number of records written to each file.
+	TargetFileDatums int64 `protobuf:"varint,6,opt,name=target_file_datums,json=targetFileDatums,proto3" json:"target_file_datums,omitempty"`
+	// target_file_bytes is the size of the records that each file is filled to
+	// before the next file is started. If neither target is set, each record
+	// is written to its own file.
+	TargetFileBytes int64 `protobuf:"varint,7,opt,name=target_file_bytes,json=targetFileBytes,proto3" json:"target_file_bytes,omitempty"`
+	// header_records is the number of CSV or SQL records at the start of the
+	// data that are written to the start of every file, rather than to a file
+	// of their own. The header and footer of a pg_dump are always written to
+	// every file.
+	HeaderRecords        int64    `protobuf:"varint,8,opt,name=header_records,json=headerRecords,proto3" json:"header_records,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
-func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
-func (*ListFileRequest) ProtoMessage()    {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{37}
+func (m *AddFile) Reset()         { *m = AddFile{} }
+func (m *AddFile) String() string { return proto.CompactTextString(m) }
+func (*AddFile) ProtoMessage()    {}
+func (*AddFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{46}
 }
-func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *AddFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddFile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2794,44 +3534,125 @@ func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ListFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFileRequest.Merge(m, src)
+func (m *AddFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFile.Merge(m, src)
 }
-func (m *ListFileRequest) XXX_Size() int {
+func (m *AddFile) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFileRequest.DiscardUnknown(m)
+func (m *AddFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFile.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFile proto.InternalMessageInfo
 
-func (m *ListFileRequest) GetFile() *File {
-	if m != nil {
-		return m.File
-	}
-	return nil
+type isAddFile_Source interface {
+	isAddFile_Source()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type WalkFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+type AddFile_Raw struct {
+	Raw *types.BytesValue `protobuf:"bytes,3,opt,name=raw,proto3,oneof" json:"raw,omitempty"`
+}
+type AddFile_Url struct {
+	Url *AddFile_URLSource `protobuf:"bytes,4,opt,name=url,proto3,oneof" json:"url,omitempty"`
+}
+
+func (*AddFile_Raw) isAddFile_Source() {}
+func (*AddFile_Url) isAddFile_Source() {}
+
+func (m *AddFile) GetSource() isAddFile_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *AddFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *AddFile) GetDatum() string {
+	if m != nil {
+		return m.Datum
+	}
+	return ""
+}
+
+func (m *AddFile) GetRaw() *types.BytesValue {
+	if x, ok := m.GetSource().(*AddFile_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *AddFile) GetUrl() *AddFile_URLSource {
+	if x, ok := m.GetSource().(*AddFile_Url); ok {
+		return x.Url
+	}
+	return nil
+}
+
+func (m *AddFile) GetDelimiter() Delimiter {
+	if m != nil {
+		return m.Delimiter
+	}
+	return Delimiter_NONE
+}
+
+func (m *AddFile) GetTargetFileDatums() int64 {
+	if m != nil {
+		return m.TargetFileDatums
+	}
+	return 0
+}
+
+func (m *AddFile) GetTargetFileBytes() int64 {
+	if m != nil {
+		return m.TargetFileBytes
+	}
+	return 0
+}
+
+func (m *AddFile) GetHeaderRecords() int64 {
+	if m != nil {
+		return m.HeaderRecords
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AddFile) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AddFile_Raw)(nil),
+		(*AddFile_Url)(nil),
+	}
+}
+
+type AddFile_URLSource struct {
+	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
-func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
-func (*WalkFileRequest) ProtoMessage()    {}
-func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{38}
+func (m *AddFile_URLSource) Reset()         { *m = AddFile_URLSource{} }
+func (m *AddFile_URLSource) String() string { return proto.CompactTextString(m) }
+func (*AddFile_URLSource) ProtoMessage()    {}
+func (*AddFile_URLSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{46, 0}
 }
-func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *AddFile_URLSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddFile_URLSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WalkFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddFile_URLSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2841,45 +3662,52 @@ func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *WalkFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalkFileRequest.Merge(m, src)
+func (m *AddFile_URLSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFile_URLSource.Merge(m, src)
 }
-func (m *WalkFileRequest) XXX_Size() int {
+func (m *AddFile_URLSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *WalkFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalkFileRequest.DiscardUnknown(m)
+func (m *AddFile_URLSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFile_URLSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalkFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFile_URLSource proto.InternalMessageInfo
 
-func (m *WalkFileRequest) GetFile() *File {
+func (m *AddFile_URLSource) GetURL() string {
 	if m != nil {
-		return m.File
+		return m.URL
 	}
-	return nil
+	return ""
 }
 
-type GlobFileRequest struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+func (m *AddFile_URLSource) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type DeleteFile struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Datum                string   `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
-func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
-func (*GlobFileRequest) ProtoMessage()    {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{39}
+func (m *DeleteFile) Reset()         { *m = DeleteFile{} }
+func (m *DeleteFile) String() string { return proto.CompactTextString(m) }
+func (*DeleteFile) ProtoMessage()    {}
+func (*DeleteFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{47}
 }
-func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GlobFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GlobFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteFile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2889,55 +3717,54 @@ func (m *GlobFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GlobFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GlobFileRequest.Merge(m, src)
+func (m *DeleteFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFile.Merge(m, src)
 }
-func (m *GlobFileRequest) XXX_Size() int {
+func (m *DeleteFile) XXX_Size() int {
 	return m.Size()
 }
-func (m *GlobFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GlobFileRequest.DiscardUnknown(m)
+func (m *DeleteFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFile.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GlobFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteFile proto.InternalMessageInfo
 
-func (m *GlobFileRequest) GetCommit() *Commit {
+func (m *DeleteFile) GetPath() string {
 	if m != nil {
-		return m.Commit
+		return m.Path
 	}
-	return nil
+	return ""
 }
 
-func (m *GlobFileRequest) GetPattern() string {
+func (m *DeleteFile) GetDatum() string {
 	if m != nil {
-		return m.Pattern
+		return m.Datum
 	}
 	return ""
 }
 
-type DiffFileRequest struct {
-	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	// OldFile may be left nil in which case the same path in the parent of
-	// NewFile's commit will be used.
-	OldFile              *File    `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
-	Shallow              bool     `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+type CopyFile struct {
+	Dst                  string   `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
+	Datum                string   `protobuf:"bytes,2,opt,name=datum,proto3" json:"datum,omitempty"`
+	Src                  *File    `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Append               bool     `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
-func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
-func (*DiffFileRequest) ProtoMessage()    {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{40}
+func (m *CopyFile) Reset()         { *m = CopyFile{} }
+func (m *CopyFile) String() string { return proto.CompactTextString(m) }
+func (*CopyFile) ProtoMessage()    {}
+func (*CopyFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{48}
 }
-func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *CopyFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CopyFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiffFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CopyFile.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2947,59 +3774,70 @@ func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DiffFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiffFileRequest.Merge(m, src)
+func (m *CopyFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyFile.Merge(m, src)
 }
-func (m *DiffFileRequest) XXX_Size() int {
+func (m *CopyFile) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiffFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiffFileRequest.DiscardUnknown(m)
+func (m *CopyFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyFile.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiffFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_CopyFile proto.InternalMessageInfo
 
-func (m *DiffFileRequest) GetNewFile() *File {
+func (m *CopyFile) GetDst() string {
 	if m != nil {
-		return m.NewFile
+		return m.Dst
 	}
-	return nil
+	return ""
 }
 
-func (m *DiffFileRequest) GetOldFile() *File {
+func (m *CopyFile) GetDatum() string {
 	if m != nil {
-		return m.OldFile
+		return m.Datum
+	}
+	return ""
+}
+
+func (m *CopyFile) GetSrc() *File {
+	if m != nil {
+		return m.Src
 	}
 	return nil
 }
 
-func (m *DiffFileRequest) GetShallow() bool {
+func (m *CopyFile) GetAppend() bool {
 	if m != nil {
-		return m.Shallow
+		return m.Append
 	}
 	return false
 }
 
-type DiffFileResponse struct {
-	NewFile              *FileInfo `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	OldFile              *FileInfo `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ModifyFileRequest struct {
+	// Types that are valid to be assigned to Body:
+	//	*ModifyFileRequest_SetCommit
+	//	*ModifyFileRequest_AddFile
+	//	*ModifyFileRequest_DeleteFile
+	//	*ModifyFileRequest_CopyFile
+	Body                 isModifyFileRequest_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
-func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
-func (*DiffFileResponse) ProtoMessage()    {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{41}
+func (m *ModifyFileRequest) Reset()         { *m = ModifyFileRequest{} }
+func (m *ModifyFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyFileRequest) ProtoMessage()    {}
+func (*ModifyFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{49}
 }
-func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
+func (m *ModifyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ModifyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiffFileResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ModifyFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3009,51 +3847,114 @@ func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DiffFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiffFileResponse.Merge(m, src)
+func (m *ModifyFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyFileRequest.Merge(m, src)
 }
-func (m *DiffFileResponse) XXX_Size() int {
+func (m *ModifyFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiffFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiffFileResponse.DiscardUnknown(m)
+func (m *ModifyFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiffFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_ModifyFileRequest proto.InternalMessageInfo
 
-func (m *DiffFileResponse) GetNewFile() *FileInfo {
+type isModifyFileRequest_Body interface {
+	isModifyFileRequest_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ModifyFileRequest_SetCommit struct {
+	SetCommit *Commit `protobuf:"bytes,1,opt,name=set_commit,json=setCommit,proto3,oneof" json:"set_commit,omitempty"`
+}
+type ModifyFileRequest_AddFile struct {
+	AddFile *AddFile `protobuf:"bytes,2,opt,name=add_file,json=addFile,proto3,oneof" json:"add_file,omitempty"`
+}
+type ModifyFileRequest_DeleteFile struct {
+	DeleteFile *DeleteFile `protobuf:"bytes,3,opt,name=delete_file,json=deleteFile,proto3,oneof" json:"delete_file,omitempty"`
+}
+type ModifyFileRequest_CopyFile struct {
+	CopyFile *CopyFile `protobuf:"bytes,4,opt,name=copy_file,json=copyFile,proto3,oneof" json:"copy_file,omitempty"`
+}
+
+func (*ModifyFileRequest_SetCommit) isModifyFileRequest_Body()  {}
+func (*ModifyFileRequest_AddFile) isModifyFileRequest_Body()    {}
+func (*ModifyFileRequest_DeleteFile) isModifyFileRequest_Body() {}
+func (*ModifyFileRequest_CopyFile) isModifyFileRequest_Body()   {}
+
+func (m *ModifyFileRequest) GetBody() isModifyFileRequest_Body {
 	if m != nil {
-		return m.NewFile
+		return m.Body
 	}
 	return nil
 }
 
-func (m *DiffFileResponse) GetOldFile() *FileInfo {
-	if m != nil {
-		return m.OldFile
+func (m *ModifyFileRequest) GetSetCommit() *Commit {
+	if x, ok := m.GetBody().(*ModifyFileRequest_SetCommit); ok {
+		return x.SetCommit
 	}
 	return nil
 }
 
-type FsckRequest struct {
-	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+func (m *ModifyFileRequest) GetAddFile() *AddFile {
+	if x, ok := m.GetBody().(*ModifyFileRequest_AddFile); ok {
+		return x.AddFile
+	}
+	return nil
+}
+
+func (m *ModifyFileRequest) GetDeleteFile() *DeleteFile {
+	if x, ok := m.GetBody().(*ModifyFileRequest_DeleteFile); ok {
+		return x.DeleteFile
+	}
+	return nil
+}
+
+func (m *ModifyFileRequest) GetCopyFile() *CopyFile {
+	if x, ok := m.GetBody().(*ModifyFileRequest_CopyFile); ok {
+		return x.CopyFile
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ModifyFileRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ModifyFileRequest_SetCommit)(nil),
+		(*ModifyFileRequest_AddFile)(nil),
+		(*ModifyFileRequest_DeleteFile)(nil),
+		(*ModifyFileRequest_CopyFile)(nil),
+	}
+}
+
+type GetFileRequest struct {
+	File   *File  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	URL    string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// If set with URL, only the files that differ from the same paths in this
+	// commit are written to URL.
+	Since *Commit `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	// If set with since, the files that are in since but not in file are
+	// deleted from URL.
+	DeleteRemoved        bool     `protobuf:"varint,5,opt,name=delete_removed,json=deleteRemoved,proto3" json:"delete_removed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
-func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
-func (*FsckRequest) ProtoMessage()    {}
-func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{42}
+func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
+func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFileRequest) ProtoMessage()    {}
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{50}
 }
-func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FsckRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3063,45 +3964,72 @@ func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *FsckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FsckRequest.Merge(m, src)
+func (m *GetFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFileRequest.Merge(m, src)
 }
-func (m *FsckRequest) XXX_Size() int {
+func (m *GetFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FsckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FsckRequest.DiscardUnknown(m)
+func (m *GetFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FsckRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFileRequest proto.InternalMessageInfo
 
-func (m *FsckRequest) GetFix() bool {
+func (m *GetFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Fix
+		return m.File
+	}
+	return nil
+}
+
+func (m *GetFileRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *GetFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetFileRequest) GetSince() *Commit {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *GetFileRequest) GetDeleteRemoved() bool {
+	if m != nil {
+		return m.DeleteRemoved
 	}
 	return false
 }
 
-type FsckResponse struct {
-	Fix                  string   `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
-	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type InspectFileRequest struct {
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
-func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
-func (*FsckResponse) ProtoMessage()    {}
-func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{43}
+func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
+func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectFileRequest) ProtoMessage()    {}
+func (*InspectFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{51}
 }
-func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
+func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FsckResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3111,51 +4039,48 @@ func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *FsckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FsckResponse.Merge(m, src)
+func (m *InspectFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectFileRequest.Merge(m, src)
 }
-func (m *FsckResponse) XXX_Size() int {
+func (m *InspectFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FsckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FsckResponse.DiscardUnknown(m)
+func (m *InspectFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FsckResponse proto.InternalMessageInfo
-
-func (m *FsckResponse) GetFix() string {
-	if m != nil {
-		return m.Fix
-	}
-	return ""
-}
+var xxx_messageInfo_InspectFileRequest proto.InternalMessageInfo
 
-func (m *FsckResponse) GetError() string {
+func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Error
+		return m.File
 	}
-	return ""
+	return nil
 }
 
-type CreateFileSetResponse struct {
-	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+type ListFileRequest struct {
+	// File is the parent directory of the files we want to list. This sets the
+	// repo, the commit/branch, and path prefix of files we're interested in
+	// If the "path" field is omitted, a list of files at the top level of the repo
+	// is returned
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateFileSetResponse) Reset()         { *m = CreateFileSetResponse{} }
-func (m *CreateFileSetResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateFileSetResponse) ProtoMessage()    {}
-func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{44}
+func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
+func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFileRequest) ProtoMessage()    {}
+func (*ListFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{52}
 }
-func (m *CreateFileSetResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateFileSetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3165,44 +4090,44 @@ func (m *CreateFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *CreateFileSetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateFileSetResponse.Merge(m, src)
+func (m *ListFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFileRequest.Merge(m, src)
 }
-func (m *CreateFileSetResponse) XXX_Size() int {
+func (m *ListFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateFileSetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateFileSetResponse.DiscardUnknown(m)
+func (m *ListFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateFileSetResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListFileRequest proto.InternalMessageInfo
 
-func (m *CreateFileSetResponse) GetFileSetId() string {
+func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
-		return m.FileSetId
+		return m.File
 	}
-	return ""
+	return nil
 }
 
-type GetFileSetRequest struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+type WalkFileRequest struct {
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFileSetRequest) Reset()         { *m = GetFileSetRequest{} }
-func (m *GetFileSetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFileSetRequest) ProtoMessage()    {}
-func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{45}
+func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
+func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
+func (*WalkFileRequest) ProtoMessage()    {}
+func (*WalkFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{53}
 }
-func (m *GetFileSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFileSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WalkFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3212,45 +4137,45 @@ func (m *GetFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetFileSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFileSetRequest.Merge(m, src)
+func (m *WalkFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalkFileRequest.Merge(m, src)
 }
-func (m *GetFileSetRequest) XXX_Size() int {
+func (m *WalkFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFileSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFileSetRequest.DiscardUnknown(m)
+func (m *WalkFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalkFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFileSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_WalkFileRequest proto.InternalMessageInfo
 
-func (m *GetFileSetRequest) GetCommit() *Commit {
+func (m *WalkFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Commit
+		return m.File
 	}
 	return nil
 }
 
-type AddFileSetRequest struct {
+type GlobFileRequest struct {
 	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	FileSetId            string   `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddFileSetRequest) Reset()         { *m = AddFileSetRequest{} }
-func (m *AddFileSetRequest) String() string { return proto.CompactTextString(m) }
-func (*AddFileSetRequest) ProtoMessage()    {}
-func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{46}
+func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
+func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
+func (*GlobFileRequest) ProtoMessage()    {}
+func (*GlobFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{54}
 }
-func (m *AddFileSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GlobFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddFileSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GlobFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3260,52 +4185,55 @@ func (m *AddFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *AddFileSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddFileSetRequest.Merge(m, src)
+func (m *GlobFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobFileRequest.Merge(m, src)
 }
-func (m *AddFileSetRequest) XXX_Size() int {
+func (m *GlobFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddFileSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddFileSetRequest.DiscardUnknown(m)
+func (m *GlobFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddFileSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_GlobFileRequest proto.InternalMessageInfo
 
-func (m *AddFileSetRequest) GetCommit() *Commit {
+func (m *GlobFileRequest) GetCommit() *Commit {
 	if m != nil {
 		return m.Commit
 	}
 	return nil
 }
 
-func (m *AddFileSetRequest) GetFileSetId() string {
+func (m *GlobFileRequest) GetPattern() string {
 	if m != nil {
-		return m.FileSetId
+		return m.Pattern
 	}
 	return ""
 }
 
-type RenewFileSetRequest struct {
-	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
-	TtlSeconds           int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+type DiffFileRequest struct {
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile              *File    `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	Shallow              bool     `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RenewFileSetRequest) Reset()         { *m = RenewFileSetRequest{} }
-func (m *RenewFileSetRequest) String() string { return proto.CompactTextString(m) }
-func (*RenewFileSetRequest) ProtoMessage()    {}
-func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{47}
+func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
+func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffFileRequest) ProtoMessage()    {}
+func (*DiffFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{55}
 }
-func (m *RenewFileSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenewFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenewFileSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DiffFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3315,50 +4243,59 @@ func (m *RenewFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RenewFileSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenewFileSetRequest.Merge(m, src)
+func (m *DiffFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileRequest.Merge(m, src)
 }
-func (m *RenewFileSetRequest) XXX_Size() int {
+func (m *DiffFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenewFileSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenewFileSetRequest.DiscardUnknown(m)
+func (m *DiffFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenewFileSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_DiffFileRequest proto.InternalMessageInfo
 
-func (m *RenewFileSetRequest) GetFileSetId() string {
+func (m *DiffFileRequest) GetNewFile() *File {
 	if m != nil {
-		return m.FileSetId
+		return m.NewFile
 	}
-	return ""
+	return nil
 }
 
-func (m *RenewFileSetRequest) GetTtlSeconds() int64 {
+func (m *DiffFileRequest) GetOldFile() *File {
 	if m != nil {
-		return m.TtlSeconds
+		return m.OldFile
 	}
-	return 0
+	return nil
 }
 
-type ActivateAuthRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DiffFileRequest) GetShallow() bool {
+	if m != nil {
+		return m.Shallow
+	}
+	return false
 }
 
-func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
-func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthRequest) ProtoMessage()    {}
-func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{48}
+type DiffFileResponse struct {
+	NewFile              *FileInfo `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	OldFile              *FileInfo `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
+func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffFileResponse) ProtoMessage()    {}
+func (*DiffFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{56}
+}
+func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DiffFileResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3368,36 +4305,51 @@ func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
+func (m *DiffFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileResponse.Merge(m, src)
 }
-func (m *ActivateAuthRequest) XXX_Size() int {
+func (m *DiffFileResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
+func (m *DiffFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
+var xxx_messageInfo_DiffFileResponse proto.InternalMessageInfo
 
-type ActivateAuthResponse struct {
+func (m *DiffFileResponse) GetNewFile() *FileInfo {
+	if m != nil {
+		return m.NewFile
+	}
+	return nil
+}
+
+func (m *DiffFileResponse) GetOldFile() *FileInfo {
+	if m != nil {
+		return m.OldFile
+	}
+	return nil
+}
+
+type FsckRequest struct {
+	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
-func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
-func (*ActivateAuthResponse) ProtoMessage()    {}
-func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{49}
+func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
+func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
+func (*FsckRequest) ProtoMessage()    {}
+func (*FsckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{57}
 }
-func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
+func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FsckRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3407,39 +4359,45 @@ func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
+func (m *FsckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckRequest.Merge(m, src)
 }
-func (m *ActivateAuthResponse) XXX_Size() int {
+func (m *FsckRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
+func (m *FsckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_FsckRequest proto.InternalMessageInfo
 
-type RunLoadTestRequest struct {
-	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+func (m *FsckRequest) GetFix() bool {
+	if m != nil {
+		return m.Fix
+	}
+	return false
+}
+
+type FsckResponse struct {
+	Fix                  string   `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
-func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
-func (*RunLoadTestRequest) ProtoMessage()    {}
-func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{50}
+func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
+func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
+func (*FsckResponse) ProtoMessage()    {}
+func (*FsckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{58}
 }
-func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
+func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RunLoadTestRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FsckResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3449,61 +4407,52 @@ func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RunLoadTestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunLoadTestRequest.Merge(m, src)
+func (m *FsckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckResponse.Merge(m, src)
 }
-func (m *RunLoadTestRequest) XXX_Size() int {
+func (m *FsckResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RunLoadTestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunLoadTestRequest.DiscardUnknown(m)
+func (m *FsckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RunLoadTestRequest proto.InternalMessageInfo
+var xxx_messageInfo_FsckResponse proto.InternalMessageInfo
 
-func (m *RunLoadTestRequest) GetSpec() string {
+func (m *FsckResponse) GetFix() string {
 	if m != nil {
-		return m.Spec
+		return m.Fix
 	}
 	return ""
 }
 
-func (m *RunLoadTestRequest) GetBranch() *Branch {
-	if m != nil {
-		return m.Branch
-	}
-	return nil
-}
-
-func (m *RunLoadTestRequest) GetSeed() int64 {
+func (m *FsckResponse) GetError() string {
 	if m != nil {
-		return m.Seed
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type RunLoadTestResponse struct {
-	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
-	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
-	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+type GarbageCollectRequest struct {
+	// dry_run reports what the pass would delete, without deleting anything.
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
-func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
-func (*RunLoadTestResponse) ProtoMessage()    {}
-func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21a7b2476cbc6216, []int{51}
+func (m *GarbageCollectRequest) Reset()         { *m = GarbageCollectRequest{} }
+func (m *GarbageCollectRequest) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectRequest) ProtoMessage()    {}
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{59}
 }
-func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
+func (m *GarbageCollectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GarbageCollectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RunLoadTestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GarbageCollectRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3513,3509 +4462,3050 @@ func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RunLoadTestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RunLoadTestResponse.Merge(m, src)
+func (m *GarbageCollectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectRequest.Merge(m, src)
 }
-func (m *RunLoadTestResponse) XXX_Size() int {
+func (m *GarbageCollectRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RunLoadTestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RunLoadTestResponse.DiscardUnknown(m)
+func (m *GarbageCollectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RunLoadTestResponse proto.InternalMessageInfo
+var xxx_messageInfo_GarbageCollectRequest proto.InternalMessageInfo
 
-func (m *RunLoadTestResponse) GetSpec() string {
+func (m *GarbageCollectRequest) GetDryRun() bool {
 	if m != nil {
-		return m.Spec
+		return m.DryRun
 	}
-	return ""
+	return false
 }
 
-func (m *RunLoadTestResponse) GetBranch() *Branch {
-	if m != nil {
-		return m.Branch
-	}
-	return nil
+// GarbageCollectResponse describes a single pass of garbage collection.
+type GarbageCollectResponse struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// tracker_objects is the number of tracked objects (filesets, chunks and
+	// temporary references) deleted.
+	TrackerObjects int64 `protobuf:"varint,2,opt,name=tracker_objects,json=trackerObjects,proto3" json:"tracker_objects,omitempty"`
+	// chunk_objects is the number of chunk objects deleted from object storage.
+	ChunkObjects int64 `protobuf:"varint,3,opt,name=chunk_objects,json=chunkObjects,proto3" json:"chunk_objects,omitempty"`
+	// chunk_bytes is the size of those chunks in object storage, after
+	// compression and encryption.
+	ChunkBytes int64 `protobuf:"varint,4,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
+	// oldest_chunk is when the oldest of those chunks was created.
+	OldestChunk          *types.Timestamp `protobuf:"bytes,5,opt,name=oldest_chunk,json=oldestChunk,proto3" json:"oldest_chunk,omitempty"`
+	Duration             *types.Duration  `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *RunLoadTestResponse) GetSeed() int64 {
-	if m != nil {
-		return m.Seed
-	}
-	return 0
+func (m *GarbageCollectResponse) Reset()         { *m = GarbageCollectResponse{} }
+func (m *GarbageCollectResponse) String() string { return proto.CompactTextString(m) }
+func (*GarbageCollectResponse) ProtoMessage()    {}
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{60}
 }
-
-func (m *RunLoadTestResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
+func (m *GarbageCollectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterEnum("pfs_v2.OriginKind", OriginKind_name, OriginKind_value)
-	proto.RegisterEnum("pfs_v2.FileType", FileType_name, FileType_value)
-	proto.RegisterEnum("pfs_v2.CommitState", CommitState_name, CommitState_value)
-	proto.RegisterEnum("pfs_v2.Delimiter", Delimiter_name, Delimiter_value)
-	proto.RegisterType((*Repo)(nil), "pfs_v2.Repo")
-	proto.RegisterType((*Branch)(nil), "pfs_v2.Branch")
-	proto.RegisterType((*File)(nil), "pfs_v2.File")
-	proto.RegisterType((*RepoInfo)(nil), "pfs_v2.RepoInfo")
-	proto.RegisterType((*RepoInfo_Details)(nil), "pfs_v2.RepoInfo.Details")
-	proto.RegisterType((*RepoAuthInfo)(nil), "pfs_v2.RepoAuthInfo")
-	proto.RegisterType((*BranchInfo)(nil), "pfs_v2.BranchInfo")
-	proto.RegisterType((*Trigger)(nil), "pfs_v2.Trigger")
-	proto.RegisterType((*CommitOrigin)(nil), "pfs_v2.CommitOrigin")
-	proto.RegisterType((*Commit)(nil), "pfs_v2.Commit")
-	proto.RegisterType((*CommitInfo)(nil), "pfs_v2.CommitInfo")
-	proto.RegisterType((*CommitInfo_Details)(nil), "pfs_v2.CommitInfo.Details")
-	proto.RegisterType((*CommitSet)(nil), "pfs_v2.CommitSet")
-	proto.RegisterType((*CommitSetInfo)(nil), "pfs_v2.CommitSetInfo")
-	proto.RegisterType((*FileInfo)(nil), "pfs_v2.FileInfo")
-	proto.RegisterType((*CreateRepoRequest)(nil), "pfs_v2.CreateRepoRequest")
-	proto.RegisterType((*InspectRepoRequest)(nil), "pfs_v2.InspectRepoRequest")
-	proto.RegisterType((*ListRepoRequest)(nil), "pfs_v2.ListRepoRequest")
-	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs_v2.DeleteRepoRequest")
-	proto.RegisterType((*StartCommitRequest)(nil), "pfs_v2.StartCommitRequest")
-	proto.RegisterType((*FinishCommitRequest)(nil), "pfs_v2.FinishCommitRequest")
-	proto.RegisterType((*InspectCommitRequest)(nil), "pfs_v2.InspectCommitRequest")
-	proto.RegisterType((*ListCommitRequest)(nil), "pfs_v2.ListCommitRequest")
-	proto.RegisterType((*InspectCommitSetRequest)(nil), "pfs_v2.InspectCommitSetRequest")
-	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs_v2.ListCommitSetRequest")
-	proto.RegisterType((*SquashCommitSetRequest)(nil), "pfs_v2.SquashCommitSetRequest")
-	proto.RegisterType((*DropCommitSetRequest)(nil), "pfs_v2.DropCommitSetRequest")
-	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs_v2.SubscribeCommitRequest")
-	proto.RegisterType((*ClearCommitRequest)(nil), "pfs_v2.ClearCommitRequest")
-	proto.RegisterType((*CreateBranchRequest)(nil), "pfs_v2.CreateBranchRequest")
-	proto.RegisterType((*InspectBranchRequest)(nil), "pfs_v2.InspectBranchRequest")
-	proto.RegisterType((*ListBranchRequest)(nil), "pfs_v2.ListBranchRequest")
-	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs_v2.DeleteBranchRequest")
-	proto.RegisterType((*AddFile)(nil), "pfs_v2.AddFile")
-	proto.RegisterType((*AddFile_URLSource)(nil), "pfs_v2.AddFile.URLSource")
-	proto.RegisterType((*DeleteFile)(nil), "pfs_v2.DeleteFile")
-	proto.RegisterType((*CopyFile)(nil), "pfs_v2.CopyFile")
-	proto.RegisterType((*ModifyFileRequest)(nil), "pfs_v2.ModifyFileRequest")
-	proto.RegisterType((*GetFileRequest)(nil), "pfs_v2.GetFileRequest")
-	proto.RegisterType((*InspectFileRequest)(nil), "pfs_v2.InspectFileRequest")
-	proto.RegisterType((*ListFileRequest)(nil), "pfs_v2.ListFileRequest")
-	proto.RegisterType((*WalkFileRequest)(nil), "pfs_v2.WalkFileRequest")
-	proto.RegisterType((*GlobFileRequest)(nil), "pfs_v2.GlobFileRequest")
-	proto.RegisterType((*DiffFileRequest)(nil), "pfs_v2.DiffFileRequest")
-	proto.RegisterType((*DiffFileResponse)(nil), "pfs_v2.DiffFileResponse")
-	proto.RegisterType((*FsckRequest)(nil), "pfs_v2.FsckRequest")
-	proto.RegisterType((*FsckResponse)(nil), "pfs_v2.FsckResponse")
-	proto.RegisterType((*CreateFileSetResponse)(nil), "pfs_v2.CreateFileSetResponse")
-	proto.RegisterType((*GetFileSetRequest)(nil), "pfs_v2.GetFileSetRequest")
-	proto.RegisterType((*AddFileSetRequest)(nil), "pfs_v2.AddFileSetRequest")
-	proto.RegisterType((*RenewFileSetRequest)(nil), "pfs_v2.RenewFileSetRequest")
-	proto.RegisterType((*ActivateAuthRequest)(nil), "pfs_v2.ActivateAuthRequest")
-	proto.RegisterType((*ActivateAuthResponse)(nil), "pfs_v2.ActivateAuthResponse")
-	proto.RegisterType((*RunLoadTestRequest)(nil), "pfs_v2.RunLoadTestRequest")
-	proto.RegisterType((*RunLoadTestResponse)(nil), "pfs_v2.RunLoadTestResponse")
+func (m *GarbageCollectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GarbageCollectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
-
-var fileDescriptor_21a7b2476cbc6216 = []byte{
-	// 2686 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x73, 0xdb, 0xd6,
-	0xf5, 0x17, 0x1e, 0xe2, 0xe3, 0x50, 0xb2, 0xa0, 0x2b, 0x45, 0xe1, 0x9f, 0x4e, 0x64, 0x0f, 0xfe,
-	0xad, 0xe3, 0x38, 0x89, 0xe4, 0xca, 0x89, 0xd3, 0xd4, 0x4d, 0x3b, 0x94, 0x48, 0x5b, 0x8c, 0x64,
-	0x2a, 0x05, 0x25, 0xa7, 0x6d, 0x32, 0xc3, 0x01, 0x89, 0x4b, 0x11, 0x35, 0x08, 0x20, 0x00, 0x28,
-	0x55, 0xed, 0xb4, 0xcb, 0x6e, 0xfa, 0x05, 0xba, 0xcc, 0xc7, 0xc9, 0xb2, 0xeb, 0x2e, 0x3a, 0x19,
-	0xaf, 0xba, 0xee, 0xa2, 0xcb, 0x4e, 0xe7, 0x3e, 0x80, 0x0b, 0x80, 0x0f, 0x51, 0x4e, 0x36, 0x9c,
-	0x8b, 0x7b, 0xcf, 0xeb, 0x9e, 0xd7, 0xfd, 0x1d, 0xc2, 0xaa, 0x3f, 0x08, 0x77, 0xfd, 0x41, 0xb8,
-	0xe3, 0x07, 0x5e, 0xe4, 0xa1, 0x82, 0x3f, 0x08, 0xbb, 0x17, 0x7b, 0xb5, 0xdb, 0xe7, 0x9e, 0x77,
-	0xee, 0xe0, 0x5d, 0xba, 0xdb, 0x1b, 0x0f, 0x76, 0xf1, 0xc8, 0x8f, 0xae, 0x18, 0x51, 0xed, 0x4e,
-	0xfe, 0x30, 0xb2, 0x47, 0x38, 0x8c, 0xcc, 0x91, 0xcf, 0x09, 0xb6, 0xf3, 0x04, 0x97, 0x81, 0xe9,
-	0xfb, 0x38, 0xe0, 0x5a, 0x6a, 0x9b, 0xe7, 0xde, 0xb9, 0x47, 0x97, 0xbb, 0x64, 0xc5, 0x77, 0xd7,
-	0xcc, 0x71, 0x34, 0xdc, 0x25, 0x3f, 0x6c, 0x43, 0xff, 0x10, 0x54, 0x03, 0xfb, 0x1e, 0x42, 0xa0,
-	0xba, 0xe6, 0x08, 0x57, 0xa5, 0xbb, 0xd2, 0xfd, 0xb2, 0x41, 0xd7, 0x64, 0x2f, 0xba, 0xf2, 0x71,
-	0x55, 0x66, 0x7b, 0x64, 0xfd, 0x33, 0xf5, 0x6f, 0xdf, 0xdc, 0x59, 0xd2, 0x1b, 0x50, 0xd8, 0x0f,
-	0x4c, 0xb7, 0x3f, 0x44, 0x77, 0x41, 0x0d, 0xb0, 0xef, 0x51, 0xbe, 0xca, 0xde, 0xca, 0x0e, 0xbb,
-	0xdb, 0x0e, 0x91, 0x69, 0xd0, 0x93, 0x44, 0xb2, 0x2c, 0x24, 0x73, 0x29, 0xbf, 0x06, 0xf5, 0xa9,
-	0xed, 0x60, 0x74, 0x0f, 0x0a, 0x7d, 0x6f, 0x34, 0xb2, 0x23, 0x2e, 0xe5, 0x56, 0x2c, 0xe5, 0x80,
-	0xee, 0x1a, 0xfc, 0x94, 0x48, 0xf2, 0xcd, 0x68, 0x18, 0x4b, 0x22, 0x6b, 0xb4, 0x09, 0xcb, 0x96,
-	0x19, 0x8d, 0x47, 0x55, 0x85, 0x6e, 0xb2, 0x0f, 0xfd, 0x3f, 0x32, 0x94, 0x88, 0x09, 0x2d, 0x77,
-	0xe0, 0x2d, 0x60, 0xe2, 0x87, 0x50, 0xec, 0x07, 0xd8, 0x8c, 0xb0, 0x45, 0x65, 0x57, 0xf6, 0x6a,
-	0x3b, 0xcc, 0xbb, 0x3b, 0xb1, 0x77, 0x77, 0x4e, 0x63, 0xf7, 0x1b, 0x31, 0x29, 0x7a, 0x04, 0x5b,
-	0xa1, 0xfd, 0x07, 0xdc, 0xed, 0x5d, 0x45, 0x38, 0xec, 0x8e, 0x89, 0xf3, 0xbb, 0x3d, 0x6f, 0xec,
-	0x5a, 0xd4, 0x16, 0xc5, 0xd8, 0x20, 0xa7, 0xfb, 0xe4, 0xf0, 0x8c, 0x9c, 0xed, 0x93, 0x23, 0x74,
-	0x17, 0x2a, 0x16, 0x0e, 0xfb, 0x81, 0xed, 0x47, 0xb6, 0xe7, 0x56, 0x55, 0x6a, 0x75, 0x7a, 0x0b,
-	0x3d, 0x80, 0x52, 0x8f, 0xfa, 0x16, 0x87, 0xd5, 0xe5, 0xbb, 0x4a, 0xda, 0x1f, 0xcc, 0xe7, 0x46,
-	0x72, 0x8e, 0x7e, 0x02, 0x65, 0x12, 0xcb, 0xae, 0xed, 0x0e, 0xbc, 0x6a, 0x81, 0x9a, 0xbe, 0x99,
-	0xbe, 0x5f, 0x7d, 0x1c, 0x0d, 0x89, 0x0f, 0x8c, 0x92, 0xc9, 0x57, 0x68, 0x0f, 0x8a, 0x16, 0x8e,
-	0x4c, 0xdb, 0x09, 0xab, 0x45, 0xca, 0x50, 0x4d, 0x33, 0x10, 0x92, 0x9d, 0x06, 0x3b, 0x37, 0x62,
-	0xc2, 0xda, 0x7d, 0x28, 0xf2, 0x3d, 0xf4, 0x36, 0x80, 0xb8, 0x34, 0x75, 0xa9, 0x62, 0x94, 0x93,
-	0x8b, 0xea, 0x5f, 0xc2, 0x4a, 0x5a, 0x2f, 0xfa, 0x08, 0x2a, 0x3e, 0x0e, 0x46, 0x76, 0x18, 0xda,
-	0x9e, 0x4b, 0xe8, 0x95, 0xfb, 0xb7, 0xf6, 0x36, 0x76, 0xa8, 0xd1, 0x17, 0x7b, 0x3b, 0x9f, 0x27,
-	0x67, 0x46, 0x9a, 0x8e, 0x44, 0x35, 0xf0, 0x1c, 0x1c, 0x56, 0xe5, 0xbb, 0x0a, 0x89, 0x2a, 0xfd,
-	0xd0, 0xbf, 0x91, 0x01, 0x98, 0x0b, 0xa8, 0xec, 0x7b, 0x50, 0x60, 0x8e, 0xc8, 0xa7, 0x0d, 0x77,
-	0x13, 0x3f, 0x45, 0x3a, 0xa8, 0x43, 0x6c, 0xc6, 0xa1, 0xcd, 0x27, 0x17, 0x3d, 0x43, 0x3b, 0x00,
-	0x7e, 0xe0, 0x5d, 0x60, 0xd7, 0x74, 0xfb, 0xb8, 0xaa, 0x4c, 0x75, 0x7b, 0x8a, 0x82, 0xd0, 0x87,
-	0xe3, 0x5e, 0x4c, 0xaf, 0x4e, 0xa7, 0x17, 0x14, 0xe8, 0x09, 0xac, 0x5b, 0x76, 0x80, 0xfb, 0x51,
-	0x37, 0xa5, 0x66, 0x7a, 0x74, 0x35, 0x46, 0xf8, 0xb9, 0x50, 0xf6, 0x2e, 0x14, 0xa3, 0xc0, 0x3e,
-	0x3f, 0xc7, 0x01, 0x8f, 0xf1, 0x5a, 0xcc, 0x72, 0xca, 0xb6, 0x8d, 0xf8, 0x5c, 0xff, 0x33, 0x14,
-	0xf9, 0x1e, 0xda, 0xca, 0xb8, 0xa7, 0x9c, 0xb8, 0x43, 0x03, 0xc5, 0x74, 0x1c, 0xea, 0x8d, 0x92,
-	0x41, 0x96, 0xe8, 0x36, 0x94, 0xfb, 0x81, 0xe7, 0x76, 0x43, 0x1f, 0xf7, 0x79, 0x1d, 0x95, 0xc8,
-	0x46, 0xc7, 0xc7, 0x7d, 0x52, 0x74, 0x24, 0xbc, 0x3c, 0x53, 0xe9, 0x1a, 0x55, 0xa1, 0xc8, 0x4a,
-	0x92, 0x64, 0x28, 0xc9, 0x80, 0xf8, 0x53, 0x7f, 0x0c, 0x2b, 0xcc, 0xaf, 0x27, 0x81, 0x7d, 0x6e,
-	0xbb, 0xe8, 0x1e, 0xa8, 0x2f, 0x6d, 0xd7, 0xa2, 0x26, 0xdc, 0xda, 0x43, 0xb1, 0xdd, 0xec, 0xf4,
-	0xc8, 0x76, 0x2d, 0x83, 0x9e, 0xeb, 0x6d, 0x28, 0x30, 0xbe, 0x85, 0xa3, 0xba, 0x05, 0xb2, 0xcd,
-	0x62, 0x5a, 0xde, 0x2f, 0xbc, 0xfa, 0xe7, 0x1d, 0xb9, 0xd5, 0x30, 0x64, 0xdb, 0xe2, 0xad, 0xe5,
-	0xbf, 0x2a, 0x00, 0x13, 0x18, 0xa7, 0xca, 0x42, 0x1d, 0xe6, 0x7d, 0x28, 0x78, 0xd4, 0x34, 0x9e,
-	0x2c, 0x9b, 0x59, 0x3a, 0x66, 0xb6, 0xc1, 0x69, 0xf2, 0xb5, 0xac, 0x4c, 0xd6, 0xf2, 0x23, 0x58,
-	0xf5, 0xcd, 0x00, 0xbb, 0x51, 0x97, 0xab, 0x57, 0xa7, 0xaa, 0x5f, 0x61, 0x44, 0xdc, 0x03, 0x8f,
-	0x60, 0xb5, 0x3f, 0xb4, 0x1d, 0xab, 0x2b, 0x7c, 0xac, 0x4c, 0x63, 0xa2, 0x44, 0xec, 0x23, 0x24,
-	0x2d, 0x2c, 0x8c, 0xcc, 0x80, 0xb4, 0xb0, 0xc2, 0xf5, 0x2d, 0x8c, 0x93, 0xa2, 0x9f, 0x42, 0x79,
-	0x60, 0xbb, 0x76, 0x38, 0xb4, 0xdd, 0x73, 0xde, 0x0e, 0xe6, 0xf1, 0x09, 0x62, 0xf4, 0x18, 0x4a,
-	0xec, 0x03, 0x5b, 0xd5, 0xd2, 0xb5, 0x8c, 0x09, 0xed, 0xf4, 0x42, 0x28, 0x2f, 0x58, 0x08, 0x9b,
-	0xb0, 0x8c, 0x83, 0xc0, 0x0b, 0xaa, 0xc0, 0x9a, 0x3d, 0xfd, 0x98, 0xd3, 0x87, 0x2b, 0xb3, 0xfb,
-	0xf0, 0x87, 0xa2, 0x0d, 0xae, 0x70, 0xf3, 0x33, 0xee, 0xfd, 0xbe, 0x8d, 0xf0, 0xff, 0xa1, 0xcc,
-	0x04, 0x75, 0x70, 0xc4, 0x73, 0x55, 0xca, 0xe7, 0xaa, 0xee, 0xc1, 0x6a, 0x42, 0x44, 0xf3, 0xf4,
-	0x21, 0x00, 0x0b, 0x7a, 0x37, 0xc4, 0x71, 0xae, 0xae, 0x67, 0x0d, 0xeb, 0xe0, 0xc8, 0x28, 0xf7,
-	0x13, 0xd1, 0xef, 0x8b, 0x52, 0x94, 0xa9, 0x17, 0xd1, 0xe4, 0x3d, 0x44, 0x79, 0x7e, 0x2b, 0x41,
-	0x89, 0x3c, 0xb9, 0xf1, 0xbb, 0x38, 0xb0, 0x1d, 0x9c, 0x7f, 0x17, 0xc9, 0xb9, 0x41, 0x4f, 0xd0,
-	0x07, 0x24, 0x3d, 0x1c, 0xdc, 0x4d, 0x50, 0xc0, 0xad, 0x3d, 0x2d, 0x4d, 0x76, 0x7a, 0xe5, 0x63,
-	0x12, 0x5b, 0xb6, 0x22, 0xd9, 0xc4, 0x14, 0x91, 0x2c, 0x54, 0xae, 0xcf, 0xa6, 0x84, 0x38, 0xe7,
-	0x4c, 0x35, 0xe7, 0x4c, 0xd2, 0x83, 0x86, 0x66, 0x38, 0xa4, 0xcd, 0x66, 0xc5, 0xa0, 0x6b, 0xdd,
-	0x83, 0xf5, 0x03, 0xfa, 0x10, 0xd3, 0x77, 0x1c, 0x7f, 0x3d, 0xc6, 0x61, 0xb4, 0xc0, 0x53, 0x9f,
-	0xab, 0x59, 0x79, 0xb2, 0x66, 0xb7, 0xa0, 0x30, 0xf6, 0x2d, 0x33, 0xc2, 0xf4, 0x0a, 0x25, 0x83,
-	0x7f, 0xe9, 0x8f, 0x01, 0xb5, 0x5c, 0xd2, 0x22, 0xa3, 0x1b, 0x69, 0xd4, 0x7f, 0x0c, 0x6b, 0xc7,
-	0x76, 0x98, 0x61, 0x8a, 0x81, 0x95, 0x24, 0x80, 0x95, 0x7e, 0x04, 0xeb, 0x0d, 0xec, 0xe0, 0x9b,
-	0xde, 0x67, 0x13, 0x96, 0x07, 0x5e, 0xd0, 0xc7, 0xbc, 0x9f, 0xb3, 0x0f, 0xfd, 0x2f, 0x12, 0xa0,
-	0x0e, 0xa9, 0x71, 0xde, 0x2b, 0xb8, 0xb8, 0x7b, 0x50, 0x60, 0x9d, 0x66, 0x56, 0x1b, 0x64, 0xa7,
-	0x0b, 0x38, 0x49, 0x74, 0x69, 0x65, 0x5e, 0x97, 0xd6, 0xff, 0x2a, 0xc1, 0xc6, 0x53, 0x5a, 0xfb,
-	0x13, 0x96, 0x2c, 0xd4, 0x90, 0xaf, 0xb7, 0x24, 0xe9, 0x09, 0x4a, 0xba, 0x27, 0x24, 0x6e, 0x51,
-	0xd3, 0x6e, 0x39, 0x87, 0x4d, 0x1e, 0xc2, 0xd7, 0xb3, 0xe6, 0x1d, 0x50, 0x2f, 0x4d, 0x3b, 0xe2,
-	0xa5, 0xb0, 0x91, 0x2b, 0xcc, 0x88, 0x24, 0x23, 0x25, 0xd0, 0xff, 0x2d, 0xc1, 0x3a, 0x09, 0x7a,
-	0x56, 0xcd, 0xf5, 0xd1, 0xd4, 0x41, 0x1d, 0x04, 0xde, 0x68, 0x16, 0x54, 0x21, 0x67, 0x68, 0x1b,
-	0xe4, 0xc8, 0xcb, 0xbb, 0x9d, 0x53, 0xc8, 0x91, 0x47, 0xf2, 0xd7, 0x1d, 0x8f, 0x7a, 0x38, 0xe0,
-	0x75, 0xc4, 0xbf, 0xc8, 0xa3, 0x1d, 0xe0, 0x0b, 0x1c, 0x84, 0x98, 0xd6, 0x51, 0xc9, 0x88, 0x3f,
-	0x63, 0x44, 0x50, 0x10, 0x88, 0xe0, 0x11, 0x54, 0xd8, 0x1b, 0xd7, 0xa5, 0xaf, 0x77, 0x71, 0xe6,
-	0xeb, 0x0d, 0x5e, 0xb2, 0xd6, 0xbb, 0xf0, 0x66, 0xc6, 0xbb, 0xa4, 0x53, 0xf1, 0x9b, 0xdf, 0xbc,
-	0xaf, 0xa1, 0x94, 0xab, 0x4b, 0xdc, 0xab, 0x5b, 0xb0, 0x29, 0x9c, 0x2a, 0xa4, 0xeb, 0x9f, 0xc1,
-	0x56, 0xe7, 0xeb, 0xb1, 0x19, 0xe7, 0xd8, 0xf7, 0xd1, 0xab, 0x1f, 0xc2, 0x66, 0x23, 0xf0, 0xfc,
-	0x1f, 0x40, 0xd2, 0xbf, 0x24, 0xd8, 0xea, 0x8c, 0x7b, 0x24, 0x53, 0x7b, 0xf8, 0xa6, 0x89, 0x20,
-	0xc0, 0x9b, 0x9c, 0x01, 0x6f, 0x71, 0x82, 0x28, 0x73, 0x12, 0xe4, 0x5d, 0x58, 0x0e, 0x49, 0x2e,
-	0xd2, 0xf8, 0xcf, 0x48, 0x53, 0x46, 0x11, 0x47, 0x7e, 0x79, 0x66, 0xe4, 0x0b, 0x0b, 0x45, 0xfe,
-	0xe7, 0x80, 0x0e, 0x1c, 0x6c, 0x06, 0xaf, 0x55, 0x55, 0xfa, 0x2b, 0x09, 0x36, 0x58, 0x2b, 0xe7,
-	0xcd, 0x83, 0xf3, 0xc7, 0xb8, 0x5d, 0x9a, 0x83, 0xdb, 0xef, 0x65, 0xfc, 0x34, 0x1b, 0x2d, 0xde,
-	0x14, 0xdf, 0xa7, 0x20, 0xb7, 0x3a, 0x1f, 0x72, 0xa3, 0x1f, 0xc1, 0x2d, 0x17, 0x5f, 0x76, 0x53,
-	0xd9, 0xc1, 0xdc, 0xb9, 0xe2, 0xe2, 0xcb, 0x24, 0x31, 0xf4, 0x5f, 0x24, 0xad, 0x27, 0x7b, 0xc9,
-	0x05, 0xe1, 0xae, 0x7e, 0xc2, 0x1a, 0x4a, 0x96, 0xf9, 0xfa, 0x3c, 0x4a, 0x15, 0xbd, 0x9c, 0x29,
-	0x7a, 0xbd, 0x03, 0x1b, 0xec, 0xbd, 0x79, 0x2d, 0x7b, 0x66, 0xbc, 0x3b, 0xff, 0x90, 0xa0, 0x58,
-	0xb7, 0x2c, 0x3a, 0xd5, 0xc7, 0xd3, 0xba, 0x34, 0x6d, 0x5a, 0x97, 0x53, 0xd3, 0x3a, 0xda, 0x05,
-	0x25, 0x30, 0x2f, 0x79, 0x4e, 0xdf, 0x9e, 0x40, 0x0c, 0x14, 0x03, 0xbc, 0x30, 0x9d, 0x31, 0x3e,
-	0x5c, 0x32, 0x08, 0x25, 0xfa, 0x00, 0x94, 0x71, 0xe0, 0xf0, 0xc8, 0xfc, 0x5f, 0x6c, 0x21, 0x57,
-	0xbc, 0x73, 0x66, 0x1c, 0x77, 0xbc, 0x71, 0xd0, 0xa7, 0xe4, 0xe3, 0xc0, 0xa9, 0x3d, 0x81, 0x72,
-	0xb2, 0x47, 0x52, 0xfe, 0xcc, 0x38, 0xe6, 0x56, 0x91, 0x25, 0x7a, 0x0b, 0xca, 0x01, 0xee, 0x8f,
-	0x83, 0xd0, 0xbe, 0x88, 0xaf, 0x23, 0x36, 0xf6, 0x4b, 0x50, 0x08, 0x29, 0xa7, 0xfe, 0x18, 0x80,
-	0x79, 0xec, 0x66, 0xd7, 0xd3, 0x7f, 0x07, 0xa5, 0x03, 0xcf, 0xbf, 0xa2, 0x5c, 0x1a, 0x28, 0x56,
-	0x18, 0xc5, 0xda, 0xad, 0x30, 0x9a, 0xe1, 0x92, 0x6d, 0x50, 0xc2, 0xa0, 0xcf, 0x5d, 0x92, 0x85,
-	0x66, 0xe4, 0x80, 0xf4, 0x07, 0xd3, 0xf7, 0xb1, 0x6b, 0xf1, 0x07, 0x8e, 0x7f, 0x91, 0x5a, 0x5a,
-	0x7f, 0xee, 0x59, 0xf6, 0x80, 0xaa, 0x8b, 0x83, 0xba, 0x0b, 0x10, 0xe2, 0x64, 0x06, 0x99, 0x5a,
-	0x4f, 0x87, 0x4b, 0x46, 0x39, 0xc4, 0xf1, 0x08, 0xf2, 0x3e, 0x94, 0x4c, 0xcb, 0xea, 0x52, 0x78,
-	0x28, 0x67, 0xf3, 0x9f, 0x7b, 0xf9, 0x70, 0xc9, 0x28, 0x9a, 0x3c, 0xd2, 0x1f, 0x91, 0x47, 0x9a,
-	0x38, 0x86, 0x31, 0x30, 0xa3, 0x93, 0x9e, 0x21, 0x7c, 0x76, 0xb8, 0x64, 0x80, 0x25, 0x3c, 0xb8,
-	0x4b, 0xe0, 0xa2, 0x7f, 0xc5, 0x98, 0x58, 0x2c, 0x35, 0x61, 0x14, 0x73, 0xd8, 0xe1, 0x92, 0x51,
-	0xea, 0xf3, 0xf5, 0x7e, 0x01, 0xd4, 0x9e, 0x67, 0x5d, 0xe9, 0x5f, 0xc1, 0xad, 0x67, 0x38, 0x4a,
-	0x5f, 0xf0, 0x7a, 0x28, 0xcb, 0xc3, 0x2e, 0x8b, 0xb0, 0x6f, 0x41, 0xc1, 0x1b, 0x0c, 0x48, 0xbd,
-	0xb2, 0xbf, 0x6b, 0xf8, 0x57, 0x0a, 0xe7, 0xdd, 0x48, 0x83, 0xfe, 0x09, 0xc3, 0x79, 0x37, 0x62,
-	0xfa, 0x4c, 0x2d, 0xc9, 0x9a, 0xa2, 0x3f, 0x82, 0xb5, 0x2f, 0x4c, 0xe7, 0xe5, 0xcd, 0xf4, 0x75,
-	0x60, 0xed, 0x99, 0xe3, 0xf5, 0xd2, 0x4c, 0x8b, 0xe2, 0x98, 0x2a, 0x14, 0x7d, 0x33, 0x8a, 0x70,
-	0x10, 0x23, 0xaa, 0xf8, 0x53, 0xff, 0x13, 0xac, 0x35, 0xec, 0xc1, 0x20, 0x2d, 0xf4, 0x1d, 0x28,
-	0x91, 0xfe, 0x36, 0xd3, 0x9a, 0xa2, 0x8b, 0x2f, 0x69, 0x3c, 0xdf, 0x81, 0x92, 0xe7, 0x64, 0x92,
-	0x26, 0x47, 0xe8, 0x39, 0x2c, 0x5f, 0xaa, 0x50, 0x0c, 0x87, 0xa6, 0xe3, 0x78, 0x97, 0x1c, 0x62,
-	0xc7, 0x9f, 0xba, 0x03, 0x9a, 0x50, 0x1f, 0xfa, 0x9e, 0x1b, 0x62, 0xf4, 0xde, 0x84, 0xfe, 0xcc,
-	0x0c, 0xc2, 0x06, 0x9c, 0xd8, 0x86, 0xf7, 0x26, 0x6c, 0x98, 0x42, 0xcc, 0xed, 0xd0, 0xef, 0x40,
-	0xe5, 0x69, 0xd8, 0x7f, 0x19, 0x5f, 0x54, 0x03, 0x65, 0x60, 0xff, 0x9e, 0xea, 0x28, 0x19, 0x64,
-	0xa9, 0x3f, 0x86, 0x15, 0x46, 0xc0, 0x4d, 0x49, 0x51, 0x94, 0x29, 0x85, 0x40, 0x9f, 0x72, 0x0a,
-	0x7d, 0xea, 0x1f, 0xc3, 0x1b, 0xec, 0x41, 0x23, 0x6a, 0x28, 0x88, 0xe0, 0x02, 0xb6, 0xa1, 0x42,
-	0x07, 0x2a, 0x52, 0x8d, 0xf1, 0x44, 0x68, 0xd0, 0x19, 0x8b, 0x4c, 0x80, 0x96, 0xfe, 0x04, 0xd6,
-	0x79, 0x66, 0xa7, 0xa0, 0xc7, 0xa2, 0xef, 0xe8, 0x97, 0xb0, 0xce, 0x8b, 0xf3, 0xe6, 0xcc, 0x79,
-	0xcb, 0xe4, 0xbc, 0x65, 0x2f, 0x60, 0xc3, 0xc0, 0xdc, 0xcb, 0x29, 0xf1, 0xd7, 0x5c, 0x08, 0xdd,
-	0x81, 0x4a, 0x14, 0x39, 0xdd, 0x10, 0xf7, 0x3d, 0xd7, 0x0a, 0xa9, 0x58, 0xc5, 0x80, 0x28, 0x72,
-	0x3a, 0x6c, 0x47, 0x7f, 0x03, 0x36, 0xea, 0xfd, 0xc8, 0xbe, 0x30, 0x23, 0x5c, 0x1f, 0x47, 0xf1,
-	0x33, 0x44, 0xa0, 0x5e, 0x76, 0x9b, 0x39, 0x50, 0xb7, 0x00, 0x19, 0x63, 0xf7, 0xd8, 0x33, 0xad,
-	0x53, 0x1c, 0x46, 0xa9, 0x79, 0x8a, 0xfe, 0x77, 0xc5, 0x7b, 0x31, 0x59, 0x2f, 0x8c, 0x0c, 0x08,
-	0x2f, 0xc6, 0xf1, 0x7f, 0xb6, 0x74, 0xad, 0xff, 0x11, 0x36, 0x32, 0x5a, 0x78, 0xf4, 0x7e, 0x60,
-	0x35, 0x22, 0x79, 0xd4, 0x54, 0xf2, 0x3c, 0x68, 0x03, 0x08, 0x98, 0x85, 0xde, 0x84, 0x8d, 0x13,
-	0xa3, 0xf5, 0xac, 0xd5, 0xee, 0x1e, 0xb5, 0xda, 0x8d, 0xee, 0x59, 0xfb, 0xa8, 0x7d, 0xf2, 0x45,
-	0x5b, 0x5b, 0x42, 0x25, 0x50, 0xcf, 0x3a, 0x4d, 0x43, 0x93, 0xc8, 0xaa, 0x7e, 0x76, 0x7a, 0xa2,
-	0xc9, 0x64, 0xf5, 0xb4, 0x73, 0x70, 0xa4, 0x29, 0xa8, 0x0c, 0xcb, 0xf5, 0xe3, 0x56, 0xbd, 0xa3,
-	0xa9, 0x0f, 0xde, 0x63, 0x23, 0x3f, 0x9d, 0xd0, 0x57, 0xa0, 0x64, 0x34, 0x3b, 0x4d, 0xe3, 0x45,
-	0xb3, 0xc1, 0x44, 0x3c, 0x6d, 0x1d, 0x37, 0x35, 0x09, 0x15, 0x41, 0x69, 0xb4, 0x0c, 0x4d, 0x7e,
-	0xf0, 0x15, 0x54, 0x52, 0x30, 0x11, 0x55, 0x61, 0xf3, 0xe0, 0xe4, 0xf9, 0xf3, 0xd6, 0x69, 0xb7,
-	0x73, 0x5a, 0x3f, 0x6d, 0xa6, 0xd4, 0x57, 0xa0, 0xd8, 0x39, 0xad, 0x1b, 0xa7, 0xcd, 0x86, 0x26,
-	0x11, 0x6d, 0x46, 0xb3, 0xde, 0xf8, 0x8d, 0x26, 0xa3, 0x55, 0x28, 0x3f, 0x6d, 0xb5, 0x5b, 0x9d,
-	0xc3, 0x56, 0xfb, 0x99, 0xa6, 0x10, 0x85, 0xec, 0xb3, 0xd9, 0xd0, 0xd4, 0x07, 0x4f, 0xa0, 0xdc,
-	0xc0, 0x8e, 0x3d, 0xb2, 0x23, 0x1c, 0x10, 0xed, 0xed, 0x93, 0x76, 0x93, 0xd9, 0xf1, 0x59, 0xe7,
-	0xa4, 0xcd, 0xae, 0x72, 0xdc, 0x6a, 0x37, 0x35, 0x99, 0x58, 0xd4, 0xf9, 0xd5, 0xb1, 0xa6, 0x90,
-	0xc5, 0x41, 0xe7, 0x85, 0xa6, 0xee, 0x7d, 0x87, 0x40, 0xa9, 0x7f, 0xde, 0x42, 0x75, 0x00, 0x31,
-	0xf8, 0xa3, 0xe4, 0xf5, 0x9f, 0xf8, 0x33, 0xa0, 0xb6, 0x35, 0x81, 0x24, 0x9a, 0x23, 0x3f, 0xba,
-	0xd2, 0x97, 0xd0, 0xa7, 0x50, 0x49, 0x8d, 0xf2, 0x28, 0xf9, 0xeb, 0x67, 0x72, 0xbe, 0xaf, 0x69,
-	0xf9, 0x7f, 0xc7, 0xf5, 0x25, 0xf4, 0x09, 0x94, 0xe2, 0x89, 0x1e, 0xbd, 0x19, 0x9f, 0xe7, 0x66,
-	0xfc, 0x69, 0x8c, 0x0f, 0x25, 0x62, 0xbc, 0x98, 0xf2, 0x85, 0xf1, 0x13, 0x93, 0xff, 0x1c, 0xe3,
-	0x9f, 0x40, 0x25, 0x35, 0xda, 0x0b, 0xe3, 0x27, 0xe7, 0xfd, 0x5a, 0xae, 0xd8, 0xf5, 0x25, 0xd4,
-	0x84, 0x95, 0xf4, 0x38, 0x8e, 0x6e, 0x8b, 0xee, 0x38, 0x31, 0xa4, 0xcf, 0xb1, 0xe1, 0x00, 0x2a,
-	0x29, 0xc0, 0x2f, 0x6c, 0x98, 0x9c, 0x02, 0xe6, 0x0a, 0x59, 0xcd, 0xcc, 0x8b, 0xe8, 0xad, 0x5c,
-	0x1c, 0xb2, 0x82, 0xa6, 0xfc, 0xb1, 0xa5, 0x2f, 0xa1, 0x5f, 0x02, 0x88, 0x99, 0x50, 0x38, 0x74,
-	0x62, 0xf8, 0x9e, 0xce, 0xfe, 0x50, 0x42, 0x2d, 0x58, 0xcb, 0x4d, 0x69, 0x68, 0x3b, 0x71, 0xe9,
-	0xd4, 0xf1, 0x6d, 0xa6, 0xa8, 0x23, 0xd0, 0xf2, 0x03, 0x30, 0xba, 0x33, 0xf5, 0x4e, 0xa2, 0x83,
-	0xce, 0x14, 0x76, 0x08, 0xab, 0x99, 0x61, 0x57, 0x78, 0x67, 0xda, 0x0c, 0x5c, 0x7b, 0x63, 0x62,
-	0x16, 0x4d, 0x99, 0xb5, 0x96, 0x1b, 0x8f, 0x53, 0x37, 0x9c, 0x3a, 0x37, 0xcf, 0x09, 0xda, 0x33,
-	0x58, 0xcd, 0xcc, 0xc7, 0xc2, 0xac, 0x69, 0x63, 0xf3, 0x1c, 0x41, 0x4d, 0x58, 0x49, 0x0f, 0x7d,
-	0x22, 0x13, 0xa7, 0x8c, 0x82, 0x0b, 0x25, 0x11, 0x97, 0x93, 0x4f, 0xa2, 0xac, 0x20, 0x94, 0x6d,
-	0xcf, 0xd9, 0x24, 0xe2, 0x12, 0x32, 0x49, 0xb4, 0x00, 0xfb, 0x43, 0x89, 0x5c, 0x26, 0x3d, 0x4c,
-	0x89, 0xcb, 0x4c, 0x19, 0xb1, 0xe6, 0x5e, 0x06, 0x04, 0x78, 0x17, 0x76, 0x4c, 0x00, 0xfa, 0xd9,
-	0x22, 0xee, 0x4b, 0x68, 0x1f, 0x8a, 0x1c, 0x43, 0xa0, 0xad, 0x58, 0x42, 0x16, 0x2e, 0xd7, 0xe6,
-	0xcd, 0x58, 0xfc, 0x3e, 0xc0, 0x59, 0x4e, 0xeb, 0xc6, 0xeb, 0x8b, 0x11, 0x7d, 0x96, 0x9a, 0x93,
-	0xef, 0xb3, 0x69, 0x59, 0x13, 0x30, 0x4d, 0xf4, 0x59, 0xca, 0x9b, 0xe9, 0xb3, 0xd7, 0x30, 0x3e,
-	0x94, 0x08, 0x6b, 0x8c, 0xa8, 0x05, 0x6b, 0x0e, 0x63, 0xcf, 0x66, 0x8d, 0x71, 0xb5, 0x60, 0xcd,
-	0x21, 0xed, 0x19, 0xac, 0x75, 0x28, 0xc5, 0xf0, 0x55, 0xb0, 0xe6, 0xf0, 0x74, 0xad, 0x3a, 0x79,
-	0xc0, 0xc1, 0x0d, 0x2b, 0xd6, 0x95, 0x34, 0xf0, 0x11, 0x99, 0x34, 0x05, 0x25, 0xd5, 0xde, 0x9a,
-	0x7e, 0x18, 0x8b, 0x43, 0x9f, 0xd2, 0xf7, 0x16, 0x47, 0xb8, 0xee, 0x38, 0x68, 0x46, 0xce, 0xcc,
-	0x49, 0xc7, 0x8f, 0x40, 0x25, 0xf0, 0x17, 0x25, 0xff, 0x20, 0xa5, 0xd0, 0x72, 0x6d, 0x33, 0xbb,
-	0x99, 0xba, 0xc2, 0x73, 0x58, 0xcd, 0xa0, 0xdf, 0x79, 0x89, 0xfc, 0x76, 0xb6, 0xea, 0x73, 0x78,
-	0x99, 0xe6, 0xf3, 0x61, 0x92, 0x8b, 0x19, 0x59, 0x13, 0x38, 0xf9, 0x5a, 0x59, 0xe4, 0xf1, 0x15,
-	0x00, 0x19, 0xe5, 0xff, 0x37, 0x58, 0xb4, 0x6b, 0xa5, 0x61, 0xb0, 0x08, 0xcf, 0x14, 0x70, 0x3c,
-	0x47, 0xcc, 0x21, 0x54, 0x52, 0x00, 0x53, 0x14, 0xc6, 0x24, 0xb6, 0xad, 0xdd, 0x9e, 0x7a, 0x96,
-	0xdc, 0xe9, 0x28, 0x03, 0x88, 0x1b, 0x78, 0x60, 0x8e, 0x9d, 0x68, 0x66, 0xac, 0xe7, 0x0b, 0xdb,
-	0xff, 0xf8, 0xdb, 0x57, 0xdb, 0xd2, 0xdf, 0x5f, 0x6d, 0x4b, 0xdf, 0xbd, 0xda, 0x96, 0x7e, 0xfb,
-	0xee, 0xb9, 0x1d, 0x0d, 0xc7, 0xbd, 0x9d, 0xbe, 0x37, 0xda, 0xf5, 0xcd, 0xfe, 0xf0, 0xca, 0xc2,
-	0x41, 0x7a, 0x75, 0xb1, 0xb7, 0x1b, 0x06, 0xfd, 0x5d, 0x7f, 0x10, 0xf6, 0x0a, 0x54, 0xcf, 0xa3,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x62, 0xc9, 0xcd, 0xea, 0x22, 0x00, 0x00,
+func (m *GarbageCollectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollectResponse.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	// CreateRepo creates a new repo.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ClearCommit removes all data from the commit.
-	ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
-	// ListCommit returns info about all commits.
-	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error)
-	// SubscribeCommit subscribes for new commits on a given branch.
-	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
-	// InspectCommitSet returns the info about a CommitSet.
-	InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error)
-	// ListCommitSet returns info about all CommitSets.
-	ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error)
-	// SquashCommitSet squashes the commits of a CommitSet into their children.
-	SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
-	DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// CreateBranch creates a new branch.
-	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ModifyFile performs modifications on a set of files.
-	ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error)
-	// GetFile returns the contents of a single file
-	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
-	// GetFileTAR returns a TAR stream of the contents matched by the request
-	GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error)
-	// InspectFile returns info about a file.
-	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// ListFile returns info about all files.
-	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error)
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
-	// GlobFile returns info about all files.
-	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error)
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error)
-	// ActivateAuth creates a role binding for all existing repos
-	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
-	// DeleteAll deletes everything.
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs.
-	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
-	// FileSet API
-	// CreateFileSet creates a new file set.
-	CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error)
-	// GetFileSet returns a file set with the data from a commit
-	GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
-	// AddFileSet associates a file set with a commit
-	AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// RenewFileSet prevents a file set from being deleted for a set amount of time.
-	RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// RunLoadTest runs a load test.
-	RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
-	// RunLoadTestDefault runs the default load tests.
-	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
+func (m *GarbageCollectResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *GarbageCollectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollectResponse.DiscardUnknown(m)
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
-}
+var xxx_messageInfo_GarbageCollectResponse proto.InternalMessageInfo
 
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
-	out := new(RepoInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetTrackerObjects() int64 {
+	if m != nil {
+		return m.TrackerObjects
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs_v2.API/ListRepo", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListRepoClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetChunkObjects() int64 {
+	if m != nil {
+		return m.ChunkObjects
 	}
-	return x, nil
-}
-
-type API_ListRepoClient interface {
-	Recv() (*RepoInfo, error)
-	grpc.ClientStream
-}
-
-type aPIListRepoClient struct {
-	grpc.ClientStream
+	return 0
 }
 
-func (x *aPIListRepoClient) Recv() (*RepoInfo, error) {
-	m := new(RepoInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetChunkBytes() int64 {
+	if m != nil {
+		return m.ChunkBytes
 	}
-	return m, nil
+	return 0
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetOldestChunk() *types.Timestamp {
+	if m != nil {
+		return m.OldestChunk
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/StartCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GarbageCollectResponse) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/FinishCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateFileSetResponse struct {
+	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateFileSetResponse) Reset()         { *m = CreateFileSetResponse{} }
+func (m *CreateFileSetResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateFileSetResponse) ProtoMessage()    {}
+func (*CreateFileSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{61}
 }
-
-func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
-	out := new(CommitInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateFileSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CreateFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateFileSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *CreateFileSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFileSetResponse.Merge(m, src)
 }
-
-type aPIListCommitClient struct {
-	grpc.ClientStream
+func (m *CreateFileSetResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIListCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *CreateFileSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFileSetResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/SubscribeCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_CreateFileSetResponse proto.InternalMessageInfo
+
+func (m *CreateFileSetResponse) GetFileSetId() string {
+	if m != nil {
+		return m.FileSetId
 	}
-	return x, nil
+	return ""
 }
 
-type API_SubscribeCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+type GetFileSetRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPISubscribeCommitClient struct {
-	grpc.ClientStream
+func (m *GetFileSetRequest) Reset()         { *m = GetFileSetRequest{} }
+func (m *GetFileSetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFileSetRequest) ProtoMessage()    {}
+func (*GetFileSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{62}
 }
-
-func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetFileSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/InspectCommitSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIInspectCommitSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFileSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_InspectCommitSetClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *GetFileSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFileSetRequest.Merge(m, src)
 }
-
-type aPIInspectCommitSetClient struct {
-	grpc.ClientStream
+func (m *GetFileSetRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIInspectCommitSetClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetFileSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFileSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/ListCommitSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitSetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_GetFileSetRequest proto.InternalMessageInfo
+
+func (m *GetFileSetRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListCommitSetClient interface {
-	Recv() (*CommitSetInfo, error)
-	grpc.ClientStream
+type AddFileSetRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	FileSetId            string   `protobuf:"bytes,2,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIListCommitSetClient struct {
-	grpc.ClientStream
+func (m *AddFileSetRequest) Reset()         { *m = AddFileSetRequest{} }
+func (m *AddFileSetRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFileSetRequest) ProtoMessage()    {}
+func (*AddFileSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{63}
 }
-
-func (x *aPIListCommitSetClient) Recv() (*CommitSetInfo, error) {
-	m := new(CommitSetInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *AddFileSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/SquashCommitSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddFileSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AddFileSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFileSetRequest.Merge(m, src)
+}
+func (m *AddFileSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddFileSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFileSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DropCommitSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddFileSetRequest proto.InternalMessageInfo
+
+func (m *AddFileSetRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddFileSetRequest) GetFileSetId() string {
+	if m != nil {
+		return m.FileSetId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
-	out := new(BranchInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RenewFileSetRequest struct {
+	FileSetId            string   `protobuf:"bytes,1,opt,name=file_set_id,json=fileSetId,proto3" json:"file_set_id,omitempty"`
+	TtlSeconds           int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListBranch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListBranchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RenewFileSetRequest) Reset()         { *m = RenewFileSetRequest{} }
+func (m *RenewFileSetRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewFileSetRequest) ProtoMessage()    {}
+func (*RenewFileSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{64}
+}
+func (m *RenewFileSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenewFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenewFileSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListBranchClient interface {
-	Recv() (*BranchInfo, error)
-	grpc.ClientStream
+func (m *RenewFileSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewFileSetRequest.Merge(m, src)
 }
-
-type aPIListBranchClient struct {
-	grpc.ClientStream
+func (m *RenewFileSetRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIListBranchClient) Recv() (*BranchInfo, error) {
-	m := new(BranchInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *RenewFileSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewFileSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RenewFileSetRequest proto.InternalMessageInfo
+
+func (m *RenewFileSetRequest) GetFileSetId() string {
+	if m != nil {
+		return m.FileSetId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ModifyFile", opts...)
-	if err != nil {
-		return nil, err
+func (m *RenewFileSetRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
 	}
-	x := &aPIModifyFileClient{stream}
-	return x, nil
+	return 0
 }
 
-type API_ModifyFileClient interface {
-	Send(*ModifyFileRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+type ActivateAuthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIModifyFileClient struct {
-	grpc.ClientStream
+func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
+func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthRequest) ProtoMessage()    {}
+func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{65}
 }
-
-func (x *aPIModifyFileClient) Send(m *ModifyFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPIModifyFileClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ActivateAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateAuthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/GetFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *ActivateAuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthRequest.Merge(m, src)
 }
-
-type API_GetFileClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func (m *ActivateAuthRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type aPIGetFileClient struct {
-	grpc.ClientStream
+func (m *ActivateAuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthRequest.DiscardUnknown(m)
 }
 
-func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+var xxx_messageInfo_ActivateAuthRequest proto.InternalMessageInfo
+
+type ActivateAuthResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFileTAR", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileTARClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
+func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateAuthResponse) ProtoMessage()    {}
+func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{66}
+}
+func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivateAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivateAuthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_GetFileTARClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func (m *ActivateAuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateAuthResponse.Merge(m, src)
+}
+func (m *ActivateAuthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateAuthResponse.DiscardUnknown(m)
 }
 
-type aPIGetFileTARClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
+
+type RunLoadTestRequest struct {
+	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *aPIGetFileTARClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RunLoadTestRequest) Reset()         { *m = RunLoadTestRequest{} }
+func (m *RunLoadTestRequest) String() string { return proto.CompactTextString(m) }
+func (*RunLoadTestRequest) ProtoMessage()    {}
+func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{67}
+}
+func (m *RunLoadTestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunLoadTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunLoadTestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *RunLoadTestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunLoadTestRequest.Merge(m, src)
+}
+func (m *RunLoadTestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunLoadTestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunLoadTestRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
-	out := new(FileInfo)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RunLoadTestRequest proto.InternalMessageInfo
+
+func (m *RunLoadTestRequest) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/ListFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
 	}
-	return x, nil
+	return nil
 }
 
-type API_ListFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
-}
-
-type aPIListFileClient struct {
-	grpc.ClientStream
-}
-
-func (x *aPIListFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/WalkFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIWalkFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
 	}
-	return x, nil
+	return 0
 }
 
-type API_WalkFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+type RunLoadTestResponse struct {
+	Spec                 string   `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Branch               *Branch  `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Seed                 int64    `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIWalkFileClient struct {
-	grpc.ClientStream
+func (m *RunLoadTestResponse) Reset()         { *m = RunLoadTestResponse{} }
+func (m *RunLoadTestResponse) String() string { return proto.CompactTextString(m) }
+func (*RunLoadTestResponse) ProtoMessage()    {}
+func (*RunLoadTestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21a7b2476cbc6216, []int{68}
 }
-
-func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *RunLoadTestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/GlobFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGlobFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunLoadTestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
-}
-
-type API_GlobFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
-}
-
-type aPIGlobFileClient struct {
-	grpc.ClientStream
 }
-
-func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *RunLoadTestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunLoadTestResponse.Merge(m, src)
 }
-
-func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/DiffFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIDiffFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *RunLoadTestResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type API_DiffFileClient interface {
-	Recv() (*DiffFileResponse, error)
-	grpc.ClientStream
+func (m *RunLoadTestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunLoadTestResponse.DiscardUnknown(m)
 }
 
-type aPIDiffFileClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_RunLoadTestResponse proto.InternalMessageInfo
 
-func (x *aPIDiffFileClient) Recv() (*DiffFileResponse, error) {
-	m := new(DiffFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
-	out := new(ActivateAuthResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/ActivateAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/Fsck", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIFsckClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RunLoadTestResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return x, nil
+	return ""
 }
 
-type API_FsckClient interface {
-	Recv() (*FsckResponse, error)
-	grpc.ClientStream
+func init() {
+	proto.RegisterEnum("pfs_v2.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("pfs_v2.OriginKind", OriginKind_name, OriginKind_value)
+	proto.RegisterEnum("pfs_v2.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs_v2.CommitState", CommitState_name, CommitState_value)
+	proto.RegisterEnum("pfs_v2.MergeResolution", MergeResolution_name, MergeResolution_value)
+	proto.RegisterEnum("pfs_v2.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterEnum("pfs_v2.ListCommitRequest_ErrorFilter", ListCommitRequest_ErrorFilter_name, ListCommitRequest_ErrorFilter_value)
+	proto.RegisterType((*Repo)(nil), "pfs_v2.Repo")
+	proto.RegisterType((*Branch)(nil), "pfs_v2.Branch")
+	proto.RegisterType((*File)(nil), "pfs_v2.File")
+	proto.RegisterType((*RepoInfo)(nil), "pfs_v2.RepoInfo")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.RepoInfo.MetadataEntry")
+	proto.RegisterType((*RepoInfo_Details)(nil), "pfs_v2.RepoInfo.Details")
+	proto.RegisterType((*RepoAuthInfo)(nil), "pfs_v2.RepoAuthInfo")
+	proto.RegisterType((*BranchInfo)(nil), "pfs_v2.BranchInfo")
+	proto.RegisterType((*Tag)(nil), "pfs_v2.Tag")
+	proto.RegisterType((*TagInfo)(nil), "pfs_v2.TagInfo")
+	proto.RegisterType((*BranchProtection)(nil), "pfs_v2.BranchProtection")
+	proto.RegisterType((*Trigger)(nil), "pfs_v2.Trigger")
+	proto.RegisterType((*CommitOrigin)(nil), "pfs_v2.CommitOrigin")
+	proto.RegisterType((*Commit)(nil), "pfs_v2.Commit")
+	proto.RegisterType((*CommitInfo)(nil), "pfs_v2.CommitInfo")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.CommitInfo.MetadataEntry")
+	proto.RegisterType((*CommitInfo_Details)(nil), "pfs_v2.CommitInfo.Details")
+	proto.RegisterType((*CommitSet)(nil), "pfs_v2.CommitSet")
+	proto.RegisterType((*CommitSetInfo)(nil), "pfs_v2.CommitSetInfo")
+	proto.RegisterType((*FileInfo)(nil), "pfs_v2.FileInfo")
+	proto.RegisterType((*CreateRepoRequest)(nil), "pfs_v2.CreateRepoRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.CreateRepoRequest.MetadataEntry")
+	proto.RegisterType((*InspectRepoRequest)(nil), "pfs_v2.InspectRepoRequest")
+	proto.RegisterType((*InspectRepoStorageRequest)(nil), "pfs_v2.InspectRepoStorageRequest")
+	proto.RegisterType((*RepoStorageInfo)(nil), "pfs_v2.RepoStorageInfo")
+	proto.RegisterType((*ListRepoRequest)(nil), "pfs_v2.ListRepoRequest")
+	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs_v2.DeleteRepoRequest")
+	proto.RegisterType((*StartCommitRequest)(nil), "pfs_v2.StartCommitRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.StartCommitRequest.MetadataEntry")
+	proto.RegisterType((*FinishCommitRequest)(nil), "pfs_v2.FinishCommitRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.FinishCommitRequest.MetadataEntry")
+	proto.RegisterType((*InspectCommitRequest)(nil), "pfs_v2.InspectCommitRequest")
+	proto.RegisterType((*ListCommitRequest)(nil), "pfs_v2.ListCommitRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pfs_v2.ListCommitRequest.MetadataEntry")
+	proto.RegisterType((*InspectCommitSetRequest)(nil), "pfs_v2.InspectCommitSetRequest")
+	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs_v2.ListCommitSetRequest")
+	proto.RegisterType((*SquashCommitSetRequest)(nil), "pfs_v2.SquashCommitSetRequest")
+	proto.RegisterType((*DropCommitSetRequest)(nil), "pfs_v2.DropCommitSetRequest")
+	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs_v2.SubscribeCommitRequest")
+	proto.RegisterType((*ClearCommitRequest)(nil), "pfs_v2.ClearCommitRequest")
+	proto.RegisterType((*RevertCommitRequest)(nil), "pfs_v2.RevertCommitRequest")
+	proto.RegisterType((*RevertCommitResponse)(nil), "pfs_v2.RevertCommitResponse")
+	proto.RegisterType((*CreateBranchRequest)(nil), "pfs_v2.CreateBranchRequest")
+	proto.RegisterType((*InspectBranchRequest)(nil), "pfs_v2.InspectBranchRequest")
+	proto.RegisterType((*ListBranchRequest)(nil), "pfs_v2.ListBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs_v2.DeleteBranchRequest")
+	proto.RegisterType((*CreateTagRequest)(nil), "pfs_v2.CreateTagRequest")
+	proto.RegisterType((*InspectTagRequest)(nil), "pfs_v2.InspectTagRequest")
+	proto.RegisterType((*ListTagRequest)(nil), "pfs_v2.ListTagRequest")
+	proto.RegisterType((*DeleteTagRequest)(nil), "pfs_v2.DeleteTagRequest")
+	proto.RegisterType((*MergeBranchRequest)(nil), "pfs_v2.MergeBranchRequest")
+	proto.RegisterMapType((map[string]MergeResolution)(nil), "pfs_v2.MergeBranchRequest.ResolutionsEntry")
+	proto.RegisterType((*MergeConflict)(nil), "pfs_v2.MergeConflict")
+	proto.RegisterType((*MergeBranchResponse)(nil), "pfs_v2.MergeBranchResponse")
+	proto.RegisterType((*SetBranchProtectionRequest)(nil), "pfs_v2.SetBranchProtectionRequest")
+	proto.RegisterType((*AddFile)(nil), "pfs_v2.AddFile")
+	proto.RegisterType((*AddFile_URLSource)(nil), "pfs_v2.AddFile.URLSource")
+	proto.RegisterType((*DeleteFile)(nil), "pfs_v2.DeleteFile")
+	proto.RegisterType((*CopyFile)(nil), "pfs_v2.CopyFile")
+	proto.RegisterType((*ModifyFileRequest)(nil), "pfs_v2.ModifyFileRequest")
+	proto.RegisterType((*GetFileRequest)(nil), "pfs_v2.GetFileRequest")
+	proto.RegisterType((*InspectFileRequest)(nil), "pfs_v2.InspectFileRequest")
+	proto.RegisterType((*ListFileRequest)(nil), "pfs_v2.ListFileRequest")
+	proto.RegisterType((*WalkFileRequest)(nil), "pfs_v2.WalkFileRequest")
+	proto.RegisterType((*GlobFileRequest)(nil), "pfs_v2.GlobFileRequest")
+	proto.RegisterType((*DiffFileRequest)(nil), "pfs_v2.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "pfs_v2.DiffFileResponse")
+	proto.RegisterType((*FsckRequest)(nil), "pfs_v2.FsckRequest")
+	proto.RegisterType((*FsckResponse)(nil), "pfs_v2.FsckResponse")
+	proto.RegisterType((*GarbageCollectRequest)(nil), "pfs_v2.GarbageCollectRequest")
+	proto.RegisterType((*GarbageCollectResponse)(nil), "pfs_v2.GarbageCollectResponse")
+	proto.RegisterType((*CreateFileSetResponse)(nil), "pfs_v2.CreateFileSetResponse")
+	proto.RegisterType((*GetFileSetRequest)(nil), "pfs_v2.GetFileSetRequest")
+	proto.RegisterType((*AddFileSetRequest)(nil), "pfs_v2.AddFileSetRequest")
+	proto.RegisterType((*RenewFileSetRequest)(nil), "pfs_v2.RenewFileSetRequest")
+	proto.RegisterType((*ActivateAuthRequest)(nil), "pfs_v2.ActivateAuthRequest")
+	proto.RegisterType((*ActivateAuthResponse)(nil), "pfs_v2.ActivateAuthResponse")
+	proto.RegisterType((*RunLoadTestRequest)(nil), "pfs_v2.RunLoadTestRequest")
+	proto.RegisterType((*RunLoadTestResponse)(nil), "pfs_v2.RunLoadTestResponse")
 }
 
-type aPIFsckClient struct {
-	grpc.ClientStream
-}
+func init() { proto.RegisterFile("pfs/pfs.proto", fileDescriptor_21a7b2476cbc6216) }
 
-func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
-	m := new(FsckResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+var fileDescriptor_21a7b2476cbc6216 = []byte{
+	// 3997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x73, 0xdc, 0x46,
+	0x76, 0x1c, 0x60, 0x38, 0x1f, 0x6f, 0x86, 0x24, 0xd8, 0xa4, 0xa8, 0xf1, 0xc8, 0xa6, 0x64, 0xac,
+	0x2d, 0xcb, 0x92, 0x4d, 0x6a, 0x29, 0x59, 0xf6, 0x5a, 0xeb, 0x38, 0x43, 0xce, 0x50, 0x9c, 0x15,
+	0x45, 0x6a, 0x01, 0xd2, 0xce, 0x7a, 0x53, 0x99, 0x80, 0x83, 0x9e, 0x21, 0x56, 0x18, 0x60, 0x04,
+	0x60, 0x28, 0x33, 0x5b, 0xd9, 0xaa, 0x1c, 0x53, 0x95, 0xaa, 0xdc, 0x52, 0x39, 0xe6, 0x94, 0xda,
+	0x54, 0xe5, 0x96, 0x3f, 0x90, 0xe3, 0x1e, 0x73, 0xca, 0x21, 0x87, 0x54, 0x4a, 0x97, 0x24, 0x3f,
+	0x21, 0x39, 0xa5, 0xfa, 0x03, 0x40, 0x03, 0x98, 0x2f, 0x6a, 0x6d, 0xe7, 0xc2, 0x6a, 0xf4, 0x7b,
+	0xfd, 0xfa, 0xf5, 0xeb, 0xf7, 0xd5, 0xef, 0x0d, 0x61, 0x69, 0xd8, 0xf3, 0xb7, 0x87, 0x3d, 0x7f,
+	0x6b, 0xe8, 0xb9, 0x81, 0x8b, 0x0a, 0xc3, 0x9e, 0xdf, 0xb9, 0xd8, 0xa9, 0x6f, 0xf6, 0x5d, 0xb7,
+	0x6f, 0xe3, 0x6d, 0x3a, 0x7b, 0x36, 0xea, 0x6d, 0x9b, 0x23, 0xcf, 0x08, 0x2c, 0xd7, 0x61, 0x78,
+	0xf5, 0x1b, 0x69, 0x38, 0x1e, 0x0c, 0x83, 0x4b, 0x0e, 0xbc, 0x99, 0x06, 0x06, 0xd6, 0x00, 0xfb,
+	0x81, 0x31, 0x18, 0x72, 0x84, 0x0c, 0xf5, 0x57, 0x9e, 0x31, 0x1c, 0x62, 0x8f, 0x73, 0x51, 0x5f,
+	0xef, 0xbb, 0x7d, 0x97, 0x0e, 0xb7, 0xc9, 0x88, 0xcf, 0xae, 0x18, 0xa3, 0xe0, 0x7c, 0x9b, 0xfc,
+	0x61, 0x13, 0xea, 0x43, 0xc8, 0x6b, 0x78, 0xe8, 0x22, 0x04, 0x79, 0xc7, 0x18, 0xe0, 0x5a, 0xee,
+	0x56, 0xee, 0x4e, 0x59, 0xa3, 0x63, 0x32, 0x17, 0x5c, 0x0e, 0x71, 0x4d, 0x62, 0x73, 0x64, 0xfc,
+	0x79, 0xfe, 0x6f, 0xff, 0xee, 0xe6, 0x82, 0xda, 0x84, 0xc2, 0xae, 0x67, 0x38, 0xdd, 0x73, 0x74,
+	0x0b, 0xf2, 0x1e, 0x1e, 0xba, 0x74, 0x5d, 0x65, 0xa7, 0xba, 0xc5, 0xce, 0xbe, 0x45, 0x68, 0x6a,
+	0x14, 0x12, 0x51, 0x96, 0x62, 0xca, 0x9c, 0xca, 0x1f, 0x41, 0x7e, 0xdf, 0xb2, 0x31, 0xba, 0x0d,
+	0x85, 0xae, 0x3b, 0x18, 0x58, 0x01, 0xa7, 0xb2, 0x1c, 0x52, 0xd9, 0xa3, 0xb3, 0x1a, 0x87, 0x12,
+	0x4a, 0x43, 0x23, 0x38, 0x0f, 0x29, 0x91, 0x31, 0x5a, 0x87, 0x45, 0xd3, 0x08, 0x46, 0x83, 0x9a,
+	0x4c, 0x27, 0xd9, 0x87, 0xfa, 0xf7, 0x79, 0x28, 0x11, 0x16, 0xda, 0x4e, 0xcf, 0x9d, 0x83, 0xc5,
+	0x87, 0x50, 0xec, 0x7a, 0xd8, 0x08, 0xb0, 0x49, 0x69, 0x57, 0x76, 0xea, 0x5b, 0x4c, 0xba, 0x5b,
+	0xa1, 0x74, 0xb7, 0x4e, 0x42, 0xf1, 0x6b, 0x21, 0x2a, 0x7a, 0x00, 0x1b, 0xbe, 0xf5, 0x67, 0xb8,
+	0x73, 0x76, 0x19, 0x60, 0xbf, 0x33, 0x22, 0xc2, 0xef, 0x9c, 0xb9, 0x23, 0xc7, 0xa4, 0xbc, 0xc8,
+	0xda, 0x1a, 0x81, 0xee, 0x12, 0xe0, 0x29, 0x81, 0xed, 0x12, 0x10, 0xba, 0x05, 0x15, 0x13, 0xfb,
+	0x5d, 0xcf, 0x1a, 0x12, 0x4d, 0xa8, 0xe5, 0x29, 0xd7, 0xe2, 0x14, 0xba, 0x0b, 0xa5, 0x33, 0x2a,
+	0x5b, 0xec, 0xd7, 0x16, 0x6f, 0xc9, 0xa2, 0x3c, 0x98, 0xcc, 0xb5, 0x08, 0x8e, 0x7e, 0x0c, 0x65,
+	0x72, 0x97, 0x1d, 0xcb, 0xe9, 0xb9, 0xb5, 0x02, 0x65, 0x7d, 0x5d, 0x3c, 0x5f, 0x63, 0x14, 0x9c,
+	0x13, 0x19, 0x68, 0x25, 0x83, 0x8f, 0xd0, 0x0e, 0x14, 0x4d, 0x1c, 0x18, 0x96, 0xed, 0xd7, 0x8a,
+	0x74, 0x41, 0x4d, 0x5c, 0x40, 0x50, 0xb6, 0x9a, 0x0c, 0xae, 0x85, 0x88, 0xe8, 0x13, 0xa8, 0x74,
+	0xdd, 0xc1, 0xd0, 0xc3, 0xbe, 0x4f, 0x98, 0x2e, 0xdd, 0xca, 0xdd, 0x59, 0xde, 0x59, 0x13, 0x6e,
+	0x29, 0x04, 0x69, 0x22, 0x1e, 0xfa, 0x1c, 0x4a, 0x03, 0x1c, 0x18, 0xa6, 0x11, 0x18, 0xb5, 0x32,
+	0x3d, 0xc9, 0x66, 0x66, 0xaf, 0x67, 0x1c, 0xa1, 0xe5, 0x04, 0xde, 0xa5, 0x16, 0xe1, 0xd7, 0xef,
+	0x40, 0x91, 0xb3, 0x81, 0xde, 0x01, 0x88, 0xe5, 0x4c, 0x6f, 0x51, 0xd6, 0xca, 0x91, 0x6c, 0xeb,
+	0x8f, 0x61, 0x29, 0x41, 0x04, 0x29, 0x20, 0xbf, 0xc0, 0x97, 0x5c, 0x93, 0xc9, 0x90, 0x28, 0xc9,
+	0x85, 0x61, 0x8f, 0x42, 0x1d, 0x64, 0x1f, 0x9f, 0x4b, 0x9f, 0xe5, 0xd4, 0x5f, 0x42, 0x55, 0x94,
+	0x13, 0x39, 0xe9, 0x10, 0x7b, 0x03, 0x8b, 0x1e, 0x80, 0x6c, 0x26, 0xd3, 0x93, 0x52, 0x21, 0x5f,
+	0xec, 0x6c, 0x3d, 0x8f, 0x60, 0x9a, 0x88, 0x47, 0x36, 0xf0, 0x5c, 0x1b, 0xfb, 0x35, 0xe9, 0x96,
+	0x4c, 0x36, 0xa0, 0x1f, 0xea, 0xbf, 0x49, 0x00, 0xec, 0xca, 0x28, 0xed, 0xdb, 0x50, 0x60, 0x17,
+	0x97, 0x56, 0x73, 0x7e, 0xad, 0x1c, 0x8a, 0x54, 0xc8, 0x9f, 0x63, 0x23, 0x54, 0xc5, 0xb4, 0x31,
+	0x50, 0x18, 0xda, 0x02, 0x18, 0x7a, 0xee, 0x05, 0x76, 0x0c, 0xa7, 0x8b, 0x6b, 0xf2, 0x58, 0x35,
+	0x11, 0x30, 0x08, 0xbe, 0x3f, 0x3a, 0x0b, 0xf1, 0xf3, 0xe3, 0xf1, 0x63, 0x0c, 0xf4, 0x18, 0x56,
+	0x4d, 0xcb, 0xc3, 0xdd, 0xa0, 0x23, 0x6c, 0x33, 0x5e, 0x1b, 0x15, 0x86, 0xf8, 0x3c, 0xde, 0xec,
+	0x43, 0x28, 0x06, 0x9e, 0xd5, 0xef, 0x63, 0x8f, 0xeb, 0xe4, 0x4a, 0xb8, 0xe4, 0x84, 0x4d, 0x6b,
+	0x21, 0x1c, 0x7d, 0x46, 0xcf, 0x11, 0xe0, 0x2e, 0xb5, 0x86, 0x94, 0x42, 0xb2, 0x0d, 0x9e, 0x47,
+	0x70, 0x4d, 0xc0, 0x55, 0x1b, 0x20, 0x9f, 0x18, 0xfd, 0xdf, 0xcb, 0xff, 0xfc, 0x36, 0x07, 0xc5,
+	0x13, 0xa3, 0x4f, 0x2f, 0xe7, 0x1d, 0x90, 0x03, 0xa3, 0xcf, 0xc9, 0x54, 0x22, 0x7e, 0x8d, 0xbe,
+	0x46, 0xe6, 0x05, 0x17, 0x25, 0x4d, 0x75, 0x51, 0x82, 0x27, 0x91, 0xe7, 0xf7, 0x24, 0x33, 0x9d,
+	0x82, 0xfa, 0x4f, 0x39, 0x50, 0xd2, 0xe2, 0x40, 0x5b, 0xb0, 0x66, 0x62, 0xe7, 0xb2, 0xc3, 0x6f,
+	0x8a, 0xb1, 0xc0, 0x2c, 0xa4, 0xa4, 0xad, 0x12, 0x50, 0x93, 0x42, 0x18, 0x8f, 0x3e, 0xba, 0x49,
+	0xb6, 0x71, 0x2e, 0x3b, 0x1e, 0x7e, 0x65, 0x39, 0x4c, 0xbf, 0x4a, 0x1a, 0x90, 0x29, 0x8d, 0xce,
+	0xa0, 0x7b, 0xb0, 0xea, 0xe1, 0x97, 0x23, 0xcb, 0xc3, 0x66, 0x67, 0x68, 0x0d, 0xb1, 0x6d, 0x39,
+	0x98, 0x3b, 0x56, 0x25, 0x04, 0x3c, 0xe7, 0xf3, 0xe8, 0x5d, 0xa8, 0x0e, 0x3d, 0x77, 0xe0, 0x06,
+	0xb8, 0xd3, 0xf3, 0xdc, 0x41, 0xc8, 0x35, 0x9f, 0xdb, 0xf7, 0xdc, 0x81, 0xfa, 0x1b, 0x28, 0xf2,
+	0x1b, 0x47, 0x1b, 0x09, 0xe5, 0x2f, 0x47, 0xca, 0xae, 0x80, 0x6c, 0xd8, 0x36, 0xe7, 0x85, 0x0c,
+	0xd1, 0x0d, 0x28, 0x77, 0x3d, 0xd7, 0xe9, 0xf8, 0x43, 0xdc, 0xe5, 0x9b, 0x97, 0xc8, 0x84, 0x3e,
+	0xc4, 0x5d, 0x72, 0x99, 0xc4, 0xf2, 0xf9, 0x66, 0x74, 0x8c, 0x6a, 0x50, 0x0c, 0x8f, 0xbe, 0x48,
+	0x9d, 0x43, 0xf8, 0xa9, 0x3e, 0x82, 0x2a, 0x3b, 0xfb, 0xb1, 0x67, 0xf5, 0x2d, 0x07, 0xdd, 0x86,
+	0xfc, 0x0b, 0x72, 0xf2, 0x1c, 0x75, 0x60, 0x28, 0xbc, 0x43, 0x06, 0x7d, 0x6a, 0x39, 0xa6, 0x46,
+	0xe1, 0xea, 0x11, 0x14, 0xd8, 0xba, 0xb9, 0x6d, 0x76, 0x03, 0x24, 0x8b, 0x49, 0xb4, 0xbc, 0x5b,
+	0x78, 0xfd, 0xef, 0x37, 0xa5, 0x76, 0x53, 0x93, 0x2c, 0x93, 0x2b, 0xda, 0xff, 0x2c, 0x02, 0x30,
+	0x82, 0xa1, 0x23, 0x98, 0x2b, 0xde, 0x7d, 0x04, 0x05, 0x97, 0xb2, 0x56, 0x93, 0x92, 0xae, 0x5d,
+	0x3c, 0x94, 0xc6, 0x71, 0xd2, 0x4a, 0x24, 0x67, 0x23, 0xcb, 0x03, 0x58, 0x1a, 0x1a, 0x1e, 0x76,
+	0x42, 0x55, 0xa9, 0xe5, 0xc7, 0x6e, 0x5f, 0x65, 0x48, 0xec, 0x8b, 0x2c, 0xea, 0x9e, 0x5b, 0xb6,
+	0xd9, 0x89, 0x65, 0x2c, 0x8f, 0x5b, 0x44, 0x91, 0x42, 0x4d, 0x7b, 0x08, 0x45, 0x3f, 0x30, 0x3c,
+	0x62, 0x06, 0x85, 0xd9, 0x66, 0xc0, 0x51, 0xd1, 0x67, 0x50, 0xee, 0x59, 0x8e, 0xe5, 0x9f, 0x5b,
+	0x4e, 0xbf, 0x56, 0x9c, 0xb9, 0x2e, 0x46, 0x46, 0x8f, 0xa0, 0xc4, 0x3e, 0xb0, 0x59, 0x2b, 0xcd,
+	0x5c, 0x18, 0xe1, 0x8e, 0x77, 0x73, 0xe5, 0x39, 0xdd, 0xdc, 0x3a, 0x2c, 0x62, 0xcf, 0x73, 0xbd,
+	0x1a, 0xb0, 0xa8, 0x42, 0x3f, 0xa6, 0x64, 0x05, 0x95, 0xc9, 0x59, 0xc1, 0xc3, 0x38, 0x28, 0x57,
+	0x39, 0xfb, 0x09, 0xf1, 0x8e, 0x0f, 0xcb, 0x3f, 0x15, 0xe2, 0xeb, 0x12, 0x65, 0xfa, 0xd6, 0x98,
+	0x65, 0xff, 0xcf, 0x11, 0xf6, 0x47, 0x50, 0x66, 0xcc, 0xe8, 0x38, 0xe0, 0x66, 0x92, 0x4b, 0x9b,
+	0x89, 0xea, 0xc2, 0x52, 0x84, 0x44, 0x4d, 0xe4, 0x3e, 0x00, 0xd3, 0xb7, 0x8e, 0x8f, 0x43, 0x33,
+	0x59, 0x4d, 0x1e, 0x4e, 0xc7, 0x81, 0x56, 0xee, 0x46, 0xa4, 0x3f, 0x8a, 0xbd, 0x80, 0x44, 0x65,
+	0x81, 0xb2, 0xb2, 0x88, 0x3d, 0xc3, 0xef, 0x72, 0x50, 0x22, 0xb9, 0x67, 0x98, 0x20, 0xf6, 0x2c,
+	0x1b, 0xa7, 0x63, 0x08, 0x81, 0x6b, 0x14, 0x82, 0x3e, 0x26, 0x9a, 0x69, 0xe3, 0x4e, 0x94, 0x0e,
+	0x2f, 0xef, 0x28, 0x22, 0xda, 0xc9, 0xe5, 0x10, 0x13, 0xb5, 0x62, 0x23, 0xa2, 0xc8, 0x6c, 0xa3,
+	0xf9, 0xe2, 0x40, 0x8c, 0x9c, 0xba, 0x89, 0x7c, 0xea, 0x26, 0x88, 0xfb, 0x3b, 0x37, 0xfc, 0x73,
+	0xea, 0xe7, 0xaa, 0x1a, 0x1d, 0xab, 0xbf, 0x95, 0x60, 0x75, 0x8f, 0x06, 0x12, 0x1a, 0xf4, 0xf0,
+	0xcb, 0x11, 0xf6, 0x83, 0x39, 0xe2, 0x62, 0xca, 0x5f, 0x48, 0x59, 0x7f, 0xb1, 0x01, 0x85, 0xd1,
+	0xd0, 0x34, 0x02, 0x16, 0x03, 0x4a, 0x1a, 0xff, 0x4a, 0xa7, 0x83, 0xf9, 0x39, 0xd3, 0xc1, 0x3d,
+	0x41, 0x5d, 0x99, 0x13, 0xf9, 0x20, 0x5a, 0x93, 0xe6, 0x7f, 0xa2, 0xd6, 0xfe, 0x5e, 0xba, 0xf8,
+	0x08, 0x50, 0xdb, 0x21, 0x71, 0x25, 0xb8, 0x92, 0xa8, 0xd4, 0xbf, 0xc8, 0xc1, 0x5b, 0xc2, 0x42,
+	0x3d, 0x70, 0x3d, 0xa3, 0x8f, 0xe7, 0x17, 0xb5, 0x0a, 0x79, 0x1a, 0x22, 0x27, 0x64, 0x74, 0x04,
+	0x86, 0x36, 0x41, 0x0a, 0xdc, 0x9a, 0x3c, 0x16, 0x43, 0x0a, 0x5c, 0xf5, 0x5f, 0x73, 0xb0, 0x22,
+	0x6c, 0x3e, 0xe7, 0xcb, 0xe6, 0x47, 0xb0, 0x64, 0xbb, 0x7d, 0xab, 0x6b, 0xd8, 0x5c, 0xa5, 0x24,
+	0xaa, 0x52, 0x55, 0x3e, 0xc9, 0xb4, 0xea, 0x23, 0x40, 0x23, 0xc7, 0x7a, 0x39, 0xc2, 0x9d, 0xee,
+	0xf9, 0xc8, 0x79, 0xc1, 0x31, 0xd9, 0x23, 0x46, 0x61, 0x90, 0x3d, 0x02, 0x60, 0xd8, 0xef, 0x42,
+	0xd5, 0x3f, 0x37, 0x48, 0x8a, 0x20, 0x2a, 0x69, 0x85, 0xcd, 0x31, 0x14, 0x9a, 0x47, 0x74, 0x6d,
+	0xc3, 0x1a, 0x18, 0x67, 0x76, 0xa8, 0xcc, 0x2c, 0x36, 0x2b, 0x02, 0x80, 0x22, 0xab, 0xef, 0xc3,
+	0xca, 0xa1, 0xe5, 0x27, 0x6e, 0x24, 0x7c, 0x78, 0xe6, 0xe2, 0x87, 0xa7, 0xfa, 0x14, 0x56, 0x9b,
+	0xd8, 0xc6, 0x57, 0xd5, 0xf2, 0x75, 0x58, 0xec, 0xb9, 0x5e, 0x17, 0xf3, 0x0c, 0x83, 0x7d, 0xa8,
+	0x7f, 0x29, 0x01, 0xd2, 0x49, 0xd4, 0xe1, 0x02, 0xe6, 0xe4, 0x6e, 0x43, 0x81, 0xc5, 0xbe, 0x49,
+	0x81, 0x99, 0x41, 0xe7, 0x30, 0x9d, 0x38, 0x6f, 0x90, 0xa7, 0xe6, 0x0d, 0x4d, 0xc1, 0x26, 0x58,
+	0x56, 0x7e, 0x27, 0xc4, 0xcc, 0xf2, 0xf7, 0xfd, 0x18, 0xc5, 0x5f, 0x4b, 0xb0, 0xb6, 0x4f, 0x03,
+	0x62, 0x46, 0x18, 0x73, 0x65, 0x29, 0xb3, 0x85, 0x11, 0x05, 0x4a, 0x59, 0x0c, 0x94, 0xd1, 0xcd,
+	0xe4, 0x85, 0x9b, 0x41, 0xad, 0x8c, 0x93, 0xf8, 0x30, 0x76, 0xb4, 0x19, 0x26, 0xbf, 0x1f, 0x89,
+	0xf4, 0x61, 0x9d, 0x5b, 0xfb, 0x9b, 0x49, 0xe4, 0x03, 0xc8, 0xbf, 0x32, 0xf8, 0x53, 0x21, 0xe9,
+	0x18, 0x49, 0xac, 0x0a, 0x88, 0xa7, 0xa3, 0x08, 0xea, 0xff, 0x16, 0x60, 0x95, 0xe8, 0x7e, 0x72,
+	0x9b, 0x1f, 0xc4, 0x9f, 0x10, 0xe7, 0xee, 0x8c, 0x06, 0x67, 0xd8, 0xe3, 0x06, 0xcc, 0xbf, 0x48,
+	0x36, 0xed, 0xe1, 0x0b, 0xec, 0xf9, 0x98, 0x5a, 0x6c, 0x49, 0x0b, 0x3f, 0xc3, 0x54, 0xbd, 0x10,
+	0xa7, 0xea, 0x0f, 0xa0, 0xc2, 0x92, 0xcf, 0x0e, 0x4d, 0xab, 0x8b, 0x13, 0xd3, 0x6a, 0x70, 0xa3,
+	0x71, 0x22, 0x0c, 0x94, 0x92, 0x61, 0x20, 0x23, 0x8b, 0x49, 0xf7, 0x8b, 0xbe, 0x84, 0x25, 0x9e,
+	0x35, 0x76, 0x8c, 0x5e, 0x80, 0xbd, 0x5a, 0x79, 0x66, 0x94, 0xad, 0xf2, 0x05, 0x0d, 0x82, 0x8f,
+	0x1a, 0xb0, 0x1c, 0x12, 0x38, 0xc3, 0x3d, 0xd7, 0xc3, 0x35, 0x98, 0x49, 0x21, 0xdc, 0x72, 0x97,
+	0x2e, 0x20, 0x24, 0xc2, 0x44, 0x92, 0x33, 0x51, 0x99, 0x4d, 0x22, 0x5c, 0xc1, 0xb8, 0xd8, 0x83,
+	0x95, 0x88, 0x04, 0x67, 0xa3, 0x3a, 0x93, 0x46, 0xb4, 0x2b, 0xe7, 0x23, 0x65, 0x80, 0x4b, 0x59,
+	0x03, 0x3c, 0x80, 0x2a, 0xb5, 0xb9, 0x4e, 0xcf, 0xb2, 0x09, 0x9f, 0xcb, 0xf4, 0xa2, 0xde, 0x9f,
+	0x2c, 0xf6, 0x16, 0xc1, 0xde, 0xa7, 0xc8, 0x5a, 0x05, 0xc7, 0x1f, 0xe8, 0x3d, 0x58, 0x1e, 0x58,
+	0x4e, 0x47, 0xc8, 0x51, 0x56, 0x58, 0x40, 0x19, 0x58, 0x8e, 0x1e, 0xa5, 0x29, 0x04, 0xcb, 0xf8,
+	0x56, 0xc4, 0x52, 0x38, 0x96, 0xf1, 0xad, 0xfe, 0xdd, 0xa4, 0x95, 0x7f, 0x08, 0x15, 0x81, 0x49,
+	0xb4, 0x06, 0x2b, 0x8d, 0xa3, 0x5f, 0x74, 0x5a, 0x9a, 0x76, 0xac, 0x75, 0xf4, 0x93, 0xc6, 0x49,
+	0x4b, 0x59, 0x40, 0x15, 0x28, 0xd2, 0x89, 0x56, 0x53, 0xc9, 0xa1, 0x15, 0xa8, 0x1c, 0x1d, 0x9f,
+	0x74, 0xc2, 0x09, 0x49, 0xed, 0xc0, 0xf5, 0x84, 0x95, 0xeb, 0x38, 0x3c, 0xfe, 0x1b, 0x64, 0x9f,
+	0x48, 0x30, 0xf9, 0x12, 0xb7, 0xee, 0x0d, 0x58, 0x8f, 0x25, 0x1b, 0x53, 0x57, 0xff, 0x14, 0x36,
+	0xf4, 0x97, 0x23, 0xc3, 0x3f, 0x4f, 0x43, 0xde, 0x60, 0xdf, 0xf1, 0xe1, 0xed, 0x4f, 0x60, 0xbd,
+	0xe9, 0xb9, 0xc3, 0xef, 0x8d, 0xfe, 0x7f, 0xe5, 0x60, 0x43, 0x1f, 0x9d, 0x11, 0x05, 0x3b, 0xc3,
+	0x57, 0x75, 0x5e, 0x71, 0x25, 0x40, 0x4a, 0x54, 0x02, 0x42, 0xa7, 0x26, 0x4f, 0x71, 0x6a, 0x1f,
+	0xc2, 0xa2, 0x4f, 0xfc, 0xe7, 0x98, 0x9c, 0x33, 0x72, 0xad, 0x0c, 0x23, 0xf4, 0x56, 0x8b, 0x13,
+	0xbd, 0x55, 0x61, 0x1e, 0x6f, 0xa5, 0xfe, 0x14, 0xd0, 0x9e, 0x8d, 0x0d, 0xef, 0x8d, 0x22, 0x81,
+	0xfa, 0xcf, 0x39, 0x58, 0xd3, 0x88, 0xfb, 0x7c, 0xc3, 0x48, 0x72, 0x3b, 0x21, 0xab, 0xc9, 0x69,
+	0xc4, 0xa7, 0x00, 0x1e, 0xf6, 0x5d, 0x7b, 0x14, 0x3d, 0xfd, 0x97, 0x77, 0xae, 0x87, 0xb8, 0xcf,
+	0xb0, 0x47, 0x92, 0xd5, 0x10, 0xac, 0x09, 0xa8, 0x73, 0x54, 0x9e, 0x7c, 0x58, 0x4f, 0x9e, 0xc0,
+	0x1f, 0xba, 0x8e, 0x3f, 0x7f, 0xd1, 0xfe, 0x01, 0x79, 0x0b, 0x39, 0x3d, 0xdb, 0xea, 0x46, 0x2f,
+	0xb3, 0x6b, 0x09, 0xce, 0xf6, 0x38, 0x54, 0x8b, 0xf1, 0xd4, 0xd7, 0x39, 0x58, 0x63, 0x6f, 0x02,
+	0x7e, 0x50, 0x2e, 0xb7, 0xb0, 0x34, 0x9a, 0x9b, 0x52, 0x1a, 0x9d, 0x57, 0x66, 0x57, 0x2d, 0xa1,
+	0x0a, 0x55, 0xcd, 0xfc, 0x8c, 0xaa, 0xe6, 0x7b, 0xb0, 0xec, 0xe0, 0x57, 0x1d, 0xc1, 0xd6, 0x98,
+	0x1a, 0x56, 0x1d, 0xfc, 0x2a, 0x32, 0x33, 0xf5, 0x0f, 0xa2, 0x34, 0x23, 0x79, 0xc8, 0x39, 0x6b,
+	0x4e, 0xea, 0x31, 0x4b, 0x1e, 0x92, 0x8b, 0x67, 0xdb, 0x9f, 0x10, 0xe0, 0xa5, 0x44, 0x80, 0x57,
+	0x75, 0x58, 0x63, 0x29, 0xf6, 0x1b, 0xf1, 0x33, 0xc1, 0x57, 0xfc, 0x1a, 0x14, 0x76, 0x93, 0xa4,
+	0x96, 0xca, 0x29, 0x7e, 0x47, 0xc5, 0xd6, 0x99, 0x15, 0x2f, 0x75, 0x07, 0x56, 0xb9, 0x88, 0xe7,
+	0xde, 0x5d, 0xdd, 0x81, 0x65, 0x22, 0x56, 0x61, 0xc1, 0xec, 0x07, 0xe2, 0x8f, 0x41, 0x61, 0x92,
+	0x9b, 0x7f, 0x9b, 0xff, 0x94, 0x00, 0x51, 0xfd, 0xcf, 0x08, 0xdb, 0x77, 0x47, 0x44, 0x8a, 0x13,
+	0x84, 0xcd, 0xa0, 0x04, 0x2f, 0x30, 0xbc, 0x3e, 0x0e, 0x26, 0x69, 0x39, 0x83, 0xbe, 0xb9, 0x67,
+	0x78, 0x06, 0x95, 0xf8, 0xcb, 0xe7, 0x8f, 0x93, 0x7b, 0x89, 0x95, 0x09, 0xce, 0xb7, 0x62, 0x3a,
+	0x3e, 0xcb, 0xd6, 0xc4, 0xf5, 0xe9, 0xbb, 0x5a, 0xcc, 0xdc, 0x55, 0xfd, 0x6b, 0x50, 0xd2, 0x24,
+	0xc6, 0x64, 0x04, 0x1f, 0x8b, 0x19, 0xc1, 0x94, 0xa3, 0x08, 0xa9, 0xc2, 0x3f, 0xe6, 0x60, 0x29,
+	0xe1, 0x69, 0xa2, 0x46, 0x62, 0x4e, 0x68, 0x24, 0xbe, 0x07, 0x79, 0x77, 0xe4, 0xf9, 0x5c, 0x9c,
+	0x89, 0xea, 0x0e, 0x2d, 0x1d, 0x51, 0x28, 0xba, 0x03, 0x85, 0xe0, 0x1c, 0x5b, 0x9e, 0x5f, 0x93,
+	0x27, 0xe0, 0x71, 0x78, 0x4a, 0xf0, 0xf9, 0xb9, 0x05, 0xaf, 0x7a, 0xb0, 0x96, 0x90, 0xee, 0x0f,
+	0xe1, 0x6f, 0x7f, 0x03, 0x75, 0x1d, 0x07, 0x99, 0x7e, 0xcb, 0x15, 0x1d, 0x40, 0xb2, 0x99, 0x23,
+	0x5d, 0xa1, 0x99, 0xf3, 0x57, 0x32, 0x14, 0x1b, 0xa6, 0x49, 0xbb, 0xc1, 0xe3, 0x2e, 0x27, 0xea,
+	0xf2, 0x4a, 0x42, 0x97, 0x17, 0x6d, 0x83, 0xec, 0x19, 0xaf, 0xf8, 0x4d, 0xdc, 0xc8, 0x64, 0xcc,
+	0x34, 0xcb, 0xfc, 0x8a, 0xa8, 0xc1, 0xc1, 0x82, 0x46, 0x30, 0xd1, 0xc7, 0x20, 0x8f, 0x3c, 0x9b,
+	0xbb, 0xef, 0xb7, 0x42, 0xce, 0xf8, 0xc6, 0x5b, 0xa7, 0xda, 0xa1, 0x4e, 0x8d, 0x8b, 0xa0, 0x8f,
+	0x3c, 0x1b, 0x6d, 0x43, 0xd9, 0xc4, 0xb6, 0x35, 0xb0, 0x48, 0xce, 0xbc, 0x48, 0x6f, 0x30, 0xca,
+	0x96, 0x9a, 0x21, 0x40, 0x8b, 0x71, 0x48, 0x21, 0x85, 0x99, 0x5d, 0x87, 0x56, 0x0b, 0x29, 0x97,
+	0x3e, 0x4d, 0x34, 0x64, 0x4d, 0x61, 0x10, 0xb2, 0x53, 0x93, 0xce, 0xa3, 0xbb, 0xb0, 0x2a, 0x62,
+	0xb3, 0x44, 0xb9, 0x48, 0x91, 0x57, 0x62, 0x64, 0x96, 0x51, 0xbf, 0x0f, 0xcb, 0x24, 0xb8, 0x61,
+	0xaf, 0xe3, 0xe1, 0xae, 0xeb, 0x99, 0x3e, 0x2d, 0x73, 0xcb, 0xda, 0x12, 0x9b, 0xd5, 0xd8, 0x64,
+	0xfd, 0x31, 0x94, 0xa3, 0x53, 0x10, 0xe3, 0x39, 0xd5, 0x0e, 0x43, 0xe3, 0x39, 0xd5, 0x0e, 0xd1,
+	0xdb, 0x50, 0xf6, 0x70, 0x77, 0xe4, 0xf9, 0xd6, 0x45, 0xe8, 0xa5, 0xe3, 0x89, 0xdd, 0x52, 0xe8,
+	0x7a, 0xd4, 0x47, 0x00, 0xcc, 0x9d, 0x5d, 0xed, 0x42, 0xd4, 0x5f, 0x41, 0x69, 0xcf, 0x1d, 0x5e,
+	0xd2, 0x55, 0x0a, 0xc8, 0xa6, 0x1f, 0x84, 0xbb, 0x9b, 0x7e, 0x30, 0xe1, 0x12, 0x37, 0x41, 0xf6,
+	0xbd, 0x6e, 0x4d, 0x4e, 0xfa, 0x56, 0x42, 0x42, 0x23, 0x00, 0x92, 0x2e, 0x1a, 0xc3, 0x21, 0x76,
+	0x4c, 0x5e, 0x27, 0xe0, 0x5f, 0x24, 0x45, 0x58, 0x7d, 0xe6, 0x9a, 0x56, 0x8f, 0x6e, 0x17, 0xaa,
+	0xea, 0x36, 0x80, 0x8f, 0xa3, 0xfe, 0xc6, 0x58, 0x4b, 0x39, 0x58, 0xd0, 0xca, 0x3e, 0x0e, 0xdb,
+	0x1b, 0x1f, 0x41, 0xc9, 0x30, 0x4d, 0x7a, 0x03, 0x35, 0x29, 0x19, 0xd6, 0xb9, 0x5e, 0x1c, 0x2c,
+	0x68, 0x45, 0x83, 0x0d, 0x49, 0xe5, 0xd3, 0xa4, 0x82, 0x61, 0x0b, 0x18, 0xd3, 0x48, 0xd0, 0x09,
+	0x2e, 0xb3, 0x83, 0x05, 0xd2, 0x57, 0x0b, 0xbf, 0x88, 0x22, 0x75, 0xdd, 0xe1, 0x25, 0x5b, 0x94,
+	0x4f, 0x3a, 0x8e, 0x50, 0x60, 0x07, 0x0b, 0x5a, 0xa9, 0xcb, 0xc7, 0xbb, 0x05, 0xc8, 0x9f, 0xb9,
+	0xe6, 0xa5, 0xfa, 0x0f, 0x39, 0x58, 0x7e, 0x82, 0x03, 0xf1, 0x84, 0xb3, 0x8b, 0xd5, 0xfc, 0xde,
+	0xa5, 0xf8, 0xde, 0x37, 0xa0, 0xe0, 0xf6, 0x7a, 0x24, 0x0f, 0x61, 0x45, 0x3d, 0xfe, 0x85, 0xde,
+	0x83, 0x45, 0xdf, 0x72, 0xba, 0x78, 0x42, 0x23, 0x88, 0x01, 0x89, 0xee, 0xf1, 0x43, 0x7b, 0x78,
+	0xe0, 0x5e, 0x60, 0x93, 0x67, 0x33, 0x4b, 0x26, 0xaf, 0xc7, 0xd1, 0x49, 0xa1, 0xb8, 0x7a, 0x25,
+	0x76, 0xd5, 0x9f, 0xb0, 0xfa, 0xdf, 0x95, 0x16, 0xfd, 0x2c, 0x5f, 0x92, 0x14, 0x59, 0x7d, 0x00,
+	0x2b, 0x5f, 0x1b, 0xf6, 0x8b, 0xab, 0xed, 0xa7, 0xc3, 0xca, 0x13, 0xdb, 0x3d, 0x13, 0x17, 0xcd,
+	0xeb, 0x5b, 0x6b, 0x50, 0x1c, 0x1a, 0x41, 0x80, 0xbd, 0xb0, 0xcc, 0x15, 0x7e, 0xaa, 0x7f, 0x0e,
+	0x2b, 0x4d, 0xab, 0xd7, 0x13, 0x89, 0x7e, 0x00, 0x25, 0x92, 0x04, 0x4e, 0xe4, 0xa6, 0xe8, 0xe0,
+	0x57, 0x64, 0x40, 0x10, 0x5d, 0x3b, 0xa1, 0x82, 0x29, 0x44, 0xd7, 0x66, 0xda, 0x57, 0x83, 0xa2,
+	0x7f, 0x6e, 0xd8, 0xb6, 0xfb, 0x8a, 0x17, 0xe4, 0xc3, 0x4f, 0xd5, 0x06, 0x25, 0xde, 0x9e, 0x07,
+	0x8c, 0x7b, 0x99, 0xfd, 0xb3, 0xc1, 0x2a, 0xe2, 0xe1, 0x5e, 0x86, 0x87, 0x31, 0xc8, 0x9c, 0x0f,
+	0xf5, 0x26, 0x54, 0xf6, 0xfd, 0xee, 0x8b, 0xf0, 0xa0, 0x0a, 0xc8, 0x3d, 0xeb, 0x5b, 0xde, 0x76,
+	0x26, 0x43, 0xd2, 0x77, 0x65, 0x08, 0x9c, 0x15, 0x01, 0xa3, 0x4c, 0x31, 0xe2, 0x92, 0xa0, 0x24,
+	0x94, 0x04, 0xd5, 0xfb, 0x70, 0xed, 0x89, 0xe1, 0x9d, 0x19, 0x24, 0x48, 0xd9, 0x36, 0xad, 0xb6,
+	0xb3, 0x2d, 0xae, 0x43, 0xd1, 0xf4, 0x2e, 0x3b, 0xde, 0xc8, 0xe1, 0xdb, 0x14, 0x4c, 0xef, 0x52,
+	0x1b, 0x39, 0xea, 0xdf, 0x48, 0xb0, 0x91, 0x5e, 0xc2, 0x37, 0x9d, 0xb4, 0x06, 0x7d, 0x00, 0x2b,
+	0x81, 0x67, 0x74, 0x5f, 0x60, 0xaf, 0xe3, 0x9e, 0xfd, 0x0a, 0xb3, 0x38, 0x49, 0xcc, 0x62, 0x99,
+	0x4f, 0x1f, 0xb3, 0x59, 0x52, 0x3c, 0x67, 0x05, 0xf1, 0x10, 0x8d, 0x59, 0x4f, 0x95, 0x4e, 0x86,
+	0x48, 0x37, 0xa1, 0x22, 0x56, 0xcd, 0x59, 0x31, 0x0d, 0xba, 0x71, 0xbd, 0xfc, 0x0b, 0xa8, 0xba,
+	0xb6, 0x89, 0xfd, 0x80, 0x55, 0xd7, 0x6b, 0x8b, 0x33, 0x0b, 0x3c, 0x15, 0x86, 0x4f, 0x6b, 0xee,
+	0xe8, 0x13, 0x28, 0x85, 0xbf, 0x1b, 0xe3, 0xbd, 0xd4, 0xb7, 0x32, 0x4b, 0x9b, 0x1c, 0x41, 0x8b,
+	0x50, 0xd5, 0x4f, 0xe1, 0x1a, 0x4b, 0xbb, 0xc9, 0x8d, 0xe9, 0x38, 0x16, 0xcb, 0x26, 0x54, 0x68,
+	0xb8, 0x21, 0x6e, 0x32, 0xec, 0xc5, 0x69, 0xb4, 0xbb, 0x45, 0x7a, 0x6f, 0xa6, 0xfa, 0x18, 0x56,
+	0xb9, 0xc7, 0x11, 0x0a, 0x07, 0xf3, 0xbe, 0x77, 0x7f, 0x09, 0xab, 0xdc, 0x6b, 0x5e, 0x7d, 0x71,
+	0x9a, 0x33, 0x29, 0xcd, 0xd9, 0x57, 0xe4, 0x2d, 0xcd, 0x15, 0x56, 0x20, 0x3f, 0xe3, 0x40, 0xe4,
+	0x82, 0x82, 0xc0, 0xee, 0xf8, 0xb8, 0xeb, 0x3a, 0x66, 0x78, 0xd5, 0x10, 0x04, 0xb6, 0xce, 0x66,
+	0xd4, 0x6b, 0xb0, 0xd6, 0xe8, 0x06, 0xd6, 0x85, 0x11, 0x60, 0xf2, 0x3b, 0xa0, 0xb0, 0x4c, 0xb3,
+	0x01, 0xeb, 0xc9, 0x69, 0x26, 0x40, 0xd5, 0x04, 0xa4, 0x8d, 0x9c, 0x43, 0xd7, 0x30, 0x4f, 0xb0,
+	0x1f, 0x08, 0x2d, 0x0b, 0xfa, 0x83, 0x05, 0x1e, 0x24, 0xc9, 0x78, 0xee, 0x97, 0x28, 0x59, 0x8b,
+	0x71, 0xf8, 0xb3, 0x31, 0x3a, 0x56, 0x7f, 0x0d, 0x6b, 0x89, 0x5d, 0xf8, 0xed, 0x7d, 0xc7, 0xdb,
+	0xc4, 0x76, 0x98, 0x17, 0xec, 0xf0, 0xee, 0xd7, 0x50, 0x11, 0xba, 0x78, 0xe8, 0x3a, 0xac, 0x35,
+	0x5b, 0xfb, 0x8d, 0xd3, 0xc3, 0x93, 0xce, 0xde, 0xf1, 0xb3, 0xe7, 0x5a, 0x4b, 0xd7, 0xdb, 0xc7,
+	0x47, 0xca, 0x02, 0x42, 0xb0, 0x7c, 0x74, 0x9c, 0x98, 0xcb, 0xa1, 0x12, 0xe4, 0x9f, 0x7c, 0xd3,
+	0x7e, 0xae, 0x48, 0x64, 0xf4, 0x8d, 0x7e, 0xd2, 0x54, 0x64, 0x54, 0x04, 0xf9, 0xf0, 0x9b, 0x87,
+	0x4a, 0xfe, 0xee, 0x11, 0x40, 0x5c, 0x67, 0x21, 0x74, 0x8f, 0xb5, 0xf6, 0x93, 0xf6, 0x51, 0xe7,
+	0x69, 0xfb, 0xa8, 0xd9, 0x39, 0x3d, 0x7a, 0x7a, 0x74, 0xfc, 0x35, 0xa1, 0x5b, 0x82, 0xfc, 0xa9,
+	0xde, 0xd2, 0x18, 0xb5, 0xc6, 0xe9, 0xc9, 0x31, 0xa3, 0xb6, 0xaf, 0xef, 0x3d, 0x55, 0x64, 0x54,
+	0x86, 0xc5, 0xc6, 0x61, 0xbb, 0xa1, 0x2b, 0xf9, 0xbb, 0xf7, 0x58, 0x17, 0x97, 0x36, 0x5d, 0xab,
+	0x50, 0xd2, 0x5a, 0x7a, 0x4b, 0xfb, 0xaa, 0xd5, 0x64, 0x24, 0xf6, 0xdb, 0x87, 0x2d, 0x25, 0x47,
+	0x36, 0x6f, 0xb6, 0x35, 0x45, 0xba, 0xfb, 0xc7, 0x50, 0xe1, 0x8f, 0x6f, 0x5a, 0x20, 0xaa, 0xc1,
+	0xfa, 0xde, 0xf1, 0xb3, 0x67, 0xed, 0x13, 0x56, 0x2f, 0x14, 0xb6, 0xaf, 0x40, 0x51, 0x3f, 0x69,
+	0x68, 0x27, 0xb4, 0x6e, 0x58, 0x86, 0x45, 0xad, 0xd5, 0x68, 0xfe, 0x42, 0x91, 0xd0, 0x12, 0x94,
+	0xf7, 0xdb, 0x47, 0x6d, 0xfd, 0xa0, 0x7d, 0xf4, 0x44, 0x91, 0xc9, 0x86, 0xec, 0xb3, 0xd5, 0x54,
+	0xf2, 0x77, 0x3f, 0x85, 0x95, 0x54, 0x56, 0x8f, 0x96, 0x01, 0x4e, 0x8f, 0xb4, 0x96, 0x7e, 0x7c,
+	0x18, 0xf1, 0x74, 0x7c, 0xaa, 0xe9, 0x4a, 0x0e, 0x01, 0x14, 0x4e, 0x0e, 0x5a, 0x6d, 0x4d, 0x57,
+	0xa4, 0xbb, 0x8f, 0xa1, 0x1c, 0x25, 0x93, 0x04, 0xe5, 0xe8, 0xf8, 0xa8, 0xc5, 0x90, 0x7f, 0xa6,
+	0x87, 0x12, 0x3d, 0x6c, 0x1f, 0xb5, 0x14, 0x89, 0x1c, 0x45, 0xff, 0xf9, 0x21, 0x13, 0xe8, 0x9e,
+	0xfe, 0x95, 0x92, 0xdf, 0xf9, 0xef, 0xeb, 0x20, 0x37, 0x9e, 0xb7, 0x51, 0x03, 0x20, 0xee, 0xa1,
+	0xa2, 0xb7, 0x26, 0xf6, 0x55, 0xeb, 0x1b, 0x19, 0xe7, 0xd1, 0x22, 0xbf, 0x3a, 0x55, 0x17, 0xd0,
+	0x17, 0x50, 0x11, 0x7a, 0x9c, 0x28, 0xfa, 0x05, 0x42, 0xb6, 0x63, 0x5a, 0x57, 0xd2, 0x3f, 0xe3,
+	0x53, 0x17, 0x90, 0x96, 0xe8, 0xad, 0xf2, 0x2e, 0x25, 0x7a, 0x77, 0x0c, 0x95, 0x64, 0xfb, 0xb4,
+	0x7e, 0x5d, 0x24, 0x26, 0x74, 0x37, 0xd5, 0x05, 0xf4, 0x13, 0x28, 0x85, 0xad, 0x41, 0x74, 0x5d,
+	0xac, 0x56, 0xcf, 0x60, 0xe6, 0x7e, 0x8e, 0x08, 0x24, 0x6e, 0x17, 0xc6, 0x02, 0xc9, 0xb4, 0x10,
+	0xa7, 0x08, 0xe4, 0x31, 0x54, 0x84, 0x1e, 0x5c, 0x2c, 0x90, 0x6c, 0x63, 0xae, 0x9e, 0x72, 0x69,
+	0xea, 0x02, 0x6a, 0x41, 0x55, 0xec, 0x57, 0xa1, 0x1b, 0x53, 0xba, 0x58, 0x53, 0x78, 0xd8, 0x83,
+	0x8a, 0x50, 0x7e, 0x8c, 0x79, 0xc8, 0xd6, 0x24, 0xa7, 0x10, 0x79, 0x0a, 0x55, 0xb1, 0x84, 0x17,
+	0xf3, 0x32, 0xa6, 0x34, 0x59, 0x7f, 0x7b, 0x3c, 0x90, 0x3b, 0x3f, 0xc2, 0xd1, 0x52, 0xa2, 0x6c,
+	0x8e, 0xde, 0x4e, 0x5d, 0x71, 0x92, 0xdc, 0x98, 0x5f, 0x61, 0xa8, 0x0b, 0xe8, 0x4b, 0x80, 0xb8,
+	0x34, 0x1e, 0xdf, 0x4e, 0xa6, 0x11, 0x31, 0x7e, 0xf9, 0xfd, 0x1c, 0x6a, 0xc3, 0x4a, 0xaa, 0x00,
+	0x8d, 0xa2, 0xdf, 0x96, 0x8e, 0xaf, 0x4c, 0x4f, 0x24, 0xf5, 0x14, 0x94, 0x74, 0x1f, 0x00, 0xdd,
+	0x1c, 0x7b, 0x26, 0x1d, 0xcf, 0x24, 0x76, 0x00, 0x4b, 0x89, 0x9a, 0x7f, 0x2c, 0x9d, 0x71, 0xad,
+	0x80, 0xfa, 0xb5, 0x4c, 0xf1, 0x5d, 0x60, 0x6b, 0x25, 0xd5, 0x25, 0x10, 0x4e, 0x38, 0xb6, 0x7d,
+	0x30, 0x45, 0x03, 0x9e, 0xc0, 0x52, 0xa2, 0x21, 0x10, 0xb3, 0x35, 0xae, 0x4f, 0x30, 0x85, 0x50,
+	0x0b, 0xaa, 0x62, 0x5d, 0x36, 0x56, 0xa5, 0x31, 0xd5, 0xda, 0xa9, 0x6a, 0xbd, 0x94, 0x28, 0x7d,
+	0x66, 0x94, 0x28, 0x49, 0x08, 0x25, 0x23, 0x5a, 0x52, 0x89, 0x38, 0x85, 0x84, 0x12, 0xcd, 0xb1,
+	0xfc, 0x7e, 0x8e, 0x1c, 0x46, 0xac, 0x77, 0xc6, 0x87, 0x19, 0x53, 0x05, 0x9d, 0x72, 0x18, 0x1d,
+	0xd6, 0xc6, 0x14, 0x4f, 0x90, 0x1a, 0xdd, 0xd6, 0xc4, 0xca, 0xca, 0x14, 0xa2, 0x07, 0x50, 0x11,
+	0xaa, 0x40, 0xb1, 0xe1, 0x67, 0x0b, 0x6f, 0xf5, 0x1b, 0x63, 0x61, 0x91, 0xc1, 0x7e, 0x09, 0xe5,
+	0xa8, 0x00, 0x8b, 0x6a, 0xc9, 0xfb, 0x8a, 0xcb, 0x95, 0x53, 0x58, 0xf9, 0x1c, 0x20, 0x2e, 0xa2,
+	0xc6, 0x72, 0xce, 0x14, 0x56, 0xeb, 0x2b, 0x42, 0x91, 0x93, 0xdf, 0xd1, 0x23, 0x28, 0xf2, 0x62,
+	0x2a, 0xda, 0x10, 0x2f, 0x68, 0xea, 0xaa, 0xfb, 0x39, 0xc2, 0x74, 0x54, 0x50, 0x8d, 0x99, 0x4e,
+	0xd7, 0x58, 0xa7, 0x6a, 0x18, 0xc4, 0xd5, 0x81, 0x98, 0xe9, 0x4c, 0xc5, 0x60, 0x32, 0x89, 0x3b,
+	0x39, 0xb4, 0x0b, 0x45, 0x9e, 0x0b, 0xc7, 0xdc, 0x27, 0x9f, 0xe3, 0xf5, 0x69, 0x65, 0x27, 0xae,
+	0x64, 0xc0, 0x97, 0x9c, 0x34, 0xb4, 0x37, 0x27, 0x13, 0x47, 0x67, 0xca, 0x4e, 0x3a, 0x3a, 0x8b,
+	0xb4, 0x32, 0x2f, 0xb7, 0x38, 0x92, 0xd2, 0xb5, 0x89, 0x48, 0x3a, 0x63, 0xe1, 0xfd, 0x1c, 0x59,
+	0x1a, 0x3e, 0xb2, 0xe3, 0xa5, 0xa9, 0x67, 0xf7, 0xe4, 0xa5, 0xe1, 0x53, 0x3b, 0x5e, 0x9a, 0x7a,
+	0x7c, 0x4f, 0x58, 0xda, 0x80, 0x52, 0xf8, 0xa2, 0x8d, 0x97, 0xa6, 0x9e, 0xd8, 0xf5, 0x5a, 0x16,
+	0x10, 0xaa, 0x3d, 0xf5, 0xa0, 0x55, 0x31, 0x81, 0x8f, 0xcd, 0x7b, 0x4c, 0xb6, 0x5f, 0x7f, 0x7b,
+	0x3c, 0x30, 0xb2, 0xa2, 0x2f, 0x42, 0x85, 0x6c, 0xd8, 0x36, 0x9a, 0xa0, 0x33, 0x53, 0xd4, 0xf1,
+	0x13, 0xc8, 0x93, 0x17, 0x31, 0x8a, 0x3a, 0x96, 0xc2, 0x03, 0xba, 0xbe, 0x9e, 0x9c, 0x14, 0x8e,
+	0xf0, 0x73, 0x58, 0x4e, 0xbe, 0x6e, 0xd1, 0x3b, 0x91, 0x18, 0xc7, 0x3d, 0x94, 0xeb, 0x9b, 0x93,
+	0xc0, 0xd1, 0x41, 0x9e, 0xc1, 0x52, 0xe2, 0x61, 0x38, 0xcd, 0x36, 0xde, 0x49, 0x7a, 0x8b, 0xd4,
+	0x53, 0x92, 0x9a, 0xc8, 0x41, 0xa4, 0xde, 0x09, 0x5a, 0x99, 0x27, 0xe4, 0x4c, 0x5a, 0x24, 0x63,
+	0x8b, 0xdf, 0x8e, 0x28, 0x5d, 0x9d, 0x9d, 0x37, 0x3a, 0x89, 0x2f, 0x44, 0x31, 0xd1, 0xc9, 0xbc,
+	0x1b, 0xa7, 0xfb, 0x5e, 0xe1, 0xed, 0x15, 0xdb, 0x5a, 0xf6, 0xd9, 0x57, 0xbf, 0x31, 0x16, 0x16,
+	0x9d, 0xe9, 0x69, 0xe2, 0xad, 0xd8, 0xc4, 0x3d, 0x63, 0x64, 0x07, 0x13, 0xd5, 0x67, 0x3a, 0xb1,
+	0xdd, 0x4f, 0x7f, 0xf7, 0x7a, 0x33, 0xf7, 0x2f, 0xaf, 0x37, 0x73, 0xff, 0xf1, 0x7a, 0x33, 0xf7,
+	0xcd, 0x87, 0x7d, 0x2b, 0x38, 0x1f, 0x9d, 0x6d, 0x75, 0xdd, 0xc1, 0xf6, 0xd0, 0xe8, 0x9e, 0x5f,
+	0x9a, 0xd8, 0x13, 0x47, 0x17, 0x3b, 0xdb, 0xbe, 0xd7, 0x25, 0xff, 0x96, 0x76, 0x56, 0xa0, 0xfb,
+	0x3c, 0xf8, 0xbf, 0x01, 0x00, 0x55, 0x7b, 0x11, 0xd1, 0xa8, 0x36, 0x00, 0x00,
 }
 
-func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/CreateFileSet", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPICreateFileSetClient{stream}
-	return x, nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-type API_CreateFileSetClient interface {
-	Send(*ModifyFileRequest) error
-	CloseAndRecv() (*CreateFileSetResponse, error)
-	grpc.ClientStream
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-type aPICreateFileSetClient struct {
-	grpc.ClientStream
-}
-
-func (x *aPICreateFileSetClient) Send(m *ModifyFileRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *aPICreateFileSetClient) CloseAndRecv() (*CreateFileSetResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(CreateFileSetResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *aPIClient) GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
-	out := new(CreateFileSetResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetFileSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aPIClient) AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/AddFileSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aPIClient) RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RenewFileSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aPIClient) RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
-	out := new(RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
-	out := new(RunLoadTestResponse)
-	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTestDefault", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// APIServer is the server API for API service.
-type APIServer interface {
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
 	// CreateRepo creates a new repo.
-	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// InspectRepo returns info about a repo.
-	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
+	// InspectRepoStorage returns the deduplicated storage usage of a repo.
+	InspectRepoStorage(ctx context.Context, in *InspectRepoStorageRequest, opts ...grpc.CallOption) (*RepoStorageInfo, error)
 	// ListRepo returns info about all repos.
-	ListRepo(*ListRepoRequest, API_ListRepoServer) error
+	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error)
 	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
 	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// ClearCommit removes all data from the commit.
-	ClearCommit(context.Context, *ClearCommitRequest) (*types.Empty, error)
+	ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RevertCommit makes a new commit that undoes the changes made by a commit.
+	RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*RevertCommitResponse, error)
 	// InspectCommit returns the info about a commit.
-	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
 	// ListCommit returns info about all commits.
-	ListCommit(*ListCommitRequest, API_ListCommitServer) error
+	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error)
 	// SubscribeCommit subscribes for new commits on a given branch.
-	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
 	// InspectCommitSet returns the info about a CommitSet.
-	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
+	InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error)
 	// ListCommitSet returns info about all CommitSets.
-	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
+	ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error)
 	// SquashCommitSet squashes the commits of a CommitSet into their children.
-	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*types.Empty, error)
+	SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
-	DropCommitSet(context.Context, *DropCommitSetRequest) (*types.Empty, error)
+	DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// CreateBranch creates a new branch.
-	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// InspectBranch returns info about a branch.
-	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
+	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
 	// ListBranch returns info about the heads of branches.
-	ListBranch(*ListBranchRequest, API_ListBranchServer) error
+	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// SetBranchProtection sets or removes the protection rules on a branch.
+	SetBranchProtection(ctx context.Context, in *SetBranchProtectionRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// MergeBranch applies the changes made on one branch since its common
+	// ancestor with another branch to the other branch, in a new commit.
+	MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error)
+	// CreateTag creates a new tag pointing at a commit. Tags cannot be moved.
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectTag returns info about a tag.
+	InspectTag(ctx context.Context, in *InspectTagRequest, opts ...grpc.CallOption) (*TagInfo, error)
+	// ListTag returns info about the tags in a repo.
+	ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (API_ListTagClient, error)
+	// DeleteTag deletes a tag; note that the commit still exists.
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// ModifyFile performs modifications on a set of files.
-	ModifyFile(API_ModifyFileServer) error
+	ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error)
 	// GetFile returns the contents of a single file
-	GetFile(*GetFileRequest, API_GetFileServer) error
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
 	// GetFileTAR returns a TAR stream of the contents matched by the request
-	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
+	GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error)
 	// InspectFile returns info about a file.
-	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
 	// ListFile returns info about all files.
-	ListFile(*ListFileRequest, API_ListFileServer) error
+	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error)
 	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(*WalkFileRequest, API_WalkFileServer) error
+	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
 	// GlobFile returns info about all files.
-	GlobFile(*GlobFileRequest, API_GlobFileServer) error
+	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error)
 	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(*DiffFileRequest, API_DiffFileServer) error
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error)
 	// ActivateAuth creates a role binding for all existing repos
-	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
 	// DeleteAll deletes everything.
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 	// Fsck does a file system consistency check for pfs.
-	Fsck(*FsckRequest, API_FsckServer) error
+	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
+	// GarbageCollect runs, or reports what would be deleted by, a single
+	// pass of storage garbage collection.
+	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
 	// FileSet API
 	// CreateFileSet creates a new file set.
-	CreateFileSet(API_CreateFileSetServer) error
+	CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error)
 	// GetFileSet returns a file set with the data from a commit
-	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
+	GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error)
 	// AddFileSet associates a file set with a commit
-	AddFileSet(context.Context, *AddFileSetRequest) (*types.Empty, error)
+	AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// RenewFileSet prevents a file set from being deleted for a set amount of time.
-	RenewFileSet(context.Context, *RenewFileSetRequest) (*types.Empty, error)
+	RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// RunLoadTest runs a load test.
-	RunLoadTest(context.Context, *RunLoadTestRequest) (*RunLoadTestResponse, error)
+	RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
 	// RunLoadTestDefault runs the default load tests.
-	RunLoadTestDefault(context.Context, *types.Empty) (*RunLoadTestResponse, error)
+	RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error)
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+type aPIClient struct {
+	cc *grpc.ClientConn
 }
 
-func (*UnimplementedAPIServer) CreateRepo(ctx context.Context, req *CreateRepoRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
-}
-func (*UnimplementedAPIServer) InspectRepo(ctx context.Context, req *InspectRepoRequest) (*RepoInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
-}
-func (*UnimplementedAPIServer) ListRepo(req *ListRepoRequest, srv API_ListRepoServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
 }
-func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
+
+func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
+	out := new(RepoInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) FinishCommit(ctx context.Context, req *FinishCommitRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
+
+func (c *aPIClient) InspectRepoStorage(ctx context.Context, in *InspectRepoStorageRequest, opts ...grpc.CallOption) (*RepoStorageInfo, error) {
+	out := new(RepoStorageInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectRepoStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) ClearCommit(ctx context.Context, req *ClearCommitRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
+
+func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (API_ListRepoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs_v2.API/ListRepo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListRepoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedAPIServer) InspectCommit(ctx context.Context, req *InspectCommitRequest) (*CommitInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
+
+type API_ListRepoClient interface {
+	Recv() (*RepoInfo, error)
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) ListCommit(req *ListCommitRequest, srv API_ListCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
+
+type aPIListRepoClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedAPIServer) SubscribeCommit(req *SubscribeCommitRequest, srv API_SubscribeCommitServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
+
+func (x *aPIListRepoClient) Recv() (*RepoInfo, error) {
+	m := new(RepoInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedAPIServer) InspectCommitSet(req *InspectCommitSetRequest, srv API_InspectCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
+
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) ListCommitSet(req *ListCommitSetRequest, srv API_ListCommitSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
+
+func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/StartCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) SquashCommitSet(ctx context.Context, req *SquashCommitSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
+
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/FinishCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedAPIServer) DropCommitSet(ctx context.Context, req *DropCommitSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
-}
-func (*UnimplementedAPIServer) CreateBranch(ctx context.Context, req *CreateBranchRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
-}
-func (*UnimplementedAPIServer) InspectBranch(ctx context.Context, req *InspectBranchRequest) (*BranchInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
-}
-func (*UnimplementedAPIServer) ListBranch(req *ListBranchRequest, srv API_ListBranchServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
-}
-func (*UnimplementedAPIServer) DeleteBranch(ctx context.Context, req *DeleteBranchRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
-}
-func (*UnimplementedAPIServer) ModifyFile(srv API_ModifyFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
-}
-func (*UnimplementedAPIServer) GetFile(req *GetFileRequest, srv API_GetFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
-}
-func (*UnimplementedAPIServer) GetFileTAR(req *GetFileRequest, srv API_GetFileTARServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
-}
-func (*UnimplementedAPIServer) InspectFile(ctx context.Context, req *InspectFileRequest) (*FileInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
-}
-func (*UnimplementedAPIServer) ListFile(req *ListFileRequest, srv API_ListFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
-}
-func (*UnimplementedAPIServer) WalkFile(req *WalkFileRequest, srv API_WalkFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
-}
-func (*UnimplementedAPIServer) GlobFile(req *GlobFileRequest, srv API_GlobFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
-}
-func (*UnimplementedAPIServer) DiffFile(req *DiffFileRequest, srv API_DiffFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
-}
-func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
-}
-func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
-}
-func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
-	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
-}
-func (*UnimplementedAPIServer) CreateFileSet(srv API_CreateFileSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
-}
-func (*UnimplementedAPIServer) GetFileSet(ctx context.Context, req *GetFileSetRequest) (*CreateFileSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
-}
-func (*UnimplementedAPIServer) AddFileSet(ctx context.Context, req *AddFileSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
-}
-func (*UnimplementedAPIServer) RenewFileSet(ctx context.Context, req *RenewFileSetRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *RunLoadTestRequest) (*RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
-}
-func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*RunLoadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
+
+func (c *aPIClient) ClearCommit(ctx context.Context, in *ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ClearCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+func (c *aPIClient) RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*RevertCommitResponse, error) {
+	out := new(RevertCommitResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RevertCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRepoRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
+	out := new(CommitInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectCommit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/CreateRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRepoRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs_v2.API/ListCommit", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	x := &aPIListCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRepoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
-}
-
-type API_ListRepoServer interface {
-	Send(*RepoInfo) error
-	grpc.ServerStream
+	return x, nil
 }
 
-type aPIListRepoServer struct {
-	grpc.ServerStream
+type API_ListCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIListRepoServer) Send(m *RepoInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListCommitClient struct {
+	grpc.ClientStream
 }
 
-func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRepoRequest)
-	if err := dec(in); err != nil {
+func (x *aPIListCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs_v2.API/SubscribeCommit", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).StartCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/StartCommit",
+	x := &aPISubscribeCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinishCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).FinishCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/FinishCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type API_SubscribeCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCommitRequest)
-	if err := dec(in); err != nil {
+type aPISubscribeCommitClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ClearCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/ClearCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectCommitRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (API_InspectCommitSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs_v2.API/InspectCommitSet", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectCommit",
+	x := &aPIInspectCommitSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
+type API_InspectCommitSetClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-type API_ListCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+type aPIInspectCommitSetClient struct {
+	grpc.ClientStream
 }
 
-type aPIListCommitServer struct {
-	grpc.ServerStream
+func (x *aPIInspectCommitSetClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIListCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs_v2.API/ListCommitSet", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
-}
-
-type API_SubscribeCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+	x := &aPIListCommitSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPISubscribeCommitServer struct {
-	grpc.ServerStream
+type API_ListCommitSetClient interface {
+	Recv() (*CommitSetInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListCommitSetClient struct {
+	grpc.ClientStream
 }
 
-func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(InspectCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIListCommitSetClient) Recv() (*CommitSetInfo, error) {
+	m := new(CommitSetInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
+	return m, nil
 }
 
-type API_InspectCommitSetServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+func (c *aPIClient) SquashCommitSet(ctx context.Context, in *SquashCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/SquashCommitSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIInspectCommitSetServer struct {
-	grpc.ServerStream
+func (c *aPIClient) DropCommitSet(ctx context.Context, in *DropCommitSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DropCommitSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
+	return out, nil
 }
 
-type API_ListCommitSetServer interface {
-	Send(*CommitSetInfo) error
-	grpc.ServerStream
+func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs_v2.API/ListBranch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListBranchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIListCommitSetServer struct {
-	grpc.ServerStream
+type API_ListBranchClient interface {
+	Recv() (*BranchInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListBranchClient struct {
+	grpc.ClientStream
 }
 
-func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SquashCommitSetRequest)
-	if err := dec(in); err != nil {
+func (x *aPIListBranchClient) Recv() (*BranchInfo, error) {
+	m := new(BranchInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).SquashCommitSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/SquashCommitSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCommitSetRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteBranch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DropCommitSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DropCommitSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) SetBranchProtection(ctx context.Context, in *SetBranchProtectionRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/SetBranchProtection", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/CreateBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error) {
+	out := new(MergeBranchResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/MergeBranch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListBranchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
-}
-
-type API_ListBranchServer interface {
-	Send(*BranchInfo) error
-	grpc.ServerStream
-}
-
-type aPIListBranchServer struct {
-	grpc.ServerStream
+	return out, nil
 }
 
-func (x *aPIListBranchServer) Send(m *BranchInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) InspectTag(ctx context.Context, in *InspectTagRequest, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBranchRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (API_ListTagClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs_v2.API/ListTag", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteBranch",
+	x := &aPIListTagClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
+	return x, nil
 }
 
-type API_ModifyFileServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*ModifyFileRequest, error)
-	grpc.ServerStream
+type API_ListTagClient interface {
+	Recv() (*TagInfo, error)
+	grpc.ClientStream
 }
 
-type aPIModifyFileServer struct {
-	grpc.ServerStream
+type aPIListTagClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIModifyFileServer) SendAndClose(m *types.Empty) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIListTagClient) Recv() (*TagInfo, error) {
+	m := new(TagInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *aPIClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteTag", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (API_ModifyFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs_v2.API/ModifyFile", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
+	x := &aPIModifyFileClient{stream}
+	return x, nil
 }
 
-type API_GetFileServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+type API_ModifyFileClient interface {
+	Send(*ModifyFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
 }
 
-type aPIGetFileServer struct {
-	grpc.ServerStream
+type aPIModifyFileClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIModifyFileClient) Send(m *ModifyFileRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIModifyFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type API_GetFileTARServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs_v2.API/GetFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIGetFileTARServer struct {
-	grpc.ServerStream
+type API_GetFileClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
 }
 
-func (x *aPIGetFileTARServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+type aPIGetFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectFileRequest)
-	if err := dec(in); err != nil {
+func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/InspectFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) GetFileTAR(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileTARClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs_v2.API/GetFileTAR", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
+	x := &aPIGetFileTARClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type API_ListFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+type API_GetFileTARClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
 }
 
-type aPIListFileServer struct {
-	grpc.ServerStream
+type aPIGetFileTARClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPIListFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIGetFileTARClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WalkFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/InspectFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
+	return out, nil
 }
 
-type API_WalkFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs_v2.API/ListFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIWalkFileServer struct {
-	grpc.ServerStream
+type API_ListFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIWalkFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GlobFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIListFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
+	return m, nil
 }
 
-type API_GlobFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs_v2.API/WalkFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWalkFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIGlobFileServer struct {
-	grpc.ServerStream
+type API_WalkFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIGlobFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+type aPIWalkFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DiffFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
+	return m, nil
 }
 
-type API_DiffFileServer interface {
-	Send(*DiffFileResponse) error
-	grpc.ServerStream
+func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs_v2.API/GlobFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGlobFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIDiffFileServer struct {
-	grpc.ServerStream
+type API_GlobFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
-	return x.ServerStream.SendMsg(m)
+type aPIGlobFileClient struct {
+	grpc.ClientStream
 }
 
-func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateAuthRequest)
-	if err := dec(in); err != nil {
+func (x *aPIGlobFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ActivateAuth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/ActivateAuth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs_v2.API/DiffFile", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/DeleteAll",
+	x := &aPIDiffFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FsckRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
+type API_DiffFileClient interface {
+	Recv() (*DiffFileResponse, error)
+	grpc.ClientStream
 }
 
-type API_FsckServer interface {
-	Send(*FsckResponse) error
-	grpc.ServerStream
-}
+type aPIDiffFileClient struct {
+	grpc.ClientStream
+}
 
-type aPIFsckServer struct {
-	grpc.ServerStream
+func (x *aPIDiffFileClient) Recv() (*DiffFileResponse, error) {
+	m := new(DiffFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *aPIFsckServer) Send(m *FsckResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
+	out := new(ActivateAuthResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/ActivateAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
+func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type API_CreateFileSetServer interface {
-	SendAndClose(*CreateFileSetResponse) error
-	Recv() (*ModifyFileRequest, error)
-	grpc.ServerStream
+func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[14], "/pfs_v2.API/Fsck", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFsckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPICreateFileSetServer struct {
-	grpc.ServerStream
+type API_FsckClient interface {
+	Recv() (*FsckResponse, error)
+	grpc.ClientStream
 }
 
-func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
-	return x.ServerStream.SendMsg(m)
+type aPIFsckClient struct {
+	grpc.ClientStream
 }
 
-func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
-	m := new(ModifyFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
+	m := new(FsckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileSetRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error) {
+	out := new(GarbageCollectResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/GarbageCollect", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GetFileSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/GetFileSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddFileSetRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) CreateFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateFileSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[15], "/pfs_v2.API/CreateFileSet", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).AddFileSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/AddFileSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	x := &aPICreateFileSetClient{stream}
+	return x, nil
 }
 
-func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewFileSetRequest)
-	if err := dec(in); err != nil {
+type API_CreateFileSetClient interface {
+	Send(*ModifyFileRequest) error
+	CloseAndRecv() (*CreateFileSetResponse, error)
+	grpc.ClientStream
+}
+
+type aPICreateFileSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPICreateFileSetClient) Send(m *ModifyFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPICreateFileSetClient) CloseAndRecv() (*CreateFileSetResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RenewFileSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/RenewFileSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
+	m := new(CreateFileSetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunLoadTestRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) GetFileSet(ctx context.Context, in *GetFileSetRequest, opts ...grpc.CallOption) (*CreateFileSetResponse, error) {
+	out := new(CreateFileSetResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/GetFileSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunLoadTest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/RunLoadTest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTest(ctx, req.(*RunLoadTestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *aPIClient) AddFileSet(ctx context.Context, in *AddFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/AddFileSet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).RunLoadTestDefault(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) RenewFileSet(ctx context.Context, in *RenewFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RenewFileSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs_v2.API/RunLoadTestDefault",
+	return out, nil
+}
+
+func (c *aPIClient) RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
+	out := new(RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
+	return out, nil
+}
+
+func (c *aPIClient) RunLoadTestDefault(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*RunLoadTestResponse, error) {
+	out := new(RunLoadTestResponse)
+	err := c.cc.Invoke(ctx, "/pfs_v2.API/RunLoadTestDefault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRepo",
-			Handler:    _API_CreateRepo_Handler,
-		},
-		{
-			MethodName: "InspectRepo",
-			Handler:    _API_InspectRepo_Handler,
-		},
-		{
-			MethodName: "DeleteRepo",
-			Handler:    _API_DeleteRepo_Handler,
-		},
-		{
-			MethodName: "StartCommit",
-			Handler:    _API_StartCommit_Handler,
-		},
-		{
-			MethodName: "FinishCommit",
-			Handler:    _API_FinishCommit_Handler,
-		},
-		{
-			MethodName: "ClearCommit",
-			Handler:    _API_ClearCommit_Handler,
-		},
-		{
-			MethodName: "InspectCommit",
-			Handler:    _API_InspectCommit_Handler,
-		},
-		{
-			MethodName: "SquashCommitSet",
-			Handler:    _API_SquashCommitSet_Handler,
-		},
-		{
-			MethodName: "DropCommitSet",
-			Handler:    _API_DropCommitSet_Handler,
-		},
-		{
-			MethodName: "CreateBranch",
-			Handler:    _API_CreateBranch_Handler,
-		},
-		{
-			MethodName: "InspectBranch",
-			Handler:    _API_InspectBranch_Handler,
-		},
-		{
-			MethodName: "DeleteBranch",
-			Handler:    _API_DeleteBranch_Handler,
-		},
-		{
-			MethodName: "InspectFile",
-			Handler:    _API_InspectFile_Handler,
-		},
-		{
-			MethodName: "ActivateAuth",
-			Handler:    _API_ActivateAuth_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-		{
-			MethodName: "GetFileSet",
-			Handler:    _API_GetFileSet_Handler,
-		},
-		{
-			MethodName: "AddFileSet",
-			Handler:    _API_AddFileSet_Handler,
-		},
-		{
-			MethodName: "RenewFileSet",
-			Handler:    _API_RenewFileSet_Handler,
-		},
-		{
-			MethodName: "RunLoadTest",
-			Handler:    _API_RunLoadTest_Handler,
-		},
-		{
-			MethodName: "RunLoadTestDefault",
-			Handler:    _API_RunLoadTestDefault_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListRepo",
-			Handler:       _API_ListRepo_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListCommit",
-			Handler:       _API_ListCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SubscribeCommit",
-			Handler:       _API_SubscribeCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "InspectCommitSet",
-			Handler:       _API_InspectCommitSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListCommitSet",
-			Handler:       _API_ListCommitSet_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListBranch",
-			Handler:       _API_ListBranch_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ModifyFile",
-			Handler:       _API_ModifyFile_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetFile",
-			Handler:       _API_GetFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetFileTAR",
-			Handler:       _API_GetFileTAR_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListFile",
-			Handler:       _API_ListFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WalkFile",
-			Handler:       _API_WalkFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GlobFile",
-			Handler:       _API_GlobFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "DiffFile",
-			Handler:       _API_DiffFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Fsck",
-			Handler:       _API_Fsck_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "CreateFileSet",
-			Handler:       _API_CreateFileSet_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pfs/pfs.proto",
+// APIServer is the server API for API service.
+type APIServer interface {
+	// CreateRepo creates a new repo.
+	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	// InspectRepoStorage returns the deduplicated storage usage of a repo.
+	InspectRepoStorage(context.Context, *InspectRepoStorageRequest) (*RepoStorageInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(*ListRepoRequest, API_ListRepoServer) error
+	// DeleteRepo deletes a repo.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
+	// ClearCommit removes all data from the commit.
+	ClearCommit(context.Context, *ClearCommitRequest) (*types.Empty, error)
+	// RevertCommit makes a new commit that undoes the changes made by a commit.
+	RevertCommit(context.Context, *RevertCommitRequest) (*RevertCommitResponse, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	// ListCommit returns info about all commits.
+	ListCommit(*ListCommitRequest, API_ListCommitServer) error
+	// SubscribeCommit subscribes for new commits on a given branch.
+	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	// InspectCommitSet returns the info about a CommitSet.
+	InspectCommitSet(*InspectCommitSetRequest, API_InspectCommitSetServer) error
+	// ListCommitSet returns info about all CommitSets.
+	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
+	// SquashCommitSet squashes the commits of a CommitSet into their children.
+	SquashCommitSet(context.Context, *SquashCommitSetRequest) (*types.Empty, error)
+	// DropCommitSet drops the commits of a CommitSet and all data included in the commits.
+	DropCommitSet(context.Context, *DropCommitSetRequest) (*types.Empty, error)
+	// CreateBranch creates a new branch.
+	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(*ListBranchRequest, API_ListBranchServer) error
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
+	// SetBranchProtection sets or removes the protection rules on a branch.
+	SetBranchProtection(context.Context, *SetBranchProtectionRequest) (*types.Empty, error)
+	// MergeBranch applies the changes made on one branch since its common
+	// ancestor with another branch to the other branch, in a new commit.
+	MergeBranch(context.Context, *MergeBranchRequest) (*MergeBranchResponse, error)
+	// CreateTag creates a new tag pointing at a commit. Tags cannot be moved.
+	CreateTag(context.Context, *CreateTagRequest) (*types.Empty, error)
+	// InspectTag returns info about a tag.
+	InspectTag(context.Context, *InspectTagRequest) (*TagInfo, error)
+	// ListTag returns info about the tags in a repo.
+	ListTag(*ListTagRequest, API_ListTagServer) error
+	// DeleteTag deletes a tag; note that the commit still exists.
+	DeleteTag(context.Context, *DeleteTagRequest) (*types.Empty, error)
+	// ModifyFile performs modifications on a set of files.
+	ModifyFile(API_ModifyFileServer) error
+	// GetFile returns the contents of a single file
+	GetFile(*GetFileRequest, API_GetFileServer) error
+	// GetFileTAR returns a TAR stream of the contents matched by the request
+	GetFileTAR(*GetFileRequest, API_GetFileTARServer) error
+	// InspectFile returns info about a file.
+	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	// ListFile returns info about all files.
+	ListFile(*ListFileRequest, API_ListFileServer) error
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(*WalkFileRequest, API_WalkFileServer) error
+	// GlobFile returns info about all files.
+	GlobFile(*GlobFileRequest, API_GlobFileServer) error
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(*DiffFileRequest, API_DiffFileServer) error
+	// ActivateAuth creates a role binding for all existing repos
+	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+	// DeleteAll deletes everything.
+	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs.
+	Fsck(*FsckRequest, API_FsckServer) error
+	// GarbageCollect runs, or reports what would be deleted by, a single
+	// pass of storage garbage collection.
+	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	// FileSet API
+	// CreateFileSet creates a new file set.
+	CreateFileSet(API_CreateFileSetServer) error
+	// GetFileSet returns a file set with the data from a commit
+	GetFileSet(context.Context, *GetFileSetRequest) (*CreateFileSetResponse, error)
+	// AddFileSet associates a file set with a commit
+	AddFileSet(context.Context, *AddFileSetRequest) (*types.Empty, error)
+	// RenewFileSet prevents a file set from being deleted for a set amount of time.
+	RenewFileSet(context.Context, *RenewFileSetRequest) (*types.Empty, error)
+	// RunLoadTest runs a load test.
+	RunLoadTest(context.Context, *RunLoadTestRequest) (*RunLoadTestResponse, error)
+	// RunLoadTestDefault runs the default load tests.
+	RunLoadTestDefault(context.Context, *types.Empty) (*RunLoadTestResponse, error)
 }
 
-func (m *Repo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
 }
 
-func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAPIServer) CreateRepo(ctx context.Context, req *CreateRepoRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
 }
-
-func (m *Repo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAPIServer) InspectRepo(ctx context.Context, req *InspectRepoRequest) (*RepoInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectRepo not implemented")
 }
-
-func (m *Branch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAPIServer) InspectRepoStorage(ctx context.Context, req *InspectRepoStorageRequest) (*RepoStorageInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectRepoStorage not implemented")
 }
-
-func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedAPIServer) ListRepo(req *ListRepoRequest, srv API_ListRepoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
 }
-
-func (m *Branch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
 }
-
-func (m *File) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
+}
+func (*UnimplementedAPIServer) FinishCommit(ctx context.Context, req *FinishCommitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishCommit not implemented")
+}
+func (*UnimplementedAPIServer) ClearCommit(ctx context.Context, req *ClearCommitRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCommit not implemented")
+}
+func (*UnimplementedAPIServer) RevertCommit(ctx context.Context, req *RevertCommitRequest) (*RevertCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCommit not implemented")
+}
+func (*UnimplementedAPIServer) InspectCommit(ctx context.Context, req *InspectCommitRequest) (*CommitInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectCommit not implemented")
+}
+func (*UnimplementedAPIServer) ListCommit(req *ListCommitRequest, srv API_ListCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommit not implemented")
+}
+func (*UnimplementedAPIServer) SubscribeCommit(req *SubscribeCommitRequest, srv API_SubscribeCommitServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCommit not implemented")
+}
+func (*UnimplementedAPIServer) InspectCommitSet(req *InspectCommitSetRequest, srv API_InspectCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method InspectCommitSet not implemented")
+}
+func (*UnimplementedAPIServer) ListCommitSet(req *ListCommitSetRequest, srv API_ListCommitSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCommitSet not implemented")
+}
+func (*UnimplementedAPIServer) SquashCommitSet(ctx context.Context, req *SquashCommitSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquashCommitSet not implemented")
+}
+func (*UnimplementedAPIServer) DropCommitSet(ctx context.Context, req *DropCommitSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCommitSet not implemented")
+}
+func (*UnimplementedAPIServer) CreateBranch(ctx context.Context, req *CreateBranchRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (*UnimplementedAPIServer) InspectBranch(ctx context.Context, req *InspectBranchRequest) (*BranchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectBranch not implemented")
+}
+func (*UnimplementedAPIServer) ListBranch(req *ListBranchRequest, srv API_ListBranchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBranch not implemented")
+}
+func (*UnimplementedAPIServer) DeleteBranch(ctx context.Context, req *DeleteBranchRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+}
+func (*UnimplementedAPIServer) SetBranchProtection(ctx context.Context, req *SetBranchProtectionRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBranchProtection not implemented")
+}
+func (*UnimplementedAPIServer) MergeBranch(ctx context.Context, req *MergeBranchRequest) (*MergeBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBranch not implemented")
+}
+func (*UnimplementedAPIServer) CreateTag(ctx context.Context, req *CreateTagRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (*UnimplementedAPIServer) InspectTag(ctx context.Context, req *InspectTagRequest) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectTag not implemented")
+}
+func (*UnimplementedAPIServer) ListTag(req *ListTagRequest, srv API_ListTagServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTag not implemented")
+}
+func (*UnimplementedAPIServer) DeleteTag(ctx context.Context, req *DeleteTagRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (*UnimplementedAPIServer) ModifyFile(srv API_ModifyFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ModifyFile not implemented")
+}
+func (*UnimplementedAPIServer) GetFile(req *GetFileRequest, srv API_GetFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (*UnimplementedAPIServer) GetFileTAR(req *GetFileRequest, srv API_GetFileTARServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFileTAR not implemented")
+}
+func (*UnimplementedAPIServer) InspectFile(ctx context.Context, req *InspectFileRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectFile not implemented")
+}
+func (*UnimplementedAPIServer) ListFile(req *ListFileRequest, srv API_ListFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFile not implemented")
+}
+func (*UnimplementedAPIServer) WalkFile(req *WalkFileRequest, srv API_WalkFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WalkFile not implemented")
+}
+func (*UnimplementedAPIServer) GlobFile(req *GlobFileRequest, srv API_GlobFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GlobFile not implemented")
+}
+func (*UnimplementedAPIServer) DiffFile(req *DiffFileRequest, srv API_DiffFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
+}
+func (*UnimplementedAPIServer) ActivateAuth(ctx context.Context, req *ActivateAuthRequest) (*ActivateAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAuth not implemented")
+}
+func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
+func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
+	return status.Errorf(codes.Unimplemented, "method Fsck not implemented")
+}
+func (*UnimplementedAPIServer) GarbageCollect(ctx context.Context, req *GarbageCollectRequest) (*GarbageCollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GarbageCollect not implemented")
+}
+func (*UnimplementedAPIServer) CreateFileSet(srv API_CreateFileSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateFileSet not implemented")
+}
+func (*UnimplementedAPIServer) GetFileSet(ctx context.Context, req *GetFileSetRequest) (*CreateFileSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileSet not implemented")
+}
+func (*UnimplementedAPIServer) AddFileSet(ctx context.Context, req *AddFileSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFileSet not implemented")
+}
+func (*UnimplementedAPIServer) RenewFileSet(ctx context.Context, req *RenewFileSetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewFileSet not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTest(ctx context.Context, req *RunLoadTestRequest) (*RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
+}
+func (*UnimplementedAPIServer) RunLoadTestDefault(ctx context.Context, req *types.Empty) (*RunLoadTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTestDefault not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).CreateRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CreateRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_InspectRepoStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).InspectRepoStorage(ctx, in)
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectRepoStorage",
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectRepoStorage(ctx, req.(*InspectRepoStorageRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListRepo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRepoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListRepo(m, &aPIListRepoServer{stream})
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListRepoServer interface {
+	Send(*RepoInfo) error
+	grpc.ServerStream
 }
 
-func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListRepoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListRepoServer) Send(m *RepoInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRepo(ctx, in)
 	}
-	if m.AuthInfo != nil {
-		{
-			size, err := m.AuthInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteRepo",
 	}
-	if len(m.Branches) > 0 {
-		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytesUpperBound != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(APIServer).StartCommit(ctx, in)
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/StartCommit",
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo_Details) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishCommitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).FinishCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/FinishCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo_Details) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_ClearCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ClearCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ClearCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ClearCommit(ctx, req.(*ClearCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_RevertCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(APIServer).RevertCommit(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RevertCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevertCommit(ctx, req.(*RevertCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).InspectCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_ListCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListCommit(m, &aPIListCommitServer{stream})
 }
 
-func (m *RepoAuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintPfs(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Permissions) > 0 {
-		dAtA8 := make([]byte, len(m.Permissions)*10)
-		var j7 int
-		for _, num := range m.Permissions {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintPfs(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0xa
+type API_ListCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
 }
 
-func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type API_SubscribeCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPISubscribeCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InspectCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).InspectCommitSet(m, &aPIInspectCommitSetServer{stream})
 }
 
-func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_InspectCommitSetServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
 }
 
-func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIInspectCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIInspectCommitSetServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.Trigger != nil {
-		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DirectProvenance) > 0 {
-		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Subvenance) > 0 {
-		for iNdEx := len(m.Subvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
+}
+
+type API_ListCommitSetServer interface {
+	Send(*CommitSetInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SquashCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Provenance) > 0 {
-		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(APIServer).SquashCommitSet(ctx, in)
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/SquashCommitSet",
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SquashCommitSet(ctx, req.(*SquashCommitSetRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Trigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DropCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCommitSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).DropCommitSet(ctx, in)
 	}
-	if m.Commits != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commits))
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DropCommitSet",
 	}
-	if len(m.Size_) > 0 {
-		i -= len(m.Size_)
-		copy(dAtA[i:], m.Size_)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Size_)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DropCommitSet(ctx, req.(*DropCommitSetRequest))
 	}
-	if len(m.CronSpec) > 0 {
-		i -= len(m.CronSpec)
-		copy(dAtA[i:], m.CronSpec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.CronSpec)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CreateBranch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitOrigin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectBranchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).InspectBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitOrigin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_ListBranch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBranchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ListBranch(m, &aPIListBranchServer{stream})
 }
 
-func (m *CommitOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Kind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type API_ListBranchServer interface {
+	Send(*BranchInfo) error
+	grpc.ServerStream
 }
 
-func (m *Commit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListBranchServer struct {
+	grpc.ServerStream
 }
 
-func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *aPIListBranchServer) Send(m *BranchInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(APIServer).DeleteBranch(ctx, in)
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteBranch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_SetBranchProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBranchProtectionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).SetBranchProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/SetBranchProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetBranchProtection(ctx, req.(*SetBranchProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+func _API_MergeBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytesUpperBound != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
-		i--
-		dAtA[i] = 0x58
+	if interceptor == nil {
+		return srv.(APIServer).MergeBranch(ctx, in)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/MergeBranch",
 	}
-	if len(m.DirectProvenance) > 0 {
-		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MergeBranch(ctx, req.(*MergeBranchRequest))
 	}
-	if m.Finished != nil {
-		{
-			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Finishing != nil {
-		{
-			size, err := m.Finishing.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(APIServer).CreateTag(ctx, in)
 	}
-	if m.Started != nil {
-		{
-			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/CreateTag",
 	}
-	if len(m.ChildCommits) > 0 {
-		for iNdEx := len(m.ChildCommits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ChildCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateTag(ctx, req.(*CreateTagRequest))
 	}
-	if m.ParentCommit != nil {
-		{
-			size, err := m.ParentCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).InspectTag(ctx, in)
 	}
-	if m.Origin != nil {
-		{
-			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectTag",
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectTag(ctx, req.(*InspectTagRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo_Details) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListTag_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListTag(m, &aPIListTagServer{stream})
 }
 
-func (m *CommitInfo_Details) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListTagServer interface {
+	Send(*TagInfo) error
+	grpc.ServerStream
 }
 
-func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type aPIListTagServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *aPIListTagServer) Send(m *TagInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_ModifyFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ModifyFile(&aPIModifyFileServer{stream})
 }
 
-func (m *CommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type API_ModifyFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
 }
 
-func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIModifyFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *aPIModifyFileServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CommitSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Commits) > 0 {
-		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPfs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (x *aPIModifyFileServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
 }
 
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_GetFileServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SizeBytes != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Committed != nil {
-		{
-			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FileType != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPIGetFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GetFileTAR_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetFileTAR(m, &aPIGetFileTARServer{stream})
 }
 
-func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_GetFileTARServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIGetFileTARServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetFileTARServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Update {
-		i--
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(APIServer).InspectFile(ctx, in)
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/InspectFile",
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_ListFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListFile(m, &aPIListFileServer{stream})
 }
 
-func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_ListFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *InspectRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIListFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WalkFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
 }
 
-func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type API_WalkFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type aPIWalkFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (x *aPIWalkFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _API_GlobFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GlobFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GlobFile(m, &aPIGlobFileServer{stream})
 }
 
-func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_GlobFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPIGlobFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *aPIGlobFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DiffFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiffFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).DiffFile(m, &aPIDiffFileServer{stream})
 }
 
-func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_DiffFileServer interface {
+	Send(*DiffFileResponse) error
+	grpc.ServerStream
 }
 
-func (m *StartCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type aPIDiffFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIDiffFileServer) Send(m *DiffFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).ActivateAuth(ctx, in)
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/ActivateAuth",
 	}
-	if m.Parent != nil {
-		{
-			size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _API_Fsck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FsckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Fsck(m, &aPIFsckServer{stream})
 }
 
-func (m *FinishCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type API_FsckServer interface {
+	Send(*FsckResponse) error
+	grpc.ServerStream
+}
+
+type aPIFsckServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFsckServer) Send(m *FsckResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	if interceptor == nil {
+		return srv.(APIServer).GarbageCollect(ctx, in)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x1a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/GarbageCollect",
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GarbageCollect(ctx, req.(*GarbageCollectRequest))
 	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _API_CreateFileSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).CreateFileSet(&aPICreateFileSetServer{stream})
 }
 
-func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type API_CreateFileSetServer interface {
+	SendAndClose(*CreateFileSetResponse) error
+	Recv() (*ModifyFileRequest, error)
+	grpc.ServerStream
 }
 
-func (m *InspectCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Wait != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Wait))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Commit != nil {
-		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type aPICreateFileSetServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *aPICreateFileSetServer) SendAndClose(m *CreateFileSetResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *aPICreateFileSetServer) Recv() (*ModifyFileRequest, error) {
+	m := new(ModifyFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_GetFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OriginKind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(APIServer).GetFileSet(ctx, in)
 	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/GetFileSet",
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetFileSet(ctx, req.(*GetFileSetRequest))
 	}
-	if m.Number != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AddFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFileSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(APIServer).AddFileSet(ctx, in)
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/AddFileSet",
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddFileSet(ctx, req.(*AddFileSetRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RenewFileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewFileSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(APIServer).RenewFileSet(ctx, in)
 	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RenewFileSet",
 	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenewFileSet(ctx, req.(*RenewFileSetRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _API_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunLoadTestRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RunLoadTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTest(ctx, req.(*RunLoadTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _API_RunLoadTestDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(APIServer).RunLoadTestDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs_v2.API/RunLoadTestDefault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunLoadTestDefault(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepo",
+			Handler:    _API_CreateRepo_Handler,
+		},
+		{
+			MethodName: "InspectRepo",
+			Handler:    _API_InspectRepo_Handler,
+		},
+		{
+			MethodName: "InspectRepoStorage",
+			Handler:    _API_InspectRepoStorage_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _API_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "StartCommit",
+			Handler:    _API_StartCommit_Handler,
+		},
+		{
+			MethodName: "FinishCommit",
+			Handler:    _API_FinishCommit_Handler,
+		},
+		{
+			MethodName: "ClearCommit",
+			Handler:    _API_ClearCommit_Handler,
+		},
+		{
+			MethodName: "RevertCommit",
+			Handler:    _API_RevertCommit_Handler,
+		},
+		{
+			MethodName: "InspectCommit",
+			Handler:    _API_InspectCommit_Handler,
+		},
+		{
+			MethodName: "SquashCommitSet",
+			Handler:    _API_SquashCommitSet_Handler,
+		},
+		{
+			MethodName: "DropCommitSet",
+			Handler:    _API_DropCommitSet_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _API_CreateBranch_Handler,
+		},
+		{
+			MethodName: "InspectBranch",
+			Handler:    _API_InspectBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _API_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "SetBranchProtection",
+			Handler:    _API_SetBranchProtection_Handler,
+		},
+		{
+			MethodName: "MergeBranch",
+			Handler:    _API_MergeBranch_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _API_CreateTag_Handler,
+		},
+		{
+			MethodName: "InspectTag",
+			Handler:    _API_InspectTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _API_DeleteTag_Handler,
+		},
+		{
+			MethodName: "InspectFile",
+			Handler:    _API_InspectFile_Handler,
+		},
+		{
+			MethodName: "ActivateAuth",
+			Handler:    _API_ActivateAuth_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+		{
+			MethodName: "GarbageCollect",
+			Handler:    _API_GarbageCollect_Handler,
+		},
+		{
+			MethodName: "GetFileSet",
+			Handler:    _API_GetFileSet_Handler,
+		},
+		{
+			MethodName: "AddFileSet",
+			Handler:    _API_AddFileSet_Handler,
+		},
+		{
+			MethodName: "RenewFileSet",
+			Handler:    _API_RenewFileSet_Handler,
+		},
+		{
+			MethodName: "RunLoadTest",
+			Handler:    _API_RunLoadTest_Handler,
+		},
+		{
+			MethodName: "RunLoadTestDefault",
+			Handler:    _API_RunLoadTestDefault_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListRepo",
+			Handler:       _API_ListRepo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommit",
+			Handler:       _API_ListCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCommit",
+			Handler:       _API_SubscribeCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InspectCommitSet",
+			Handler:       _API_InspectCommitSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommitSet",
+			Handler:       _API_ListCommitSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBranch",
+			Handler:       _API_ListBranch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTag",
+			Handler:       _API_ListTag_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ModifyFile",
+			Handler:       _API_ModifyFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFile",
+			Handler:       _API_GetFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFileTAR",
+			Handler:       _API_GetFileTAR_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFile",
+			Handler:       _API_ListFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WalkFile",
+			Handler:       _API_WalkFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GlobFile",
+			Handler:       _API_GlobFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DiffFile",
+			Handler:       _API_DiffFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Fsck",
+			Handler:       _API_Fsck_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateFileSet",
+			Handler:       _API_CreateFileSet_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pfs/pfs.proto",
+}
+
+func (m *Repo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7025,12 +7515,12 @@ func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SquashCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Repo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7039,22 +7529,24 @@ func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitSet != nil {
-		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *Branch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7064,12 +7556,12 @@ func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Branch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7078,9 +7570,16 @@ func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitSet != nil {
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
 		{
-			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7093,7 +7592,7 @@ func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *File) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7103,12 +7602,12 @@ func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *File) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7117,48 +7616,23 @@ func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.OriginKind != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.State != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Repo != nil {
+	if m.Commit != nil {
 		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7171,7 +7645,7 @@ func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7181,12 +7655,12 @@ func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClearCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7195,9 +7669,33 @@ func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Commit != nil {
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Details != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7205,48 +7703,11 @@ func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.NewCommitSet {
-		i--
-		if m.NewCommitSet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x3a
 	}
-	if m.Trigger != nil {
+	if m.AuthInfo != nil {
 		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AuthInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7254,12 +7715,12 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if len(m.Provenance) > 0 {
-		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7267,12 +7728,24 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPfs(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.Branch != nil {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SizeBytesUpperBound != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Created != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7282,9 +7755,9 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Head != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7297,7 +7770,7 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7307,12 +7780,12 @@ func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7321,22 +7794,15 @@ func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Branch != nil {
-		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7346,12 +7812,12 @@ func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoAuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7360,32 +7826,37 @@ func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Permissions) > 0 {
+		dAtA8 := make([]byte, len(m.Permissions)*10)
+		var j7 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintPfs(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7395,12 +7866,12 @@ func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BranchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7409,19 +7880,9 @@ func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Branch != nil {
+	if m.Protection != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Protection.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7429,71 +7890,65 @@ func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AddFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x3a
 	}
-	if m.Source != nil {
+	if m.Trigger != nil {
 		{
-			size := m.Source.Size()
-			i -= size
-			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.DirectProvenance) > 0 {
+		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddFile_Raw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Raw != nil {
+	if len(m.Subvenance) > 0 {
+		for iNdEx := len(m.Subvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Head != nil {
 		{
-			size, err := m.Raw.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7501,20 +7956,11 @@ func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *AddFile_Url) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Url != nil {
+	if m.Branch != nil {
 		{
-			size, err := m.Url.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7522,11 +7968,12 @@ func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7536,12 +7983,12 @@ func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFile_URLSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7550,27 +7997,29 @@ func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Recursive {
-		i--
-		if m.Recursive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
+func (m *TagInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7580,12 +8029,12 @@ func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *TagInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TagInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7594,24 +8043,53 @@ func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CopyFile) Marshal() (dAtA []byte, err error) {
+func (m *BranchProtection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7621,12 +8099,12 @@ func (m *CopyFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CopyFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchProtection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BranchProtection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7635,46 +8113,44 @@ func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Append {
+	if len(m.PromoteFrom) > 0 {
+		i -= len(m.PromoteFrom)
+		copy(dAtA[i:], m.PromoteFrom)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PromoteFrom)))
 		i--
-		if m.Append {
+		dAtA[i] = 0x22
+	}
+	if len(m.RequiredPipeline) > 0 {
+		i -= len(m.RequiredPipeline)
+		copy(dAtA[i:], m.RequiredPipeline)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RequiredPipeline)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DenyRewind {
+		i--
+		if m.DenyRewind {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.Src != nil {
-		{
-			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
-	if len(m.Datum) > 0 {
-		i -= len(m.Datum)
-		copy(dAtA[i:], m.Datum)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+	if m.DenyDirectCommits {
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Dst) > 0 {
-		i -= len(m.Dst)
-		copy(dAtA[i:], m.Dst)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Dst)))
+		if m.DenyDirectCommits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7684,12 +8160,12 @@ func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModifyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7698,91 +8174,111 @@ func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if m.Commits != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Size_) > 0 {
+		i -= len(m.Size_)
+		copy(dAtA[i:], m.Size_)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Size_)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CronSpec) > 0 {
+		i -= len(m.CronSpec)
+		copy(dAtA[i:], m.CronSpec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.CronSpec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest_SetCommit) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitOrigin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyFileRequest_SetCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SetCommit != nil {
-		{
-			size, err := m.SetCommit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Kind))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_AddFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ModifyFileRequest_AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.AddFile != nil {
-		{
-			size, err := m.AddFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *Commit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *ModifyFileRequest_DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyFileRequest_DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DeleteFile != nil {
-		{
-			size, err := m.DeleteFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ModifyFileRequest_CopyFile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ModifyFileRequest_CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CopyFile != nil {
+	if m.Branch != nil {
 		{
-			size, err := m.CopyFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7790,11 +8286,12 @@ func (m *ModifyFileRequest_CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+
+func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7804,12 +8301,12 @@ func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7818,21 +8315,147 @@ func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Offset))
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x62
 	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+	if m.SizeBytesUpperBound != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytesUpperBound))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DirectProvenance) > 0 {
+		for iNdEx := len(m.DirectProvenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectProvenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Finishing != nil {
+		{
+			size, err := m.Finishing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChildCommits) > 0 {
+		for iNdEx := len(m.ChildCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChildCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ParentCommit != nil {
+		{
+			size, err := m.ParentCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.File != nil {
+	if m.Commit != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7845,7 +8468,7 @@ func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitInfo_Details) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7855,12 +8478,12 @@ func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitInfo_Details) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7869,22 +8492,15 @@ func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7894,12 +8510,12 @@ func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7908,22 +8524,17 @@ func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
-		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7933,12 +8544,12 @@ func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitSetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7947,9 +8558,23 @@ func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CommitSet != nil {
 		{
-			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7962,7 +8587,7 @@ func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7972,12 +8597,12 @@ func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7986,16 +8611,38 @@ func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Pattern) > 0 {
-		i -= len(m.Pattern)
-		copy(dAtA[i:], m.Pattern)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.Commit != nil {
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Committed != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FileType != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8008,7 +8655,7 @@ func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8018,12 +8665,12 @@ func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8032,9 +8679,33 @@ func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Shallow {
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Compression != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Compression))
 		i--
-		if m.Shallow {
+		dAtA[i] = 0x20
+	}
+	if m.Update {
+		i--
+		if m.Update {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -8042,21 +8713,16 @@ func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.OldFile != nil {
-		{
-			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.NewFile != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8069,7 +8735,7 @@ func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8079,12 +8745,12 @@ func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8093,21 +8759,9 @@ func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.OldFile != nil {
-		{
-			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPfs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NewFile != nil {
+	if m.Repo != nil {
 		{
-			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8120,7 +8774,7 @@ func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectRepoStorageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8130,12 +8784,12 @@ func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FsckRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectRepoStorageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectRepoStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8144,20 +8798,46 @@ func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Fix {
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
-		if m.Fix {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
+func (m *RepoStorageInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8167,12 +8847,12 @@ func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FsckResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoStorageInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoStorageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8181,24 +8861,42 @@ func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+	if m.ReclaimableBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ReclaimableBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if len(m.Fix) > 0 {
-		i -= len(m.Fix)
-		copy(dAtA[i:], m.Fix)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Fix)))
+	if m.SharedBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SharedBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UniqueChunkBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.UniqueChunkBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogicalBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.LogicalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8208,12 +8906,12 @@ func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8222,17 +8920,17 @@ func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8242,12 +8940,12 @@ func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8256,9 +8954,19 @@ func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Commit != nil {
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Repo != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8271,7 +8979,7 @@ func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8281,12 +8989,12 @@ func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8295,16 +9003,47 @@ func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Commit != nil {
+	if m.Branch != nil {
 		{
-			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Parent != nil {
+		{
+			size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8317,7 +9056,7 @@ func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8327,12 +9066,12 @@ func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinishCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8341,22 +9080,65 @@ func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TtlSeconds != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Force {
 		i--
-		dAtA[i] = 0x10
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.FileSetId) > 0 {
-		i -= len(m.FileSetId)
-		copy(dAtA[i:], m.FileSetId)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8366,12 +9148,12 @@ func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8380,10 +9162,27 @@ func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Wait != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Wait))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8393,12 +9192,12 @@ func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8407,10 +9206,167 @@ func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaxSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxSizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MinSizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ErrorFilter != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ErrorFilter))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.FinishedBefore != nil {
+		{
+			size, err := m.FinishedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.FinishedAfter != nil {
+		{
+			size, err := m.FinishedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StartedBefore != nil {
+		{
+			size, err := m.StartedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartedAfter != nil {
+		{
+			size, err := m.StartedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.OriginKind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Number != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8420,12 +9376,12 @@ func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunLoadTestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InspectCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8434,14 +9390,19 @@ func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Seed != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+	if m.Wait {
 		i--
-		dAtA[i] = 0x18
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Branch != nil {
+	if m.CommitSet != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8449,19 +9410,12 @@ func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8471,12 +9425,12 @@ func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RunLoadTestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8485,21 +9439,46 @@ func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *SquashCommitSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Seed != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+	return dAtA[:n], nil
+}
+
+func (m *SquashCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SquashCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Force {
 		i--
-		dAtA[i] = 0x18
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Branch != nil {
+	if m.CommitSet != nil {
 		{
-			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8507,1303 +9486,6795 @@ func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPfs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DropCommitSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Repo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *DropCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Branch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DropCommitSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CommitSet != nil {
+		{
+			size, err := m.CommitSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *File) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.OriginKind != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.OriginKind))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.SizeBytesUpperBound != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.State != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Branches) > 0 {
-		for _, e := range m.Branches {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AuthInfo != nil {
-		l = m.AuthInfo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RepoInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClearCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepoAuthInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClearCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Permissions) > 0 {
-		l = 0
-		for _, e := range m.Permissions {
-			l += sovPfs(uint64(e))
-		}
-		n += 1 + sovPfs(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BranchInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevertCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.Resolution != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Subvenance) > 0 {
-		for _, e := range m.Subvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DirectProvenance) > 0 {
-		for _, e := range m.DirectProvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Trigger != nil {
-		l = m.Trigger.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.All {
-		n += 2
-	}
-	l = len(m.CronSpec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Size_)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Commits != 0 {
-		n += 1 + sovPfs(uint64(m.Commits))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RevertCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommitOrigin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Kind != 0 {
-		n += 1 + sovPfs(uint64(m.Kind))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *RevertCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Commit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RevertCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.NewCommitSet {
+		i--
+		if m.NewCommitSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ParentCommit != nil {
-		l = m.ParentCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.ChildCommits) > 0 {
-		for _, e := range m.ChildCommits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Finishing != nil {
-		l = m.Finishing.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.DirectProvenance) > 0 {
-		for _, e := range m.DirectProvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Provenance) > 0 {
+		for iNdEx := len(m.Provenance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provenance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.SizeBytesUpperBound != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
-	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitInfo_Details) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommitSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FileInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.FileType != 0 {
-		n += 1 + sovPfs(uint64(m.FileType))
-	}
-	if m.Committed != nil {
-		l = m.Committed.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Update {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MergeBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Force {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *StartCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Resolutions) > 0 {
+		for k := range m.Resolutions {
+			v := m.Resolutions[k]
+			baseI := i
+			i = encodeVarintPfs(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPfs(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Resolution != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FinishCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MergeConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Resolution != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Theirs != nil {
+		{
+			size, err := m.Theirs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Force {
-		n += 2
+	if m.Ours != nil {
+		{
+			size, err := m.Ours.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Wait != 0 {
-		n += 1 + sovPfs(uint64(m.Wait))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *MergeBranchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Number != 0 {
-		n += 1 + sovPfs(uint64(m.Number))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.All {
-		n += 2
-	}
-	if m.OriginKind != 0 {
-		n += 1 + sovPfs(uint64(m.OriginKind))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *MergeBranchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MergeBranchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Wait {
-		n += 2
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetBranchProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SquashCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *SetBranchProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropCommitSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetBranchProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CommitSet != nil {
-		l = m.CommitSet.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Protection != nil {
+		{
+			size, err := m.Protection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.HeaderRecords != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.HeaderRecords))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.TargetFileBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.State != 0 {
-		n += 1 + sovPfs(uint64(m.State))
+	if m.TargetFileDatums != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.All {
-		n += 2
+	if m.Delimiter != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.OriginKind != 0 {
-		n += 1 + sovPfs(uint64(m.OriginKind))
+	if m.Source != nil {
+		{
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClearCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AddFile_Raw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+func (m *AddFile_Raw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Raw != nil {
+		{
+			size, err := m.Raw.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Trigger != nil {
-		l = m.Trigger.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.NewCommitSet {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *AddFile_Url) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *AddFile_Url) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Url != nil {
+		{
+			size, err := m.Url.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return len(dAtA) - i, nil
+}
+func (m *AddFile_URLSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AddFile_URLSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddFile_URLSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Force {
-		n += 2
+	if m.Recursive {
+		i--
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddFile) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Source != nil {
-		n += m.Source.Size()
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddFile_Raw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Raw != nil {
-		l = m.Raw.Size()
-		n += 1 + l + sovPfs(uint64(l))
+func (m *CopyFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *AddFile_Url) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Url != nil {
-		l = m.Url.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	return n
+
+func (m *CopyFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *AddFile_URLSource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Recursive {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *DeleteFile) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Append {
+		i--
+		if m.Append {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Src != nil {
+		{
+			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Datum) > 0 {
+		i -= len(m.Datum)
+		copy(dAtA[i:], m.Datum)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Datum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Dst) > 0 {
+		i -= len(m.Dst)
+		copy(dAtA[i:], m.Dst)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Dst)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CopyFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Dst)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Datum)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Append {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ModifyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ModifyFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ModifyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModifyFileRequest_SetCommit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ModifyFileRequest_SetCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_SetCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.SetCommit != nil {
-		l = m.SetCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		{
+			size, err := m.SetCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_AddFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ModifyFileRequest_AddFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_AddFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.AddFile != nil {
-		l = m.AddFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		{
+			size, err := m.AddFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_DeleteFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ModifyFileRequest_DeleteFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_DeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.DeleteFile != nil {
-		l = m.DeleteFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		{
+			size, err := m.DeleteFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ModifyFileRequest_CopyFile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ModifyFileRequest_CopyFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyFileRequest_CopyFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.CopyFile != nil {
-		l = m.CopyFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		{
+			size, err := m.CopyFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *GetFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.DeleteRemoved {
+		i--
+		if m.DeleteRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Offset != 0 {
-		n += 1 + sovPfs(uint64(m.Offset))
+		i = encodeVarintPfs(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *InspectFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WalkFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalkFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Shallow {
-		n += 2
+		i--
+		if m.Shallow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.OldFile != nil {
+		{
+			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.NewFile != nil {
+		{
+			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DiffFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		{
+			size, err := m.OldFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.NewFile != nil {
+		{
+			size, err := m.NewFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FsckRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fix {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *FsckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FsckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *FsckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateFileSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FsckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Fix {
+		i--
+		if m.Fix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *FsckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *FsckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RenewFileSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FsckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FileSetId)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TtlSeconds != 0 {
-		n += 1 + sovPfs(uint64(m.TtlSeconds))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Fix) > 0 {
+		i -= len(m.Fix)
+		copy(dAtA[i:], m.Fix)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Fix)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateAuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RunLoadTestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Seed != 0 {
-		n += 1 + sovPfs(uint64(m.Seed))
+	if m.OldestChunk != nil {
+		{
+			size, err := m.OldestChunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChunkBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChunkObjects != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.ChunkObjects))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TrackerObjects != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TrackerObjects))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateFileSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateFileSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateFileSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewFileSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewFileSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewFileSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TtlSeconds != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FileSetId) > 0 {
+		i -= len(m.FileSetId)
+		copy(dAtA[i:], m.FileSetId)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.FileSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunLoadTestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunLoadTestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunLoadTestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Seed != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunLoadTestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunLoadTestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunLoadTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Seed != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPfs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Repo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Branch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *File) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytesUpperBound != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.AuthInfo != nil {
+		l = m.AuthInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovPfs(uint64(m.Compression))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo_Details) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAuthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovPfs(uint64(e))
+		}
+		n += 1 + sovPfs(uint64(l)) + l
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.Subvenance) > 0 {
+		for _, e := range m.Subvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.DirectProvenance) > 0 {
+		for _, e := range m.DirectProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Protection != nil {
+		l = m.Protection.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TagInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchProtection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenyDirectCommits {
+		n += 2
+	}
+	if m.DenyRewind {
+		n += 2
+	}
+	l = len(m.RequiredPipeline)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.PromoteFrom)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	l = len(m.CronSpec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Size_)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovPfs(uint64(m.Commits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovPfs(uint64(m.Kind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ParentCommit != nil {
+		l = m.ParentCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.ChildCommits) > 0 {
+		for _, e := range m.ChildCommits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finishing != nil {
+		l = m.Finishing.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.DirectProvenance) > 0 {
+		for _, e := range m.DirectProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytesUpperBound != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytesUpperBound))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfo_Details) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileType != 0 {
+		n += 1 + sovPfs(uint64(m.FileType))
+	}
+	if m.Committed != nil {
+		l = m.Committed.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.Compression != 0 {
+		n += 1 + sovPfs(uint64(m.Compression))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectRepoStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoStorageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.LogicalBytes != 0 {
+		n += 1 + sovPfs(uint64(m.LogicalBytes))
+	}
+	if m.UniqueChunkBytes != 0 {
+		n += 1 + sovPfs(uint64(m.UniqueChunkBytes))
+	}
+	if m.SharedBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SharedBytes))
+	}
+	if m.ReclaimableBytes != 0 {
+		n += 1 + sovPfs(uint64(m.ReclaimableBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinishCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Wait != 0 {
+		n += 1 + sovPfs(uint64(m.Wait))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	if m.OriginKind != 0 {
+		n += 1 + sovPfs(uint64(m.OriginKind))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.StartedAfter != nil {
+		l = m.StartedAfter.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.StartedBefore != nil {
+		l = m.StartedBefore.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishedAfter != nil {
+		l = m.FinishedAfter.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishedBefore != nil {
+		l = m.FinishedBefore.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ErrorFilter != 0 {
+		n += 1 + sovPfs(uint64(m.ErrorFilter))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 2 + sovPfs(uint64(m.MaxSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Wait {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SquashCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitSet != nil {
+		l = m.CommitSet.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPfs(uint64(m.State))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.OriginKind != 0 {
+		n += 1 + sovPfs(uint64(m.OriginKind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClearCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovPfs(uint64(m.Resolution))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevertCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.NewCommitSet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovPfs(uint64(m.Resolution))
+	}
+	if len(m.Resolutions) > 0 {
+		for k, v := range m.Resolutions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + sovPfs(uint64(v))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Ours != nil {
+		l = m.Ours.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Theirs != nil {
+		l = m.Theirs.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovPfs(uint64(m.Resolution))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeBranchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetBranchProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Protection != nil {
+		l = m.Protection.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovPfs(uint64(m.Delimiter))
+	}
+	if m.TargetFileDatums != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	}
+	if m.TargetFileBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	}
+	if m.HeaderRecords != 0 {
+		n += 1 + sovPfs(uint64(m.HeaderRecords))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFile_Raw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Raw != nil {
+		l = m.Raw.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *AddFile_Url) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Url != nil {
+		l = m.Url.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *AddFile_URLSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Datum)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Append {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyFileRequest_SetCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetCommit != nil {
+		l = m.SetCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_AddFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddFile != nil {
+		l = m.AddFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_DeleteFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeleteFile != nil {
+		l = m.DeleteFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *ModifyFileRequest_CopyFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CopyFile != nil {
+		l = m.CopyFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+func (m *GetFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovPfs(uint64(m.Offset))
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.DeleteRemoved {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WalkFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fix {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	if m.TrackerObjects != 0 {
+		n += 1 + sovPfs(uint64(m.TrackerObjects))
+	}
+	if m.ChunkObjects != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkObjects))
+	}
+	if m.ChunkBytes != 0 {
+		n += 1 + sovPfs(uint64(m.ChunkBytes))
+	}
+	if m.OldestChunk != nil {
+		l = m.OldestChunk.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateFileSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewFileSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileSetId)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovPfs(uint64(m.TtlSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunLoadTestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovPfs(uint64(m.Seed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RunLoadTestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovPfs(uint64(m.Seed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Branch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+			}
+			m.SizeBytesUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &RepoInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v auth.Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= auth.Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPfs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPfs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]auth.Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v auth.Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= auth.Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subvenance = append(m.Subvenance, &Branch{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protection == nil {
+				m.Protection = &BranchProtection{}
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TagInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &Tag{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchProtection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchProtection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchProtection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyDirectCommits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyDirectCommits = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyRewind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyRewind = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredPipeline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredPipeline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromoteFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Size_ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &CommitOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildCommits = append(m.ChildCommits, &Commit{})
+			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finishing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finishing == nil {
+				m.Finishing = &types.Timestamp{}
+			}
+			if err := m.Finishing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+			}
+			m.SizeBytesUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &CommitInfo_Details{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RunLoadTestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Seed != 0 {
-		n += 1 + sovPfs(uint64(m.Seed))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPfs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *CommitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9826,15 +16297,15 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9862,13 +16333,100 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9878,23 +16436,25 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Commits = append(m.Commits, &CommitInfo{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9918,7 +16478,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Branch) Unmarshal(dAtA []byte) error {
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9941,15 +16501,70 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= FileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9966,28 +16581,47 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Committed == nil {
+				m.Committed = &types.Timestamp{}
+			}
+			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9997,23 +16631,25 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10037,7 +16673,7 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10060,15 +16696,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10095,16 +16731,16 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10132,13 +16768,52 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10148,23 +16823,118 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Datum = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10188,7 +16958,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10211,10 +16981,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10253,96 +17023,60 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
-			}
-			m.SizeBytesUpperBound = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectRepoStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectRepoStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectRepoStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10369,14 +17103,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branches = append(m.Branches, &Branch{})
-			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10403,16 +17139,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10439,10 +17175,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &RepoInfo_Details{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10468,7 +17204,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *RepoStorageInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10491,17 +17227,53 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoStorageInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoStorageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicalBytes", wireType)
 			}
-			m.SizeBytes = 0
+			m.LogicalBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10511,7 +17283,64 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				m.LogicalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueChunkBytes", wireType)
+			}
+			m.UniqueChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniqueChunkBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedBytes", wireType)
+			}
+			m.SharedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SharedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimableBytes", wireType)
+			}
+			m.ReclaimableBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReclaimableBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10538,7 +17367,7 @@ func (m *RepoInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10561,84 +17390,15 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v auth.Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPfs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= auth.Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPfs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPfs
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPfs
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]auth.Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v auth.Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPfs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= auth.Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10666,7 +17426,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10690,7 +17450,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10713,15 +17473,15 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10748,18 +17508,18 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10769,31 +17529,66 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10820,16 +17615,18 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Parent == nil {
+				m.Parent = &Commit{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10839,29 +17636,27 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &Branch{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10888,14 +17683,16 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10922,12 +17719,103 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10951,7 +17839,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10974,17 +17862,17 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10994,47 +17882,31 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.All = bool(v != 0)
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11062,11 +17934,11 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronSpec = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11091,86 +17963,16 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Size_ = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			m.Commits = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Commits |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.Kind = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11180,11 +17982,139 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= OriginKind(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11207,7 +18137,7 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11230,15 +18160,15 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11265,18 +18195,18 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			var stringLen uint64
+			m.Wait = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11286,24 +18216,11 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Wait |= CommitState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11326,7 +18243,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11349,15 +18266,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11384,16 +18301,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11420,18 +18337,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &CommitOrigin{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11441,29 +18358,33 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.To == nil {
+				m.To = &Commit{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11473,31 +18394,73 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Reverse = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.All = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
 			}
-			iNdEx = postIndex
-		case 5:
+			m.OriginKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginKind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11524,14 +18487,107 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildCommits = append(m.ChildCommits, &Commit{})
-			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11558,16 +18614,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.StartedAfter == nil {
+				m.StartedAfter = &types.Timestamp{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finishing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBefore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11594,16 +18650,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finishing == nil {
-				m.Finishing = &types.Timestamp{}
+			if m.StartedBefore == nil {
+				m.StartedBefore = &types.Timestamp{}
 			}
-			if err := m.Finishing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11630,16 +18686,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.FinishedAfter == nil {
+				m.FinishedAfter = &types.Timestamp{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBefore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11666,14 +18722,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedBefore == nil {
+				m.FinishedBefore = &types.Timestamp{}
+			}
+			if err := m.FinishedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11701,13 +18759,13 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytesUpperBound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorFilter", wireType)
 			}
-			m.SizeBytesUpperBound = 0
+			m.ErrorFilter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11717,16 +18775,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytesUpperBound |= int64(b&0x7F) << shift
+				m.ErrorFilter |= ListCommitRequest_ErrorFilter(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
 			}
-			var msglen int
+			m.MinSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11736,28 +18794,30 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinSizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &CommitInfo_Details{}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11780,7 +18840,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11803,17 +18863,53 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			m.SizeBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11823,11 +18919,12 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Wait = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11850,7 +18947,7 @@ func (m *CommitInfo_Details) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitSet) Unmarshal(dAtA []byte) error {
+func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11873,17 +18970,104 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			if m.CommitSet == nil {
+				m.CommitSet = &CommitSet{}
+			}
+			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11893,24 +19077,12 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11933,7 +19105,7 @@ func (m *CommitSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
+func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11956,10 +19128,10 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11999,10 +19171,10 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12012,26 +19184,12 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commits = append(m.Commits, &CommitInfo{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12054,7 +19212,7 @@ func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12077,15 +19235,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12112,18 +19270,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			m.FileType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12133,14 +19291,27 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= FileType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12167,18 +19338,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Committed == nil {
-				m.Committed = &types.Timestamp{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.SizeBytes = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12188,16 +19359,16 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				m.State |= CommitState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12207,26 +19378,31 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.All = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.OriginKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginKind |= OriginKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12249,7 +19425,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12272,15 +19448,15 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12307,65 +19483,13 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12388,7 +19512,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *RevertCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12411,15 +19535,15 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12446,67 +19570,71 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= MergeResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12534,7 +19662,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12558,7 +19686,7 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *RevertCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12581,15 +19709,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevertCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevertCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12616,18 +19744,18 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12637,12 +19765,26 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &MergeConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12665,7 +19807,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12688,15 +19830,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12723,18 +19865,18 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Head == nil {
+				m.Head = &Commit{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12744,27 +19886,31 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12791,13 +19937,67 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCommitSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewCommitSet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12820,7 +20020,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12843,15 +20043,15 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12878,50 +20078,69 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12931,27 +20150,31 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12968,7 +20191,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12991,7 +20214,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13014,15 +20237,15 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13049,18 +20272,18 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.Wait = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13070,11 +20293,12 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wait |= CommitState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13097,7 +20321,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13120,15 +20344,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13155,16 +20379,16 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Tag == nil {
+				m.Tag = &Tag{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13191,18 +20415,18 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13212,106 +20436,24 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
-			}
-			m.OriginKind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OriginKind |= OriginKind(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13334,7 +20476,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13357,15 +20499,15 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13392,84 +20534,13 @@ func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			if m.Tag == nil {
+				m.Tag = &Tag{}
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Wait = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13492,7 +20563,7 @@ func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13515,15 +20586,15 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SquashCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13550,10 +20621,10 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13579,7 +20650,7 @@ func (m *SquashCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13602,15 +20673,15 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropCommitSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13637,10 +20708,10 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommitSet == nil {
-				m.CommitSet = &CommitSet{}
+			if m.Tag == nil {
+				m.Tag = &Tag{}
 			}
-			if err := m.CommitSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13666,7 +20737,7 @@ func (m *DropCommitSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13689,15 +20760,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13724,48 +20795,16 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Source == nil {
+				m.Source = &Branch{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13792,37 +20831,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			if m.Target == nil {
+				m.Target = &Branch{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= CommitState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			var v int
+			m.Resolution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13832,17 +20852,16 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Resolution |= MergeResolution(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginKind", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolutions", wireType)
 			}
-			m.OriginKind = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13852,67 +20871,110 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OriginKind |= OriginKind(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Resolutions == nil {
+				m.Resolutions = make(map[string]MergeResolution)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue MergeResolution
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= MergeResolution(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClearCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Resolutions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13922,27 +20984,23 @@ func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13966,7 +21024,7 @@ func (m *ClearCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeConflict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13989,53 +21047,17 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeConflict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeConflict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &Commit{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14045,31 +21067,27 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ours", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14096,14 +21114,16 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Ours == nil {
+				m.Ours = &FileInfo{}
+			}
+			if err := m.Ours.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Theirs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14130,18 +21150,18 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
+			if m.Theirs == nil {
+				m.Theirs = &FileInfo{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Theirs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCommitSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			var v int
+			m.Resolution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14151,12 +21171,11 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Resolution |= MergeResolution(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NewCommitSet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14179,7 +21198,7 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeBranchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14202,15 +21221,15 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeBranchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeBranchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14237,67 +21256,16 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14324,33 +21292,11 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conflicts = append(m.Conflicts, &MergeConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14373,7 +21319,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *SetBranchProtectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14396,10 +21342,10 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBranchProtectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBranchProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14439,10 +21385,10 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protection", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14452,12 +21398,28 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protection == nil {
+				m.Protection = &BranchProtection{}
+			}
+			if err := m.Protection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14643,6 +21605,82 @@ func (m *AddFile) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = &AddFile_Url{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			}
+			m.Delimiter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delimiter |= Delimiter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
+			}
+			m.TargetFileDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileDatums |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			}
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRecords", wireType)
+			}
+			m.HeaderRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRecords |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15340,13 +22378,68 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &Commit{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRemoved", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15356,11 +22449,12 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DeleteRemoved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16215,6 +23309,277 @@ func (m *FsckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackerObjects", wireType)
+			}
+			m.TrackerObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackerObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkObjects", wireType)
+			}
+			m.ChunkObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkBytes", wireType)
+			}
+			m.ChunkBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestChunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestChunk == nil {
+				m.OldestChunk = &types.Timestamp{}
+			}
+			if err := m.OldestChunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &types.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CreateFileSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
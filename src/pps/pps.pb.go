@@ -203,6 +203,61 @@ func (PipelineState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_beade573c128ccc7, []int{3}
 }
 
+type SQLDatabaseEgress_Mode int32
+
+const (
+	// The rows from each output commit are added to the table.
+	SQLDatabaseEgress_APPEND SQLDatabaseEgress_Mode = 0
+	// The rows in the table are replaced by the rows from each output commit.
+	SQLDatabaseEgress_REPLACE SQLDatabaseEgress_Mode = 1
+)
+
+var SQLDatabaseEgress_Mode_name = map[int32]string{
+	0: "APPEND",
+	1: "REPLACE",
+}
+
+var SQLDatabaseEgress_Mode_value = map[string]int32{
+	"APPEND":  0,
+	"REPLACE": 1,
+}
+
+func (x SQLDatabaseEgress_Mode) String() string {
+	return proto.EnumName(SQLDatabaseEgress_Mode_name, int32(x))
+}
+
+func (SQLDatabaseEgress_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4, 0}
+}
+
+type SQLDatabaseEgress_FileFormat_Type int32
+
+const (
+	SQLDatabaseEgress_FileFormat_UNKNOWN SQLDatabaseEgress_FileFormat_Type = 0
+	SQLDatabaseEgress_FileFormat_CSV     SQLDatabaseEgress_FileFormat_Type = 1
+	SQLDatabaseEgress_FileFormat_JSON    SQLDatabaseEgress_FileFormat_Type = 2
+)
+
+var SQLDatabaseEgress_FileFormat_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CSV",
+	2: "JSON",
+}
+
+var SQLDatabaseEgress_FileFormat_Type_value = map[string]int32{
+	"UNKNOWN": 0,
+	"CSV":     1,
+	"JSON":    2,
+}
+
+func (x SQLDatabaseEgress_FileFormat_Type) String() string {
+	return proto.EnumName(SQLDatabaseEgress_FileFormat_Type_name, int32(x))
+}
+
+func (SQLDatabaseEgress_FileFormat_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4, 1, 0}
+}
+
 // The pipeline type is stored here so that we can internally know the type of
 // the pipeline without loading the spec from PFS.
 type PipelineInfo_PipelineType int32
@@ -233,7 +288,7 @@ func (x PipelineInfo_PipelineType) String() string {
 }
 
 func (PipelineInfo_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 0}
+	return fileDescriptor_beade573c128ccc7, []int{31, 0}
 }
 
 type SecretMount struct {
@@ -503,10 +558,19 @@ func (m *TFJob) GetTFJob() string {
 }
 
 type Egress struct {
-	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	// If true, only the files that changed since the last egressed output commit
+	// are written to URL, rather than the whole output commit.
+	Incremental bool `protobuf:"varint,2,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// If true, incremental egress also deletes the files that were removed since
+	// the last egressed output commit from URL.
+	DeleteRemoved bool `protobuf:"varint,3,opt,name=delete_removed,json=deleteRemoved,proto3" json:"delete_removed,omitempty"`
+	// If set, the output files are loaded into a database table rather than
+	// written to URL.
+	SQLDatabase          *SQLDatabaseEgress `protobuf:"bytes,4,opt,name=sql_database,json=sqlDatabase,proto3" json:"sql_database,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Egress) Reset()         { *m = Egress{} }
@@ -549,6 +613,240 @@ func (m *Egress) GetURL() string {
 	return ""
 }
 
+func (m *Egress) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+func (m *Egress) GetDeleteRemoved() bool {
+	if m != nil {
+		return m.DeleteRemoved
+	}
+	return false
+}
+
+func (m *Egress) GetSQLDatabase() *SQLDatabaseEgress {
+	if m != nil {
+		return m.SQLDatabase
+	}
+	return nil
+}
+
+type SQLDatabaseEgress struct {
+	// The URL of the database, e.g. "postgres://user@host:5432/db" or
+	// "mysql://user@host:3306/db". The password is read from secret.
+	URL        string                        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret     *SQLDatabaseEgress_Secret     `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	FileFormat *SQLDatabaseEgress_FileFormat `protobuf:"bytes,3,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty"`
+	// The schema of the table. If empty, the database's default is used.
+	Schema               string                 `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table                string                 `protobuf:"bytes,5,opt,name=table,proto3" json:"table,omitempty"`
+	Mode                 SQLDatabaseEgress_Mode `protobuf:"varint,6,opt,name=mode,proto3,enum=pps_v2.SQLDatabaseEgress_Mode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SQLDatabaseEgress) Reset()         { *m = SQLDatabaseEgress{} }
+func (m *SQLDatabaseEgress) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress) ProtoMessage()    {}
+func (*SQLDatabaseEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4}
+}
+func (m *SQLDatabaseEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLDatabaseEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLDatabaseEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress.Merge(m, src)
+}
+func (m *SQLDatabaseEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLDatabaseEgress proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *SQLDatabaseEgress) GetSecret() *SQLDatabaseEgress_Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *SQLDatabaseEgress) GetFileFormat() *SQLDatabaseEgress_FileFormat {
+	if m != nil {
+		return m.FileFormat
+	}
+	return nil
+}
+
+func (m *SQLDatabaseEgress) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *SQLDatabaseEgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *SQLDatabaseEgress) GetMode() SQLDatabaseEgress_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return SQLDatabaseEgress_APPEND
+}
+
+type SQLDatabaseEgress_Secret struct {
+	// Name must be the name of the secret in kubernetes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Key of the password in the secret.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLDatabaseEgress_Secret) Reset()         { *m = SQLDatabaseEgress_Secret{} }
+func (m *SQLDatabaseEgress_Secret) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress_Secret) ProtoMessage()    {}
+func (*SQLDatabaseEgress_Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4, 0}
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress_Secret.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress_Secret.Merge(m, src)
+}
+func (m *SQLDatabaseEgress_Secret) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress_Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress_Secret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLDatabaseEgress_Secret proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress_Secret) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SQLDatabaseEgress_Secret) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type SQLDatabaseEgress_FileFormat struct {
+	Type SQLDatabaseEgress_FileFormat_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pps_v2.SQLDatabaseEgress_FileFormat_Type" json:"type,omitempty"`
+	// The columns that the fields of each CSV record, in order, or the keys of
+	// each JSON object, are loaded into.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// If true, the first record of each CSV file is a header and is skipped.
+	Header               bool     `protobuf:"varint,3,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLDatabaseEgress_FileFormat) Reset()         { *m = SQLDatabaseEgress_FileFormat{} }
+func (m *SQLDatabaseEgress_FileFormat) String() string { return proto.CompactTextString(m) }
+func (*SQLDatabaseEgress_FileFormat) ProtoMessage()    {}
+func (*SQLDatabaseEgress_FileFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{4, 1}
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLDatabaseEgress_FileFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLDatabaseEgress_FileFormat.Merge(m, src)
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLDatabaseEgress_FileFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLDatabaseEgress_FileFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLDatabaseEgress_FileFormat proto.InternalMessageInfo
+
+func (m *SQLDatabaseEgress_FileFormat) GetType() SQLDatabaseEgress_FileFormat_Type {
+	if m != nil {
+		return m.Type
+	}
+	return SQLDatabaseEgress_FileFormat_UNKNOWN
+}
+
+func (m *SQLDatabaseEgress_FileFormat) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *SQLDatabaseEgress_FileFormat) GetHeader() bool {
+	if m != nil {
+		return m.Header
+	}
+	return false
+}
+
 type Job struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	ID                   string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -560,7 +858,7 @@ type Job struct {
 func (m *Job) Reset()      { *m = Job{} }
 func (*Job) ProtoMessage() {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{4}
+	return fileDescriptor_beade573c128ccc7, []int{5}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +913,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{5}
+	return fileDescriptor_beade573c128ccc7, []int{6}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +970,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{6}
+	return fileDescriptor_beade573c128ccc7, []int{7}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +1038,7 @@ func (m *Spout) Reset()         { *m = Spout{} }
 func (m *Spout) String() string { return proto.CompactTextString(m) }
 func (*Spout) ProtoMessage()    {}
 func (*Spout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{7}
+	return fileDescriptor_beade573c128ccc7, []int{8}
 }
 func (m *Spout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,17 +1097,36 @@ type PFSInput struct {
 	S3 bool `protobuf:"varint,11,opt,name=s3,proto3" json:"s3,omitempty"`
 	// Trigger defines when this input is processed by the pipeline, if it's nil
 	// the input is processed anytime something is committed to the input branch.
-	Trigger              *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Trigger *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// Exclude is a list of glob patterns, paths matched by 'glob' that also
+	// match any of these patterns do not produce datums. Patterns that don't
+	// contain a '/' are matched against the base name of the path (e.g. "*.tmp"
+	// or "_SUCCESS"), all others are matched against the full path.
+	Exclude []string `protobuf:"bytes,14,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// MinSizeBytes and MaxSizeBytes, if non-zero, restrict datums to paths
+	// whose size (the total size of the contents for directories) is within
+	// the given bounds, inclusive.
+	MinSizeBytes int64 `protobuf:"varint,15,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	MaxSizeBytes int64 `protobuf:"varint,16,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// ContentKey, if set, derives the join and group keys of this input from
+	// the contents of each file matched by 'glob', rather than from its path.
+	// It can't be combined with 'join_on' or 'group_by'.
+	ContentKey *ContentKey `protobuf:"bytes,17,opt,name=content_key,json=contentKey,proto3" json:"content_key,omitempty"`
+	// ModifiedAfter and ModifiedBefore, if set, restrict datums to paths whose
+	// committed time (see pfs.FileInfo.committed) is within the given bounds,
+	// inclusive.
+	ModifiedAfter        *types.Timestamp `protobuf:"bytes,18,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
+	ModifiedBefore       *types.Timestamp `protobuf:"bytes,19,opt,name=modified_before,json=modifiedBefore,proto3" json:"modified_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *PFSInput) Reset()         { *m = PFSInput{} }
 func (m *PFSInput) String() string { return proto.CompactTextString(m) }
 func (*PFSInput) ProtoMessage()    {}
 func (*PFSInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{8}
+	return fileDescriptor_beade573c128ccc7, []int{9}
 }
 func (m *PFSInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,32 +1246,80 @@ func (m *PFSInput) GetTrigger() *pfs.Trigger {
 	return nil
 }
 
-type CronInput struct {
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
-	Spec   string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-	// Overwrite, if true, will expose a single datum that gets overwritten each
-	// tick. If false, it will create a new datum for each tick.
-	Overwrite            bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	Start                *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *PFSInput) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
 }
 
-func (m *CronInput) Reset()         { *m = CronInput{} }
-func (m *CronInput) String() string { return proto.CompactTextString(m) }
-func (*CronInput) ProtoMessage()    {}
-func (*CronInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{9}
+func (m *PFSInput) GetMinSizeBytes() int64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
 }
-func (m *CronInput) XXX_Unmarshal(b []byte) error {
+
+func (m *PFSInput) GetMaxSizeBytes() int64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
+}
+
+func (m *PFSInput) GetContentKey() *ContentKey {
+	if m != nil {
+		return m.ContentKey
+	}
+	return nil
+}
+
+func (m *PFSInput) GetModifiedAfter() *types.Timestamp {
+	if m != nil {
+		return m.ModifiedAfter
+	}
+	return nil
+}
+
+func (m *PFSInput) GetModifiedBefore() *types.Timestamp {
+	if m != nil {
+		return m.ModifiedBefore
+	}
+	return nil
+}
+
+// ContentKey describes how a join or group key is read from the contents of
+// a file. If json_path is set, the file is parsed as a JSON document,
+// otherwise the key is read from a delimited line of the file.
+type ContentKey struct {
+	// JSONPath is a jq path expression (e.g. ".user.id") that selects the key
+	// from the document. Non-string values are converted to their JSON
+	// representation.
+	JSONPath string `protobuf:"bytes,1,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	// Line is the index of the line that holds the key, 0 being the first line.
+	Line int64 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Field is the index of the field within the line that holds the key, 1
+	// being the first field. If it's 0, the whole line is used.
+	Field int64 `protobuf:"varint,3,opt,name=field,proto3" json:"field,omitempty"`
+	// Delimiter separates the fields in a line, it defaults to ",".
+	Delimiter            string   `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContentKey) Reset()         { *m = ContentKey{} }
+func (m *ContentKey) String() string { return proto.CompactTextString(m) }
+func (*ContentKey) ProtoMessage()    {}
+func (*ContentKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{10}
+}
+func (m *ContentKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CronInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContentKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CronInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContentKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -964,35 +1329,110 @@ func (m *CronInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CronInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CronInput.Merge(m, src)
+func (m *ContentKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentKey.Merge(m, src)
 }
-func (m *CronInput) XXX_Size() int {
+func (m *ContentKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *CronInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_CronInput.DiscardUnknown(m)
+func (m *ContentKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CronInput proto.InternalMessageInfo
+var xxx_messageInfo_ContentKey proto.InternalMessageInfo
 
-func (m *CronInput) GetName() string {
+func (m *ContentKey) GetJSONPath() string {
 	if m != nil {
-		return m.Name
+		return m.JSONPath
 	}
 	return ""
 }
 
-func (m *CronInput) GetRepo() string {
+func (m *ContentKey) GetLine() int64 {
 	if m != nil {
-		return m.Repo
+		return m.Line
 	}
-	return ""
+	return 0
 }
 
-func (m *CronInput) GetCommit() string {
+func (m *ContentKey) GetField() int64 {
 	if m != nil {
-		return m.Commit
+		return m.Field
+	}
+	return 0
+}
+
+func (m *ContentKey) GetDelimiter() string {
+	if m != nil {
+		return m.Delimiter
+	}
+	return ""
+}
+
+type CronInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Spec   string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Overwrite, if true, will expose a single datum that gets overwritten each
+	// tick. If false, it will create a new datum for each tick.
+	Overwrite            bool             `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Start                *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CronInput) Reset()         { *m = CronInput{} }
+func (m *CronInput) String() string { return proto.CompactTextString(m) }
+func (*CronInput) ProtoMessage()    {}
+func (*CronInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{11}
+}
+func (m *CronInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronInput.Merge(m, src)
+}
+func (m *CronInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronInput proto.InternalMessageInfo
+
+func (m *CronInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CronInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *CronInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
 	}
 	return ""
 }
@@ -1018,6 +1458,108 @@ func (m *CronInput) GetStart() *types.Timestamp {
 	return nil
 }
 
+// URLInput keeps a repo in sync with a prefix in object storage. On each tick
+// of spec, the objects under url whose size or ETag changed since the last
+// tick are copied into repo in a single commit.
+type URLInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// The object storage prefix to sync, e.g. "s3://bucket/dir".
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// The schedule on which url is polled, in the same format as CronInput.spec.
+	Spec string `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	Glob string `protobuf:"bytes,6,opt,name=glob,proto3" json:"glob,omitempty"`
+	// If true, files are deleted from repo when their objects are removed from
+	// url.
+	DeleteRemoved        bool     `protobuf:"varint,7,opt,name=delete_removed,json=deleteRemoved,proto3" json:"delete_removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *URLInput) Reset()         { *m = URLInput{} }
+func (m *URLInput) String() string { return proto.CompactTextString(m) }
+func (*URLInput) ProtoMessage()    {}
+func (*URLInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{12}
+}
+func (m *URLInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *URLInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_URLInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *URLInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_URLInput.Merge(m, src)
+}
+func (m *URLInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *URLInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_URLInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_URLInput proto.InternalMessageInfo
+
+func (m *URLInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *URLInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *URLInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *URLInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *URLInput) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *URLInput) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *URLInput) GetDeleteRemoved() bool {
+	if m != nil {
+		return m.DeleteRemoved
+	}
+	return false
+}
+
 type Input struct {
 	Pfs                  *PFSInput  `protobuf:"bytes,1,opt,name=pfs,proto3" json:"pfs,omitempty"`
 	Join                 []*Input   `protobuf:"bytes,2,rep,name=join,proto3" json:"join,omitempty"`
@@ -1025,6 +1567,7 @@ type Input struct {
 	Cross                []*Input   `protobuf:"bytes,4,rep,name=cross,proto3" json:"cross,omitempty"`
 	Union                []*Input   `protobuf:"bytes,5,rep,name=union,proto3" json:"union,omitempty"`
 	Cron                 *CronInput `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	URL                  *URLInput  `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1034,7 +1577,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{10}
+	return fileDescriptor_beade573c128ccc7, []int{13}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,6 +1648,13 @@ func (m *Input) GetCron() *CronInput {
 	return nil
 }
 
+func (m *Input) GetURL() *URLInput {
+	if m != nil {
+		return m.URL
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Commit               *pfs.Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1119,7 +1669,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{11}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1741,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1791,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1297,7 +1847,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{17}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,11 +1891,14 @@ func (m *Datum) GetID() string {
 }
 
 type DatumInfo struct {
-	Datum                *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
-	State                DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
-	Stats                *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
-	PfsState             *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
-	Data                 []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Datum    *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	State    DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
+	Stats    *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	PfsState *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
+	Data     []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	// Attempts records each time the datum was run by the most recent job that
+	// processed it, in order.
+	Attempts             []*DatumAttempt `protobuf:"bytes,6,rep,name=attempts,proto3" json:"attempts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1355,7 +1908,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{18}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,6 +1972,107 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
+func (m *DatumInfo) GetAttempts() []*DatumAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+// DatumAttempt describes a single run of the user code on a datum.
+type DatumAttempt struct {
+	Started  *types.Timestamp `protobuf:"bytes,1,opt,name=started,proto3" json:"started,omitempty"`
+	Duration *types.Duration  `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Error is empty if the attempt succeeded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// ExitCode is the exit code of the user code, or -1 if it didn't exit
+	// normally (e.g. it was killed by a signal or timed out).
+	ExitCode int32 `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// OOMKilled is true if the user code was killed by the kernel, which in a
+	// worker almost always means it ran out of memory.
+	OOMKilled bool `protobuf:"varint,5,opt,name=oom_killed,json=oomKilled,proto3" json:"oom_killed,omitempty"`
+	// StderrTail is the end of what the user code wrote to stderr, if it failed.
+	StderrTail           string   `protobuf:"bytes,6,opt,name=stderr_tail,json=stderrTail,proto3" json:"stderr_tail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumAttempt) Reset()         { *m = DatumAttempt{} }
+func (m *DatumAttempt) String() string { return proto.CompactTextString(m) }
+func (*DatumAttempt) ProtoMessage()    {}
+func (*DatumAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{19}
+}
+func (m *DatumAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumAttempt.Merge(m, src)
+}
+func (m *DatumAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumAttempt proto.InternalMessageInfo
+
+func (m *DatumAttempt) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *DatumAttempt) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *DatumAttempt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DatumAttempt) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *DatumAttempt) GetOOMKilled() bool {
+	if m != nil {
+		return m.OOMKilled
+	}
+	return false
+}
+
+func (m *DatumAttempt) GetStderrTail() string {
+	if m != nil {
+		return m.StderrTail
+	}
+	return ""
+}
+
 type Aggregate struct {
 	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -1434,7 +2088,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1513,7 +2167,7 @@ func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +2246,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,7 +2323,7 @@ func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +2386,7 @@ func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
 func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
 func (*DatumStatus) ProtoMessage()    {}
 func (*DatumStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{24}
 }
 func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,7 +2452,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +2523,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1924,7 +2578,7 @@ func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
 func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
 func (*JobSetInfo) ProtoMessage()    {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+	return fileDescriptor_beade573c128ccc7, []int{27}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1984,23 +2638,30 @@ type JobInfo struct {
 	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered int64 `protobuf:"varint,9,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
 	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
-	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Created              *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
-	Details              *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Stats    *ProcessStats    `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	State    JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps_v2.JobState" json:"state,omitempty"`
+	Reason   string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Created  *types.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Started  *types.Timestamp `protobuf:"bytes,14,opt,name=started,proto3" json:"started,omitempty"`
+	Finished *types.Timestamp `protobuf:"bytes,15,opt,name=finished,proto3" json:"finished,omitempty"`
+	Details  *JobInfo_Details `protobuf:"bytes,16,opt,name=details,proto3" json:"details,omitempty"`
+	// The output commit that incremental egress diffed this job's output commit
+	// against. It is recorded before egress starts, so that a restarted egress
+	// writes the same files.
+	EgressBase *pfs.Commit `protobuf:"bytes,17,opt,name=egress_base,json=egressBase,proto3" json:"egress_base,omitempty"`
+	// The metadata of the user commits that triggered this job, i.e. those in
+	// the job's commit set. It's set once the job's inputs are finished.
+	Metadata             map[string]string `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,35 +2802,51 @@ func (m *JobInfo) GetDetails() *JobInfo_Details {
 	return nil
 }
 
+func (m *JobInfo) GetEgressBase() *pfs.Commit {
+	if m != nil {
+		return m.EgressBase
+	}
+	return nil
+}
+
+func (m *JobInfo) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type JobInfo_Details struct {
-	Transform             *Transform       `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
-	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                *Egress          `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
-	Service               *Service         `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                 *Spout           `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
-	WorkerStatus          []*WorkerStatus  `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
-	ResourceRequests      *ResourceSpec    `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits        *ResourceSpec    `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                 *Input           `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Salt                  string           `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout          *types.Duration  `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout            *types.Duration  `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries            int64            `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec               string           `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch              string           `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	Transform              *Transform        `protobuf:"bytes,1,opt,name=transform,proto3" json:"transform,omitempty"`
+	ParallelismSpec        *ParallelismSpec  `protobuf:"bytes,2,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                 *Egress           `protobuf:"bytes,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	Service                *Service          `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                  *Spout            `protobuf:"bytes,5,opt,name=spout,proto3" json:"spout,omitempty"`
+	WorkerStatus           []*WorkerStatus   `protobuf:"bytes,6,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
+	ResourceRequests       *ResourceSpec     `protobuf:"bytes,7,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits         *ResourceSpec     `protobuf:"bytes,8,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits  *ResourceSpec     `protobuf:"bytes,9,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                  *Input            `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Salt                   string            `protobuf:"bytes,11,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumSetSpec           *DatumSetSpec     `protobuf:"bytes,12,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout           *types.Duration   `protobuf:"bytes,13,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout             *types.Duration   `protobuf:"bytes,14,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries             int64             `protobuf:"varint,15,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec         *SchedulingSpec   `protobuf:"bytes,16,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec                string            `protobuf:"bytes,17,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch               string            `protobuf:"bytes,18,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	DatumRetryPolicy       *DatumRetryPolicy `protobuf:"bytes,19,opt,name=datum_retry_policy,json=datumRetryPolicy,proto3" json:"datum_retry_policy,omitempty"`
+	QuarantineFailedDatums bool              `protobuf:"varint,20,opt,name=quarantine_failed_datums,json=quarantineFailedDatums,proto3" json:"quarantine_failed_datums,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
 }
 
 func (m *JobInfo_Details) Reset()         { *m = JobInfo_Details{} }
 func (m *JobInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*JobInfo_Details) ProtoMessage()    {}
 func (*JobInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24, 0}
+	return fileDescriptor_beade573c128ccc7, []int{28, 0}
 }
 func (m *JobInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2324,6 +3001,20 @@ func (m *JobInfo_Details) GetPodPatch() string {
 	return ""
 }
 
+func (m *JobInfo_Details) GetDatumRetryPolicy() *DatumRetryPolicy {
+	if m != nil {
+		return m.DatumRetryPolicy
+	}
+	return nil
+}
+
+func (m *JobInfo_Details) GetQuarantineFailedDatums() bool {
+	if m != nil {
+		return m.QuarantineFailedDatums
+	}
+	return false
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps_v2.WorkerState" json:"state,omitempty"`
@@ -2336,7 +3027,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{29}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2390,7 +3081,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{30}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,7 +3152,7 @@ func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{31}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2579,46 +3270,48 @@ type PipelineInfo_Details struct {
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
 	// when running in a kubernetes cluster on which kubeflow has been installed.
 	// Exactly one of 'tf_job' and 'transform' should be set
-	TFJob                 *TFJob           `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
-	ParallelismSpec       *ParallelismSpec `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress                *Egress          `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
-	CreatedAt             *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	RecentError           string           `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
-	WorkersRequested      int64            `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
-	WorkersAvailable      int64            `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
-	OutputBranch          string           `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	ResourceRequests      *ResourceSpec    `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits        *ResourceSpec    `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	SidecarResourceLimits *ResourceSpec    `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
-	Input                 *Input           `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
-	Description           string           `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
-	Salt                  string           `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
-	Reason                string           `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
-	Service               *Service         `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                 *Spout           `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec          *DatumSetSpec    `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout          *types.Duration  `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout            *types.Duration  `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries            int64            `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec               string           `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch              string           `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                 bool             `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata              *Metadata        `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec         string           `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	UnclaimedTasks        int64            `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
-	WorkerRc              string           `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
-	Autoscaling           bool             `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+	TFJob                  *TFJob            `protobuf:"bytes,2,opt,name=tf_job,json=tfJob,proto3" json:"tf_job,omitempty"`
+	ParallelismSpec        *ParallelismSpec  `protobuf:"bytes,3,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress                 *Egress           `protobuf:"bytes,4,opt,name=egress,proto3" json:"egress,omitempty"`
+	CreatedAt              *types.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RecentError            string            `protobuf:"bytes,6,opt,name=recent_error,json=recentError,proto3" json:"recent_error,omitempty"`
+	WorkersRequested       int64             `protobuf:"varint,7,opt,name=workers_requested,json=workersRequested,proto3" json:"workers_requested,omitempty"`
+	WorkersAvailable       int64             `protobuf:"varint,8,opt,name=workers_available,json=workersAvailable,proto3" json:"workers_available,omitempty"`
+	OutputBranch           string            `protobuf:"bytes,9,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	ResourceRequests       *ResourceSpec     `protobuf:"bytes,10,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits         *ResourceSpec     `protobuf:"bytes,11,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	SidecarResourceLimits  *ResourceSpec     `protobuf:"bytes,12,opt,name=sidecar_resource_limits,json=sidecarResourceLimits,proto3" json:"sidecar_resource_limits,omitempty"`
+	Input                  *Input            `protobuf:"bytes,13,opt,name=input,proto3" json:"input,omitempty"`
+	Description            string            `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Salt                   string            `protobuf:"bytes,16,opt,name=salt,proto3" json:"salt,omitempty"`
+	Reason                 string            `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
+	Service                *Service          `protobuf:"bytes,19,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                  *Spout            `protobuf:"bytes,20,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec           *DatumSetSpec     `protobuf:"bytes,21,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout           *types.Duration   `protobuf:"bytes,22,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout             *types.Duration   `protobuf:"bytes,23,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries             int64             `protobuf:"varint,24,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec         *SchedulingSpec   `protobuf:"bytes,25,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec                string            `protobuf:"bytes,26,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch               string            `protobuf:"bytes,27,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out                  bool              `protobuf:"varint,28,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata               *Metadata         `protobuf:"bytes,29,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec          string            `protobuf:"bytes,30,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	UnclaimedTasks         int64             `protobuf:"varint,31,opt,name=unclaimed_tasks,json=unclaimedTasks,proto3" json:"unclaimed_tasks,omitempty"`
+	WorkerRc               string            `protobuf:"bytes,32,opt,name=worker_rc,json=workerRc,proto3" json:"worker_rc,omitempty"`
+	Autoscaling            bool              `protobuf:"varint,33,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DatumRetryPolicy       *DatumRetryPolicy `protobuf:"bytes,34,opt,name=datum_retry_policy,json=datumRetryPolicy,proto3" json:"datum_retry_policy,omitempty"`
+	QuarantineFailedDatums bool              `protobuf:"varint,35,opt,name=quarantine_failed_datums,json=quarantineFailedDatums,proto3" json:"quarantine_failed_datums,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
 }
 
 func (m *PipelineInfo_Details) Reset()         { *m = PipelineInfo_Details{} }
 func (m *PipelineInfo_Details) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo_Details) ProtoMessage()    {}
 func (*PipelineInfo_Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27, 1}
+	return fileDescriptor_beade573c128ccc7, []int{31, 1}
 }
 func (m *PipelineInfo_Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2864,6 +3557,20 @@ func (m *PipelineInfo_Details) GetAutoscaling() bool {
 	return false
 }
 
+func (m *PipelineInfo_Details) GetDatumRetryPolicy() *DatumRetryPolicy {
+	if m != nil {
+		return m.DatumRetryPolicy
+	}
+	return nil
+}
+
+func (m *PipelineInfo_Details) GetQuarantineFailedDatums() bool {
+	if m != nil {
+		return m.QuarantineFailedDatums
+	}
+	return false
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -2875,7 +3582,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
+	return fileDescriptor_beade573c128ccc7, []int{32}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2922,7 +3629,7 @@ func (m *JobSet) Reset()         { *m = JobSet{} }
 func (m *JobSet) String() string { return proto.CompactTextString(m) }
 func (*JobSet) ProtoMessage()    {}
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *JobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2971,7 +3678,7 @@ func (m *InspectJobSetRequest) Reset()         { *m = InspectJobSetRequest{} }
 func (m *InspectJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobSetRequest) ProtoMessage()    {}
 func (*InspectJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *InspectJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3032,7 +3739,7 @@ func (m *ListJobSetRequest) Reset()         { *m = ListJobSetRequest{} }
 func (m *ListJobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobSetRequest) ProtoMessage()    {}
 func (*ListJobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *ListJobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3082,7 +3789,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{36}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3160,7 +3867,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{37}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3237,7 +3944,7 @@ func (m *SubscribeJobRequest) Reset()         { *m = SubscribeJobRequest{} }
 func (m *SubscribeJobRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeJobRequest) ProtoMessage()    {}
 func (*SubscribeJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *SubscribeJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3291,7 +3998,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3339,7 +4046,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3402,7 +4109,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3537,7 +4244,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3655,7 +4362,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{43}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3759,7 +4466,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{44}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3813,7 +4520,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3857,7 +4564,14 @@ type ListDatumRequest struct {
 	// Input is the input to list datums from.
 	// The datums listed are the ones that would be run if a pipeline was created
 	// with the provided input.
-	Input                *Input   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Input  *Input                   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Filter *ListDatumRequest_Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Datums are returned in a stable order. If pagination_marker is set, only
+	// the datums after the datum with this ID are returned, so a listing can be
+	// resumed by passing the ID of the last datum received.
+	PaginationMarker string `protobuf:"bytes,4,opt,name=pagination_marker,json=paginationMarker,proto3" json:"pagination_marker,omitempty"`
+	// number, if nonzero, is the maximum number of datums to return.
+	Number               int64    `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3867,7 +4581,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{46}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,6 +4624,174 @@ func (m *ListDatumRequest) GetInput() *Input {
 	return nil
 }
 
+func (m *ListDatumRequest) GetFilter() *ListDatumRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListDatumRequest) GetPaginationMarker() string {
+	if m != nil {
+		return m.PaginationMarker
+	}
+	return ""
+}
+
+func (m *ListDatumRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// Filter restricts returned DatumInfo messages to those which match
+// all of the filtered attributes.
+type ListDatumRequest_Filter struct {
+	State                []DatumState `protobuf:"varint,1,rep,packed,name=state,proto3,enum=pps_v2.DatumState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListDatumRequest_Filter) Reset()         { *m = ListDatumRequest_Filter{} }
+func (m *ListDatumRequest_Filter) String() string { return proto.CompactTextString(m) }
+func (*ListDatumRequest_Filter) ProtoMessage()    {}
+func (*ListDatumRequest_Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{46, 0}
+}
+func (m *ListDatumRequest_Filter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDatumRequest_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDatumRequest_Filter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDatumRequest_Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatumRequest_Filter.Merge(m, src)
+}
+func (m *ListDatumRequest_Filter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDatumRequest_Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatumRequest_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatumRequest_Filter proto.InternalMessageInfo
+
+func (m *ListDatumRequest_Filter) GetState() []DatumState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// DatumRetryPolicy specifies how a pipeline retries datums that fail.
+type DatumRetryPolicy struct {
+	// max_attempts is the maximum number of times a datum is tried, it
+	// overrides datum_tries if nonzero.
+	MaxAttempts int64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// initial_backoff is how long to wait before the first retry of a datum.
+	// The wait doubles with each subsequent retry, up to max_backoff. If it's
+	// unset, datums are retried immediately.
+	InitialBackoff *types.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *types.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// retry_exit_codes, if nonempty, restricts retries to failures where the
+	// user code exited with one of these codes.
+	RetryExitCodes []int32 `protobuf:"varint,4,rep,packed,name=retry_exit_codes,json=retryExitCodes,proto3" json:"retry_exit_codes,omitempty"`
+	// fail_fast_exit_codes are exit codes that are never retried.
+	FailFastExitCodes []int32 `protobuf:"varint,5,rep,packed,name=fail_fast_exit_codes,json=failFastExitCodes,proto3" json:"fail_fast_exit_codes,omitempty"`
+	// retry_oom_killed_only restricts retries to failures where the user code
+	// was killed for running out of memory.
+	RetryOOMKilledOnly   bool     `protobuf:"varint,6,opt,name=retry_oom_killed_only,json=retryOomKilledOnly,proto3" json:"retry_oom_killed_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatumRetryPolicy) Reset()         { *m = DatumRetryPolicy{} }
+func (m *DatumRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*DatumRetryPolicy) ProtoMessage()    {}
+func (*DatumRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{47}
+}
+func (m *DatumRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumRetryPolicy.Merge(m, src)
+}
+func (m *DatumRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumRetryPolicy proto.InternalMessageInfo
+
+func (m *DatumRetryPolicy) GetMaxAttempts() int64 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *DatumRetryPolicy) GetInitialBackoff() *types.Duration {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return nil
+}
+
+func (m *DatumRetryPolicy) GetMaxBackoff() *types.Duration {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return nil
+}
+
+func (m *DatumRetryPolicy) GetRetryExitCodes() []int32 {
+	if m != nil {
+		return m.RetryExitCodes
+	}
+	return nil
+}
+
+func (m *DatumRetryPolicy) GetFailFastExitCodes() []int32 {
+	if m != nil {
+		return m.FailFastExitCodes
+	}
+	return nil
+}
+
+func (m *DatumRetryPolicy) GetRetryOOMKilledOnly() bool {
+	if m != nil {
+		return m.RetryOOMKilledOnly
+	}
+	return false
+}
+
 // DatumSetSpec specifies how a pipeline should split its datums into datum sets.
 type DatumSetSpec struct {
 	// number, if nonzero, specifies that each datum set should contain `number`
@@ -3932,7 +4814,7 @@ func (m *DatumSetSpec) Reset()         { *m = DatumSetSpec{} }
 func (m *DatumSetSpec) String() string { return proto.CompactTextString(m) }
 func (*DatumSetSpec) ProtoMessage()    {}
 func (*DatumSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *DatumSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4876,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4061,31 +4943,36 @@ type CreatePipelineRequest struct {
 	Description           string        `protobuf:"bytes,13,opt,name=description,proto3" json:"description,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Service              *Service        `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
-	DatumSetSpec         *DatumSetSpec   `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
-	DatumTries           int64           `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	Autoscaling          bool            `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess        bool              `protobuf:"varint,15,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Service          *Service          `protobuf:"bytes,17,opt,name=service,proto3" json:"service,omitempty"`
+	Spout            *Spout            `protobuf:"bytes,18,opt,name=spout,proto3" json:"spout,omitempty"`
+	DatumSetSpec     *DatumSetSpec     `protobuf:"bytes,19,opt,name=datum_set_spec,json=datumSetSpec,proto3" json:"datum_set_spec,omitempty"`
+	DatumTimeout     *types.Duration   `protobuf:"bytes,20,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout       *types.Duration   `protobuf:"bytes,21,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt             string            `protobuf:"bytes,22,opt,name=salt,proto3" json:"salt,omitempty"`
+	DatumTries       int64             `protobuf:"varint,23,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec   *SchedulingSpec   `protobuf:"bytes,24,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec          string            `protobuf:"bytes,25,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch         string            `protobuf:"bytes,26,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit       *pfs.Commit       `protobuf:"bytes,27,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata         *Metadata         `protobuf:"bytes,28,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec    string            `protobuf:"bytes,29,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	Autoscaling      bool              `protobuf:"varint,30,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	DatumRetryPolicy *DatumRetryPolicy `protobuf:"bytes,31,opt,name=datum_retry_policy,json=datumRetryPolicy,proto3" json:"datum_retry_policy,omitempty"`
+	// QuarantineFailedDatums, if true, lets jobs succeed even if some of their
+	// datums fail. The failed datums produce no output, and a record of each
+	// failure is written to /errors/<datum ID> in the pipeline's meta commit.
+	QuarantineFailedDatums bool     `protobuf:"varint,32,opt,name=quarantine_failed_datums,json=quarantineFailedDatums,proto3" json:"quarantine_failed_datums,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{50}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4310,6 +5197,20 @@ func (m *CreatePipelineRequest) GetAutoscaling() bool {
 	return false
 }
 
+func (m *CreatePipelineRequest) GetDatumRetryPolicy() *DatumRetryPolicy {
+	if m != nil {
+		return m.DatumRetryPolicy
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetQuarantineFailedDatums() bool {
+	if m != nil {
+		return m.QuarantineFailedDatums
+	}
+	return false
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// When true, return PipelineInfos with the details field, which requires
@@ -4324,7 +5225,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+	return fileDescriptor_beade573c128ccc7, []int{51}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4392,7 +5293,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4463,7 +5364,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4531,7 +5432,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4578,7 +5479,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4627,7 +5528,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{56}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4688,7 +5589,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4735,7 +5636,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{58}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4782,7 +5683,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4829,7 +5730,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4876,7 +5777,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4925,7 +5826,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4986,7 +5887,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5032,7 +5933,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5071,7 +5972,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{65}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5105,12 +6006,17 @@ func init() {
 	proto.RegisterEnum("pps_v2.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps_v2.WorkerState", WorkerState_name, WorkerState_value)
 	proto.RegisterEnum("pps_v2.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps_v2.SQLDatabaseEgress_Mode", SQLDatabaseEgress_Mode_name, SQLDatabaseEgress_Mode_value)
+	proto.RegisterEnum("pps_v2.SQLDatabaseEgress_FileFormat_Type", SQLDatabaseEgress_FileFormat_Type_name, SQLDatabaseEgress_FileFormat_Type_value)
 	proto.RegisterEnum("pps_v2.PipelineInfo_PipelineType", PipelineInfo_PipelineType_name, PipelineInfo_PipelineType_value)
 	proto.RegisterType((*SecretMount)(nil), "pps_v2.SecretMount")
 	proto.RegisterType((*Transform)(nil), "pps_v2.Transform")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Transform.EnvEntry")
 	proto.RegisterType((*TFJob)(nil), "pps_v2.TFJob")
 	proto.RegisterType((*Egress)(nil), "pps_v2.Egress")
+	proto.RegisterType((*SQLDatabaseEgress)(nil), "pps_v2.SQLDatabaseEgress")
+	proto.RegisterType((*SQLDatabaseEgress_Secret)(nil), "pps_v2.SQLDatabaseEgress.Secret")
+	proto.RegisterType((*SQLDatabaseEgress_FileFormat)(nil), "pps_v2.SQLDatabaseEgress.FileFormat")
 	proto.RegisterType((*Job)(nil), "pps_v2.Job")
 	proto.RegisterType((*Metadata)(nil), "pps_v2.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.Metadata.AnnotationsEntry")
@@ -5118,13 +6024,16 @@ func init() {
 	proto.RegisterType((*Service)(nil), "pps_v2.Service")
 	proto.RegisterType((*Spout)(nil), "pps_v2.Spout")
 	proto.RegisterType((*PFSInput)(nil), "pps_v2.PFSInput")
+	proto.RegisterType((*ContentKey)(nil), "pps_v2.ContentKey")
 	proto.RegisterType((*CronInput)(nil), "pps_v2.CronInput")
+	proto.RegisterType((*URLInput)(nil), "pps_v2.URLInput")
 	proto.RegisterType((*Input)(nil), "pps_v2.Input")
 	proto.RegisterType((*JobInput)(nil), "pps_v2.JobInput")
 	proto.RegisterType((*ParallelismSpec)(nil), "pps_v2.ParallelismSpec")
 	proto.RegisterType((*InputFile)(nil), "pps_v2.InputFile")
 	proto.RegisterType((*Datum)(nil), "pps_v2.Datum")
 	proto.RegisterType((*DatumInfo)(nil), "pps_v2.DatumInfo")
+	proto.RegisterType((*DatumAttempt)(nil), "pps_v2.DatumAttempt")
 	proto.RegisterType((*Aggregate)(nil), "pps_v2.Aggregate")
 	proto.RegisterType((*ProcessStats)(nil), "pps_v2.ProcessStats")
 	proto.RegisterType((*AggregateProcessStats)(nil), "pps_v2.AggregateProcessStats")
@@ -5134,6 +6043,7 @@ func init() {
 	proto.RegisterType((*GPUSpec)(nil), "pps_v2.GPUSpec")
 	proto.RegisterType((*JobSetInfo)(nil), "pps_v2.JobSetInfo")
 	proto.RegisterType((*JobInfo)(nil), "pps_v2.JobInfo")
+	proto.RegisterMapType((map[string]string)(nil), "pps_v2.JobInfo.MetadataEntry")
 	proto.RegisterType((*JobInfo_Details)(nil), "pps_v2.JobInfo.Details")
 	proto.RegisterType((*Worker)(nil), "pps_v2.Worker")
 	proto.RegisterType((*Pipeline)(nil), "pps_v2.Pipeline")
@@ -5155,6 +6065,8 @@ func init() {
 	proto.RegisterType((*RestartDatumRequest)(nil), "pps_v2.RestartDatumRequest")
 	proto.RegisterType((*InspectDatumRequest)(nil), "pps_v2.InspectDatumRequest")
 	proto.RegisterType((*ListDatumRequest)(nil), "pps_v2.ListDatumRequest")
+	proto.RegisterType((*ListDatumRequest_Filter)(nil), "pps_v2.ListDatumRequest.Filter")
+	proto.RegisterType((*DatumRetryPolicy)(nil), "pps_v2.DatumRetryPolicy")
 	proto.RegisterType((*DatumSetSpec)(nil), "pps_v2.DatumSetSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
@@ -5179,295 +6091,352 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0x4b, 0x73, 0x1b, 0x49,
-	0x72, 0xbf, 0xf0, 0x06, 0x12, 0x0f, 0x82, 0xc5, 0x87, 0x20, 0xea, 0xdd, 0xfa, 0xaf, 0x56, 0xd2,
-	0xce, 0x90, 0x33, 0xd4, 0xac, 0xfe, 0x3b, 0xf2, 0x3c, 0x96, 0x0f, 0x48, 0xa6, 0xc4, 0xa1, 0xe8,
-	0x06, 0x39, 0x13, 0xb3, 0x61, 0x47, 0x6f, 0x03, 0x5d, 0x04, 0x5b, 0x04, 0xba, 0x7b, 0xbb, 0x1a,
-	0x94, 0x39, 0x17, 0xfb, 0xec, 0xf0, 0xc9, 0xe3, 0x83, 0x8f, 0xbe, 0xf8, 0xe0, 0x83, 0x1f, 0x1f,
-	0xc0, 0x11, 0x0e, 0x47, 0xf8, 0x60, 0xdf, 0xf6, 0x64, 0x1f, 0x1c, 0x31, 0xe1, 0x50, 0xf8, 0xba,
-	0x5f, 0xc0, 0x27, 0x47, 0x65, 0x55, 0xf5, 0x03, 0x68, 0x82, 0xaf, 0x39, 0xb1, 0x2a, 0x33, 0xeb,
-	0x95, 0x55, 0x99, 0xf9, 0xcb, 0x44, 0x13, 0xea, 0x9e, 0xc7, 0x56, 0x3c, 0x8f, 0x2d, 0x7b, 0xbe,
-	0x1b, 0xb8, 0xa4, 0xe8, 0x79, 0xcc, 0x38, 0x5e, 0x5d, 0xba, 0xd9, 0x77, 0xdd, 0xfe, 0x80, 0xae,
-	0x20, 0xb5, 0x3b, 0x3a, 0x58, 0xa1, 0x43, 0x2f, 0x38, 0x11, 0x42, 0x4b, 0x77, 0xc7, 0x99, 0x81,
-	0x3d, 0xa4, 0x2c, 0x30, 0x87, 0x9e, 0x14, 0xb8, 0x33, 0x2e, 0x60, 0x8d, 0x7c, 0x33, 0xb0, 0x5d,
-	0x47, 0xf2, 0xe7, 0xfb, 0x6e, 0xdf, 0xc5, 0xe6, 0x0a, 0x6f, 0x49, 0x6a, 0xdd, 0x3b, 0x60, 0x2b,
-	0xde, 0x81, 0xdc, 0x8a, 0x76, 0x04, 0xd5, 0x0e, 0xed, 0xf9, 0x34, 0xf8, 0xca, 0x1d, 0x39, 0x01,
-	0x21, 0x90, 0x77, 0xcc, 0x21, 0x6d, 0x65, 0xee, 0x65, 0x1e, 0x55, 0x74, 0x6c, 0x93, 0x26, 0xe4,
-	0x8e, 0xe8, 0x49, 0x2b, 0x8b, 0x24, 0xde, 0x24, 0xb7, 0x01, 0x86, 0x5c, 0xdc, 0xf0, 0xcc, 0xe0,
-	0xb0, 0x95, 0x43, 0x46, 0x05, 0x29, 0xbb, 0x66, 0x70, 0x48, 0xae, 0x43, 0x89, 0x3a, 0xc7, 0xc6,
-	0xb1, 0xe9, 0xb7, 0xf2, 0xc8, 0x2b, 0x52, 0xe7, 0xf8, 0x6b, 0xd3, 0xd7, 0xfe, 0x2b, 0x07, 0x95,
-	0x3d, 0xdf, 0x74, 0xd8, 0x81, 0xeb, 0x0f, 0xc9, 0x3c, 0x14, 0xec, 0xa1, 0xd9, 0x57, 0x8b, 0x89,
-	0x0e, 0x5f, 0xad, 0x37, 0xb4, 0x5a, 0xd9, 0x7b, 0x39, 0xbe, 0x5a, 0x6f, 0x68, 0xe1, 0x74, 0xbe,
-	0x6f, 0x70, 0x6a, 0x0e, 0xa9, 0x45, 0xea, 0xfb, 0x1b, 0x43, 0x8b, 0x7c, 0x00, 0x39, 0xea, 0x1c,
-	0xb7, 0xf2, 0xf7, 0x72, 0x8f, 0xaa, 0xab, 0x4b, 0xcb, 0x42, 0xa9, 0xcb, 0xe1, 0x02, 0xcb, 0x6d,
-	0xe7, 0xb8, 0xed, 0x04, 0xfe, 0x89, 0xce, 0xc5, 0xc8, 0x87, 0x50, 0x62, 0x78, 0x52, 0xd6, 0x2a,
-	0xe0, 0x88, 0x39, 0x35, 0x22, 0xa6, 0x00, 0x5d, 0xc9, 0x90, 0x0f, 0x80, 0xe0, 0x86, 0x0c, 0x6f,
-	0x34, 0x18, 0x18, 0x6a, 0x64, 0x11, 0x37, 0xd0, 0x44, 0xce, 0xee, 0x68, 0x30, 0xe8, 0x48, 0xe9,
-	0x79, 0x28, 0xb0, 0xc0, 0xb2, 0x9d, 0x56, 0x09, 0x05, 0x44, 0x87, 0xdc, 0x84, 0x0a, 0xdf, 0xb9,
-	0xe0, 0x94, 0x91, 0x53, 0xa6, 0xbe, 0xdf, 0x41, 0xe6, 0x07, 0x40, 0xcc, 0x5e, 0x8f, 0x7a, 0x81,
-	0xe1, 0xd3, 0x60, 0xe4, 0x3b, 0x46, 0xcf, 0xb5, 0x68, 0xab, 0x72, 0x2f, 0xf7, 0x28, 0xa7, 0x37,
-	0x05, 0x47, 0x47, 0xc6, 0x86, 0x6b, 0x51, 0xbe, 0x80, 0x45, 0xbb, 0xa3, 0x7e, 0x0b, 0xee, 0x65,
-	0x1e, 0x95, 0x75, 0xd1, 0xe1, 0xd7, 0x35, 0x62, 0xd4, 0x6f, 0x55, 0xc5, 0x75, 0xf1, 0x36, 0xb9,
-	0x0b, 0xd5, 0x77, 0xae, 0x7f, 0x64, 0x3b, 0x7d, 0xc3, 0xb2, 0xfd, 0x56, 0x0d, 0x59, 0x20, 0x49,
-	0x9b, 0xb6, 0x4f, 0xee, 0x00, 0x58, 0x6e, 0xef, 0x88, 0xfa, 0x07, 0xf6, 0x80, 0xb6, 0xea, 0x82,
-	0x1f, 0x51, 0x96, 0x9e, 0x41, 0x59, 0x69, 0x4e, 0xdd, 0x7d, 0x26, 0xba, 0xfb, 0x79, 0x28, 0x1c,
-	0x9b, 0x83, 0x11, 0x95, 0xef, 0x41, 0x74, 0x9e, 0x67, 0x7f, 0x91, 0xd1, 0x1e, 0x43, 0x61, 0xef,
-	0xc5, 0x2b, 0xb7, 0x4b, 0xee, 0x41, 0x31, 0x38, 0x30, 0xde, 0xba, 0x5d, 0x31, 0x6e, 0xbd, 0xf2,
-	0xfe, 0x87, 0xbb, 0x82, 0xa5, 0x17, 0x82, 0x83, 0x57, 0x6e, 0x57, 0x5b, 0x82, 0x62, 0xbb, 0xef,
-	0x53, 0xc6, 0xf8, 0x02, 0xfb, 0xfa, 0xb6, 0x5a, 0x60, 0x5f, 0xdf, 0xd6, 0xfe, 0x00, 0x72, 0x7c,
-	0x92, 0x0f, 0xa0, 0xec, 0xd9, 0x1e, 0x1d, 0xd8, 0x8e, 0x78, 0x20, 0xd5, 0xd5, 0xa6, 0xba, 0xaf,
-	0x5d, 0x49, 0xd7, 0x43, 0x09, 0xb2, 0x08, 0x59, 0xdb, 0x12, 0x5b, 0x5a, 0x2f, 0xbe, 0xff, 0xe1,
-	0x6e, 0x76, 0x6b, 0x53, 0xcf, 0xda, 0xd6, 0xf3, 0xfc, 0x5f, 0xfd, 0xf5, 0xdd, 0x6b, 0xda, 0x9f,
-	0x66, 0xa1, 0xfc, 0x15, 0x0d, 0x4c, 0xcb, 0x0c, 0x4c, 0xb2, 0x01, 0x55, 0xd3, 0x71, 0xdc, 0x00,
-	0x4d, 0x85, 0xb5, 0x32, 0xf8, 0x16, 0xee, 0xab, 0xb9, 0x95, 0xd8, 0xf2, 0x5a, 0x24, 0x23, 0x1e,
-	0x51, 0x7c, 0x14, 0xf9, 0x04, 0x8a, 0x03, 0xb3, 0x4b, 0x07, 0x0c, 0x1f, 0x6a, 0x75, 0xf5, 0xd6,
-	0xc4, 0xf8, 0x6d, 0x64, 0x8b, 0xa1, 0x52, 0x76, 0xe9, 0x0b, 0x68, 0x8e, 0x4f, 0x7b, 0x11, 0x0d,
-	0x2f, 0x7d, 0x0a, 0xd5, 0xd8, 0xb4, 0x17, 0xba, 0x9c, 0x3f, 0x81, 0x52, 0x87, 0xfa, 0xc7, 0x76,
-	0x8f, 0x92, 0x07, 0x50, 0xb7, 0x9d, 0x80, 0xfa, 0x8e, 0x39, 0x30, 0x3c, 0xd7, 0x0f, 0x70, 0x82,
-	0x82, 0x5e, 0x53, 0xc4, 0x5d, 0xd7, 0x0f, 0xb8, 0x10, 0xfd, 0xe3, 0xb8, 0x50, 0x56, 0x08, 0x29,
-	0x22, 0x0a, 0x71, 0xad, 0x7b, 0xc2, 0xfe, 0xa5, 0xd6, 0x77, 0xf5, 0xac, 0xed, 0xf1, 0x67, 0x19,
-	0x9c, 0x78, 0x54, 0x5a, 0x3f, 0xb6, 0xb5, 0x55, 0x28, 0x74, 0x3c, 0x77, 0x14, 0x90, 0xc7, 0xdc,
-	0x0e, 0x71, 0x27, 0xf2, 0x5e, 0x67, 0x22, 0x3b, 0x44, 0xb2, 0xae, 0xf8, 0xda, 0x7f, 0x64, 0xa1,
-	0xbc, 0xfb, 0xa2, 0xb3, 0xe5, 0x78, 0xa3, 0x74, 0xd7, 0x44, 0x20, 0xef, 0x53, 0xcf, 0x95, 0xc7,
-	0xc5, 0x36, 0x37, 0x3a, 0xfe, 0xd7, 0xc0, 0x1d, 0x88, 0xd7, 0x5d, 0xe6, 0x84, 0xbd, 0x13, 0x8f,
-	0xbf, 0x93, 0x62, 0xd7, 0x37, 0x9d, 0x9e, 0xf2, 0x5a, 0xb2, 0xc7, 0xe9, 0x3d, 0x77, 0x38, 0xb4,
-	0x03, 0xe5, 0xb1, 0x44, 0x8f, 0x2f, 0xd0, 0x1f, 0xb8, 0xdd, 0x56, 0x41, 0x2c, 0xc0, 0xdb, 0xdc,
-	0x1f, 0xbd, 0x75, 0x6d, 0xc7, 0x70, 0x9d, 0x56, 0x51, 0x08, 0xf3, 0xee, 0x1b, 0x87, 0xbb, 0x45,
-	0x77, 0x14, 0x50, 0xdf, 0xe0, 0xfd, 0x56, 0x09, 0x0d, 0xb5, 0x82, 0x94, 0x57, 0xae, 0xed, 0x90,
-	0x1b, 0x50, 0xee, 0xfb, 0xee, 0xc8, 0x33, 0xba, 0x27, 0xad, 0x32, 0x0e, 0x2c, 0x61, 0x7f, 0xfd,
-	0x84, 0x2f, 0x33, 0x30, 0xbf, 0x3b, 0x69, 0x55, 0x70, 0x0c, 0xb6, 0xb9, 0x1d, 0x63, 0x38, 0x30,
-	0xb8, 0x51, 0x32, 0x69, 0xf7, 0x80, 0xa4, 0x17, 0x9c, 0x42, 0x1a, 0x90, 0x65, 0x4f, 0xd1, 0xf4,
-	0xcb, 0x7a, 0x96, 0x3d, 0xe5, 0x8a, 0x0d, 0x7c, 0xbb, 0xdf, 0xa7, 0xc2, 0xe8, 0x51, 0xb1, 0x07,
-	0xd2, 0x25, 0x22, 0x59, 0x57, 0x7c, 0xed, 0x1f, 0x32, 0x50, 0xd9, 0xf0, 0x5d, 0xe7, 0x62, 0x9a,
-	0x8d, 0x94, 0x94, 0x1b, 0x57, 0x12, 0xf3, 0x68, 0x4f, 0x5d, 0x37, 0x6f, 0x93, 0x5b, 0x50, 0x71,
-	0x8f, 0xa9, 0xff, 0xce, 0xb7, 0x03, 0x8a, 0xda, 0xe3, 0xaa, 0x50, 0x04, 0xf2, 0x11, 0x77, 0x97,
-	0xa6, 0x1f, 0xa0, 0x02, 0xb9, 0xef, 0x16, 0xa1, 0x6c, 0x59, 0x85, 0xb2, 0xe5, 0x3d, 0x15, 0xeb,
-	0x74, 0x21, 0xa8, 0xfd, 0x4f, 0x06, 0x0a, 0x62, 0xb7, 0x1a, 0xe4, 0xbc, 0x03, 0x36, 0xe1, 0x13,
-	0xe4, 0x33, 0xd1, 0x39, 0x93, 0xdc, 0x87, 0x3c, 0xde, 0x81, 0x30, 0xce, 0xba, 0x12, 0x12, 0x12,
-	0xc8, 0x22, 0x0f, 0xa0, 0x80, 0xda, 0xc7, 0x98, 0x32, 0x21, 0x23, 0x78, 0x5c, 0xa8, 0xe7, 0xbb,
-	0x8c, 0xc9, 0x18, 0x33, 0x2e, 0x84, 0x3c, 0x2e, 0x34, 0x72, 0x6c, 0xd7, 0x91, 0x61, 0x65, 0x5c,
-	0x08, 0x79, 0xe4, 0x27, 0x90, 0xef, 0xf9, 0xf2, 0xc5, 0x54, 0x57, 0x67, 0x95, 0x4c, 0x78, 0x09,
-	0x3a, 0xb2, 0x35, 0x07, 0xca, 0xaf, 0xdc, 0xee, 0xe9, 0xd7, 0xf2, 0x30, 0xbc, 0x82, 0x2c, 0x4e,
-	0xd4, 0x50, 0x57, 0xbc, 0x81, 0xd4, 0x89, 0x77, 0x9b, 0x8b, 0xbd, 0x5b, 0xf5, 0xc8, 0xf2, 0xd1,
-	0x23, 0xd3, 0x3e, 0x84, 0x99, 0x5d, 0xd3, 0x37, 0x07, 0x03, 0x3a, 0xb0, 0xd9, 0xb0, 0xc3, 0x6f,
-	0x6e, 0x09, 0xca, 0x3d, 0xd7, 0x61, 0x81, 0xe9, 0x08, 0xcf, 0x90, 0xd7, 0xc3, 0xbe, 0xf6, 0x14,
-	0x2a, 0xb8, 0x37, 0xfe, 0x00, 0xf9, 0x7c, 0x18, 0xff, 0xe5, 0xfe, 0x78, 0x9b, 0xd3, 0x0e, 0x4d,
-	0x76, 0x88, 0xbb, 0xab, 0xe9, 0xd8, 0xd6, 0xbe, 0x80, 0xc2, 0xa6, 0x19, 0x8c, 0x86, 0xe4, 0x36,
-	0xe4, 0x54, 0x50, 0xa8, 0xae, 0x56, 0x95, 0x0a, 0x78, 0x58, 0xe0, 0xf4, 0xd3, 0x7c, 0xb8, 0xf6,
-	0x9f, 0x19, 0xa8, 0xe0, 0x04, 0x5b, 0xce, 0x81, 0xcb, 0xb5, 0x6d, 0xf1, 0x8e, 0x9c, 0x26, 0xd4,
-	0x36, 0x4a, 0xe8, 0x82, 0x47, 0x1e, 0xe1, 0xfb, 0x0a, 0x84, 0x1f, 0x6c, 0xac, 0x92, 0x84, 0x50,
-	0x87, 0x73, 0x74, 0x21, 0x40, 0x9e, 0x08, 0x49, 0x86, 0x9a, 0xaa, 0xae, 0xce, 0x87, 0xef, 0xc9,
-	0x77, 0x7b, 0x94, 0x31, 0x2e, 0xcb, 0x84, 0x2c, 0x23, 0x8f, 0xa1, 0xc2, 0xb5, 0x2d, 0x66, 0xce,
-	0xa3, 0x7c, 0x4d, 0xe9, 0x9f, 0x6b, 0x44, 0x2f, 0x7b, 0x07, 0x38, 0x82, 0x92, 0xff, 0x07, 0x79,
-	0x1e, 0x05, 0xe4, 0x93, 0x68, 0xc6, 0xa5, 0xf8, 0x29, 0x74, 0xe4, 0x6a, 0xff, 0x98, 0x81, 0xca,
-	0x5a, 0xbf, 0xef, 0xd3, 0x3e, 0x1f, 0x33, 0x0f, 0x85, 0x1e, 0xc7, 0x20, 0x78, 0xb2, 0x9c, 0x2e,
-	0x3a, 0x5c, 0xa3, 0x43, 0x6a, 0x3a, 0x78, 0x92, 0x8c, 0x8e, 0x6d, 0x6e, 0x88, 0x2c, 0xb0, 0x2c,
-	0x7a, 0x8c, 0xbb, 0xce, 0xe8, 0xb2, 0x47, 0x1e, 0x43, 0xf3, 0xc0, 0x3e, 0x08, 0x0e, 0x0d, 0x8f,
-	0xfa, 0x3d, 0xea, 0x04, 0x3c, 0xbe, 0xe7, 0x51, 0x62, 0x06, 0xe9, 0xbb, 0x21, 0x99, 0x3c, 0x83,
-	0xeb, 0x8e, 0xed, 0x50, 0x74, 0x2f, 0x63, 0x23, 0x0a, 0x38, 0x62, 0x41, 0xb0, 0x5f, 0x24, 0xc7,
-	0x69, 0x7f, 0x91, 0x85, 0x5a, 0x5c, 0x37, 0xe4, 0x0b, 0xa8, 0x5b, 0xee, 0x3b, 0x67, 0xe0, 0x9a,
-	0x96, 0xc1, 0x11, 0xaa, 0xbc, 0x97, 0x1b, 0x13, 0x26, 0xbd, 0x29, 0xd1, 0xa9, 0x5e, 0x53, 0xf2,
-	0xdc, 0xc8, 0xc9, 0x67, 0x50, 0xf3, 0xc4, 0x7c, 0x62, 0x78, 0xf6, 0xac, 0xe1, 0x55, 0x29, 0x8e,
-	0xa3, 0x9f, 0x43, 0x75, 0xe4, 0x45, 0x6b, 0xe7, 0xce, 0x1a, 0x0c, 0x42, 0x1a, 0xc7, 0xfe, 0x04,
-	0x1a, 0xe1, 0xce, 0xbb, 0x27, 0x01, 0x65, 0xa8, 0xab, 0x9c, 0x1e, 0x9e, 0x67, 0x9d, 0x13, 0xc9,
-	0x7d, 0xa8, 0xc9, 0x25, 0x84, 0x50, 0x01, 0x85, 0xe4, 0xb2, 0x28, 0xa2, 0xfd, 0x6d, 0x16, 0x16,
-	0xc2, 0x7b, 0x4c, 0x68, 0xe7, 0x59, 0xba, 0x76, 0x42, 0xfb, 0x0f, 0x47, 0x8d, 0x69, 0xe5, 0x93,
-	0x54, 0xad, 0xa4, 0x0c, 0x4b, 0x68, 0x63, 0x35, 0x4d, 0x1b, 0x29, 0x83, 0xe2, 0x5a, 0xf8, 0x45,
-	0xaa, 0x16, 0x52, 0x87, 0x8d, 0x29, 0xe6, 0x93, 0x14, 0xc5, 0xa4, 0xef, 0x31, 0xae, 0xab, 0xef,
-	0x33, 0x50, 0xfb, 0xc6, 0xf5, 0x8f, 0xa8, 0xcf, 0x35, 0x34, 0x42, 0xab, 0x7a, 0x87, 0x7d, 0xc3,
-	0xb6, 0x24, 0x60, 0xac, 0xbd, 0xff, 0xe1, 0x6e, 0x59, 0x08, 0x6d, 0x6d, 0xea, 0x65, 0xc1, 0xde,
-	0xb2, 0x38, 0xb0, 0x7c, 0xeb, 0x76, 0x8d, 0xd0, 0x4b, 0x20, 0xb0, 0xe4, 0xfe, 0x72, 0x53, 0x2f,
-	0xbc, 0x75, 0xbb, 0x5b, 0x16, 0x79, 0x06, 0x35, 0xf4, 0x00, 0x68, 0xa4, 0x23, 0x65, 0xd5, 0x73,
-	0x13, 0xf6, 0x3f, 0x62, 0x7a, 0xd5, 0x8a, 0x3a, 0xda, 0x5b, 0xa8, 0xc6, 0x78, 0xe4, 0x13, 0x28,
-	0x61, 0xd8, 0xa1, 0x96, 0xbc, 0xb0, 0x69, 0x11, 0x4a, 0x89, 0x72, 0x1f, 0x8f, 0x46, 0x2f, 0xa2,
-	0xce, 0x6c, 0x22, 0x0e, 0xa0, 0x7f, 0x10, 0x56, 0xef, 0x42, 0x4d, 0xa7, 0xcc, 0x1d, 0xf9, 0x3d,
-	0x8a, 0x0e, 0x97, 0x67, 0x3c, 0xde, 0x08, 0x17, 0xca, 0xea, 0xbc, 0xc9, 0xed, 0x7b, 0x48, 0x87,
-	0xae, 0xaf, 0x92, 0x2e, 0xd9, 0x23, 0xf7, 0x21, 0xd7, 0xf7, 0x46, 0xf2, 0x50, 0x21, 0x6c, 0x7a,
-	0xb9, 0xbb, 0xcf, 0xe7, 0xd1, 0x39, 0x8f, 0xbb, 0x0b, 0xcb, 0x66, 0x47, 0x2a, 0x16, 0xf3, 0xb6,
-	0xf6, 0x73, 0x28, 0x49, 0x99, 0x10, 0x99, 0x65, 0x22, 0x64, 0xc6, 0x57, 0x73, 0x46, 0xc3, 0x2e,
-	0xf5, 0x71, 0xb5, 0x9c, 0x2e, 0x7b, 0xda, 0xaf, 0x00, 0x5e, 0xb9, 0xdd, 0x0e, 0x0d, 0xd0, 0xef,
-	0xfe, 0x94, 0xa3, 0x9e, 0xae, 0xc1, 0x68, 0x20, 0x55, 0xd2, 0x88, 0x39, 0xf0, 0x0e, 0x0d, 0x38,
-	0x0a, 0xe2, 0x7f, 0xc9, 0x03, 0x1e, 0x7b, 0xbb, 0x0a, 0x18, 0xcf, 0xc4, 0xa4, 0x84, 0xe7, 0xe3,
-	0x4c, 0xed, 0x6f, 0x6a, 0x50, 0x92, 0x94, 0xb3, 0xc2, 0xc2, 0x63, 0x68, 0x2a, 0x98, 0x6f, 0x1c,
-	0x53, 0x9f, 0xf1, 0x48, 0x9b, 0xc5, 0xb8, 0x34, 0xa3, 0xe8, 0x5f, 0x0b, 0x32, 0x79, 0x0a, 0x75,
-	0x77, 0x14, 0x78, 0xa3, 0xc0, 0x88, 0xe1, 0x94, 0xc9, 0x20, 0x59, 0x13, 0x42, 0xa2, 0x47, 0x5a,
-	0x50, 0xf2, 0xa9, 0x40, 0x23, 0x79, 0x9c, 0x56, 0x75, 0xd1, 0x41, 0x98, 0x81, 0x69, 0x48, 0x13,
-	0xa3, 0x96, 0xb4, 0xfd, 0x3a, 0xa7, 0xee, 0x2a, 0x22, 0x77, 0x10, 0x28, 0xc6, 0x8e, 0x6c, 0xcf,
-	0xa3, 0x16, 0x86, 0xf8, 0x1c, 0x3e, 0x2f, 0xb3, 0x23, 0x48, 0x1c, 0x19, 0xa2, 0x48, 0xe0, 0x06,
-	0xe6, 0x00, 0x91, 0x61, 0x4e, 0xaf, 0x70, 0xca, 0x1e, 0x27, 0x70, 0xa8, 0x87, 0xec, 0x03, 0xd3,
-	0x1e, 0x50, 0x0b, 0xc1, 0x61, 0x4e, 0xc7, 0x11, 0x2f, 0x90, 0x12, 0xee, 0xc4, 0xa7, 0x3d, 0x0e,
-	0xa2, 0xa8, 0x85, 0x48, 0x51, 0xee, 0x44, 0x57, 0xc4, 0x28, 0x98, 0xc1, 0xd9, 0xc1, 0xec, 0xa1,
-	0x0a, 0x91, 0x55, 0x0c, 0x91, 0xcd, 0xf8, 0x6d, 0xc6, 0x03, 0xe4, 0x22, 0x14, 0x7d, 0x6a, 0x32,
-	0xd7, 0x91, 0x99, 0xa4, 0xec, 0x71, 0x13, 0xe9, 0xf9, 0xd4, 0xe4, 0x26, 0x52, 0x3f, 0xdb, 0x44,
-	0xa4, 0x68, 0xdc, 0xb0, 0x1a, 0xe7, 0x37, 0xac, 0x67, 0x50, 0x3e, 0xb0, 0x1d, 0x9b, 0x1d, 0x52,
-	0xab, 0x35, 0x73, 0xe6, 0xb0, 0x50, 0x96, 0x7c, 0x0c, 0x25, 0x8b, 0x06, 0xa6, 0x3d, 0x60, 0xad,
-	0x26, 0x0e, 0xbb, 0x3e, 0xf6, 0x1a, 0x97, 0x37, 0x05, 0x5b, 0x57, 0x72, 0x4b, 0x7f, 0x5e, 0x82,
-	0x92, 0x24, 0x92, 0x15, 0xa8, 0x04, 0xaa, 0x98, 0x30, 0xee, 0xb8, 0xc3, 0x2a, 0x83, 0x1e, 0xc9,
-	0x90, 0x75, 0x68, 0x7a, 0x11, 0x9a, 0x32, 0x10, 0x14, 0x67, 0x93, 0x0b, 0x8f, 0xa1, 0x2d, 0x7d,
-	0xc6, 0x1b, 0x83, 0x5f, 0x0f, 0xa1, 0x48, 0x31, 0x35, 0x8e, 0x1e, 0xaf, 0x18, 0x29, 0x12, 0x66,
-	0x5d, 0x72, 0xe3, 0x69, 0x54, 0x7e, 0x7a, 0x1a, 0xc5, 0x21, 0x13, 0xe3, 0xa9, 0x97, 0xf4, 0xd0,
-	0x21, 0x64, 0xc2, 0x7c, 0x4c, 0x17, 0x3c, 0xf2, 0x29, 0xd4, 0xa5, 0x1b, 0x96, 0xae, 0xb3, 0x88,
-	0xf6, 0x1b, 0xbe, 0xa1, 0xb8, 0xcf, 0xd6, 0x6b, 0xef, 0xe2, 0x1e, 0x7c, 0x0d, 0x66, 0x7d, 0xe9,
-	0xd0, 0x0c, 0x9f, 0xfe, 0x66, 0x44, 0x59, 0xc0, 0xf0, 0x91, 0xc7, 0x86, 0xc7, 0x3d, 0x9e, 0xde,
-	0x54, 0xe2, 0xba, 0x94, 0x26, 0x9f, 0xc3, 0x4c, 0x38, 0xc5, 0xc0, 0x1e, 0xda, 0x01, 0x43, 0x2b,
-	0x38, 0x6d, 0x82, 0x86, 0x12, 0xde, 0x46, 0x59, 0xb2, 0x0d, 0xd7, 0x99, 0x6d, 0xd1, 0x9e, 0xe9,
-	0x1b, 0xe3, 0xd3, 0x54, 0xa6, 0x4c, 0xb3, 0x20, 0x07, 0xe9, 0xc9, 0xd9, 0x1e, 0x40, 0xc1, 0xe6,
-	0x3e, 0x5b, 0x9a, 0xd1, 0x38, 0xa0, 0xb7, 0x15, 0x3a, 0x67, 0xe6, 0x20, 0x50, 0xa5, 0x17, 0xde,
-	0x26, 0xcf, 0xd1, 0x4c, 0x79, 0xf4, 0xa1, 0x81, 0xb8, 0xfd, 0x5a, 0x72, 0x75, 0x11, 0x63, 0x68,
-	0x80, 0xab, 0x8b, 0x48, 0x25, 0x7b, 0x88, 0xa3, 0x70, 0x2c, 0x0f, 0xdd, 0xfc, 0xb2, 0xea, 0x67,
-	0xe3, 0x28, 0x2e, 0xbf, 0x27, 0xc4, 0x39, 0x12, 0xe2, 0xfe, 0x59, 0x8d, 0x6e, 0x9c, 0x89, 0x84,
-	0xde, 0xba, 0x5d, 0x35, 0x56, 0xf8, 0x1f, 0xbe, 0xb6, 0x6f, 0x53, 0x86, 0x26, 0x26, 0xfc, 0xcf,
-	0x68, 0xb8, 0xc7, 0x29, 0xe4, 0x4b, 0x98, 0x61, 0xbd, 0x43, 0x6a, 0x8d, 0x06, 0xb6, 0xd3, 0x17,
-	0x27, 0x13, 0x06, 0xb5, 0x18, 0xbe, 0xa5, 0x90, 0x2d, 0x2e, 0x88, 0x25, 0xfa, 0x3c, 0xf7, 0xf5,
-	0x5c, 0x4b, 0x8c, 0x9c, 0x15, 0xb9, 0xaf, 0xe7, 0x5a, 0xc8, 0xba, 0x09, 0x15, 0xce, 0xf2, 0xcc,
-	0xa0, 0x77, 0xd8, 0x22, 0x22, 0x5f, 0xf7, 0x5c, 0x6b, 0x97, 0xf7, 0xb5, 0x97, 0x50, 0x14, 0x0f,
-	0x2f, 0x35, 0x1b, 0x7a, 0x9c, 0x84, 0xf9, 0x73, 0x93, 0x6f, 0x55, 0xb9, 0x31, 0xed, 0x0e, 0x94,
-	0x55, 0xd9, 0x28, 0x6d, 0x2a, 0xed, 0x9f, 0x9a, 0x50, 0x53, 0x02, 0x18, 0x95, 0x2e, 0x56, 0x7f,
-	0x6a, 0x41, 0x29, 0x19, 0x9b, 0x54, 0x97, 0xac, 0x40, 0x95, 0x9f, 0x7a, 0x7a, 0x44, 0x02, 0x2e,
-	0x12, 0xc5, 0x23, 0x16, 0xb8, 0x18, 0x49, 0x44, 0xa6, 0xa6, 0xba, 0xe4, 0x67, 0xea, 0xb8, 0x05,
-	0x3c, 0xee, 0xc2, 0xf8, 0x7e, 0x4e, 0xf1, 0xdb, 0xc5, 0x84, 0xdf, 0x5e, 0x07, 0x7e, 0xf3, 0x06,
-	0x26, 0x17, 0x0c, 0xcb, 0x95, 0xd5, 0xd5, 0x07, 0xe3, 0x33, 0xa1, 0x6f, 0x7c, 0xe5, 0x76, 0x37,
-	0x50, 0x4a, 0x14, 0xb1, 0x2a, 0x6f, 0x55, 0x9f, 0x3c, 0x83, 0xc6, 0xc0, 0x64, 0x81, 0x81, 0x80,
-	0x00, 0x77, 0x54, 0x3e, 0x25, 0x88, 0xd4, 0xb8, 0x9c, 0xea, 0x91, 0x7b, 0x50, 0x8d, 0xb9, 0x3b,
-	0x34, 0xcd, 0xbc, 0x1e, 0x27, 0x91, 0x9f, 0x4b, 0x7c, 0x02, 0x38, 0xdf, 0xfd, 0xd4, 0x7d, 0xa9,
-	0xce, 0xde, 0x89, 0x47, 0x25, 0x84, 0xb9, 0x0d, 0x60, 0x8e, 0x82, 0x43, 0x23, 0x70, 0x8f, 0xa8,
-	0x23, 0x4d, 0xb2, 0xc2, 0x29, 0x7b, 0x9c, 0x40, 0x9e, 0x45, 0x71, 0x40, 0x18, 0xe4, 0xad, 0xd4,
-	0x89, 0x27, 0x82, 0xc1, 0x67, 0xd0, 0x48, 0x2a, 0x21, 0x5e, 0x72, 0x2b, 0xa4, 0x94, 0xdc, 0x0a,
-	0xf1, 0x6a, 0xdd, 0xef, 0xe0, 0x0a, 0xa1, 0x64, 0x25, 0xac, 0xa1, 0x66, 0x93, 0x4e, 0x08, 0xeb,
-	0xa8, 0x93, 0x25, 0xd5, 0xd4, 0xd8, 0x93, 0xbb, 0x74, 0xec, 0xc9, 0x4f, 0x8d, 0x3d, 0x9f, 0x02,
-	0xc8, 0x80, 0x6e, 0x98, 0x2a, 0xaa, 0x4c, 0x8b, 0xc8, 0x15, 0x29, 0xbd, 0x16, 0x70, 0xb0, 0xe4,
-	0x53, 0x9e, 0x4c, 0x1a, 0xd4, 0xf7, 0x5d, 0x5f, 0x3e, 0xce, 0xaa, 0xa0, 0xb5, 0x39, 0x89, 0xfc,
-	0x0c, 0x66, 0x45, 0x78, 0x61, 0x2a, 0x9a, 0x50, 0x4b, 0x62, 0xa6, 0xa6, 0x64, 0xe8, 0x8a, 0x1e,
-	0x17, 0x36, 0x8f, 0x4d, 0x7b, 0x60, 0x76, 0x07, 0x54, 0x02, 0x28, 0x25, 0xbc, 0xa6, 0xe8, 0xe4,
-	0x41, 0x88, 0x0f, 0x65, 0x11, 0xb0, 0x82, 0xab, 0x4b, 0x3c, 0xb8, 0x2e, 0x4a, 0x81, 0xa9, 0xd1,
-	0x0c, 0xae, 0x1a, 0xcd, 0xaa, 0x3f, 0x4e, 0x34, 0xab, 0x5d, 0x21, 0x9a, 0xd5, 0xa7, 0x44, 0xb3,
-	0x7b, 0x50, 0xb5, 0x28, 0xeb, 0xf9, 0xb6, 0xc7, 0x83, 0x03, 0x46, 0x8f, 0x8a, 0x1e, 0x27, 0x85,
-	0xf1, 0xae, 0x19, 0x8b, 0x77, 0x91, 0x8f, 0x99, 0x4d, 0xf8, 0x98, 0x18, 0x36, 0x99, 0x3b, 0x2f,
-	0x36, 0x99, 0x9f, 0x82, 0x4d, 0x26, 0xe3, 0xea, 0xc2, 0xe5, 0xe3, 0xea, 0xe2, 0x95, 0xe2, 0xea,
-	0xf5, 0x2b, 0xc4, 0xd5, 0xd6, 0x79, 0xe2, 0xea, 0x8d, 0x4b, 0xc7, 0xd5, 0xa5, 0x29, 0x71, 0xf5,
-	0x66, 0x32, 0xae, 0x92, 0x05, 0x28, 0xb2, 0xa7, 0x06, 0x3f, 0xd0, 0x2d, 0xf1, 0x7b, 0x12, 0x7b,
-	0xfa, 0x66, 0x14, 0xf0, 0xa0, 0x37, 0x94, 0x3f, 0x60, 0xb4, 0x6e, 0x27, 0x83, 0x9e, 0xfa, 0x61,
-	0x43, 0x0f, 0x25, 0x78, 0x56, 0xe2, 0x53, 0x55, 0xa6, 0xc0, 0x2d, 0xdc, 0xc1, 0x65, 0xea, 0x21,
-	0x15, 0x37, 0xf2, 0x53, 0x98, 0x19, 0x39, 0xbd, 0x81, 0x69, 0x0f, 0xa9, 0x65, 0x04, 0x26, 0x3b,
-	0x62, 0xad, 0xbb, 0xa8, 0x89, 0x46, 0x48, 0xde, 0xe3, 0x54, 0xbe, 0x63, 0x09, 0x41, 0xfd, 0x5e,
-	0xeb, 0x9e, 0xd8, 0xb1, 0x20, 0xe8, 0x3d, 0xfe, 0x42, 0xcd, 0x51, 0xe0, 0xb2, 0x9e, 0xc9, 0x0f,
-	0xdf, 0xba, 0x8f, 0xdb, 0x8e, 0x93, 0xb4, 0xef, 0xa2, 0x08, 0x8e, 0xb5, 0xfe, 0x1b, 0xb0, 0xb0,
-	0xbb, 0xb5, 0xdb, 0xde, 0xde, 0xda, 0xd9, 0x33, 0xf6, 0xbe, 0xdd, 0x6d, 0x1b, 0xfb, 0x3b, 0xaf,
-	0x77, 0xde, 0x7c, 0xb3, 0xd3, 0xbc, 0x46, 0x6e, 0xc2, 0x75, 0xc9, 0x6a, 0x0b, 0xd6, 0x9e, 0xbe,
-	0xb6, 0xd3, 0x79, 0xf1, 0x46, 0xff, 0xaa, 0x99, 0x21, 0xd7, 0x61, 0x2e, 0xc9, 0xec, 0xec, 0xbe,
-	0xd9, 0xdf, 0x6b, 0x66, 0x63, 0x13, 0x2a, 0x46, 0x5b, 0xff, 0x7a, 0x6b, 0xa3, 0xdd, 0xcc, 0x69,
-	0xaf, 0xa0, 0x1e, 0x0f, 0x25, 0xdc, 0x45, 0xd6, 0xc3, 0xac, 0xd5, 0x76, 0x0e, 0x5c, 0xf9, 0x3b,
-	0xd3, 0x7c, 0x5a, 0xe0, 0xd1, 0x6b, 0x5e, 0xac, 0xa7, 0xdd, 0x83, 0xa2, 0x48, 0xa9, 0x65, 0x45,
-	0x34, 0x33, 0x51, 0x11, 0x1d, 0xc2, 0xfc, 0x96, 0xc3, 0x15, 0x1e, 0xc8, 0xdc, 0x5b, 0x38, 0x9e,
-	0xf3, 0xe7, 0xe8, 0x04, 0xf2, 0xef, 0x4c, 0x59, 0x44, 0x2e, 0xeb, 0xd8, 0xe6, 0xb8, 0x43, 0x05,
-	0xc9, 0x9c, 0xc0, 0x1d, 0xb2, 0xab, 0x7d, 0x08, 0xb3, 0xdb, 0x36, 0x1b, 0x5b, 0x2b, 0x26, 0x9e,
-	0x49, 0x8a, 0xff, 0x1a, 0x66, 0xa3, 0xdd, 0x29, 0xf1, 0x33, 0x92, 0xfc, 0x8b, 0x6d, 0xe8, 0x5f,
-	0x32, 0xd0, 0x90, 0x3b, 0x52, 0xf3, 0x5f, 0x0c, 0xae, 0x7d, 0x0c, 0x35, 0xf4, 0x7b, 0x46, 0x58,
-	0x4c, 0xcf, 0xa5, 0xa0, 0xb2, 0x2a, 0xca, 0x44, 0xb0, 0xec, 0xd0, 0x66, 0x81, 0xeb, 0x9f, 0xc8,
-	0x32, 0xa1, 0xea, 0xc6, 0xf7, 0x59, 0x48, 0xec, 0x93, 0x2c, 0x41, 0xf9, 0xed, 0x6f, 0x5e, 0xd8,
-	0x83, 0x80, 0xaa, 0x40, 0x17, 0xf6, 0xb5, 0x3f, 0x82, 0xb9, 0xce, 0xa8, 0xcb, 0xfd, 0x6b, 0x97,
-	0x5e, 0xfa, 0x1c, 0xb1, 0xa5, 0xb3, 0x49, 0x15, 0x7d, 0x0c, 0xcd, 0x4d, 0x3a, 0xa0, 0x01, 0x3d,
-	0xf7, 0x1d, 0x68, 0x2f, 0xa1, 0xd1, 0x09, 0x5c, 0xef, 0xfc, 0x97, 0x16, 0xb9, 0xff, 0x5c, 0xdc,
-	0xfd, 0x6b, 0xbf, 0xcb, 0xc2, 0xc2, 0xbe, 0x67, 0x99, 0xb8, 0xb8, 0xc0, 0x81, 0xe7, 0x9b, 0xf0,
-	0x61, 0x12, 0xcf, 0x9f, 0xa3, 0x26, 0x91, 0x58, 0x38, 0x5e, 0xca, 0x29, 0x9c, 0x55, 0xca, 0x29,
-	0x9e, 0xa7, 0x94, 0x53, 0x9a, 0x2c, 0xe5, 0xfc, 0x58, 0xb5, 0x9a, 0x64, 0x49, 0x08, 0xc6, 0x4b,
-	0x42, 0x61, 0x29, 0xa7, 0x7a, 0x66, 0x29, 0x47, 0xfb, 0xd7, 0x2c, 0x34, 0x5e, 0xd2, 0x60, 0xdb,
-	0xed, 0xb3, 0xcb, 0x3d, 0x23, 0x79, 0x2d, 0xd9, 0x53, 0xae, 0x45, 0x69, 0xe5, 0x00, 0x5f, 0x2e,
-	0x93, 0x5f, 0x61, 0xa0, 0x1a, 0xc4, 0x63, 0x66, 0xd1, 0xaf, 0x32, 0xf9, 0x29, 0xbf, 0xca, 0x2c,
-	0x42, 0x71, 0x68, 0x32, 0x6e, 0x0c, 0xc2, 0x4e, 0x64, 0x8f, 0xd3, 0x0f, 0xdc, 0xc1, 0xc0, 0x7d,
-	0x87, 0x97, 0x52, 0xd6, 0x65, 0x0f, 0x8b, 0x95, 0xa6, 0xad, 0xea, 0x65, 0xd8, 0x26, 0x8f, 0xa0,
-	0x39, 0x62, 0xd4, 0x18, 0xb8, 0x47, 0xb6, 0xd1, 0x35, 0x7b, 0x47, 0xd4, 0x11, 0x77, 0x50, 0xd6,
-	0x1b, 0x23, 0x46, 0xb7, 0xdd, 0x23, 0x7b, 0x5d, 0x50, 0xc9, 0x0a, 0x14, 0x98, 0xed, 0xf4, 0xa8,
-	0xac, 0x00, 0x4c, 0x09, 0xd9, 0x42, 0x4e, 0xfb, 0xe7, 0x2c, 0xc0, 0xb6, 0xdb, 0xff, 0x8a, 0x32,
-	0x66, 0xf6, 0x11, 0x2c, 0x86, 0x1e, 0x3c, 0x96, 0x2e, 0x86, 0xbe, 0x7a, 0x87, 0x67, 0xa0, 0x67,
-	0x57, 0xa4, 0x13, 0xe5, 0xed, 0xdc, 0xd4, 0xf2, 0xf6, 0x43, 0x28, 0x0b, 0xb8, 0x60, 0x8b, 0xd4,
-	0xaf, 0xb2, 0x5e, 0x7d, 0xff, 0xc3, 0xdd, 0x92, 0xf8, 0xed, 0x6b, 0x53, 0x2f, 0x21, 0x73, 0xcb,
-	0x3a, 0x55, 0x8f, 0xaa, 0xfe, 0x5c, 0x9c, 0x5a, 0x7f, 0x0e, 0x3f, 0x1a, 0x11, 0x3f, 0x50, 0x8b,
-	0x8f, 0x46, 0x9e, 0x40, 0x36, 0x2c, 0xb9, 0x4c, 0x43, 0xf2, 0xd9, 0x80, 0x71, 0x2b, 0x1b, 0x0a,
-	0x1d, 0x49, 0xfc, 0xac, 0xba, 0xda, 0x37, 0x30, 0xa7, 0x0b, 0x83, 0x13, 0xf7, 0x7e, 0x3e, 0xab,
-	0x1f, 0x7f, 0x5e, 0xd9, 0x89, 0xe7, 0xa5, 0x3d, 0x87, 0x39, 0x19, 0x52, 0x12, 0x13, 0x9f, 0xe7,
-	0xb7, 0x40, 0xed, 0x6b, 0x68, 0xf2, 0x58, 0x71, 0x91, 0x1d, 0x85, 0x90, 0x39, 0x7b, 0x3a, 0x64,
-	0xd6, 0x2c, 0xa8, 0xc5, 0x61, 0x67, 0xac, 0x8c, 0x9e, 0x89, 0x97, 0xd1, 0xb9, 0xa1, 0x33, 0xfb,
-	0x3b, 0x2a, 0x7f, 0x24, 0x11, 0x25, 0xf6, 0x0a, 0xa7, 0x88, 0x5f, 0x51, 0x6e, 0x03, 0x78, 0xd4,
-	0x37, 0xc4, 0x23, 0xc0, 0x07, 0x92, 0xd3, 0x2b, 0x1e, 0xf5, 0xc5, 0xfb, 0xd0, 0x7e, 0x9b, 0x81,
-	0x46, 0x12, 0x03, 0x92, 0xaf, 0xa0, 0xee, 0xb8, 0x16, 0x35, 0x18, 0x1d, 0xd0, 0x5e, 0xe0, 0xfa,
-	0x12, 0x5a, 0x3c, 0x4a, 0x87, 0x8c, 0xcb, 0x3b, 0xae, 0x45, 0x3b, 0x52, 0x54, 0x64, 0xf2, 0x35,
-	0x27, 0x46, 0x22, 0xcb, 0x30, 0xe7, 0xf9, 0xb6, 0xeb, 0xdb, 0xc1, 0x89, 0xd1, 0x1b, 0x98, 0x8c,
-	0x89, 0xd7, 0x2e, 0x7e, 0x79, 0x98, 0x55, 0xac, 0x0d, 0xce, 0xe1, 0x4f, 0x7e, 0xe9, 0x4b, 0x98,
-	0x9d, 0x98, 0xf2, 0x42, 0x9f, 0xa2, 0xfc, 0x6f, 0x05, 0x16, 0x36, 0x30, 0x21, 0x0c, 0x5d, 0xd1,
-	0xa5, 0xbc, 0xd6, 0x85, 0x53, 0xe4, 0x44, 0x12, 0x9e, 0xbb, 0x64, 0x3d, 0x37, 0x7f, 0xe9, 0x9c,
-	0xba, 0x30, 0x35, 0xa7, 0x5e, 0x84, 0xe2, 0x08, 0x63, 0xa6, 0x72, 0x82, 0xa2, 0x37, 0x99, 0xb3,
-	0x96, 0x52, 0x72, 0xd6, 0x08, 0xce, 0x97, 0xe3, 0x70, 0x3e, 0x35, 0x95, 0xad, 0x5c, 0x35, 0x95,
-	0x85, 0x1f, 0x27, 0x95, 0xad, 0x5e, 0x21, 0x95, 0xad, 0x9d, 0x3f, 0x95, 0xad, 0x4f, 0xa6, 0xb2,
-	0xb7, 0xf0, 0x0b, 0x21, 0x11, 0x48, 0xb1, 0xd8, 0x59, 0xd6, 0x23, 0x42, 0x3c, 0x79, 0x9d, 0x3d,
-	0x6f, 0xf2, 0x4a, 0x2e, 0x94, 0xbc, 0xce, 0x5d, 0x3e, 0x79, 0x9d, 0xbf, 0x52, 0xf2, 0xba, 0x70,
-	0x91, 0xe4, 0x55, 0x25, 0xfc, 0x8b, 0xb1, 0x84, 0x7f, 0x2c, 0xa1, 0xbd, 0x7e, 0x9e, 0x84, 0xb6,
-	0x75, 0xe9, 0x84, 0xf6, 0xc6, 0x94, 0x84, 0x76, 0x69, 0x2c, 0xa1, 0x1d, 0x2b, 0xb3, 0xde, 0x3c,
-	0xb3, 0xcc, 0x1a, 0x4f, 0x75, 0x6f, 0x5d, 0x22, 0xd5, 0xbd, 0x9d, 0x96, 0xea, 0x8e, 0x25, 0xa9,
-	0x77, 0x26, 0x93, 0xd4, 0x5f, 0xc3, 0xa2, 0x8c, 0x64, 0x57, 0x73, 0x7e, 0xa7, 0x23, 0xff, 0xef,
-	0x33, 0x30, 0xc7, 0x03, 0xde, 0x95, 0xe7, 0x57, 0xe9, 0x4e, 0xf6, 0xd4, 0x74, 0x27, 0x77, 0x7a,
-	0xba, 0x93, 0x1f, 0x4b, 0x77, 0xfe, 0x2c, 0x03, 0x0b, 0x22, 0x21, 0xb9, 0xda, 0xbe, 0x9a, 0x90,
-	0x33, 0x07, 0x03, 0x79, 0x66, 0xde, 0xe4, 0x81, 0xe6, 0xc0, 0xf5, 0x7b, 0x54, 0xee, 0x46, 0x74,
-	0xf8, 0x63, 0x39, 0xa2, 0xd4, 0x33, 0xf0, 0x23, 0x36, 0x51, 0x47, 0x2f, 0x73, 0x82, 0x4e, 0x3d,
-	0x57, 0xdb, 0x84, 0xf9, 0x0e, 0x47, 0x29, 0x57, 0xda, 0x8a, 0xb6, 0x01, 0x73, 0x3c, 0x5f, 0xba,
-	0xda, 0x24, 0x7f, 0x99, 0x01, 0xa2, 0x8f, 0x9c, 0xab, 0x29, 0x65, 0x19, 0xc0, 0xf3, 0xdd, 0x63,
-	0xea, 0x98, 0x1c, 0xef, 0xa6, 0x27, 0xb3, 0x31, 0x89, 0x18, 0x6a, 0xcd, 0xa5, 0xa3, 0x56, 0xed,
-	0x0b, 0x68, 0xe8, 0x23, 0x67, 0xc3, 0x77, 0x9d, 0xcb, 0x1d, 0xeb, 0x31, 0xcc, 0x89, 0x10, 0x2f,
-	0x3e, 0x90, 0x56, 0x93, 0x10, 0xc8, 0xe3, 0x47, 0xc7, 0x19, 0xf1, 0x79, 0x18, 0x6f, 0x6b, 0x9f,
-	0xc3, 0x9c, 0x78, 0x18, 0x49, 0xd1, 0x87, 0x50, 0x14, 0x1f, 0x5d, 0x8f, 0x97, 0x32, 0xa4, 0x98,
-	0xe4, 0x6a, 0x5f, 0x84, 0xb5, 0x90, 0xcb, 0x8d, 0xbf, 0x05, 0x45, 0x41, 0x49, 0xfd, 0x59, 0xe8,
-	0xfb, 0x0c, 0x80, 0x60, 0xe3, 0x8f, 0x42, 0xe7, 0x9c, 0x34, 0xfc, 0xcc, 0x22, 0x1b, 0xfb, 0xcc,
-	0x62, 0x0b, 0x08, 0x96, 0xc1, 0x6d, 0xd7, 0x31, 0xc2, 0x4f, 0xf9, 0x25, 0x0c, 0x99, 0x06, 0xb9,
-	0x67, 0xd5, 0xa8, 0x90, 0xa4, 0xad, 0xab, 0x8f, 0xf6, 0x45, 0xad, 0xe9, 0x29, 0x54, 0xc5, 0xba,
-	0xf1, 0x4a, 0x13, 0x49, 0x6e, 0x0d, 0xeb, 0x4c, 0xc0, 0xc2, 0xb6, 0xb6, 0x00, 0x73, 0x6b, 0xbd,
-	0xc0, 0x3e, 0x36, 0x03, 0xba, 0x36, 0x0a, 0x0e, 0xa5, 0xda, 0xb4, 0x45, 0x98, 0x4f, 0x92, 0x99,
-	0xe7, 0x3a, 0x8c, 0x3e, 0xf9, 0xbb, 0x0c, 0x7e, 0x99, 0x28, 0x7e, 0xc7, 0x59, 0x80, 0xd9, 0x57,
-	0x6f, 0xd6, 0x8d, 0xce, 0xde, 0xda, 0x5e, 0xbc, 0xaa, 0x36, 0x03, 0x55, 0x4e, 0xde, 0xd0, 0xdb,
-	0x6b, 0x7b, 0xed, 0xcd, 0x66, 0x86, 0x34, 0xa1, 0x26, 0xe5, 0xf4, 0xbd, 0xad, 0x9d, 0x97, 0xcd,
-	0xac, 0x12, 0xd1, 0xf7, 0x77, 0x76, 0x38, 0x21, 0xa7, 0x08, 0x2f, 0xd6, 0xb6, 0xb6, 0xf7, 0xf5,
-	0x76, 0x33, 0xaf, 0x08, 0x9d, 0xfd, 0x8d, 0x8d, 0x76, 0xa7, 0xd3, 0x2c, 0x90, 0x06, 0x00, 0x27,
-	0xbc, 0xde, 0xda, 0xde, 0x6e, 0x6f, 0x36, 0x8b, 0x64, 0x16, 0xea, 0xbc, 0xdf, 0x7e, 0xa9, 0xb7,
-	0x3b, 0x1d, 0x3e, 0x49, 0x49, 0x91, 0x5e, 0x6c, 0xed, 0x6c, 0x75, 0x7e, 0x9f, 0x93, 0xca, 0x4f,
-	0xfe, 0x10, 0x20, 0xfa, 0xd8, 0x8f, 0x54, 0xa1, 0x14, 0x6d, 0x13, 0xa0, 0xc8, 0x97, 0xc3, 0x1d,
-	0x56, 0xa1, 0xa4, 0x56, 0xca, 0x62, 0xe7, 0xf5, 0xd6, 0xee, 0x6e, 0x7b, 0xb3, 0x99, 0x23, 0x35,
-	0x28, 0x87, 0xfb, 0xce, 0x93, 0x3a, 0x54, 0xf4, 0xf6, 0xc6, 0x9b, 0xaf, 0xdb, 0x7a, 0x7b, 0xb3,
-	0x59, 0x78, 0xf2, 0x2d, 0x54, 0x63, 0xbf, 0x31, 0x92, 0x16, 0xcc, 0x7f, 0xf3, 0x46, 0x7f, 0xdd,
-	0xd6, 0xd3, 0x54, 0xb2, 0xfb, 0x66, 0x33, 0x3c, 0x6f, 0x46, 0x11, 0xa2, 0x45, 0x1b, 0x00, 0x9c,
-	0x20, 0x77, 0x94, 0x7b, 0xf2, 0xef, 0x99, 0xa8, 0x94, 0x28, 0x66, 0x5f, 0x82, 0xc5, 0xb0, 0xec,
-	0x38, 0x3e, 0xff, 0x02, 0xcc, 0xc6, 0x79, 0x62, 0xbb, 0x19, 0x32, 0x0f, 0xcd, 0x90, 0xac, 0xd6,
-	0xce, 0x26, 0x0a, 0x9b, 0x7a, 0x3b, 0x14, 0xcf, 0x25, 0xc4, 0xa3, 0x9b, 0x98, 0x83, 0x99, 0x90,
-	0xba, 0xbb, 0xb6, 0xdf, 0xe1, 0x27, 0x4f, 0x88, 0x76, 0xf6, 0xd6, 0x76, 0x36, 0xd7, 0xbf, 0x6d,
-	0x16, 0x13, 0xdb, 0xd8, 0xd0, 0xd7, 0xc4, 0x25, 0x94, 0x56, 0xff, 0xbe, 0x01, 0xb9, 0xb5, 0xdd,
-	0x2d, 0xf2, 0x1c, 0x20, 0xaa, 0x08, 0x92, 0x1b, 0x11, 0x6c, 0x1b, 0xab, 0x12, 0x2e, 0x8d, 0x7f,
-	0x2d, 0xa4, 0x5d, 0x23, 0xeb, 0x50, 0x4f, 0xd4, 0x3a, 0xc9, 0xad, 0xc9, 0xe1, 0x51, 0x59, 0x32,
-	0x65, 0x86, 0x8f, 0x32, 0xe4, 0x19, 0x94, 0x64, 0xb9, 0x90, 0x84, 0x38, 0x24, 0x59, 0x3f, 0x4c,
-	0x1f, 0xf7, 0x25, 0x40, 0x54, 0xf8, 0x8c, 0xf6, 0x3d, 0x51, 0x0c, 0x5d, 0x22, 0xc9, 0x3a, 0x6b,
-	0x38, 0xc1, 0x2f, 0xa1, 0x16, 0x2f, 0xf2, 0x91, 0x9b, 0xa1, 0x51, 0x4e, 0x96, 0xfe, 0x4e, 0xdb,
-	0x42, 0x25, 0xac, 0xe3, 0x91, 0x56, 0x08, 0x19, 0xc7, 0x4a, 0x7b, 0x4b, 0x8b, 0x13, 0x0e, 0xa4,
-	0x3d, 0xf4, 0x82, 0x13, 0xed, 0x1a, 0xf9, 0x3d, 0x28, 0xc9, 0xaa, 0x5e, 0x74, 0xf6, 0x64, 0x99,
-	0x6f, 0xca, 0xe0, 0x5f, 0x42, 0x2d, 0x9e, 0x77, 0x47, 0xfb, 0x4f, 0xc9, 0xc6, 0x97, 0x66, 0x13,
-	0x80, 0x56, 0x5e, 0xdf, 0x67, 0x50, 0x09, 0xb3, 0xef, 0x68, 0xff, 0xe3, 0x09, 0x79, 0xea, 0xd8,
-	0x8f, 0x32, 0xa4, 0x8d, 0x9f, 0xca, 0x85, 0x05, 0x85, 0x68, 0xfd, 0x94, 0x32, 0xc3, 0x94, 0x63,
-	0x6c, 0x41, 0x23, 0x99, 0x70, 0x92, 0xdb, 0xd1, 0x07, 0xd8, 0x29, 0x89, 0xe8, 0xd4, 0xa9, 0x66,
-	0xc6, 0xf0, 0x1b, 0xb9, 0x33, 0xa6, 0x94, 0xf1, 0xc9, 0x52, 0x6b, 0xfe, 0xda, 0x35, 0x7e, 0xb8,
-	0x38, 0x4e, 0x8b, 0x0e, 0x97, 0x82, 0xde, 0x4e, 0x9b, 0xe4, 0xa3, 0x0c, 0x3f, 0x5c, 0x12, 0x58,
-	0x45, 0x87, 0x4b, 0x05, 0x5c, 0x53, 0x0e, 0xf7, 0x12, 0xea, 0x09, 0x5c, 0x14, 0xd9, 0x5a, 0x1a,
-	0x5c, 0x9a, 0x32, 0x51, 0x1b, 0x6a, 0x71, 0x68, 0x14, 0x7b, 0xf7, 0x93, 0x80, 0x69, 0xca, 0x34,
-	0x1b, 0x50, 0x8d, 0x61, 0x23, 0x12, 0xfe, 0x8b, 0xd7, 0x24, 0x60, 0x9a, 0x6e, 0x00, 0x12, 0xca,
-	0x44, 0x06, 0x90, 0xc4, 0x36, 0xd3, 0x0f, 0x12, 0xc7, 0x31, 0xd1, 0x41, 0x52, 0xd0, 0xcd, 0xf4,
-	0x69, 0xe2, 0x18, 0x27, 0x9a, 0x26, 0x05, 0xf9, 0x4c, 0x3d, 0x0a, 0xfa, 0x23, 0x39, 0xc9, 0x29,
-	0x72, 0x4b, 0x73, 0x93, 0x91, 0x9f, 0xa1, 0x32, 0xeb, 0x09, 0xa0, 0x34, 0xe1, 0x48, 0x93, 0xbb,
-	0x48, 0xc1, 0x0f, 0xda, 0x35, 0xf2, 0xb9, 0x72, 0x47, 0x6b, 0x83, 0xc1, 0xa9, 0x1b, 0x38, 0xfd,
-	0x00, 0x9f, 0x42, 0x49, 0x16, 0xaa, 0xa3, 0xbb, 0x48, 0x56, 0xae, 0xa3, 0x75, 0xa3, 0x52, 0x2c,
-	0x3e, 0xf3, 0xd7, 0x50, 0x8b, 0x03, 0x93, 0x48, 0x85, 0x29, 0x28, 0x66, 0xe9, 0x56, 0x3a, 0x53,
-	0x60, 0x19, 0xe1, 0x10, 0x92, 0x3f, 0x50, 0x44, 0x36, 0x93, 0xfa, 0xc3, 0xc5, 0x94, 0x23, 0xbd,
-	0x46, 0xfc, 0xbe, 0xed, 0x9a, 0xd6, 0x1e, 0x65, 0xc1, 0x26, 0x3d, 0x30, 0x47, 0x83, 0xd3, 0xef,
-	0xe6, 0xa6, 0x42, 0xe5, 0xb1, 0x31, 0xd1, 0xbe, 0xd6, 0xff, 0xff, 0xbf, 0xbd, 0xbf, 0x93, 0xf9,
-	0xed, 0xfb, 0x3b, 0x99, 0xff, 0x7e, 0x7f, 0x27, 0xf3, 0xab, 0xc7, 0x7d, 0x3b, 0x38, 0x1c, 0x75,
-	0x97, 0x7b, 0xee, 0x70, 0xc5, 0x33, 0x7b, 0x87, 0x27, 0x16, 0xf5, 0xe3, 0xad, 0xe3, 0xd5, 0x15,
-	0xe6, 0xf7, 0x56, 0x3c, 0x8f, 0x75, 0x8b, 0xb8, 0xce, 0xd3, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0xe1, 0xa9, 0xad, 0x69, 0x3a, 0x00, 0x00,
+	// 5511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4b, 0x6c, 0x1c, 0xd9,
+	0x75, 0xb6, 0xfa, 0xdd, 0x7d, 0xba, 0xd9, 0x6c, 0x5e, 0x3e, 0x54, 0xa2, 0x1e, 0xe4, 0x94, 0xec,
+	0xb1, 0x34, 0x1e, 0x53, 0x63, 0x6a, 0x2c, 0xcf, 0x8c, 0x3d, 0x63, 0xf3, 0xd1, 0xd2, 0x4f, 0x89,
+	0x22, 0xe9, 0xdb, 0xd4, 0x0c, 0x6c, 0xfc, 0x3f, 0xca, 0xd5, 0x5d, 0xb7, 0xc9, 0x12, 0xab, 0xab,
+	0x6a, 0xaa, 0xaa, 0x29, 0x71, 0x36, 0xfe, 0x97, 0xff, 0xef, 0x45, 0x80, 0xc4, 0x59, 0x64, 0x99,
+	0x4d, 0x16, 0x59, 0xe4, 0x81, 0x20, 0xeb, 0x04, 0x01, 0xb2, 0x48, 0x76, 0xde, 0x1b, 0x10, 0x02,
+	0xed, 0x83, 0xac, 0x03, 0x24, 0x40, 0x70, 0xee, 0xa3, 0x1e, 0xcd, 0x66, 0x93, 0x22, 0x67, 0xc5,
+	0x7b, 0xcf, 0x39, 0xf7, 0x7d, 0xef, 0x79, 0x7c, 0xa7, 0x9a, 0x30, 0xe5, 0xfb, 0xe1, 0x03, 0xdf,
+	0x0f, 0x57, 0xfc, 0xc0, 0x8b, 0x3c, 0x52, 0xf6, 0xfd, 0xd0, 0x38, 0x5e, 0x5d, 0xbc, 0x79, 0xe0,
+	0x79, 0x07, 0x0e, 0x7b, 0xc0, 0xa9, 0xdd, 0x61, 0xff, 0x01, 0x1b, 0xf8, 0xd1, 0x89, 0x10, 0x5a,
+	0x5c, 0x1a, 0x65, 0x46, 0xf6, 0x80, 0x85, 0x91, 0x39, 0xf0, 0xa5, 0xc0, 0x9d, 0x51, 0x01, 0x6b,
+	0x18, 0x98, 0x91, 0xed, 0xb9, 0x92, 0x3f, 0x77, 0xe0, 0x1d, 0x78, 0xbc, 0xf8, 0x00, 0x4b, 0x92,
+	0x3a, 0xe5, 0xf7, 0xc3, 0x07, 0x7e, 0x5f, 0x4e, 0x45, 0x3f, 0x82, 0x7a, 0x87, 0xf5, 0x02, 0x16,
+	0x3d, 0xf7, 0x86, 0x6e, 0x44, 0x08, 0x14, 0x5d, 0x73, 0xc0, 0xb4, 0xdc, 0x72, 0xee, 0x5e, 0x8d,
+	0xf2, 0x32, 0x69, 0x41, 0xe1, 0x88, 0x9d, 0x68, 0x79, 0x4e, 0xc2, 0x22, 0xb9, 0x0d, 0x30, 0x40,
+	0x71, 0xc3, 0x37, 0xa3, 0x43, 0xad, 0xc0, 0x19, 0x35, 0x4e, 0xd9, 0x33, 0xa3, 0x43, 0x72, 0x1d,
+	0x2a, 0xcc, 0x3d, 0x36, 0x8e, 0xcd, 0x40, 0x2b, 0x72, 0x5e, 0x99, 0xb9, 0xc7, 0x5f, 0x9a, 0x81,
+	0xfe, 0x87, 0x02, 0xd4, 0xf6, 0x03, 0xd3, 0x0d, 0xfb, 0x5e, 0x30, 0x20, 0x73, 0x50, 0xb2, 0x07,
+	0xe6, 0x81, 0x1a, 0x4c, 0x54, 0x70, 0xb4, 0xde, 0xc0, 0xd2, 0xf2, 0xcb, 0x05, 0x1c, 0xad, 0x37,
+	0xb0, 0x78, 0x77, 0x41, 0x60, 0x20, 0xb5, 0xc0, 0xa9, 0x65, 0x16, 0x04, 0x1b, 0x03, 0x8b, 0x7c,
+	0x08, 0x05, 0xe6, 0x1e, 0x6b, 0xc5, 0xe5, 0xc2, 0xbd, 0xfa, 0xea, 0xe2, 0x8a, 0xd8, 0xd4, 0x95,
+	0x78, 0x80, 0x95, 0xb6, 0x7b, 0xdc, 0x76, 0xa3, 0xe0, 0x84, 0xa2, 0x18, 0xf9, 0x01, 0x54, 0x42,
+	0xbe, 0xd2, 0x50, 0x2b, 0xf1, 0x16, 0xb3, 0xaa, 0x45, 0x6a, 0x03, 0xa8, 0x92, 0x21, 0x1f, 0x02,
+	0xe1, 0x13, 0x32, 0xfc, 0xa1, 0xe3, 0x18, 0xaa, 0x65, 0x99, 0x4f, 0xa0, 0xc5, 0x39, 0x7b, 0x43,
+	0xc7, 0xe9, 0x48, 0xe9, 0x39, 0x28, 0x85, 0x91, 0x65, 0xbb, 0x5a, 0x85, 0x0b, 0x88, 0x0a, 0xb9,
+	0x09, 0x35, 0x9c, 0xb9, 0xe0, 0x54, 0x39, 0xa7, 0xca, 0x82, 0xa0, 0xc3, 0x99, 0x1f, 0x02, 0x31,
+	0x7b, 0x3d, 0xe6, 0x47, 0x46, 0xc0, 0xa2, 0x61, 0xe0, 0x1a, 0x3d, 0xcf, 0x62, 0x5a, 0x6d, 0xb9,
+	0x70, 0xaf, 0x40, 0x5b, 0x82, 0x43, 0x39, 0x63, 0xc3, 0xb3, 0x18, 0x0e, 0x60, 0xb1, 0xee, 0xf0,
+	0x40, 0x83, 0xe5, 0xdc, 0xbd, 0x2a, 0x15, 0x15, 0x3c, 0xae, 0x61, 0xc8, 0x02, 0xad, 0x2e, 0x8e,
+	0x0b, 0xcb, 0x64, 0x09, 0xea, 0xaf, 0xbc, 0xe0, 0xc8, 0x76, 0x0f, 0x0c, 0xcb, 0x0e, 0xb4, 0x06,
+	0x67, 0x81, 0x24, 0x6d, 0xda, 0x01, 0xb9, 0x03, 0x60, 0x79, 0xbd, 0x23, 0x16, 0xf4, 0x6d, 0x87,
+	0x69, 0x53, 0x82, 0x9f, 0x50, 0x16, 0x1f, 0x41, 0x55, 0xed, 0x9c, 0x3a, 0xfb, 0x5c, 0x72, 0xf6,
+	0x73, 0x50, 0x3a, 0x36, 0x9d, 0x21, 0x93, 0xf7, 0x41, 0x54, 0x3e, 0xcb, 0x7f, 0x92, 0xd3, 0xef,
+	0x43, 0x69, 0xff, 0xf1, 0x53, 0xaf, 0x4b, 0x96, 0xa1, 0x1c, 0xf5, 0x8d, 0x97, 0x5e, 0x57, 0xb4,
+	0x5b, 0xaf, 0xbd, 0x7d, 0xb3, 0x24, 0x58, 0xb4, 0x14, 0xf5, 0x9f, 0x7a, 0x5d, 0xfd, 0xef, 0x72,
+	0x50, 0x6e, 0x1f, 0x04, 0x2c, 0x0c, 0x71, 0x84, 0x17, 0x74, 0x5b, 0x8d, 0xf0, 0x82, 0x6e, 0x93,
+	0x65, 0xa8, 0xdb, 0x6e, 0x2f, 0x60, 0x03, 0xe6, 0x46, 0xa6, 0xc3, 0xc7, 0xa9, 0xd2, 0x34, 0x89,
+	0x7c, 0x17, 0x9a, 0x16, 0x73, 0x58, 0xc4, 0x8c, 0x80, 0x0d, 0xbc, 0x63, 0x66, 0xf1, 0x3b, 0x58,
+	0xa5, 0x53, 0x82, 0x4a, 0x05, 0x91, 0x3c, 0x87, 0x46, 0xf8, 0xb5, 0x63, 0x58, 0x66, 0x64, 0x76,
+	0xcd, 0x90, 0xf1, 0xcb, 0x58, 0x5f, 0xbd, 0x11, 0x1f, 0xfb, 0x2f, 0xb6, 0x37, 0x25, 0x4b, 0xcc,
+	0x65, 0x7d, 0xfa, 0xed, 0x9b, 0xa5, 0x7a, 0x8a, 0x4c, 0xeb, 0xe1, 0xd7, 0x8e, 0xaa, 0xe8, 0xbf,
+	0x2d, 0xc2, 0xcc, 0xa9, 0x36, 0xe4, 0x06, 0x14, 0x86, 0x81, 0x23, 0x57, 0x5a, 0x79, 0xfb, 0x66,
+	0x09, 0xd7, 0x40, 0x91, 0x46, 0x3e, 0x81, 0xb2, 0xb8, 0x37, 0x7c, 0x0d, 0xf5, 0xd5, 0xe5, 0x33,
+	0x47, 0x96, 0x57, 0x90, 0x4a, 0x79, 0xd2, 0x86, 0x3a, 0x1e, 0x85, 0x81, 0xd7, 0xd8, 0x8c, 0xf8,
+	0xea, 0xea, 0xab, 0xdf, 0x39, 0xbb, 0xf9, 0x63, 0xdb, 0x61, 0x8f, 0xb9, 0x2c, 0x85, 0x7e, 0x5c,
+	0x26, 0x0b, 0x50, 0x0e, 0x7b, 0x87, 0x6c, 0x60, 0xaa, 0x77, 0x28, 0x6a, 0x78, 0x86, 0x91, 0xd9,
+	0x75, 0x98, 0x56, 0x12, 0x67, 0xc8, 0x2b, 0x64, 0x15, 0x8a, 0x03, 0xbc, 0x82, 0xe5, 0xe5, 0xdc,
+	0xbd, 0xe6, 0xea, 0x9d, 0xb3, 0x47, 0x7b, 0xee, 0x59, 0x8c, 0x72, 0xd9, 0xc5, 0x15, 0x28, 0x8b,
+	0xa9, 0x5f, 0x4c, 0x73, 0x2c, 0xfe, 0x45, 0x0e, 0x20, 0x99, 0x2c, 0xf9, 0x1c, 0x8a, 0xd1, 0x89,
+	0x2f, 0x1a, 0x35, 0x57, 0xef, 0x5f, 0x64, 0x81, 0x2b, 0xfb, 0x27, 0x3e, 0xa3, 0xbc, 0x19, 0xd1,
+	0xa0, 0xd2, 0xf3, 0x9c, 0xe1, 0xc0, 0x0d, 0xa5, 0xbe, 0x50, 0x55, 0x5c, 0xf9, 0x21, 0x33, 0x2d,
+	0x16, 0xc8, 0x9b, 0x21, 0x6b, 0xfa, 0xfb, 0x50, 0xc4, 0xf6, 0xa4, 0x0e, 0x95, 0x17, 0x3b, 0xcf,
+	0x76, 0x76, 0xbf, 0xda, 0x69, 0x5d, 0x23, 0x15, 0x28, 0x6c, 0x74, 0xbe, 0x6c, 0xe5, 0x48, 0x15,
+	0x8a, 0x4f, 0x3b, 0xbb, 0x3b, 0xad, 0xbc, 0xbe, 0x04, 0x45, 0x5c, 0x25, 0x01, 0x28, 0xaf, 0xed,
+	0xed, 0xb5, 0x77, 0x36, 0x5b, 0xd7, 0xb0, 0x0d, 0x6d, 0xef, 0x6d, 0xaf, 0x6d, 0xb4, 0x5b, 0x39,
+	0xfd, 0x17, 0x50, 0xc0, 0xab, 0xfe, 0x21, 0x54, 0x7d, 0xdb, 0x67, 0x8e, 0xed, 0x8a, 0x45, 0xd4,
+	0x57, 0x5b, 0x6a, 0x11, 0x7b, 0x92, 0x4e, 0x63, 0x09, 0xb2, 0x00, 0x79, 0xdb, 0x12, 0xdb, 0xb1,
+	0x5e, 0x7e, 0xfb, 0x66, 0x29, 0xbf, 0xb5, 0x49, 0xf3, 0xb6, 0xf5, 0x59, 0xf1, 0xcf, 0xfe, 0x7c,
+	0xe9, 0x9a, 0xfe, 0x7f, 0xf3, 0x50, 0x7d, 0xce, 0x22, 0x13, 0xef, 0x2b, 0xd9, 0x80, 0xba, 0xe9,
+	0xba, 0x5e, 0xc4, 0x15, 0x7a, 0xa8, 0xe5, 0xb8, 0xc6, 0x7a, 0x4f, 0xf5, 0xad, 0xc4, 0x56, 0xd6,
+	0x12, 0x19, 0xa1, 0xea, 0xd2, 0xad, 0xc8, 0xc7, 0x50, 0x76, 0xcc, 0x2e, 0x73, 0xc4, 0xf6, 0xd4,
+	0x57, 0x6f, 0x9d, 0x6a, 0xbf, 0xcd, 0xd9, 0xa2, 0xa9, 0x94, 0x5d, 0xfc, 0x02, 0x5a, 0xa3, 0xdd,
+	0xbe, 0x8b, 0x1e, 0x58, 0xfc, 0x14, 0xea, 0xa9, 0x6e, 0xdf, 0x49, 0x85, 0xfc, 0x06, 0x2a, 0x1d,
+	0x16, 0x1c, 0xdb, 0x3d, 0x46, 0xee, 0xc2, 0x94, 0xed, 0x46, 0x2c, 0x70, 0x4d, 0xc7, 0xf0, 0xbd,
+	0x20, 0xe2, 0x1d, 0x94, 0x68, 0x43, 0x11, 0xf7, 0xbc, 0x20, 0x42, 0x21, 0xf6, 0x3a, 0x2d, 0x94,
+	0x17, 0x42, 0xec, 0x75, 0x4a, 0x08, 0x77, 0xdd, 0xd7, 0x0a, 0xa9, 0x5d, 0xdf, 0xa3, 0x79, 0xdb,
+	0xc7, 0x1b, 0xcb, 0x2f, 0x9f, 0x78, 0x1b, 0xbc, 0xac, 0xaf, 0x42, 0xa9, 0xe3, 0x7b, 0xc3, 0x88,
+	0xdc, 0x47, 0x6b, 0xc1, 0x67, 0x22, 0xcf, 0x75, 0x3a, 0xb1, 0x16, 0x9c, 0x4c, 0x15, 0x5f, 0xff,
+	0xcf, 0x22, 0x54, 0xf7, 0x1e, 0x77, 0xb6, 0x5c, 0x7f, 0x38, 0xfe, 0x19, 0x10, 0x28, 0x06, 0xcc,
+	0xf7, 0xe4, 0x72, 0x79, 0x19, 0x4d, 0x03, 0xfe, 0x35, 0xf8, 0x0c, 0x84, 0x0e, 0xae, 0x22, 0x81,
+	0xdf, 0xce, 0x05, 0x28, 0x77, 0x03, 0xd3, 0xed, 0x29, 0xdb, 0x2a, 0x6b, 0x48, 0xef, 0x79, 0x83,
+	0x81, 0x1d, 0xa9, 0xf7, 0x2c, 0x6a, 0x38, 0xc0, 0x81, 0xe3, 0x75, 0xe5, 0x73, 0xe6, 0x65, 0xb4,
+	0x9a, 0x2f, 0x3d, 0xdb, 0x35, 0x3c, 0x97, 0x3f, 0xe8, 0x1a, 0x2d, 0x63, 0x75, 0xd7, 0x45, 0xe3,
+	0xed, 0x0d, 0x23, 0x16, 0x18, 0x58, 0xd7, 0x2a, 0xfc, 0x79, 0xd4, 0x38, 0xe5, 0xa9, 0x67, 0xbb,
+	0xe4, 0x06, 0x54, 0x0f, 0x02, 0x6f, 0xe8, 0x1b, 0xdd, 0x13, 0xad, 0xca, 0x1b, 0x56, 0x78, 0x7d,
+	0xfd, 0x04, 0x87, 0x71, 0xcc, 0x6f, 0x4e, 0xb4, 0x1a, 0x6f, 0xc3, 0xcb, 0x68, 0x6d, 0xb8, 0xd3,
+	0x62, 0xa0, 0xda, 0x09, 0xa5, 0x75, 0x02, 0x4e, 0xc2, 0x37, 0x1b, 0x92, 0x26, 0xe4, 0xc3, 0x87,
+	0xdc, 0x40, 0x55, 0x69, 0x3e, 0x7c, 0x88, 0x1b, 0x1b, 0x05, 0xf6, 0xc1, 0x01, 0x13, 0xa6, 0x89,
+	0x6f, 0x6c, 0x5f, 0x1a, 0x6e, 0x4e, 0xa6, 0x8a, 0x8f, 0xcf, 0x9b, 0xbd, 0xee, 0x39, 0x43, 0x8b,
+	0x69, 0x4d, 0xf1, 0xbc, 0x65, 0x95, 0x7c, 0x07, 0x9a, 0x03, 0xdb, 0x35, 0x42, 0xfb, 0x1b, 0x66,
+	0x74, 0x4f, 0x22, 0x16, 0x6a, 0xd3, 0xcb, 0xb9, 0x7b, 0x05, 0xda, 0x18, 0xd8, 0x6e, 0xc7, 0xfe,
+	0x86, 0xad, 0x23, 0x8d, 0x4b, 0x99, 0xaf, 0xd3, 0x52, 0x2d, 0x29, 0x65, 0xbe, 0x4e, 0xa4, 0x1e,
+	0x42, 0xbd, 0xe7, 0xb9, 0x11, 0x73, 0x23, 0x03, 0xef, 0xe9, 0x0c, 0x9f, 0x14, 0x51, 0xa7, 0xbd,
+	0x21, 0x58, 0xcf, 0xd8, 0x09, 0x85, 0x5e, 0x5c, 0x26, 0x6b, 0xd0, 0x1c, 0x78, 0x96, 0xdd, 0xb7,
+	0x99, 0x65, 0x98, 0xfd, 0x88, 0x05, 0x1a, 0xe1, 0xed, 0x16, 0x57, 0x84, 0x53, 0xb6, 0xa2, 0x9c,
+	0xb2, 0x95, 0x7d, 0xe5, 0xb5, 0xd1, 0x29, 0xd5, 0x62, 0x0d, 0x1b, 0x90, 0x0d, 0x98, 0x8e, 0xbb,
+	0xe8, 0xb2, 0xbe, 0x17, 0x30, 0x6d, 0xf6, 0xdc, 0x3e, 0xe2, 0x51, 0xd7, 0x79, 0x0b, 0xfd, 0x37,
+	0x00, 0xc9, 0x0c, 0xc9, 0x7d, 0xa8, 0xbd, 0x0c, 0x3d, 0x57, 0xb8, 0x65, 0xc2, 0x22, 0x35, 0xde,
+	0xbe, 0x59, 0xaa, 0xa2, 0x52, 0x43, 0xcf, 0x8c, 0x56, 0x91, 0x8d, 0x25, 0x7e, 0x96, 0xa8, 0xb4,
+	0xf2, 0x7c, 0x47, 0x78, 0x19, 0xdf, 0x65, 0xdf, 0x66, 0x8e, 0xb0, 0xa6, 0x05, 0x2a, 0x2a, 0xe4,
+	0x16, 0xd4, 0x2c, 0xe6, 0xd8, 0x03, 0x1b, 0x57, 0x29, 0xee, 0x5d, 0x42, 0xd0, 0xff, 0x26, 0x07,
+	0xb5, 0x8d, 0xc0, 0x73, 0xdf, 0xed, 0xf6, 0x27, 0x17, 0xb9, 0x30, 0x7a, 0x91, 0x43, 0x9f, 0xf5,
+	0xd4, 0x93, 0xc4, 0x32, 0x8e, 0xef, 0x1d, 0xb3, 0xe0, 0x55, 0x60, 0x47, 0xc2, 0x60, 0x55, 0x69,
+	0x42, 0x20, 0x1f, 0xa1, 0xe3, 0x65, 0x06, 0x91, 0x56, 0x3e, 0x77, 0xef, 0x84, 0xa0, 0xfe, 0xf7,
+	0x39, 0xa8, 0xbe, 0xa0, 0xdb, 0xdf, 0xce, 0x84, 0xa5, 0xf5, 0x2f, 0x8e, 0xb1, 0xfe, 0x6a, 0x2d,
+	0xa5, 0xd4, 0x5a, 0xd4, 0x43, 0x2d, 0xa7, 0x1e, 0xea, 0x69, 0x67, 0xa6, 0x32, 0xc6, 0x99, 0xd1,
+	0xff, 0x38, 0x0f, 0x25, 0x31, 0x67, 0x1d, 0x0a, 0x7e, 0x3f, 0x3c, 0x65, 0x6e, 0xa4, 0x06, 0xa2,
+	0xc8, 0x24, 0xef, 0x41, 0x91, 0x3f, 0x6f, 0xa1, 0xf7, 0xa7, 0x94, 0x90, 0x90, 0xe0, 0x2c, 0x72,
+	0x17, 0x4a, 0xfc, 0x61, 0x6b, 0x85, 0x71, 0x32, 0x82, 0x87, 0x42, 0xbd, 0xc0, 0x0b, 0x43, 0xad,
+	0x38, 0x56, 0x88, 0xf3, 0x50, 0x68, 0xe8, 0xda, 0x9e, 0xab, 0x95, 0xc6, 0x0a, 0x71, 0x1e, 0xf9,
+	0x2e, 0x14, 0x7b, 0x81, 0x54, 0x46, 0xf5, 0xd5, 0x99, 0xf8, 0x7d, 0xa9, 0xbb, 0x43, 0x39, 0x9b,
+	0x7c, 0x5f, 0x6c, 0x68, 0x25, 0xbb, 0x38, 0x75, 0x5e, 0xd9, 0x2d, 0xd6, 0x5d, 0xa8, 0x3e, 0xf5,
+	0xba, 0x67, 0x9f, 0xe4, 0xfb, 0xf1, 0xa9, 0x09, 0x07, 0xac, 0xa9, 0x54, 0xcd, 0x06, 0xa7, 0x9e,
+	0xd2, 0x9f, 0x85, 0xd4, 0xb1, 0x28, 0x65, 0x57, 0x4c, 0x94, 0x9d, 0xfe, 0x03, 0x98, 0xde, 0x33,
+	0x03, 0xd3, 0x71, 0x98, 0x63, 0x87, 0x83, 0x0e, 0x9e, 0xe8, 0x22, 0x54, 0x7b, 0x9e, 0x1b, 0x46,
+	0xa6, 0x2b, 0x2c, 0x54, 0x91, 0xc6, 0x75, 0xfd, 0x21, 0xd4, 0xf8, 0xdc, 0x50, 0x11, 0x62, 0x7f,
+	0xc9, 0xb3, 0xa4, 0xbc, 0x8c, 0xb4, 0x43, 0x33, 0x3c, 0xe4, 0xb3, 0x6b, 0x50, 0x5e, 0xd6, 0xbf,
+	0x80, 0xd2, 0xa6, 0x19, 0x0d, 0x07, 0xe4, 0x36, 0x14, 0x94, 0x0b, 0x5d, 0x5f, 0xad, 0xab, 0x9d,
+	0x40, 0x27, 0x1a, 0xe9, 0x67, 0xf9, 0x12, 0xfa, 0xff, 0xcf, 0x43, 0x8d, 0x77, 0xb0, 0xe5, 0xf6,
+	0x3d, 0x3c, 0x1a, 0x0b, 0x2b, 0xb2, 0x9b, 0xf8, 0x68, 0xb8, 0x04, 0x15, 0x3c, 0x72, 0x8f, 0xbf,
+	0xa1, 0x48, 0x28, 0x83, 0xe6, 0x2a, 0xc9, 0x08, 0x75, 0x90, 0x43, 0x85, 0x00, 0xf9, 0x40, 0x48,
+	0x86, 0xd2, 0x23, 0x9d, 0x8b, 0x2f, 0x5f, 0xe0, 0xf5, 0x58, 0x18, 0xa2, 0x6c, 0x28, 0x64, 0x43,
+	0x54, 0x46, 0xb8, 0xdb, 0xa2, 0x67, 0xe1, 0x7a, 0x37, 0xd4, 0xfe, 0xe3, 0x8e, 0xd0, 0xaa, 0xdf,
+	0xe7, 0x2d, 0x50, 0x9d, 0x17, 0xd1, 0x1b, 0x91, 0xf7, 0xa7, 0x95, 0x96, 0xc2, 0x55, 0x50, 0xce,
+	0x25, 0x1f, 0x41, 0xd5, 0x8c, 0x22, 0x34, 0x2d, 0x22, 0x0e, 0x4b, 0x8d, 0xcf, 0x67, 0xba, 0x26,
+	0x98, 0x34, 0x96, 0xd2, 0xff, 0x3b, 0x07, 0x8d, 0x34, 0x8b, 0x7c, 0x0c, 0x15, 0xae, 0x04, 0x98,
+	0xa5, 0xe5, 0xce, 0xd5, 0x17, 0x4a, 0x94, 0xfc, 0x08, 0xaa, 0x2a, 0xb4, 0x96, 0x17, 0xe9, 0xc6,
+	0xa9, 0x66, 0x9b, 0x52, 0x80, 0xc6, 0xa2, 0xa8, 0x4e, 0x59, 0x10, 0x78, 0x81, 0xbc, 0x56, 0xa2,
+	0xc2, 0x63, 0xc2, 0xd7, 0x76, 0x24, 0xa2, 0xbd, 0x22, 0x77, 0x57, 0xaa, 0x48, 0xe0, 0x51, 0xde,
+	0x87, 0x00, 0x9e, 0x37, 0x30, 0x8e, 0x6c, 0xc7, 0x61, 0x96, 0x50, 0x76, 0xeb, 0x53, 0x6f, 0xdf,
+	0x2c, 0xd5, 0x76, 0x77, 0x9f, 0x3f, 0xe3, 0x44, 0x5a, 0xf3, 0xbc, 0x81, 0x28, 0xa2, 0xed, 0x0d,
+	0x23, 0x0b, 0x23, 0xcc, 0xc8, 0xb4, 0x1d, 0xa9, 0x54, 0x40, 0x90, 0xf6, 0x4d, 0xdb, 0xd1, 0xff,
+	0x36, 0x07, 0xb5, 0xb5, 0x83, 0x83, 0x80, 0x1d, 0xe0, 0x2e, 0xcf, 0x41, 0xa9, 0x87, 0x31, 0x2e,
+	0x5f, 0x7a, 0x81, 0x8a, 0x0a, 0xde, 0xc1, 0x01, 0x33, 0xc5, 0xc2, 0x72, 0x94, 0x97, 0x79, 0xdc,
+	0x10, 0x59, 0x16, 0x3b, 0xe6, 0x53, 0xcf, 0x51, 0x59, 0x23, 0xf7, 0xa1, 0xd5, 0xb7, 0xfb, 0xd1,
+	0xa1, 0xe1, 0xb3, 0xa0, 0xc7, 0xdc, 0xc8, 0x76, 0xc4, 0x12, 0x72, 0x74, 0x9a, 0xd3, 0xf7, 0x62,
+	0x32, 0x79, 0x04, 0xd7, 0x5d, 0xdb, 0x65, 0xdc, 0x31, 0x18, 0x69, 0x51, 0xe2, 0x2d, 0xe6, 0x05,
+	0xfb, 0x71, 0xb6, 0x9d, 0xfe, 0x27, 0x79, 0x68, 0xa4, 0x6f, 0x13, 0xf9, 0x02, 0xa6, 0x2c, 0xef,
+	0x95, 0xeb, 0x78, 0xa6, 0x65, 0x20, 0x02, 0xa2, 0xe5, 0xce, 0x3b, 0x81, 0x86, 0x92, 0xc7, 0xa3,
+	0x24, 0x3f, 0x85, 0x86, 0x2f, 0xfa, 0x13, 0xcd, 0xcf, 0x3d, 0xc0, 0xba, 0x14, 0xe7, 0xad, 0x3f,
+	0x83, 0xfa, 0xd0, 0x4f, 0xc6, 0x2e, 0x9c, 0xd7, 0x18, 0x84, 0x34, 0x6f, 0x8b, 0x8a, 0x5d, 0xcd,
+	0x5c, 0xb8, 0x1f, 0x45, 0xbe, 0xf1, 0xf1, 0x7a, 0x84, 0xff, 0xf1, 0x1e, 0x34, 0x86, 0x7e, 0x4a,
+	0xa8, 0xc4, 0x85, 0xe4, 0xb0, 0x5c, 0x44, 0xff, 0xcb, 0x3c, 0xcc, 0xc7, 0xe7, 0x98, 0xd9, 0x9d,
+	0x47, 0xe3, 0x77, 0x27, 0x56, 0xaf, 0x71, 0xab, 0x91, 0x5d, 0xf9, 0x78, 0xec, 0xae, 0x8c, 0x69,
+	0x96, 0xd9, 0x8d, 0xd5, 0x71, 0xbb, 0x31, 0xa6, 0x51, 0x7a, 0x17, 0x3e, 0x19, 0xbb, 0x0b, 0x63,
+	0x9b, 0x8d, 0x6c, 0xcc, 0xc7, 0x63, 0x36, 0x66, 0xfc, 0x1c, 0xd3, 0x7b, 0xf5, 0xbb, 0x1c, 0x34,
+	0xbe, 0xf2, 0x82, 0x23, 0x16, 0xe0, 0x0e, 0x0d, 0xb9, 0x1e, 0x7a, 0xc5, 0xeb, 0x86, 0x6d, 0xa5,
+	0x9d, 0x22, 0x21, 0xb4, 0xb5, 0x49, 0xab, 0x82, 0xbd, 0x65, 0x21, 0x70, 0xf1, 0xd2, 0xeb, 0x1a,
+	0xb1, 0x5e, 0xe5, 0xc0, 0x05, 0x5a, 0x98, 0x4d, 0x5a, 0x7a, 0xe9, 0x75, 0xb7, 0x2c, 0xf2, 0x08,
+	0x1a, 0x5c, 0x67, 0x72, 0xb5, 0x36, 0x54, 0x7a, 0x70, 0xf6, 0x94, 0xc6, 0x1c, 0x86, 0xb4, 0x6e,
+	0x25, 0x15, 0xfd, 0x25, 0xd4, 0x53, 0xbc, 0x4b, 0xea, 0xa1, 0xef, 0x4a, 0x35, 0x29, 0x8c, 0xfa,
+	0x4c, 0xc6, 0xcc, 0x72, 0x8d, 0xca, 0xd9, 0xba, 0x07, 0x0d, 0xca, 0x42, 0x6f, 0x18, 0xf4, 0x18,
+	0x37, 0x51, 0x88, 0xa8, 0xf9, 0x43, 0x3e, 0x50, 0x9e, 0x62, 0x11, 0xdf, 0xf7, 0x80, 0x0d, 0xbc,
+	0x40, 0x85, 0xe6, 0xb2, 0x46, 0xde, 0x83, 0xc2, 0x81, 0x3f, 0xd4, 0x0a, 0xd9, 0x80, 0xe7, 0xc9,
+	0xde, 0x0b, 0xec, 0x87, 0x22, 0x0f, 0xd5, 0x85, 0x65, 0x87, 0x47, 0xca, 0x43, 0xc3, 0xb2, 0xfe,
+	0x23, 0xa8, 0x48, 0x99, 0x38, 0xa6, 0xca, 0x25, 0x31, 0x15, 0x8e, 0xe6, 0x0e, 0x07, 0x5d, 0x16,
+	0x48, 0x67, 0x53, 0xd6, 0xf4, 0x5f, 0x01, 0x3c, 0xf5, 0xba, 0x1d, 0x16, 0x71, 0x4b, 0xf5, 0x3d,
+	0x8c, 0x57, 0xba, 0x46, 0xc8, 0x22, 0xb9, 0x25, 0xcd, 0x94, 0xc9, 0xeb, 0x20, 0x36, 0xf2, 0x92,
+	0xff, 0x25, 0x77, 0xd1, 0xb5, 0xe9, 0xaa, 0x90, 0x76, 0x3a, 0x25, 0x25, 0x6c, 0x05, 0x32, 0xf5,
+	0xdf, 0x37, 0xa1, 0x22, 0x29, 0xe7, 0x19, 0xd2, 0xfb, 0xd0, 0x52, 0x01, 0xba, 0x71, 0xcc, 0x82,
+	0x50, 0x69, 0xf9, 0x22, 0x9d, 0x56, 0xf4, 0x2f, 0x05, 0x99, 0x3c, 0x84, 0x29, 0x6f, 0x18, 0xf9,
+	0xc3, 0xc8, 0x10, 0x8e, 0x83, 0x56, 0x18, 0xeb, 0x56, 0x34, 0x84, 0x90, 0xa8, 0x61, 0x14, 0x13,
+	0x30, 0xe1, 0xa3, 0x16, 0x79, 0xb7, 0xaa, 0xca, 0x15, 0x84, 0x19, 0x99, 0x86, 0x7c, 0x62, 0x52,
+	0xe3, 0xa3, 0x82, 0x30, 0x23, 0x73, 0x4f, 0x11, 0x51, 0x41, 0x70, 0xb1, 0xf0, 0xc8, 0xf6, 0x7d,
+	0x66, 0x71, 0x3d, 0x5f, 0xe0, 0xd7, 0xcb, 0xec, 0x08, 0x12, 0xc6, 0x74, 0x5c, 0x24, 0xf2, 0x22,
+	0x53, 0x38, 0x4f, 0x05, 0x5a, 0x43, 0xca, 0x3e, 0x12, 0xd0, 0x50, 0x70, 0x76, 0xdf, 0xb4, 0xd1,
+	0xae, 0x54, 0x39, 0x9f, 0xb7, 0x78, 0xcc, 0x29, 0xf1, 0x4c, 0x02, 0xd6, 0x43, 0xd7, 0x9a, 0x59,
+	0x5a, 0x2d, 0x99, 0x09, 0x55, 0xc4, 0xc4, 0xfc, 0xc3, 0xf9, 0xe6, 0xff, 0x7d, 0xe5, 0x54, 0xd4,
+	0xb9, 0x53, 0xd1, 0x4a, 0x9f, 0x66, 0xda, 0xa5, 0x58, 0x80, 0x72, 0xc0, 0xcc, 0xd0, 0x73, 0x25,
+	0x52, 0x29, 0x6b, 0xf8, 0x44, 0x7a, 0x01, 0x33, 0xf1, 0x89, 0x4c, 0x9d, 0xff, 0x44, 0xa4, 0x68,
+	0xfa, 0x61, 0x35, 0x2f, 0xfe, 0xb0, 0x1e, 0x41, 0xb5, 0x6f, 0xbb, 0x76, 0x78, 0xc8, 0x2c, 0x6d,
+	0xfa, 0xdc, 0x66, 0xb1, 0x2c, 0xf9, 0x21, 0x54, 0x2c, 0x86, 0xb6, 0x57, 0x44, 0x96, 0xf5, 0xd5,
+	0xeb, 0x23, 0xb7, 0x71, 0x65, 0x53, 0xb0, 0xa9, 0x92, 0x23, 0x0f, 0xa0, 0xce, 0x38, 0xa4, 0x65,
+	0x70, 0x48, 0x72, 0x66, 0xec, 0x05, 0x02, 0x21, 0xb2, 0x6e, 0x86, 0x8c, 0x7c, 0x0a, 0xd5, 0x81,
+	0x44, 0x6b, 0x34, 0xc2, 0xaf, 0xfc, 0xed, 0xd1, 0x41, 0x14, 0x9a, 0x23, 0x60, 0x9c, 0x58, 0x7c,
+	0xf1, 0x8f, 0xaa, 0x50, 0xd9, 0x8c, 0xc7, 0xad, 0x45, 0x0a, 0x18, 0x1f, 0x35, 0x12, 0x31, 0x62,
+	0x4e, 0x13, 0x19, 0xb2, 0x0e, 0x2d, 0x3f, 0xf1, 0x75, 0x0d, 0x1e, 0xca, 0xe4, 0xb3, 0x8b, 0x1c,
+	0xf1, 0x85, 0xe9, 0xb4, 0x9f, 0x25, 0xa0, 0xff, 0x2d, 0x56, 0x92, 0x3c, 0x14, 0xd1, 0x52, 0xa0,
+	0x7a, 0x54, 0x72, 0xd3, 0x60, 0x4b, 0x71, 0x32, 0xd8, 0x82, 0x0e, 0x6d, 0xe8, 0x7b, 0xc3, 0x48,
+	0x2b, 0x65, 0x1d, 0x5a, 0x8e, 0xda, 0x50, 0xc1, 0x23, 0x9f, 0xc2, 0x94, 0x54, 0xf9, 0x52, 0x4d,
+	0x8f, 0xb8, 0x8b, 0x69, 0xfb, 0x40, 0x1b, 0xaf, 0x52, 0x35, 0xb2, 0x06, 0x33, 0x81, 0x54, 0x9e,
+	0x46, 0xc0, 0xbe, 0x1e, 0xb2, 0x30, 0x0a, 0x65, 0x34, 0x12, 0x37, 0x4f, 0x6b, 0x57, 0xda, 0x52,
+	0xe2, 0x54, 0x4a, 0x93, 0xcf, 0x61, 0x3a, 0xee, 0x82, 0x87, 0xc9, 0xa1, 0x56, 0x9d, 0xd0, 0x41,
+	0x53, 0x09, 0x6f, 0x73, 0x59, 0xb2, 0x0d, 0xd7, 0x43, 0xdb, 0x62, 0x3d, 0x33, 0x30, 0x46, 0xbb,
+	0xa9, 0x4d, 0xe8, 0x66, 0x5e, 0x36, 0xa2, 0xd9, 0xde, 0xee, 0x42, 0xc9, 0x46, 0xfb, 0xa0, 0x41,
+	0x76, 0xbf, 0x64, 0x6c, 0x66, 0xab, 0xd8, 0x29, 0x34, 0x9d, 0x48, 0xa5, 0x11, 0xb0, 0x4c, 0x3e,
+	0x83, 0xa6, 0xb4, 0x74, 0x2c, 0x12, 0xa7, 0xdf, 0xc8, 0x8e, 0x2e, 0xec, 0x19, 0x8b, 0xf8, 0xe8,
+	0x0d, 0x2b, 0x55, 0xe3, 0x3e, 0x1b, 0x6f, 0x8b, 0x6e, 0x02, 0x1e, 0xd6, 0xd4, 0xf9, 0x3e, 0x1b,
+	0xca, 0xef, 0x0b, 0x71, 0xf4, 0xba, 0xd0, 0x16, 0xa8, 0xd6, 0xcd, 0xf3, 0x5a, 0xc3, 0x4b, 0xaf,
+	0xab, 0xda, 0x0a, 0x5d, 0x87, 0x63, 0x07, 0x76, 0x8c, 0x0b, 0x81, 0xe8, 0x1e, 0x29, 0xe4, 0x67,
+	0x30, 0x8d, 0x30, 0xb8, 0x35, 0x74, 0x30, 0x45, 0xc2, 0x57, 0x26, 0x1e, 0xef, 0x42, 0x7c, 0x97,
+	0x62, 0xb6, 0x38, 0xa0, 0x30, 0x53, 0x47, 0x84, 0xcc, 0xf7, 0x2c, 0xd1, 0x72, 0x46, 0x20, 0x64,
+	0xbe, 0x67, 0x71, 0xd6, 0x4d, 0xa8, 0x21, 0xcb, 0x37, 0xa3, 0xde, 0x21, 0x47, 0x84, 0x6a, 0x14,
+	0x65, 0xf7, 0xb0, 0x4e, 0x1e, 0x03, 0x11, 0x33, 0x0b, 0x58, 0x14, 0x9c, 0x18, 0xbe, 0xe7, 0xd8,
+	0xbd, 0x13, 0x89, 0xf9, 0x68, 0xd9, 0xc0, 0x0c, 0x05, 0xf6, 0x38, 0x9f, 0xb6, 0xac, 0x11, 0x0a,
+	0xf9, 0x04, 0xb4, 0xaf, 0x87, 0x66, 0x60, 0xba, 0x11, 0x9a, 0x2c, 0xa1, 0xd3, 0x0d, 0x2e, 0x15,
+	0x6a, 0x73, 0x3c, 0x5a, 0x5d, 0x48, 0xf8, 0x42, 0xc1, 0xf3, 0x5e, 0xc3, 0xc5, 0x9f, 0xc0, 0x54,
+	0x46, 0x57, 0xbc, 0x13, 0x36, 0xfb, 0x04, 0xca, 0xe2, 0xdd, 0x8c, 0x0d, 0xb5, 0xef, 0x67, 0x63,
+	0xc8, 0xd9, 0xd3, 0x4f, 0x4d, 0x69, 0x7c, 0xfd, 0x0e, 0x54, 0x15, 0x36, 0x3e, 0xae, 0x2b, 0xfd,
+	0x1f, 0x66, 0xa0, 0xa1, 0x04, 0xb8, 0x01, 0x7f, 0x37, 0x90, 0x5d, 0x83, 0x4a, 0xd6, 0x8c, 0xab,
+	0x2a, 0xea, 0x5e, 0x3c, 0xb4, 0xc9, 0xc6, 0x1b, 0x50, 0x24, 0x31, 0xdd, 0x61, 0xe4, 0x71, 0xa3,
+	0x2b, 0x60, 0x00, 0x55, 0x25, 0xdf, 0x57, 0xcb, 0x2d, 0xf1, 0xe5, 0xce, 0x8f, 0xce, 0xe7, 0x0c,
+	0x13, 0x57, 0xce, 0x98, 0xb8, 0x75, 0xc0, 0x8b, 0x6b, 0xf0, 0x38, 0x2c, 0xe4, 0x99, 0xc3, 0xfa,
+	0xea, 0xdd, 0xd1, 0x9e, 0xb8, 0x86, 0x7f, 0xea, 0x75, 0x37, 0xb8, 0x94, 0x50, 0xf1, 0xb5, 0x97,
+	0xaa, 0x4e, 0x1e, 0x41, 0xd3, 0x31, 0xc3, 0x08, 0xb3, 0x6d, 0x32, 0xd4, 0xae, 0x9e, 0x61, 0x6f,
+	0x1b, 0x28, 0xa7, 0x6a, 0x98, 0x64, 0x4b, 0x69, 0x6b, 0xae, 0x59, 0x8a, 0x34, 0x4d, 0x22, 0x3f,
+	0x92, 0xae, 0x1c, 0xf0, 0xfe, 0xde, 0x1b, 0x3b, 0x2f, 0x55, 0x49, 0xe5, 0x64, 0x6e, 0x03, 0x98,
+	0xc3, 0xe8, 0xd0, 0x88, 0xbc, 0x23, 0xe6, 0x4a, 0x8d, 0x52, 0x43, 0xca, 0x3e, 0x12, 0xc8, 0xa3,
+	0xc4, 0x64, 0x0a, 0x7d, 0x72, 0x6b, 0x6c, 0xc7, 0xa3, 0x76, 0x73, 0xf1, 0xa7, 0xd0, 0xcc, 0x6e,
+	0x42, 0xfa, 0xee, 0x96, 0xc6, 0xdc, 0xdd, 0x52, 0x3a, 0x25, 0xf1, 0x1f, 0xf5, 0x2b, 0x58, 0xc2,
+	0x07, 0x71, 0x3a, 0x33, 0x9f, 0xd5, 0xa1, 0x3c, 0xa5, 0x79, 0x3a, 0xbb, 0x39, 0xd6, 0x74, 0x16,
+	0x2e, 0x6d, 0x3a, 0x8b, 0x13, 0x4d, 0xe7, 0xa7, 0x00, 0xd2, 0xf7, 0x31, 0x4c, 0x65, 0x14, 0x27,
+	0x39, 0x2f, 0x35, 0x29, 0xbd, 0x16, 0xa1, 0x5f, 0x19, 0x30, 0x8c, 0xbb, 0x0d, 0x01, 0x53, 0x88,
+	0xcb, 0x59, 0x17, 0xb4, 0x36, 0x92, 0xc8, 0xf7, 0x61, 0x46, 0x58, 0xc7, 0x50, 0x19, 0x43, 0x09,
+	0x4f, 0x16, 0x68, 0x4b, 0x32, 0xa8, 0xa2, 0xa7, 0x85, 0xcd, 0x63, 0xd3, 0x76, 0x78, 0x86, 0xb1,
+	0x9a, 0x11, 0x5e, 0x53, 0x74, 0xcc, 0xdc, 0x48, 0x57, 0x5a, 0x66, 0x3a, 0x6a, 0x7c, 0x74, 0xe9,
+	0x3a, 0xaf, 0x73, 0xda, 0x78, 0x63, 0x0c, 0x57, 0x35, 0xc6, 0xf5, 0x6f, 0xc7, 0x18, 0x37, 0xae,
+	0x60, 0x8c, 0xa7, 0x26, 0x18, 0xe3, 0x65, 0xa8, 0x5b, 0x2c, 0xec, 0x05, 0xb6, 0xcf, 0x01, 0xa7,
+	0xa6, 0x38, 0x95, 0x14, 0x29, 0x36, 0xd7, 0xad, 0x94, 0xb9, 0x4e, 0x74, 0xcc, 0x4c, 0x46, 0xc7,
+	0xa4, 0x5c, 0xab, 0xd9, 0x8b, 0xba, 0x56, 0x73, 0x13, 0x5c, 0xab, 0xd3, 0x6e, 0xc1, 0xfc, 0xe5,
+	0xdd, 0x82, 0x85, 0x2b, 0xb9, 0x05, 0xd7, 0xaf, 0xe0, 0x16, 0x68, 0x17, 0x71, 0x0b, 0x6e, 0x5c,
+	0xda, 0x2d, 0x58, 0x9c, 0xe0, 0x16, 0xdc, 0x1c, 0x71, 0x0b, 0xe6, 0xa1, 0x1c, 0x3e, 0x34, 0x70,
+	0x41, 0xb7, 0xc4, 0xa7, 0x1d, 0xe1, 0xc3, 0xdd, 0x61, 0x84, 0x46, 0x2f, 0xf6, 0xfb, 0x6f, 0x67,
+	0x8d, 0x9e, 0xb2, 0xe1, 0x89, 0xab, 0x8f, 0x01, 0x5c, 0xc0, 0x14, 0xa2, 0xc3, 0xa7, 0x70, 0x87,
+	0x0f, 0x33, 0x15, 0x53, 0xf9, 0x44, 0xbe, 0x07, 0xd3, 0x43, 0xb7, 0xe7, 0x98, 0xf6, 0x80, 0x59,
+	0x46, 0x64, 0x86, 0x47, 0xa1, 0xb6, 0xc4, 0x77, 0xa2, 0x19, 0x93, 0xf7, 0x91, 0x8a, 0x33, 0x96,
+	0x1e, 0x74, 0xd0, 0xd3, 0x96, 0xc5, 0x8c, 0x05, 0x81, 0xf6, 0xf0, 0x86, 0x9a, 0xc3, 0xc8, 0x0b,
+	0x7b, 0x26, 0x2e, 0x5e, 0x7b, 0x8f, 0x4f, 0x3b, 0x4d, 0x3a, 0xc3, 0xd5, 0xd1, 0xbf, 0x55, 0x57,
+	0xe7, 0xee, 0x24, 0x57, 0x47, 0xff, 0x06, 0x1a, 0x69, 0xe3, 0x44, 0x6e, 0xc0, 0xfc, 0xde, 0xd6,
+	0x5e, 0x7b, 0x7b, 0x6b, 0x67, 0xdf, 0xd8, 0xff, 0xe5, 0x5e, 0xdb, 0x48, 0xd2, 0xff, 0x37, 0xe1,
+	0xba, 0x64, 0xb5, 0x05, 0x6b, 0x9f, 0xae, 0xed, 0x74, 0x1e, 0xef, 0xd2, 0xe7, 0xad, 0x1c, 0xb9,
+	0x0e, 0xb3, 0x59, 0x66, 0x67, 0x6f, 0xf7, 0xc5, 0x7e, 0x2b, 0x9f, 0xea, 0x50, 0x31, 0xda, 0xf4,
+	0xcb, 0xad, 0x8d, 0x76, 0xab, 0xa0, 0x3f, 0x85, 0xa9, 0xb4, 0x31, 0x43, 0x25, 0x3d, 0x15, 0x43,
+	0x0c, 0xb6, 0xdb, 0xf7, 0x64, 0x3a, 0x7f, 0x6e, 0x9c, 0xe9, 0xa3, 0x0d, 0x3f, 0x55, 0xd3, 0x97,
+	0xa1, 0x2c, 0xf0, 0x0f, 0x09, 0xf8, 0xe7, 0x4e, 0x01, 0xfe, 0x03, 0x98, 0xdb, 0x72, 0xf1, 0xc8,
+	0x23, 0x21, 0x28, 0x55, 0xdf, 0xc5, 0x01, 0x15, 0x02, 0xc5, 0x57, 0xa6, 0xcc, 0x91, 0x54, 0x29,
+	0x2f, 0xa3, 0xe7, 0xa3, 0xcc, 0xb4, 0xf8, 0x80, 0x42, 0x55, 0xf5, 0x1f, 0xc0, 0xcc, 0xb6, 0x1d,
+	0x8e, 0x8c, 0x95, 0x12, 0xcf, 0x65, 0xc5, 0x7f, 0x0d, 0x33, 0xc9, 0xec, 0x94, 0xf8, 0x39, 0x88,
+	0xcc, 0xbb, 0x4d, 0xe8, 0x9f, 0x72, 0xd0, 0x94, 0x33, 0x52, 0xfd, 0xbf, 0x9b, 0xc3, 0xf8, 0x43,
+	0x68, 0x70, 0xcd, 0x6b, 0xc4, 0xb9, 0xa2, 0xc2, 0x18, 0xbf, 0xb0, 0xce, 0x65, 0x12, 0xc7, 0xf0,
+	0xd0, 0x0e, 0x23, 0x44, 0xd0, 0x04, 0xa6, 0xab, 0xaa, 0xe9, 0x79, 0x96, 0x32, 0xf3, 0xc4, 0x4c,
+	0xd1, 0xcb, 0xaf, 0x1f, 0xdb, 0x4e, 0xc4, 0x94, 0xa9, 0x8d, 0xeb, 0xfa, 0xff, 0x81, 0xd9, 0xce,
+	0xb0, 0x8b, 0x1a, 0xbe, 0xcb, 0x2e, 0xbd, 0x8e, 0xd4, 0xd0, 0xf9, 0xec, 0x16, 0xfd, 0x10, 0x5a,
+	0x9b, 0x3c, 0x99, 0x78, 0xe1, 0x33, 0xd0, 0x9f, 0x40, 0xb3, 0x13, 0x79, 0xfe, 0xc5, 0x0f, 0x2d,
+	0x31, 0x40, 0x85, 0xb4, 0x01, 0xd2, 0xff, 0x3d, 0x0f, 0xf3, 0x2f, 0x7c, 0xcb, 0x8c, 0x98, 0xf2,
+	0x3d, 0x2f, 0xd8, 0xe1, 0xfb, 0xd9, 0x88, 0xe2, 0x02, 0x00, 0x52, 0x66, 0xe0, 0x34, 0xee, 0x56,
+	0x3a, 0x0f, 0x77, 0x2b, 0x5f, 0x04, 0x77, 0xab, 0x9c, 0xc6, 0xdd, 0xbe, 0x2d, 0x60, 0x2d, 0x8b,
+	0xdf, 0xc1, 0x28, 0x7e, 0x17, 0xe3, 0x6e, 0xf5, 0x73, 0x71, 0x37, 0xfd, 0x9f, 0xf3, 0xd0, 0x7c,
+	0xc2, 0xa2, 0x6d, 0xef, 0x20, 0xbc, 0xdc, 0x35, 0x92, 0xc7, 0x92, 0x3f, 0xe3, 0x58, 0xd4, 0xae,
+	0xf4, 0xf9, 0xcd, 0x0d, 0xe5, 0x27, 0x99, 0x7c, 0x1b, 0xc4, 0x65, 0x0e, 0x93, 0xa4, 0x63, 0x71,
+	0x42, 0xd2, 0x11, 0x31, 0x68, 0x33, 0xc4, 0xc7, 0x20, 0xde, 0x89, 0xac, 0x21, 0xbd, 0xef, 0x39,
+	0x8e, 0xf7, 0x8a, 0x1f, 0x4a, 0x95, 0xca, 0x1a, 0x47, 0x96, 0x31, 0xcb, 0x25, 0x4e, 0x81, 0x97,
+	0xc9, 0x3d, 0x68, 0x0d, 0x43, 0x66, 0x38, 0xde, 0x91, 0x6d, 0x74, 0xcd, 0xde, 0x11, 0x73, 0xc5,
+	0x19, 0x54, 0x69, 0x73, 0x18, 0xb2, 0x6d, 0xef, 0xc8, 0x5e, 0x17, 0x54, 0xf2, 0x00, 0x4a, 0xa1,
+	0xed, 0xf6, 0x98, 0x56, 0x3b, 0xcf, 0x69, 0x10, 0x72, 0xfa, 0x3f, 0xe6, 0x01, 0xb6, 0xbd, 0x83,
+	0xe7, 0x2c, 0x0c, 0xf1, 0xab, 0xd4, 0xbb, 0x29, 0x0d, 0x9e, 0x0a, 0x58, 0x63, 0x5d, 0xbd, 0x83,
+	0x31, 0xf0, 0xf9, 0xe9, 0x83, 0x4c, 0x2e, 0xa2, 0x30, 0x31, 0x17, 0xf1, 0x3e, 0x54, 0x85, 0x09,
+	0xb5, 0x2d, 0xf9, 0x79, 0x41, 0xfd, 0xed, 0x9b, 0xa5, 0x8a, 0x48, 0xed, 0x6e, 0xd2, 0x0a, 0x67,
+	0x6e, 0x59, 0x67, 0xee, 0xa3, 0x4a, 0x16, 0x94, 0x27, 0x26, 0x0b, 0xe2, 0x2f, 0x48, 0xc5, 0x37,
+	0x07, 0xbc, 0x4c, 0x3e, 0x80, 0x7c, 0x8c, 0x59, 0x4d, 0x8a, 0x25, 0xf2, 0x51, 0x88, 0xaf, 0x6c,
+	0x20, 0xf6, 0x48, 0x7a, 0xf0, 0xaa, 0xaa, 0x7f, 0x05, 0xb3, 0x54, 0x3c, 0x38, 0x69, 0xe8, 0x2f,
+	0xf4, 0xea, 0x47, 0xaf, 0x57, 0xfe, 0xd4, 0xf5, 0xd2, 0x3f, 0x83, 0x59, 0x69, 0x52, 0x32, 0x1d,
+	0x5f, 0x24, 0xd5, 0xad, 0xff, 0xbf, 0x3c, 0xb4, 0xd0, 0x58, 0xbc, 0xcb, 0x94, 0x62, 0xaf, 0x3d,
+	0x3f, 0xc1, 0x6b, 0xff, 0x31, 0x94, 0xc5, 0x94, 0x65, 0xa4, 0xb7, 0xa4, 0xa4, 0x46, 0x47, 0x5b,
+	0x11, 0xcb, 0xa0, 0x52, 0x1c, 0xa3, 0x26, 0xdf, 0x3c, 0xb0, 0x5d, 0x7e, 0xfb, 0x8c, 0x81, 0x89,
+	0xc7, 0x2f, 0xb3, 0x2b, 0xad, 0x84, 0xf1, 0x9c, 0xd3, 0x53, 0xa9, 0x94, 0x52, 0x3a, 0x95, 0xb2,
+	0xb8, 0x0a, 0x65, 0xd1, 0x6d, 0x92, 0xcb, 0x47, 0x17, 0x63, 0x52, 0x2e, 0x5f, 0xff, 0x43, 0x1e,
+	0x5a, 0xa3, 0x2e, 0x18, 0x6e, 0x3f, 0x7e, 0x32, 0x15, 0xe7, 0xd9, 0x45, 0xaa, 0xb8, 0x3e, 0x30,
+	0x5f, 0xcb, 0x14, 0x7a, 0x48, 0xd6, 0x61, 0xda, 0x76, 0xed, 0xc8, 0x36, 0x1d, 0xfe, 0xe6, 0xbc,
+	0x7e, 0xff, 0xfc, 0x9c, 0x6a, 0x53, 0xb6, 0x58, 0x17, 0x0d, 0xd0, 0x93, 0xc7, 0x61, 0x54, 0xfb,
+	0xf3, 0xd3, 0xaa, 0x03, 0xf3, 0xb5, 0x6a, 0x7b, 0x0f, 0x5a, 0xc2, 0xab, 0x8c, 0xd3, 0xe8, 0xe2,
+	0xeb, 0x94, 0x12, 0x06, 0x6f, 0x51, 0x70, 0xd2, 0x96, 0xc9, 0x74, 0x8c, 0xf4, 0xe7, 0x50, 0x31,
+	0x1b, 0x7d, 0x04, 0x48, 0x52, 0xd2, 0x25, 0x2e, 0x3d, 0x83, 0xbc, 0xc7, 0x66, 0x18, 0x25, 0x0d,
+	0xb6, 0x60, 0x5e, 0x74, 0x9d, 0x24, 0xe1, 0x0d, 0xcf, 0x75, 0x4e, 0x84, 0x2a, 0x5a, 0x5f, 0x78,
+	0xfb, 0x66, 0x89, 0xf0, 0xdd, 0x8a, 0xd3, 0xf1, 0xbb, 0xae, 0x73, 0x42, 0x09, 0x6f, 0xb4, 0xeb,
+	0x0d, 0x12, 0x9a, 0x6e, 0xc9, 0x2f, 0x0f, 0x54, 0xec, 0x93, 0x9c, 0x5c, 0x2e, 0x7d, 0x72, 0xa8,
+	0xf9, 0x53, 0xdf, 0xa7, 0x89, 0x04, 0x59, 0x2d, 0x8c, 0x3f, 0x4e, 0xbb, 0x0d, 0xe0, 0xb3, 0xc0,
+	0x10, 0x5a, 0x41, 0x7e, 0x97, 0x55, 0xf3, 0x59, 0x20, 0x14, 0x86, 0xfe, 0xfb, 0x1c, 0x34, 0xb3,
+	0x61, 0x09, 0x79, 0x0e, 0x53, 0xae, 0x67, 0x31, 0x23, 0x64, 0x0e, 0xeb, 0x45, 0x5e, 0x20, 0x7d,
+	0xcd, 0x7b, 0xe3, 0xa3, 0x98, 0x95, 0x1d, 0xcf, 0x62, 0x1d, 0x29, 0x2a, 0xc0, 0xa5, 0x86, 0x9b,
+	0x22, 0x91, 0x15, 0x98, 0xf5, 0x03, 0xdb, 0x0b, 0xec, 0xe8, 0xc4, 0xe8, 0x39, 0x66, 0x18, 0x0a,
+	0xf5, 0x27, 0xd0, 0xc1, 0x19, 0xc5, 0xda, 0x40, 0x0e, 0xea, 0xc0, 0xc5, 0x9f, 0xc1, 0xcc, 0xa9,
+	0x2e, 0xdf, 0x09, 0x66, 0xfc, 0x2f, 0x80, 0xf9, 0x0d, 0x8e, 0x51, 0xc4, 0xb6, 0xe9, 0x52, 0x66,
+	0xec, 0x9d, 0x51, 0x9b, 0x0c, 0x2e, 0x54, 0xb8, 0x64, 0x86, 0xa4, 0x78, 0x69, 0x98, 0xa7, 0x34,
+	0x11, 0xe6, 0x59, 0x80, 0xf2, 0x90, 0x3b, 0x51, 0xca, 0x2a, 0x8a, 0xda, 0x69, 0x18, 0xa5, 0x32,
+	0x06, 0x46, 0x49, 0x22, 0xcc, 0x6a, 0x3a, 0xc2, 0x1c, 0x8b, 0xae, 0xd4, 0xae, 0x8a, 0xae, 0xc0,
+	0xb7, 0x83, 0xae, 0xd4, 0xaf, 0x80, 0xae, 0x34, 0x2e, 0x8e, 0xae, 0x4c, 0x9d, 0x46, 0x57, 0x6e,
+	0xf1, 0x2f, 0x73, 0x85, 0x67, 0xc5, 0xd3, 0x07, 0x55, 0x9a, 0x10, 0xd2, 0x78, 0xca, 0xcc, 0x45,
+	0xf1, 0x14, 0xf2, 0x4e, 0x78, 0xca, 0xec, 0xe5, 0xf1, 0x94, 0xb9, 0x2b, 0xe1, 0x29, 0xf3, 0xef,
+	0x82, 0xa7, 0x28, 0x0c, 0x6a, 0x21, 0x85, 0x41, 0x8d, 0x60, 0x2c, 0xd7, 0x2f, 0x82, 0xb1, 0x68,
+	0x97, 0xc6, 0x58, 0x6e, 0x4c, 0xc0, 0x58, 0x16, 0x47, 0x30, 0x96, 0x11, 0xe4, 0xff, 0xe6, 0xb9,
+	0xc8, 0x7f, 0x1a, 0x7d, 0xb9, 0x75, 0x09, 0xf4, 0xe5, 0xf6, 0x38, 0xf4, 0x65, 0x04, 0x37, 0xb9,
+	0x73, 0x51, 0xdc, 0x64, 0xe9, 0x5b, 0xc5, 0x4d, 0x96, 0x27, 0xe2, 0x26, 0xbf, 0x86, 0x05, 0xe9,
+	0x5c, 0x5d, 0x4d, 0xfd, 0x9e, 0x1d, 0x8c, 0xfe, 0x2e, 0x07, 0xb3, 0xe8, 0x14, 0x5d, 0xb9, 0x7f,
+	0x15, 0x81, 0xe7, 0xcf, 0x8c, 0xc0, 0x0b, 0x67, 0x47, 0xe0, 0xc5, 0x91, 0x08, 0xfc, 0xb7, 0x39,
+	0x98, 0x17, 0x31, 0xf2, 0xd5, 0xe6, 0xd5, 0x82, 0x82, 0xe9, 0xa8, 0x1f, 0x2d, 0x61, 0x91, 0x7f,
+	0x55, 0xed, 0x05, 0x3d, 0x26, 0x67, 0x23, 0x2a, 0x78, 0x5d, 0x8f, 0x18, 0xf3, 0x0d, 0xfe, 0xa5,
+	0xb1, 0x48, 0x2e, 0x55, 0x91, 0x40, 0x99, 0xef, 0xe9, 0x9b, 0x30, 0xd7, 0x41, 0xc7, 0xf9, 0x4a,
+	0x53, 0xd1, 0x37, 0x60, 0x16, 0x43, 0xf8, 0xab, 0x75, 0xf2, 0xa7, 0x39, 0x20, 0x74, 0xe8, 0x5e,
+	0x6d, 0x53, 0x56, 0x00, 0xfc, 0xc0, 0x3b, 0x66, 0xae, 0x89, 0x21, 0xd8, 0x78, 0x7c, 0x25, 0x25,
+	0x91, 0x0a, 0xa4, 0x0a, 0xe3, 0x03, 0x29, 0xfd, 0x0b, 0x68, 0xd2, 0xa1, 0x8b, 0x1f, 0x0f, 0x5f,
+	0x6e, 0x59, 0xf7, 0x61, 0x56, 0x38, 0x19, 0xf2, 0x87, 0x57, 0xb2, 0x13, 0x02, 0x45, 0xfe, 0xa3,
+	0xb8, 0x9c, 0xf8, 0x20, 0x17, 0xcb, 0xfa, 0xe7, 0x30, 0x2b, 0x2e, 0x46, 0x56, 0xf4, 0xfd, 0xf8,
+	0xc7, 0x5d, 0x23, 0xe8, 0x5a, 0xf6, 0xa7, 0x5c, 0xfa, 0x17, 0x31, 0x3c, 0x77, 0xb9, 0xf6, 0xb7,
+	0x26, 0xfd, 0xc2, 0x0a, 0x1f, 0x13, 0x08, 0x36, 0xcf, 0x94, 0x5e, 0xb0, 0xd3, 0xf8, 0x33, 0xad,
+	0x7c, 0xea, 0x33, 0xad, 0x2d, 0x20, 0x3c, 0x37, 0x84, 0x61, 0x48, 0xfc, 0x53, 0x53, 0xad, 0x70,
+	0x6e, 0x14, 0x38, 0xa3, 0x5a, 0xc5, 0x24, 0x7d, 0x1d, 0xea, 0xc9, 0xa4, 0xf8, 0x2f, 0x2c, 0xc4,
+	0xb8, 0x69, 0xf0, 0x93, 0x64, 0xa7, 0x86, 0x92, 0x14, 0xc2, 0xb8, 0xac, 0xcf, 0xc3, 0xec, 0x5a,
+	0x2f, 0xb2, 0x8f, 0xcd, 0x88, 0xad, 0x0d, 0xa3, 0x43, 0xb9, 0x6d, 0xfa, 0x02, 0xcc, 0x65, 0xc9,
+	0xa1, 0xef, 0xb9, 0x21, 0xfb, 0xe0, 0xaf, 0x72, 0xfc, 0x5b, 0x70, 0x91, 0xdc, 0x9c, 0x87, 0x99,
+	0xa7, 0xbb, 0xeb, 0x46, 0x67, 0x7f, 0x6d, 0x3f, 0x0d, 0xf4, 0x4e, 0x43, 0x1d, 0xc9, 0x1b, 0xb4,
+	0xbd, 0xb6, 0xdf, 0xde, 0x6c, 0xe5, 0x48, 0x0b, 0x1a, 0x52, 0x8e, 0xee, 0x6f, 0xed, 0x3c, 0x69,
+	0xe5, 0x95, 0x08, 0x7d, 0xb1, 0xb3, 0x83, 0x84, 0x82, 0x22, 0x3c, 0x5e, 0xdb, 0xda, 0x7e, 0x41,
+	0xdb, 0xad, 0xa2, 0x22, 0x74, 0x5e, 0x6c, 0x6c, 0xb4, 0x3b, 0x9d, 0x56, 0x89, 0x34, 0x01, 0x90,
+	0xf0, 0x6c, 0x6b, 0x7b, 0xbb, 0xbd, 0xd9, 0x2a, 0x93, 0x19, 0x98, 0xc2, 0x7a, 0xfb, 0x09, 0x6d,
+	0x77, 0x3a, 0xd8, 0x49, 0x45, 0x91, 0x1e, 0x6f, 0xed, 0x6c, 0x75, 0xfe, 0x17, 0x92, 0xaa, 0x1f,
+	0xfc, 0x6f, 0x80, 0x24, 0x24, 0xcb, 0xfe, 0x1c, 0x0d, 0xa0, 0x8c, 0xc3, 0xf1, 0x19, 0xd6, 0xa1,
+	0xa2, 0x46, 0xca, 0xf3, 0xca, 0xb3, 0xad, 0xbd, 0xbd, 0xf6, 0x66, 0xab, 0x40, 0x1a, 0x50, 0x8d,
+	0xe7, 0x5d, 0x24, 0x53, 0x50, 0xa3, 0xed, 0x8d, 0xdd, 0x2f, 0xdb, 0xb4, 0xbd, 0xd9, 0x2a, 0x7d,
+	0xf0, 0x4b, 0xa8, 0xa7, 0x12, 0xef, 0x44, 0x83, 0xb9, 0xaf, 0x76, 0xe9, 0xb3, 0x36, 0x1d, 0xb7,
+	0x25, 0x7b, 0xbb, 0x9b, 0xf1, 0x7a, 0x73, 0x8a, 0x90, 0x0c, 0xda, 0x04, 0x40, 0x82, 0x9c, 0x51,
+	0xe1, 0x83, 0x7f, 0xcd, 0x25, 0xe8, 0xb6, 0xe8, 0x7d, 0x11, 0x16, 0x62, 0x24, 0x7c, 0xb4, 0xff,
+	0x79, 0x98, 0x49, 0xf3, 0xc4, 0x74, 0x73, 0x64, 0x0e, 0x5a, 0x31, 0x59, 0x8d, 0x9d, 0xcf, 0x60,
+	0xed, 0xb4, 0x1d, 0x8b, 0x17, 0x32, 0xe2, 0xc9, 0x49, 0xcc, 0xc2, 0x74, 0x4c, 0xdd, 0x5b, 0x7b,
+	0xd1, 0xc1, 0x95, 0x67, 0x44, 0x3b, 0xfb, 0x6b, 0x3b, 0x9b, 0xeb, 0xbf, 0x6c, 0x95, 0x33, 0xd3,
+	0xd8, 0xa0, 0x6b, 0xe2, 0x10, 0x2a, 0xab, 0x7f, 0xdd, 0x84, 0xc2, 0xda, 0xde, 0x16, 0xf9, 0x0c,
+	0x20, 0x01, 0xa9, 0xc9, 0x8d, 0xc4, 0x71, 0x1c, 0x01, 0xae, 0x17, 0x47, 0xbf, 0x36, 0xd4, 0xaf,
+	0x91, 0x75, 0x98, 0xca, 0xc0, 0xef, 0xe4, 0xd6, 0xe9, 0xe6, 0x09, 0x52, 0x3e, 0xa6, 0x87, 0x8f,
+	0x72, 0x98, 0x14, 0x97, 0x08, 0x36, 0x59, 0x48, 0xe3, 0x06, 0x13, 0x47, 0xfe, 0x28, 0x47, 0x7e,
+	0x06, 0x90, 0x60, 0xf1, 0xc9, 0xbc, 0x4f, 0xe1, 0xf3, 0x8b, 0x24, 0x0b, 0xfd, 0xc7, 0x1d, 0xfc,
+	0x1c, 0x1a, 0x69, 0xdc, 0x99, 0xdc, 0x8c, 0x1f, 0xe5, 0x69, 0x34, 0xfa, 0xac, 0x29, 0xd4, 0x62,
+	0x68, 0x99, 0x24, 0x2e, 0xca, 0x08, 0xda, 0xbc, 0xb8, 0x70, 0x4a, 0x81, 0xb4, 0xf1, 0x27, 0x62,
+	0xfa, 0x35, 0xf2, 0x13, 0xa8, 0x48, 0xa0, 0x39, 0x59, 0x7b, 0x16, 0x79, 0x9e, 0xd0, 0xf8, 0xe7,
+	0xd0, 0x48, 0x43, 0x41, 0xc9, 0xfc, 0xc7, 0x00, 0x44, 0x8b, 0x33, 0x19, 0x07, 0x4a, 0x1e, 0xdf,
+	0x4f, 0xa1, 0x16, 0x23, 0x34, 0xc9, 0xfc, 0x47, 0x41, 0x9b, 0xb1, 0x6d, 0x3f, 0xca, 0x91, 0x36,
+	0xff, 0xd4, 0x36, 0xc6, 0xb8, 0x92, 0xf1, 0xc7, 0x20, 0x5f, 0x13, 0x96, 0xb1, 0x05, 0xcd, 0x6c,
+	0xc8, 0x4b, 0x6e, 0x27, 0xbf, 0x8f, 0x19, 0x13, 0x0a, 0x4f, 0xec, 0x6a, 0x7a, 0xc4, 0x7f, 0x23,
+	0x77, 0x46, 0x36, 0x65, 0xb4, 0xb3, 0xb1, 0x69, 0x28, 0xfd, 0x1a, 0x2e, 0x2e, 0xed, 0xa7, 0x25,
+	0x8b, 0x1b, 0xe3, 0xbd, 0x9d, 0xd5, 0xc9, 0x47, 0x39, 0x5c, 0x5c, 0xd6, 0xb1, 0x4a, 0x16, 0x37,
+	0xd6, 0xe1, 0x9a, 0xb0, 0xb8, 0x27, 0x30, 0x95, 0xf1, 0x8b, 0x92, 0xb7, 0x36, 0xce, 0x5d, 0x9a,
+	0xd0, 0x51, 0x1b, 0x1a, 0x69, 0xd7, 0x28, 0x75, 0xef, 0x4f, 0x3b, 0x4c, 0x13, 0xba, 0xd9, 0x80,
+	0x7a, 0xca, 0x37, 0x22, 0xf1, 0xbf, 0x20, 0x38, 0xed, 0x30, 0x4d, 0x7e, 0x00, 0xd2, 0x95, 0x49,
+	0x1e, 0x40, 0xd6, 0xb7, 0x99, 0xbc, 0x90, 0xb4, 0x1f, 0x93, 0x2c, 0x64, 0x8c, 0x77, 0x33, 0xb9,
+	0x9b, 0xb4, 0x8f, 0x93, 0x74, 0x33, 0xc6, 0xf3, 0x99, 0xb8, 0x14, 0xae, 0x8f, 0x64, 0x27, 0x67,
+	0xc8, 0x2d, 0xce, 0x9e, 0xb6, 0xfc, 0x21, 0xdf, 0xcc, 0xa9, 0x8c, 0xa3, 0x74, 0x4a, 0x91, 0x66,
+	0x67, 0x31, 0xc6, 0x7f, 0xd0, 0xaf, 0x91, 0xcf, 0x95, 0x3a, 0x5a, 0x73, 0x9c, 0x33, 0x27, 0x70,
+	0xf6, 0x02, 0x3e, 0x85, 0x8a, 0xcc, 0x9d, 0x24, 0x67, 0x91, 0x4d, 0xa6, 0x24, 0xe3, 0x26, 0xd9,
+	0x01, 0x7e, 0xcd, 0x9f, 0x41, 0x23, 0xed, 0x98, 0x24, 0x5b, 0x38, 0xc6, 0x8b, 0x59, 0xbc, 0x35,
+	0x9e, 0x29, 0x7c, 0x19, 0xa1, 0x10, 0xb2, 0x39, 0xb3, 0xe4, 0xcd, 0x8c, 0xcd, 0xa5, 0x4d, 0x58,
+	0xd2, 0x33, 0xee, 0xbf, 0x6f, 0xe3, 0xcf, 0x31, 0x58, 0x18, 0x6d, 0xb2, 0xbe, 0x39, 0x74, 0xce,
+	0x3e, 0x9b, 0x9b, 0xca, 0x2b, 0x4f, 0xb5, 0x49, 0xe6, 0xb5, 0xfe, 0xe3, 0x7f, 0x79, 0x7b, 0x27,
+	0xf7, 0xfb, 0xb7, 0x77, 0x72, 0xff, 0xf6, 0xf6, 0x4e, 0xee, 0x57, 0xf7, 0x0f, 0xec, 0xe8, 0x70,
+	0xd8, 0x5d, 0xe9, 0x79, 0x83, 0x07, 0xbe, 0xd9, 0x3b, 0x3c, 0xb1, 0x58, 0x90, 0x2e, 0x1d, 0xaf,
+	0x3e, 0x08, 0x83, 0x1e, 0xfe, 0xdf, 0x93, 0x6e, 0x99, 0x8f, 0xf3, 0xf0, 0x7f, 0x06, 0x00, 0x03,
+	0x3c, 0xc2, 0x19, 0x09, 0x45, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6965,6 +7934,38 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SQLDatabase != nil {
+		{
+			size, err := m.SQLDatabase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeleteRemoved {
+		i--
+		if m.DeleteRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Incremental {
+		i--
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.URL) > 0 {
 		i -= len(m.URL)
 		copy(dAtA[i:], m.URL)
@@ -6975,7 +7976,7 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Job) Marshal() (dAtA []byte, err error) {
+func (m *SQLDatabaseEgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6985,12 +7986,12 @@ func (m *Job) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQLDatabaseEgress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQLDatabaseEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6999,16 +8000,28 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+	if m.Mode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Mode))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	if m.Pipeline != nil {
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FileFormat != nil {
 		{
-			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FileFormat.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7016,17 +8029,174 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x1a
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SQLDatabaseEgress_Secret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLDatabaseEgress_Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLDatabaseEgress_Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SQLDatabaseEgress_FileFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLDatabaseEgress_FileFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLDatabaseEgress_FileFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Header {
+		i--
+		if m.Header {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -7200,6 +8370,69 @@ func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ModifiedBefore != nil {
+		{
+			size, err := m.ModifiedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ModifiedAfter != nil {
+		{
+			size, err := m.ModifiedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ContentKey != nil {
+		{
+			size, err := m.ContentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxSizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxSizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinSizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MinSizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclude[iNdEx])
+			copy(dAtA[i:], m.Exclude[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Exclude[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.RepoType) > 0 {
 		i -= len(m.RepoType)
 		copy(dAtA[i:], m.RepoType)
@@ -7311,6 +8544,57 @@ func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContentKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Delimiter) > 0 {
+		i -= len(m.Delimiter)
+		copy(dAtA[i:], m.Delimiter)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Delimiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Field != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Field))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Line != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Line))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JSONPath) > 0 {
+		i -= len(m.JSONPath)
+		copy(dAtA[i:], m.JSONPath)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JSONPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CronInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7388,7 +8672,7 @@ func (m *CronInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
+func (m *URLInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7398,12 +8682,12 @@ func (m *Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *URLInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *URLInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7412,21 +8696,112 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Cron != nil {
-		{
-			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.DeleteRemoved {
+		i--
+		if m.DeleteRemoved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x38
 	}
-	if len(m.Union) > 0 {
-		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.URL != nil {
+		{
+			size, err := m.URL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Cron != nil {
+		{
+			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Union) > 0 {
+		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -7701,6 +9076,20 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7759,6 +9148,86 @@ func (m *DatumInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatumAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StderrTail) > 0 {
+		i -= len(m.StderrTail)
+		copy(dAtA[i:], m.StderrTail)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StderrTail)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OOMKilled {
+		i--
+		if m.OOMKilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Aggregate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8256,6 +9725,41 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.EgressBase != nil {
+		{
+			size, err := m.EgressBase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.Details != nil {
 		{
 			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
@@ -8416,6 +9920,32 @@ func (m *JobInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QuarantineFailedDatums {
+		i--
+		if m.QuarantineFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DatumRetryPolicy != nil {
+		{
+			size, err := m.DatumRetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.PodPatch) > 0 {
 		i -= len(m.PodPatch)
 		copy(dAtA[i:], m.PodPatch)
@@ -8845,6 +10375,32 @@ func (m *PipelineInfo_Details) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QuarantineFailedDatums {
+		i--
+		if m.QuarantineFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DatumRetryPolicy != nil {
+		{
+			size, err := m.DatumRetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -10047,6 +11603,30 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Number != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PaginationMarker) > 0 {
+		i -= len(m.PaginationMarker)
+		copy(dAtA[i:], m.PaginationMarker)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PaginationMarker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Input != nil {
 		{
 			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
@@ -10074,6 +11654,155 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ListDatumRequest_Filter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDatumRequest_Filter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDatumRequest_Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.State) > 0 {
+		dAtA96 := make([]byte, len(m.State)*10)
+		var j95 int
+		for _, num := range m.State {
+			for num >= 1<<7 {
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j95++
+			}
+			dAtA96[j95] = uint8(num)
+			j95++
+		}
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPps(dAtA, i, uint64(j95))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatumRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RetryOOMKilledOnly {
+		i--
+		if m.RetryOOMKilledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FailFastExitCodes) > 0 {
+		dAtA98 := make([]byte, len(m.FailFastExitCodes)*10)
+		var j97 int
+		for _, num1 := range m.FailFastExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j97++
+			}
+			dAtA98[j97] = uint8(num)
+			j97++
+		}
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintPps(dAtA, i, uint64(j97))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RetryExitCodes) > 0 {
+		dAtA100 := make([]byte, len(m.RetryExitCodes)*10)
+		var j99 int
+		for _, num1 := range m.RetryExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j99++
+			}
+			dAtA100[j99] = uint8(num)
+			j99++
+		}
+		i -= j99
+		copy(dAtA[i:], dAtA100[:j99])
+		i = encodeVarintPps(dAtA, i, uint64(j99))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxBackoff != nil {
+		{
+			size, err := m.MaxBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InitialBackoff != nil {
+		{
+			size, err := m.InitialBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DatumSetSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10193,6 +11922,32 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QuarantineFailedDatums {
+		i--
+		if m.QuarantineFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DatumRetryPolicy != nil {
+		{
+			size, err := m.DatumRetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	if m.Autoscaling {
 		i--
 		if m.Autoscaling {
@@ -11317,6 +13072,95 @@ func (m *Egress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Incremental {
+		n += 2
+	}
+	if m.DeleteRemoved {
+		n += 2
+	}
+	if m.SQLDatabase != nil {
+		l = m.SQLDatabase.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.FileFormat != nil {
+		l = m.FileFormat.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPps(uint64(m.Mode))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress_Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SQLDatabaseEgress_FileFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPps(uint64(m.Type))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Header {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11467,6 +13311,56 @@ func (m *PFSInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Exclude) > 0 {
+		for _, s := range m.Exclude {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 2 + sovPps(uint64(m.MaxSizeBytes))
+	}
+	if m.ContentKey != nil {
+		l = m.ContentKey.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ModifiedAfter != nil {
+		l = m.ModifiedAfter.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ModifiedBefore != nil {
+		l = m.ModifiedBefore.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContentKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JSONPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Line != 0 {
+		n += 1 + sovPps(uint64(m.Line))
+	}
+	if m.Field != 0 {
+		n += 1 + sovPps(uint64(m.Field))
+	}
+	l = len(m.Delimiter)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11508,35 +13402,74 @@ func (m *CronInput) Size() (n int) {
 	return n
 }
 
-func (m *Input) Size() (n int) {
+func (m *URLInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pfs != nil {
-		l = m.Pfs.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Join) > 0 {
-		for _, e := range m.Join {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Group) > 0 {
-		for _, e := range m.Group {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Union) > 0 {
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DeleteRemoved {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pfs != nil {
+		l = m.Pfs.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Group) > 0 {
+		for _, e := range m.Group {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Union) > 0 {
 		for _, e := range m.Union {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
@@ -11546,6 +13479,10 @@ func (m *Input) Size() (n int) {
 		l = m.Cron.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.URL != nil {
+		l = m.URL.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11661,6 +13598,46 @@ func (m *DatumInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovPps(uint64(m.ExitCode))
+	}
+	if m.OOMKilled {
+		n += 2
+	}
+	l = len(m.StderrTail)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11932,6 +13909,18 @@ func (m *JobInfo) Size() (n int) {
 		l = m.Details.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.EgressBase != nil {
+		l = m.EgressBase.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 2 + sovPps(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12017,6 +14006,13 @@ func (m *JobInfo_Details) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumRetryPolicy != nil {
+		l = m.DatumRetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.QuarantineFailedDatums {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12240,6 +14236,13 @@ func (m *PipelineInfo_Details) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DatumRetryPolicy != nil {
+		l = m.DatumRetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.QuarantineFailedDatums {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12617,6 +14620,76 @@ func (m *ListDatumRequest) Size() (n int) {
 		l = m.Input.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.PaginationMarker)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPps(uint64(m.Number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDatumRequest_Filter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		l = 0
+		for _, e := range m.State {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovPps(uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoff != nil {
+		l = m.InitialBackoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.MaxBackoff != nil {
+		l = m.MaxBackoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.RetryExitCodes) > 0 {
+		l = 0
+		for _, e := range m.RetryExitCodes {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if len(m.FailFastExitCodes) > 0 {
+		l = 0
+		for _, e := range m.FailFastExitCodes {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.RetryOOMKilledOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12781,6 +14854,13 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if m.Autoscaling {
 		n += 3
 	}
+	if m.DatumRetryPolicy != nil {
+		l = m.DatumRetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.QuarantineFailedDatums {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13986,6 +16066,82 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			}
 			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRemoved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteRemoved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQLDatabase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQLDatabase == nil {
+				m.SQLDatabase = &SQLDatabaseEgress{}
+			}
+			if err := m.SQLDatabase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14008,7 +16164,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *SQLDatabaseEgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14031,17 +16187,17 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLDatabaseEgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLDatabaseEgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14051,33 +16207,29 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14087,29 +16239,152 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.Secret == nil {
+				m.Secret = &SQLDatabaseEgress_Secret{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileFormat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileFormat == nil {
+				m.FileFormat = &SQLDatabaseEgress_FileFormat{}
+			}
+			if err := m.FileFormat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= SQLDatabaseEgress_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
@@ -14127,7 +16402,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *SQLDatabaseEgress_Secret) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14150,17 +16425,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14170,124 +16445,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14297,119 +16477,146 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SQLDatabaseEgress_FileFormat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileFormat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SQLDatabaseEgress_FileFormat_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Header = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14432,7 +16639,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14455,17 +16662,965 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PFSInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OuterJoin = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmptyFiles = bool(v != 0)
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
-			m.InternalPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14475,16 +17630,17 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InternalPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			m.S3 = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			m.ExternalPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14494,14 +17650,31 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &pfs.Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14529,11 +17702,11 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = string(dAtA[iNdEx:postIndex])
+			m.RepoType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14561,62 +17734,121 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.MinSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
+			}
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Spout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.ContentKey == nil {
+				m.ContentKey = &ContentKey{}
 			}
-			if iNdEx >= l {
+			if err := m.ContentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ModifiedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedBefore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14643,10 +17875,10 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.ModifiedBefore == nil {
+				m.ModifiedBefore = &types.Timestamp{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModifiedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14672,7 +17904,7 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PFSInput) Unmarshal(dAtA []byte) error {
+func (m *ContentKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14695,15 +17927,15 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContentKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContentKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14731,13 +17963,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
-			var stringLen uint64
+			m.Line = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14747,27 +17979,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Line |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14792,14 +18030,65 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delimiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14827,11 +18116,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14859,11 +18148,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14891,31 +18180,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OuterJoin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.OuterJoin = bool(v != 0)
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14943,51 +18212,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Lazy = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EmptyFiles = bool(v != 0)
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -15004,10 +18233,10 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.S3 = bool(v != 0)
-		case 12:
+			m.Overwrite = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15034,45 +18263,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &pfs.Trigger{}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RepoType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15095,7 +18292,7 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronInput) Unmarshal(dAtA []byte) error {
+func (m *URLInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15118,10 +18315,10 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: URLInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: URLInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15222,7 +18419,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15250,13 +18447,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15266,17 +18463,29 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15286,28 +18495,44 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &types.Timestamp{}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRemoved", wireType)
 			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DeleteRemoved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15526,14 +18751,50 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15560,10 +18821,10 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
+			if m.URL == nil {
+				m.URL = &URLInput{}
 			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16026,23 +19287,269 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Attempts = append(m.Attempts, &DatumAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16066,7 +19573,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *DatumAttempt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16089,15 +19596,15 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumAttempt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16124,35 +19631,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16179,18 +19667,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Duration == nil {
+				m.Duration = &types.Duration{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16200,33 +19688,68 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OOMKilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OOMKilled = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StderrTail", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16236,25 +19759,23 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StderrTail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17942,6 +21463,169 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EgressBase == nil {
+				m.EgressBase = &pfs.Commit{}
+			}
+			if err := m.EgressBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18610,6 +22294,62 @@ func (m *JobInfo_Details) Unmarshal(dAtA []byte) error {
 			}
 			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumRetryPolicy == nil {
+				m.DatumRetryPolicy = &DatumRetryPolicy{}
+			}
+			if err := m.DatumRetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuarantineFailedDatums = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20263,6 +24003,62 @@ func (m *PipelineInfo_Details) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Autoscaling = bool(v != 0)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumRetryPolicy == nil {
+				m.DatumRetryPolicy = &DatumRetryPolicy{}
+			}
+			if err := m.DatumRetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuarantineFailedDatums = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22107,12 +25903,167 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 8:
+			m.User = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
+			}
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22122,33 +26073,80 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
-			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22158,23 +26156,27 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22198,7 +26200,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22221,10 +26223,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22265,7 +26267,79 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &ListDatumRequest_Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaginationMarker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22293,8 +26367,27 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.PaginationMarker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22317,7 +26410,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumRequest_Filter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22340,48 +26433,81 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
+			if wireType == 0 {
+				var v DatumState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DatumState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.State = append(m.State, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.State) == 0 {
+					m.State = make([]DatumState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DatumState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DatumState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.State = append(m.State, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22404,7 +26530,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *DatumRetryPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22427,15 +26553,34 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumRetryPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22462,16 +26607,16 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.InitialBackoff == nil {
+				m.InitialBackoff = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22498,13 +26643,185 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.MaxBackoff == nil {
+				m.MaxBackoff = &types.Duration{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryExitCodes = append(m.RetryExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryExitCodes) == 0 {
+					m.RetryExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryExitCodes = append(m.RetryExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExitCodes", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailFastExitCodes = append(m.FailFastExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailFastExitCodes) == 0 {
+					m.FailFastExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailFastExitCodes = append(m.FailFastExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFastExitCodes", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOOMKilledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryOOMKilledOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23777,6 +28094,62 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Autoscaling = bool(v != 0)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumRetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumRetryPolicy == nil {
+				m.DatumRetryPolicy = &DatumRetryPolicy{}
+			}
+			if err := m.DatumRetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantineFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuarantineFailedDatums = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
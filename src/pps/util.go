@@ -15,6 +15,7 @@ var (
 	// format strings for state name parsing errors
 	errInvalidJobStateName      string
 	errInvalidPipelineStateName string
+	errInvalidDatumStateName    string
 )
 
 func init() {
@@ -29,6 +30,11 @@ func init() {
 		states = append(states, strings.ToLower(strings.TrimPrefix(PipelineState_name[i], "PIPELINE_")))
 	}
 	errInvalidPipelineStateName = fmt.Sprintf("state %%s must be one of %s, or %s, etc", strings.Join(states, ", "), PipelineState_name[0])
+	states = states[:0]
+	for i := int32(0); DatumState_name[i] != ""; i++ {
+		states = append(states, strings.ToLower(DatumState_name[i]))
+	}
+	errInvalidDatumStateName = fmt.Sprintf("state %%s must be one of %s", strings.Join(states, ", "))
 }
 
 func (j *Job) String() string {
@@ -135,6 +141,15 @@ func InputBranches(input *Input) []*pfs.Branch {
 				Name: "master",
 			})
 		}
+		if input.URL != nil {
+			result = append(result, &pfs.Branch{
+				Repo: &pfs.Repo{
+					Name: input.URL.Repo,
+					Type: pfs.UserRepoType,
+				},
+				Name: "master",
+			})
+		}
 		return nil
 	})
 	return result
@@ -161,6 +176,15 @@ func PipelineStateFromName(name string) (PipelineState, error) {
 	return 0, fmt.Errorf(errInvalidPipelineStateName, name)
 }
 
+// DatumStateFromName attempts to interpret a string as a DatumState,
+// ignoring case
+func DatumStateFromName(name string) (DatumState, error) {
+	if value, ok := DatumState_value[strings.ToUpper(name)]; ok {
+		return DatumState(value), nil
+	}
+	return 0, fmt.Errorf(errInvalidDatumStateName, name)
+}
+
 // IsTerminal returns 'true' if 'state' indicates that the job is done (i.e.
 // the state will not change later: SUCCESS, FAILURE, KILLED) and 'false'
 // otherwise.
@@ -15,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pkg/browser"
@@ -771,6 +772,138 @@ func RolesForPermissionCmd() *cobra.Command {
 	return cmdutil.CreateAlias(rotateRootToken, "auth roles-for-permission")
 }
 
+// parseRole builds a role from the name, permissions and resource types passed
+// to 'pachctl auth create role' or 'pachctl auth update role'
+func parseRole(name string, permissions, resourceTypes []string) (*auth.Role, error) {
+	role := &auth.Role{Name: name}
+	for _, p := range permissions {
+		permission, ok := auth.Permission_value[strings.ToUpper(p)]
+		if !ok {
+			return nil, errors.Errorf("unknown permission %q", p)
+		}
+		role.Permissions = append(role.Permissions, auth.Permission(permission))
+	}
+	for _, rt := range resourceTypes {
+		resourceType, ok := auth.ResourceType_value[strings.ToUpper(rt)]
+		if !ok {
+			return nil, errors.Errorf("unknown resource type %q", rt)
+		}
+		role.ResourceTypes = append(role.ResourceTypes, auth.ResourceType(resourceType))
+	}
+	return role, nil
+}
+
+// CreateRoleCmd returns a cobra command that creates a custom role
+func CreateRoleCmd() *cobra.Command {
+	var permissions, resourceTypes []string
+	createRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Create a custom role",
+		Long: "Create a custom role with the given permissions, which can be bound to " +
+			"subjects on the given types of resources in the same way as the builtin roles",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			role, err := parseRole(args[0], permissions, resourceTypes)
+			if err != nil {
+				return err
+			}
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.CreateRole(c.Ctx(), &auth.CreateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createRole.Flags().StringSliceVar(&permissions, "permission", nil, "Comma-separated list of permissions that the role grants, e.g. REPO_READ,REPO_LIST_COMMIT.")
+	createRole.Flags().StringSliceVar(&resourceTypes, "resource-type", []string{"cluster", "repo"}, "Comma-separated list of the types of resources (cluster or repo) that the role can be bound on.")
+	return cmdutil.CreateAlias(createRole, "auth create role")
+}
+
+// UpdateRoleCmd returns a cobra command that updates a custom role
+func UpdateRoleCmd() *cobra.Command {
+	var permissions, resourceTypes []string
+	updateRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Update a custom role",
+		Long: "Replace the permissions and resource types of a custom role. The change " +
+			"applies to every subject that the role is bound to.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			role, err := parseRole(args[0], permissions, resourceTypes)
+			if err != nil {
+				return err
+			}
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.UpdateRole(c.Ctx(), &auth.UpdateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	updateRole.Flags().StringSliceVar(&permissions, "permission", nil, "Comma-separated list of permissions that the role grants, e.g. REPO_READ,REPO_LIST_COMMIT.")
+	updateRole.Flags().StringSliceVar(&resourceTypes, "resource-type", []string{"cluster", "repo"}, "Comma-separated list of the types of resources (cluster or repo) that the role can be bound on.")
+	return cmdutil.CreateAlias(updateRole, "auth update role")
+}
+
+// DeleteRoleCmd returns a cobra command that deletes a custom role
+func DeleteRoleCmd() *cobra.Command {
+	deleteRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Delete a custom role",
+		Long:  "Delete a custom role. A role can't be deleted while it's bound to any subject.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.DeleteRole(c.Ctx(), &auth.DeleteRoleRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRole, "auth delete role")
+}
+
+// ListRolesCmd returns a cobra command that lists the builtin and custom roles
+func ListRolesCmd() *cobra.Command {
+	listRoles := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List the builtin and custom roles",
+		Long:  "List the builtin and custom roles, along with the permissions that they grant",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := newClient(false)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRoles(c.Ctx(), &auth.ListRolesRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			w := tabwriter.NewWriter(os.Stdout, "NAME\tTYPE\tRESOURCE TYPES\tPERMISSIONS\n")
+			for _, r := range resp.Roles {
+				roleType := "custom"
+				if r.Builtin {
+					roleType = "builtin"
+				}
+				resourceTypes := make([]string, len(r.ResourceTypes))
+				for i, rt := range r.ResourceTypes {
+					resourceTypes[i] = strings.ToLower(rt.String())
+				}
+				permissions := make([]string, len(r.Permissions))
+				for i, p := range r.Permissions {
+					permissions[i] = p.String()
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Name, roleType, strings.Join(resourceTypes, ","), strings.Join(permissions, ","))
+			}
+			return w.Flush()
+		}),
+	}
+	return cmdutil.CreateAlias(listRoles, "auth list role")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -800,6 +933,27 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, cmdutil.CreateAlias(get, "auth get"))
 	commands = append(commands, cmdutil.CreateAlias(set, "auth set"))
 	commands = append(commands, cmdutil.CreateAlias(check, "auth check"))
+
+	create := &cobra.Command{
+		Short: "Create a custom role",
+		Long:  "Create a custom role",
+	}
+	update := &cobra.Command{
+		Short: "Update a custom role",
+		Long:  "Update a custom role",
+	}
+	deleteCmd := &cobra.Command{
+		Short: "Delete a custom role",
+		Long:  "Delete a custom role",
+	}
+	list := &cobra.Command{
+		Short: "List the builtin and custom roles",
+		Long:  "List the builtin and custom roles",
+	}
+	commands = append(commands, cmdutil.CreateAlias(create, "auth create"))
+	commands = append(commands, cmdutil.CreateAlias(update, "auth update"))
+	commands = append(commands, cmdutil.CreateAlias(deleteCmd, "auth delete"))
+	commands = append(commands, cmdutil.CreateAlias(list, "auth list"))
 	commands = append(commands, ActivateCmd())
 	commands = append(commands, DeactivateCmd())
 	commands = append(commands, LoginCmd())
@@ -819,5 +973,9 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, SetEnterpriseRoleBindingCmd())
 	commands = append(commands, RotateRootToken())
 	commands = append(commands, RolesForPermissionCmd())
+	commands = append(commands, CreateRoleCmd())
+	commands = append(commands, UpdateRoleCmd())
+	commands = append(commands, DeleteRoleCmd())
+	commands = append(commands, ListRolesCmd())
 	return commands
 }
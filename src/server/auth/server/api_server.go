@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -68,6 +69,9 @@ type apiServer struct {
 	members col.PostgresCollection
 	// groups is a collection of group -> usernames mappings.
 	groups col.PostgresCollection
+	// roles is a collection of role name -> custom role mappings. Builtin roles
+	// are defined in roles.go, and aren't stored.
+	roles col.PostgresCollection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
@@ -135,6 +139,7 @@ func NewAuthServer(
 		roleBindings:   roleBindingsCollection(env.GetDBClient(), env.GetPostgresListener()),
 		members:        membersCollection(env.GetDBClient(), env.GetPostgresListener()),
 		groups:         groupsCollection(env.GetDBClient(), env.GetPostgresListener()),
+		roles:          rolesCollection(env.GetDBClient(), env.GetPostgresListener()),
 		oidcStates:     oidcStates,
 		public:         public,
 		watchesEnabled: watchesEnabled,
@@ -392,6 +397,7 @@ func (a *apiServer) Deactivate(ctx context.Context, req *auth.DeactivateRequest)
 		a.members.ReadWrite(sqlTx).DeleteAll()
 		a.groups.ReadWrite(sqlTx).DeleteAll()
 		a.authConfig.ReadWrite(sqlTx).DeleteAll()
+		a.roles.ReadWrite(sqlTx).DeleteAll()
 		return nil
 	}); err != nil {
 		return nil, err
@@ -498,7 +504,7 @@ func resourceKey(r *auth.Resource) string {
 }
 
 func (a *apiServer) evaluateRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, principal string, resource *auth.Resource, permissions map[auth.Permission]bool) (*authorizeRequest, error) {
-	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction)
+	request := newAuthorizeRequest(principal, permissions, a.getGroupsInTransaction, a.getRoleInTransaction)
 
 	// Special-case making spec repos world-readable, because the alternative breaks reading pipelines.
 	// TOOD: 2.0 - should we make this a user-configurable cluster binding instead of hard-coding it?
@@ -711,24 +717,49 @@ func (a *apiServer) DeleteRoleBindingInTransaction(txnCtx *txncontext.Transactio
 	return nil
 }
 
-// rolesFromRoleSlice converts a slice of strings into *auth.Roles,
-// validating that each role name is valid.
-func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
-	if len(rs) == 0 {
-		return nil, nil
+// getRoleInTransaction returns the builtin or custom role called 'name'
+func (a *apiServer) getRoleInTransaction(txnCtx *txncontext.TransactionContext, name string) (*auth.Role, error) {
+	if r, err := getRole(name); err == nil {
+		return r.role, nil
 	}
+	var role auth.Role
+	if err := a.roles.ReadWrite(txnCtx.SqlTx).Get(name, &role); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("unknown role %q", name)
+		}
+		return nil, err
+	}
+	return &role, nil
+}
 
+// rolesFromRoleSliceInTransaction converts a slice of strings into *auth.Roles,
+// validating that each role name is a builtin or custom role that can be bound
+// on resources of type 'rt'.
+func (a *apiServer) rolesFromRoleSliceInTransaction(txnCtx *txncontext.TransactionContext, rs []string, rt auth.ResourceType) (*auth.Roles, error) {
 	for _, r := range rs {
-		if _, err := getRole(r); err != nil {
+		role, err := a.getRoleInTransaction(txnCtx, r)
+		if err != nil {
 			return nil, err
 		}
+		if !roleAppliesToResource(role, rt) {
+			return nil, errors.Errorf("role %q cannot be bound on %v resources", r, rt)
+		}
+	}
+	return rolesFromRoleSlice(rs), nil
+}
+
+// rolesFromRoleSlice converts a slice of strings into *auth.Roles, without
+// validating the role names.
+func rolesFromRoleSlice(rs []string) *auth.Roles {
+	if len(rs) == 0 {
+		return nil
 	}
 
 	roles := &auth.Roles{Roles: make(map[string]bool)}
 	for _, r := range rs {
 		roles.Roles[r] = true
 	}
-	return roles, nil
+	return roles
 }
 
 // CreateRoleBindingInTransaction is an internal-only API to create a role binding for a new resource.
@@ -745,7 +776,7 @@ func (a *apiServer) CreateRoleBindingInTransaction(txnCtx *txncontext.Transactio
 			return err
 		}
 
-		roles, err := rolesFromRoleSlice(roleSlice)
+		roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, roleSlice, resource.Type)
 		if err != nil {
 			return err
 		}
@@ -844,7 +875,7 @@ func (a *apiServer) ModifyRoleBindingInTransaction(
 }
 
 func (a *apiServer) setUserRoleBindingInTransaction(txnCtx *txncontext.TransactionContext, resource *auth.Resource, principal string, roleSlice []string) error {
-	roles, err := rolesFromRoleSlice(roleSlice)
+	roles, err := a.rolesFromRoleSliceInTransaction(txnCtx, roleSlice, resource.Type)
 	if err != nil {
 		return err
 	}
@@ -889,10 +920,7 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 
 	// If the request is not in a transaction, block until the cache is updated
 	if req.Resource.Type == auth.ResourceType_CLUSTER {
-		expected, err := rolesFromRoleSlice(req.Roles)
-		if err != nil {
-			return nil, err
-		}
+		expected := rolesFromRoleSlice(req.Roles)
 		if err := backoff.Retry(func() error {
 			bindings, ok := a.clusterRoleBindingCache.Load().(*auth.RoleBinding)
 			if !ok {
@@ -1267,7 +1295,160 @@ func (a *apiServer) GetRolesForPermission(ctx context.Context, req *auth.GetRole
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
 
-	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission)}, nil
+	custom, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetRolesForPermissionResponse{Roles: rolesForPermission(req.Permission, custom)}, nil
+}
+
+// listCustomRoles returns all of the custom roles
+func (a *apiServer) listCustomRoles(ctx context.Context) ([]*auth.Role, error) {
+	var custom []*auth.Role
+	role := &auth.Role{}
+	if err := a.roles.ReadOnly(ctx).List(role, col.DefaultOptions(), func(string) error {
+		custom = append(custom, proto.Clone(role).(*auth.Role))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return custom, nil
+}
+
+// CreateRole implements the protobuf auth.CreateRole RPC
+func (a *apiServer) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (resp *auth.CreateRoleResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := validateCustomRole(req.Role); err != nil {
+		return nil, err
+	}
+	role := &auth.Role{
+		Name:          req.Role.Name,
+		Permissions:   req.Role.Permissions,
+		ResourceTypes: req.Role.ResourceTypes,
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := a.isActiveInTransaction(txnCtx); err != nil {
+			return err
+		}
+		if err := a.roles.ReadWrite(txnCtx.SqlTx).Create(role.Name, role); err != nil {
+			if col.IsErrExists(err) {
+				return errors.Errorf("role %q already exists", role.Name)
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRoleResponse{}, nil
+}
+
+// UpdateRole implements the protobuf auth.UpdateRole RPC
+func (a *apiServer) UpdateRole(ctx context.Context, req *auth.UpdateRoleRequest) (resp *auth.UpdateRoleResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := validateCustomRole(req.Role); err != nil {
+		return nil, err
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := a.isActiveInTransaction(txnCtx); err != nil {
+			return err
+		}
+		role := &auth.Role{}
+		if err := a.roles.ReadWrite(txnCtx.SqlTx).Update(req.Role.Name, role, func() error {
+			role.Permissions = req.Role.Permissions
+			role.ResourceTypes = req.Role.ResourceTypes
+			return nil
+		}); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("unknown role %q", req.Role.Name)
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.UpdateRoleResponse{}, nil
+}
+
+// DeleteRole implements the protobuf auth.DeleteRole RPC
+func (a *apiServer) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (resp *auth.DeleteRoleResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if _, err := getRole(req.Name); err == nil {
+		return nil, errors.Errorf("%q is a builtin role, and cannot be deleted", req.Name)
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := a.isActiveInTransaction(txnCtx); err != nil {
+			return err
+		}
+		// Deleting a role that's still bound would break authorization for
+		// every resource that it's bound on
+		if err := a.checkRoleUnboundInTransaction(txnCtx, req.Name); err != nil {
+			return err
+		}
+		if err := a.roles.ReadWrite(txnCtx.SqlTx).Delete(req.Name); err != nil {
+			if col.IsErrNotFound(err) {
+				return errors.Errorf("unknown role %q", req.Name)
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRoleResponse{}, nil
+}
+
+// checkRoleUnboundInTransaction returns an error if any role binding grants
+// the role called 'name'. The read-write collection can't list its contents,
+// so the role bindings table is scanned directly in 'txnCtx' so that no binding
+// can be added between the check and the caller's write.
+func (a *apiServer) checkRoleUnboundInTransaction(txnCtx *txncontext.TransactionContext, name string) error {
+	var rows []struct {
+		Key   string
+		Proto []byte
+	}
+	query := fmt.Sprintf("SELECT key, proto FROM collections.%s", roleBindingsCollectionName)
+	if err := txnCtx.SqlTx.Select(&rows, query); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, row := range rows {
+		var binding auth.RoleBinding
+		if err := proto.Unmarshal(row.Proto, &binding); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for principal, roles := range binding.Entries {
+			if roles.GetRoles()[name] {
+				return errors.Errorf("role %q is still bound to %q on %q; remove the role binding before deleting the role", name, principal, row.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// ListRoles implements the protobuf auth.ListRoles RPC
+func (a *apiServer) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (resp *auth.ListRolesResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+	result, err := a.listCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		result = append(result, r.role)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return &auth.ListRolesResponse{Roles: result}, nil
 }
 
 func setToList(set map[string]bool) []string {
@@ -12,6 +12,7 @@ const (
 	roleBindingsCollectionName = "role_bindings"
 	membersCollectionName      = "members"
 	groupsCollectionName       = "groups"
+	rolesCollectionName        = "roles"
 )
 
 var authConfigIndexes = []*col.Index{}
@@ -62,6 +63,18 @@ func groupsCollection(db *sqlx.DB, listener col.PostgresListener) col.PostgresCo
 	)
 }
 
+var rolesIndexes = []*col.Index{}
+
+func rolesCollection(db *sqlx.DB, listener col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		rolesCollectionName,
+		db,
+		listener,
+		&auth.Role{},
+		rolesIndexes,
+	)
+}
+
 // CollectionsV0 returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -75,3 +88,12 @@ func CollectionsV0() []col.PostgresCollection {
 		col.NewPostgresCollection(groupsCollectionName, nil, nil, nil, groupsIndexes),
 	}
 }
+
+// CollectionsV1 returns the auth collections added after CollectionsV0, for
+// postgres-initialization purposes. It's applied by a migration, so once that
+// migration is released this function must not change.
+func CollectionsV1() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(rolesCollectionName, nil, nil, nil, rolesIndexes),
+	}
+}
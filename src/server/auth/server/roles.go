@@ -2,8 +2,10 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 )
 
 type internalRole struct {
@@ -14,6 +16,7 @@ type internalRole struct {
 var roles = make(map[string]*internalRole)
 
 func registerRole(r *auth.Role) *auth.Role {
+	r.Builtin = true
 	permissionsIndex := make(map[auth.Permission]bool)
 
 	for _, permission := range r.Permissions {
@@ -55,8 +58,8 @@ func init() {
 	})
 
 	// repoWriter has the ability to create and delete commits,
-	// write files to a repo and create pipelines that write to a repo,
-	// plus all the permissions of repoReader.
+	// write files to a repo, tag commits and create pipelines that
+	// write to a repo, plus all the permissions of repoReader.
 	repoWriterRole := registerRole(&auth.Role{
 		Name:          auth.RepoWriterRole,
 		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
@@ -66,16 +69,20 @@ func init() {
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
+			auth.Permission_REPO_CREATE_TAG,
 		}),
 	})
 
-	// repoOwner has the ability to modify the role bindings for
-	// a repo and delete it, plus all the permissions of repoWriter.
+	// repoOwner has the ability to modify the role bindings and
+	// branch protection for a repo, delete its tags and delete it,
+	// plus all the permissions of repoWriter.
 	repoOwnerRole := registerRole(&auth.Role{
 		Name:          auth.RepoOwnerRole,
 		ResourceTypes: []auth.ResourceType{auth.ResourceType_CLUSTER, auth.ResourceType_REPO},
 		Permissions: combinePermissions(repoWriterRole.Permissions, []auth.Permission{
 			auth.Permission_REPO_MODIFY_BINDINGS,
+			auth.Permission_REPO_MODIFY_BRANCH_PROTECTION,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_DELETE,
 		}),
 	})
@@ -199,6 +206,7 @@ func init() {
 				auth.Permission_CLUSTER_AUTH_EXTRACT_TOKENS,
 				auth.Permission_CLUSTER_AUTH_RESTORE_TOKEN,
 				auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN,
+				auth.Permission_CLUSTER_AUTH_MODIFY_ROLES,
 				auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 				auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 				auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
@@ -228,12 +236,53 @@ func roleAppliesToResource(r *auth.Role, rt auth.ResourceType) bool {
 	return false
 }
 
-func rolesForPermission(permission auth.Permission) []*auth.Role {
+// rolesForPermission returns the builtin roles, along with the custom roles in
+// 'custom', that grant 'permission'
+func rolesForPermission(permission auth.Permission, custom []*auth.Role) []*auth.Role {
 	resp := make([]*auth.Role, 0)
 	for _, r := range roles {
 		if _, ok := r.permissionsIndex[permission]; ok {
 			resp = append(resp, r.role)
 		}
 	}
+	for _, r := range custom {
+		for _, p := range r.Permissions {
+			if p == permission {
+				resp = append(resp, r)
+				break
+			}
+		}
+	}
 	return resp
 }
+
+// validateCustomRole checks that 'r' can be stored as a custom role
+func validateCustomRole(r *auth.Role) error {
+	if r == nil || r.Name == "" {
+		return errors.New("role name must be set")
+	}
+	// pachctl accepts comma-separated lists of roles
+	if strings.Contains(r.Name, ",") {
+		return errors.Errorf("role name %q cannot contain commas (',')", r.Name)
+	}
+	if _, ok := roles[r.Name]; ok {
+		return errors.Errorf("%q is a builtin role, and cannot be modified", r.Name)
+	}
+	if len(r.Permissions) == 0 {
+		return errors.Errorf("role %q must have at least one permission", r.Name)
+	}
+	for _, p := range r.Permissions {
+		if _, ok := auth.Permission_name[int32(p)]; !ok || p == auth.Permission_PERMISSION_UNKNOWN {
+			return errors.Errorf("role %q has an unknown permission %v", r.Name, p)
+		}
+	}
+	if len(r.ResourceTypes) == 0 {
+		return errors.Errorf("role %q must apply to at least one resource type", r.Name)
+	}
+	for _, rt := range r.ResourceTypes {
+		if _, ok := auth.ResourceType_name[int32(rt)]; !ok || rt == auth.ResourceType_RESOURCE_TYPE_UNKNOWN {
+			return errors.Errorf("role %q has an unknown resource type %v", r.Name, rt)
+		}
+	}
+	return nil
+}
@@ -1130,6 +1130,8 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_WRITE,
 			auth.Permission_REPO_MODIFY_BINDINGS,
+			auth.Permission_REPO_MODIFY_BRANCH_PROTECTION,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_DELETE,
 			auth.Permission_REPO_INSPECT_COMMIT,
 			auth.Permission_REPO_LIST_COMMIT,
@@ -1141,6 +1143,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
 		},
@@ -1157,6 +1160,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
 			auth.Permission_REPO_ADD_PIPELINE_WRITER,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_INSPECT_FILE,
 			auth.Permission_PIPELINE_LIST_JOB,
 		},
@@ -2886,6 +2890,99 @@ func TestRolesForPermission(t *testing.T) {
 	require.Equal(t, []string{"clusterAdmin", "repoOwner", "repoReader", "repoWriter"}, names)
 }
 
+// TestCustomRoles tests that admins can create, update and delete custom roles,
+// and that custom roles can be bound to users like builtin roles
+func TestCustomRoles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	// alice creates a repo with some data in it
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	dataCommit := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, aliceClient.PutFile(dataCommit, "/file", strings.NewReader("1")))
+
+	// only admins can create roles
+	branchLister := &auth.Role{
+		Name:          tu.UniqueString("branchLister"),
+		Permissions:   []auth.Permission{auth.Permission_REPO_LIST_BRANCH},
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_REPO},
+	}
+	_, err := aliceClient.CreateRole(aliceClient.Ctx(), &auth.CreateRoleRequest{Role: branchLister})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	_, err = rootClient.CreateRole(rootClient.Ctx(), &auth.CreateRoleRequest{Role: branchLister})
+	require.NoError(t, err)
+	_, err = rootClient.CreateRole(rootClient.Ctx(), &auth.CreateRoleRequest{Role: branchLister})
+	require.YesError(t, err)
+	require.Matches(t, "already exists", err.Error())
+	_, err = rootClient.CreateRole(rootClient.Ctx(), &auth.CreateRoleRequest{Role: &auth.Role{
+		Name:          auth.RepoReaderRole,
+		Permissions:   []auth.Permission{auth.Permission_REPO_LIST_BRANCH},
+		ResourceTypes: []auth.ResourceType{auth.ResourceType_REPO},
+	}})
+	require.YesError(t, err)
+	require.Matches(t, "builtin role", err.Error())
+
+	// the role can only be bound on the resource types it was created for
+	err = rootClient.ModifyClusterRoleBinding(bob, []string{branchLister.Name})
+	require.YesError(t, err)
+	require.Matches(t, "cannot be bound", err.Error())
+
+	// bob can list branches, but not read files, once he has the custom role
+	_, err = bobClient.ListBranch(dataRepo)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{branchLister.Name}))
+	_, err = bobClient.ListBranch(dataRepo)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	err = bobClient.GetFile(dataCommit, "/file", buf)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// updating the role changes bob's permissions
+	branchLister.Permissions = append(branchLister.Permissions, auth.Permission_REPO_READ)
+	_, err = rootClient.UpdateRole(rootClient.Ctx(), &auth.UpdateRoleRequest{Role: branchLister})
+	require.NoError(t, err)
+	require.NoError(t, bobClient.GetFile(dataCommit, "/file", buf))
+	require.Equal(t, "1", buf.String())
+
+	// the custom role is listed along with the builtin roles
+	resp, err := bobClient.ListRoles(bobClient.Ctx(), &auth.ListRolesRequest{})
+	require.NoError(t, err)
+	builtin := make(map[string]bool)
+	for _, r := range resp.Roles {
+		builtin[r.Name] = r.Builtin
+	}
+	require.Equal(t, false, builtin[branchLister.Name])
+	require.Equal(t, true, builtin[auth.RepoReaderRole])
+	rolesResp, err := bobClient.GetRolesForPermission(bobClient.Ctx(), &auth.GetRolesForPermissionRequest{Permission: auth.Permission_REPO_LIST_BRANCH})
+	require.NoError(t, err)
+	var names []string
+	for _, r := range rolesResp.Roles {
+		names = append(names, r.Name)
+	}
+	require.OneOfEquals(t, branchLister.Name, names)
+
+	// the role can't be deleted while it's bound to bob
+	_, err = rootClient.DeleteRole(rootClient.Ctx(), &auth.DeleteRoleRequest{Name: branchLister.Name})
+	require.YesError(t, err)
+	require.Matches(t, "still bound", err.Error())
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{}))
+	_, err = rootClient.DeleteRole(rootClient.Ctx(), &auth.DeleteRoleRequest{Name: branchLister.Name})
+	require.NoError(t, err)
+	err = aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{branchLister.Name})
+	require.YesError(t, err)
+	require.Matches(t, "unknown role", err.Error())
+}
+
 // TODO: This test mirrors TestLoad in src/server/pfs/server/testing/load_test.go.
 // Need to restructure testing such that we have the implementation of this
 // test in one place while still being able to test auth enabled and disabled clusters.
@@ -2903,3 +3000,40 @@ func TestLoad(t *testing.T) {
 	require.NoError(t, cmdutil.Encoder("", buf).EncodeProto(resp))
 	require.Equal(t, "", resp.Error, buf.String())
 }
+
+// TestAddFileSetRequiresWrite tests that adding a file set to a commit, in or
+// out of a transaction, requires write access to the commit's repo
+func TestAddFileSetRequiresWrite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	commit, err := aliceClient.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	resp, err := bobClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		return mf.PutFile("/file", strings.NewReader("bob"))
+	})
+	require.NoError(t, err)
+
+	err = bobClient.AddFileSet(dataRepo, "master", commit.ID, resp.FileSetId)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	txn, err := bobClient.StartTransaction()
+	require.NoError(t, err)
+	err = bobClient.WithTransaction(txn).AddFileSet(dataRepo, "master", commit.ID, resp.FileSetId)
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	require.NoError(t, bobClient.DeleteTransaction(txn))
+
+	require.NoError(t, aliceClient.AddFileSet(dataRepo, "master", commit.ID, resp.FileSetId))
+	require.NoError(t, aliceClient.FinishCommit(dataRepo, "master", commit.ID))
+	buf := &bytes.Buffer{}
+	require.NoError(t, aliceClient.GetFile(commit, "/file", buf))
+	require.Equal(t, "bob", buf.String())
+}
@@ -716,6 +716,36 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(squashDocs, "squash"))
 
+	protectDocs := &cobra.Command{
+		Short: "Protect an existing Pachyderm resource.",
+		Long:  "Protect an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(protectDocs, "protect"))
+
+	unprotectDocs := &cobra.Command{
+		Short: "Remove the protection from an existing Pachyderm resource.",
+		Long:  "Remove the protection from an existing Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(unprotectDocs, "unprotect"))
+
+	mergeDocs := &cobra.Command{
+		Short: "Merge the changes on one Pachyderm resource into another.",
+		Long:  "Merge the changes on one Pachyderm resource into another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	revertDocs := &cobra.Command{
+		Short: "Undo the changes made to a Pachyderm resource.",
+		Long:  "Undo the changes made to a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(revertDocs, "revert"))
+
+	validateDocs := &cobra.Command{
+		Short: "Check whether an operation on a Pachyderm resource would succeed.",
+		Long:  "Check whether an operation on a Pachyderm resource would succeed.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(validateDocs, "validate"))
+
 	createDocs := &cobra.Command{
 		Short: "Create a new instance of a Pachyderm resource.",
 		Long:  "Create a new instance of a Pachyderm resource.",
@@ -881,13 +911,18 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"glob",
 			"inspect",
 			"list",
+			"merge",
+			"protect",
 			"put",
 			"restart",
+			"revert",
 			"squash",
 			"start",
 			"stop",
 			"subscribe",
-			"update":
+			"unprotect",
+			"update",
+			"validate":
 			actions = append(actions, subcmd)
 		case
 			"extract",
@@ -535,7 +535,13 @@ func doFullMode(config interface{}) (retErr error) {
 	} else {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
-	env := serviceenv.InitWithKube(serviceenv.NewConfiguration(config))
+	var env *serviceenv.NonblockingServiceEnv
+	if configuration := serviceenv.NewConfiguration(config); configuration.WorkerRuntime == serviceenv.LocalWorkerRuntime {
+		// pipeline workers run on this host, so pachd doesn't need kubernetes
+		env = serviceenv.InitServiceEnv(configuration)
+	} else {
+		env = serviceenv.InitWithKube(configuration)
+	}
 	profileutil.StartCloudProfiler("pachyderm-pachd-full", env.Config())
 	debug.SetGCPercent(env.Config().GCPercent)
 	env.InitDexDB()
@@ -51,7 +51,7 @@ func do(config interface{}) error {
 
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
-	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, "/")
+	workerInstance, err := worker.NewWorker(env, pachClient, pipelineInfo, env.Config().PPSWorkerRoot)
 	if err != nil {
 		return err
 	}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/docker/go-units"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -5734,9 +5735,91 @@ func TestPipelineWithStatsFailedDatums(t *testing.T) {
 	require.Equal(t, pps.DatumState_FAILED, datum.State)
 }
 
+func TestPipelineQuarantineFailedDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineQuarantineFailedDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	numFiles := 10
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < numFiles; i++ {
+		require.NoError(t, c.PutFile(commit1, fmt.Sprintf("file-%d", i), strings.NewReader("foo\n")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, "master", commit1.ID))
+
+	pipeline := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if [ -f /pfs/%s/file-5 ]; then echo file-5 is bad >&2; exit 3; fi", dataRepo),
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			Input:                  client.NewPFSInput(dataRepo, "/*"),
+			DatumTries:             1,
+			QuarantineFailedDatums: true,
+		})
+	require.NoError(t, err)
+	downstream := tu.UniqueString("downstream")
+	require.NoError(t, c.CreatePipeline(
+		downstream,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", pipeline)},
+		nil,
+		client.NewPFSInput(pipeline, "/*"),
+		"",
+		false,
+	))
+
+	commitInfo, err := c.InspectCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	jobInfos, err := c.WaitJobSetAll(commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(jobInfos))
+	for _, ji := range jobInfos {
+		require.Equal(t, pps.JobState_JOB_SUCCESS.String(), ji.State.String())
+	}
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), jobInfo.DataFailed)
+	require.Equal(t, int64(numFiles-1), jobInfo.DataProcessed)
+
+	// The successful datums are in the output, and made it downstream.
+	for _, repo := range []string{pipeline, downstream} {
+		files, err := c.ListFileAll(client.NewCommit(repo, "master", commitInfo.Commit.ID), "/")
+		require.NoError(t, err)
+		require.Equal(t, numFiles-1, len(files))
+	}
+
+	// The failed datum is recorded in the meta commit.
+	metaCommit := client.NewSystemRepo(pipeline, pfs.MetaRepoType).NewCommit("master", commitInfo.Commit.ID)
+	files, err := c.ListFileAll(metaCommit, "/errors/")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(metaCommit, files[0].File.Path, &buf))
+	record := &pps.DatumInfo{}
+	require.NoError(t, jsonpb.Unmarshal(&buf, record))
+	require.Equal(t, pps.DatumState_FAILED, record.State)
+	require.Equal(t, 1, len(record.Data))
+	require.Equal(t, "/file-5", record.Data[0].File.Path)
+	require.Equal(t, 1, len(record.Attempts))
+	require.Equal(t, int32(3), record.Attempts[0].ExitCode)
+	require.True(t, strings.Contains(record.Attempts[0].StderrTail, "file-5 is bad"))
+}
+
 func TestPipelineWithStatsPaginated(t *testing.T) {
-	// TODO(2.0 optional): Implement datum pagination?.
-	t.Skip("Datum pagination not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
@@ -5798,25 +5881,36 @@ func TestPipelineWithStatsPaginated(t *testing.T) {
 	_, err = c.WaitJob(pipeline, jobs[0].Job.ID, false)
 	require.NoError(t, err)
 
-	// resp, err := c.ListDatumAll(jobs[0].Job.ID, pageSize, 0)
-	// require.NoError(t, err)
-	// require.Equal(t, pageSize, int64(len(resp.DatumInfos)))
-	// require.Equal(t, int64(numFiles)/pageSize, resp.TotalPages)
-
-	// // First entry should be failed
-	// require.Equal(t, pps.DatumState_FAILED, resp.DatumInfos[0].State)
-
-	// resp, err = c.ListDatumAll(jobs[0].Job.ID, pageSize, int64(numPages-1))
-	// require.NoError(t, err)
-	// require.Equal(t, pageSize, int64(len(resp.DatumInfos)))
-	// require.Equal(t, int64(int64(numFiles)/pageSize-1), resp.Page)
+	listPage := func(states []pps.DatumState, marker string, number int64) []*pps.DatumInfo {
+		var dis []*pps.DatumInfo
+		require.NoError(t, c.ListDatumPage(pipeline, jobs[0].Job.ID, states, marker, number, func(di *pps.DatumInfo) error {
+			dis = append(dis, di)
+			return nil
+		}))
+		return dis
+	}
 
-	// // Last entry should be success
-	// require.Equal(t, pps.DatumState_SUCCESS, resp.DatumInfos[len(resp.DatumInfos)-1].State)
+	// Paging through the datums should return each datum exactly once
+	seen := make(map[string]bool)
+	var marker string
+	for i := int64(0); i < numPages; i++ {
+		dis := listPage(nil, marker, pageSize)
+		require.Equal(t, pageSize, int64(len(dis)))
+		for _, di := range dis {
+			require.False(t, seen[di.Datum.ID])
+			seen[di.Datum.ID] = true
+		}
+		marker = dis[len(dis)-1].Datum.ID
+	}
+	require.Equal(t, numFiles, len(seen))
+	require.Equal(t, 0, len(listPage(nil, marker, pageSize)))
 
-	// // Make sure we get error when requesting pages too high
-	// _, err = c.ListDatumAll(jobs[0].Job.ID, pageSize, int64(numPages))
-	// require.YesError(t, err)
+	// Only the datum for file-5 should have failed
+	dis := listPage([]pps.DatumState{pps.DatumState_FAILED}, "", 0)
+	require.Equal(t, 1, len(dis))
+	require.Equal(t, pps.DatumState_FAILED, dis[0].State)
+	dis = listPage([]pps.DatumState{pps.DatumState_SUCCESS, pps.DatumState_SKIPPED}, "", 0)
+	require.Equal(t, numFiles-1, len(dis))
 }
 
 func TestPipelineWithStatsAcrossJobs(t *testing.T) {
@@ -7505,68 +7599,58 @@ func TestCancelManyJobs(t *testing.T) {
 // downstream commits are also deleted.
 // DAG in this test: repo -> pipeline[0] -> pipeline[1]
 func TestSquashCommitSetPropagation(t *testing.T) {
-	// TODO(2.0 optional): Implement put file split in V2.
-	t.Skip("Put file split not implemented in V2")
-	// 	if testing.Short() {
-	// 		t.Skip("Skipping integration tests in short mode")
-	// 	}
-
-	// 	c := tu.GetPachClient(t)
-	// 	require.NoError(t, c.DeleteAll())
-
-	// 	// Create an input repo
-	// 	repo := tu.UniqueString("TestSquashCommitSetPropagation")
-	// 	require.NoError(t, c.CreateRepo(repo))
-	// 	_, err := c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false,
-	// 		strings.NewReader(tu.TestPGDump))
-	// 	require.NoError(t, err)
-
-	// 	// Create a pipeline that roughly validates the header
-	// 	pipeline := tu.UniqueString("TestSplitFileReprocessPL")
-	// 	require.NoError(t, c.CreatePipeline(
-	// 		pipeline,
-	// 		"",
-	// 		[]string{"/bin/bash"},
-	// 		[]string{
-	// 			`ls /pfs/*/d/*`, // for debugging
-	// 			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
-	// 			`(( cars_tables == 1 )) && exit 0 || exit 1`,
-	// 		},
-	// 		&pps.ParallelismSpec{Constant: 1},
-	// 		client.NewPFSInput(repo, "/d/*"),
-	// 		"",
-	// 		false,
-	// 	))
-
-	// 	// wait for job to run & check that all rows were processed
-	// 	var jobCount int
-	// 	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	// 		func(jobInfo *pps.JobInfo) error {
-	// 			jobCount++
-	// 			require.Equal(t, 1, jobCount)
-	// 			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	// 			require.Equal(t, int64(5), jobInfo.DataProcessed)
-	// 			require.Equal(t, int64(0), jobInfo.DataSkipped)
-	// 			return nil
-	// 		})
-
-	// 	// put empty dataset w/ new header
-	// 	_, err = c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false,
-	// 		strings.NewReader(tu.TestPGDumpNewHeader))
-	// 	require.NoError(t, err)
-
-	// 	// everything gets reprocessed (hashes all change even though the files
-	// 	// themselves weren't altered)
-	// 	jobCount = 0
-	// 	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	// 		func(jobInfo *pps.JobInfo) error {
-	// 			jobCount++
-	// 			require.Equal(t, 1, jobCount)
-	// 			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	// 			require.Equal(t, int64(5), jobInfo.DataProcessed) // added 3 new rows
-	// 			require.Equal(t, int64(0), jobInfo.DataSkipped)
-	// 			return nil
-	// 		})
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	// put a SQL file w/ header
+	repo := tu.UniqueString("TestSquashCommitSetPropagation")
+	require.NoError(t, c.CreateRepo(repo))
+	dataCommit := client.NewCommit(repo, "master", "")
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDump), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// Create a pipeline that roughly validates the header
+	pipeline := tu.UniqueString("TestSplitFileReprocessPL")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{
+			`ls /pfs/*/d/*`, // for debugging
+			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
+			`(( cars_tables == 1 )) && exit 0 || exit 1`,
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/d/*"),
+		"",
+		false,
+	))
+
+	// wait for job to run & check that all rows were processed
+	commitInfo, err := c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(5), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+
+	// put empty dataset w/ new header
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDumpNewHeader), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// The header is written to each of the split files, so a new header only
+	// applies to the records uploaded with it, and the existing files (and
+	// their datums) are unchanged.
+	commitInfo, err = c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(0), jobInfo.DataProcessed)
+	require.Equal(t, int64(5), jobInfo.DataSkipped)
 }
 
 func TestDeleteSpecRepo(t *testing.T) {
@@ -7798,6 +7882,62 @@ func TestDatumTries(t *testing.T) {
 	require.Equal(t, tries, observedTries)
 }
 
+func TestDatumRetryPolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestDatumRetryPolicy_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.PutFile(client.NewCommit(dataRepo, "master", ""), "file", strings.NewReader("foo")))
+
+	// exitAttempts creates a pipeline whose user code exits with exitCode and
+	// returns the attempts recorded for its only datum.
+	exitAttempts := func(exitCode int) []*pps.DatumAttempt {
+		pipeline := tu.UniqueString("TestDatumRetryPolicy")
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipeline),
+				Transform: &pps.Transform{
+					Cmd: []string{"sh", "-c", fmt.Sprintf("exit %d", exitCode)},
+				},
+				Input: client.NewPFSInput(dataRepo, "/"),
+				DatumRetryPolicy: &pps.DatumRetryPolicy{
+					MaxAttempts:       4,
+					InitialBackoff:    types.DurationProto(100 * time.Millisecond),
+					RetryExitCodes:    []int32{2},
+					FailFastExitCodes: []int32{3},
+				},
+			})
+		require.NoError(t, err)
+		commitInfo, err := c.InspectCommit(pipeline, "master", "")
+		require.NoError(t, err)
+		jobInfos, err := c.WaitJobSetAll(commitInfo.Commit.ID, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(jobInfos))
+		dis, err := c.ListDatumAll(pipeline, jobInfos[0].Job.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(dis))
+		require.Equal(t, pps.DatumState_FAILED, dis[0].State)
+		for _, attempt := range dis[0].Attempts {
+			require.Equal(t, int32(exitCode), attempt.ExitCode)
+			require.False(t, attempt.OOMKilled)
+			require.NotEqual(t, "", attempt.Error)
+		}
+		return dis[0].Attempts
+	}
+	// Exit code 2 is retried until the datum runs out of attempts.
+	require.Equal(t, 4, len(exitAttempts(2)))
+	// Exit code 3 fails fast.
+	require.Equal(t, 1, len(exitAttempts(3)))
+	// Other exit codes aren't retried, since retry_exit_codes is set.
+	require.Equal(t, 1, len(exitAttempts(1)))
+}
+
 func TestInspectJob(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -7861,125 +8001,112 @@ func TestPipelineVersions(t *testing.T) {
 // well, adding more data with the same header should not change the contents of
 // existing data.
 func TestSplitFileHeader(t *testing.T) {
-	// TODO(2.0 optional): Implement put file split in V2?
-	t.Skip("Split file header not implemented in V2")
-	//	if testing.Short() {
-	//		t.Skip("Skipping integration tests in short mode")
-	//	}
-	//
-	//	c := tu.GetPachClient(t)
-	//	require.NoError(t, c.DeleteAll())
-	//
-	//	// put a SQL file w/ header
-	//	repo := tu.UniqueString("TestSplitFileHeader")
-	//	require.NoError(t, c.CreateRepo(repo))
-	//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDump), client.WithAppendPutFile()))
-	//
-	//	// Create a pipeline that roughly validates the header
-	//	pipeline := tu.UniqueString("TestSplitFileHeaderPipeline")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"/bin/bash"},
-	//		[]string{
-	//			`ls /pfs/*/d/*`, // for debugging
-	//			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
-	//			`(( cars_tables == 1 )) && exit 0 || exit 1`,
-	//		},
-	//		&pps.ParallelismSpec{Constant: 1},
-	//		client.NewPFSInput(repo, "/d/*"),
-	//		"",
-	//		false,
-	//	))
-	//
-	//	// wait for job to run & check that all rows were processed
-	//	var jobCount int
-	//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	//		func(jobInfo *pps.JobInfo) error {
-	//			jobCount++
-	//			require.Equal(t, 1, jobCount)
-	//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	//			require.Equal(t, int64(5), jobInfo.DataProcessed)
-	//			require.Equal(t, int64(0), jobInfo.DataSkipped)
-	//			return nil
-	//		})
-	//
-	//	// Add new rows with same header data
-	//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDumpNewRows), client.WithAppendPutFile()))
-	//
-	//	// old data should be skipped, even though header was uploaded twice (new
-	//	// header shouldn't append or change the hash or anything)
-	//	jobCount = 0
-	//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	//		func(jobInfo *pps.JobInfo) error {
-	//			jobCount++
-	//			require.Equal(t, 1, jobCount)
-	//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	//			require.Equal(t, int64(3), jobInfo.DataProcessed) // added 3 new rows
-	//			require.Equal(t, int64(5), jobInfo.DataSkipped)
-	//			return nil
-	//		})
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	// put a SQL file w/ header
+	repo := tu.UniqueString("TestSplitFileHeader")
+	require.NoError(t, c.CreateRepo(repo))
+	dataCommit := client.NewCommit(repo, "master", "")
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDump), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// Create a pipeline that roughly validates the header
+	pipeline := tu.UniqueString("TestSplitFileHeaderPipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{
+			`ls /pfs/*/d/*`, // for debugging
+			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
+			`(( cars_tables == 1 )) && exit 0 || exit 1`,
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/d/*"),
+		"",
+		false,
+	))
+
+	// wait for job to run & check that all rows were processed
+	commitInfo, err := c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(5), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+
+	// Add new rows with same header data
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDumpNewRows), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// old data should be skipped, even though header was uploaded twice (new
+	// header shouldn't append or change the hash or anything)
+	commitInfo, err = c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(3), jobInfo.DataProcessed)
+	require.Equal(t, int64(5), jobInfo.DataSkipped)
 }
 
 func TestNewHeaderCausesReprocess(t *testing.T) {
-	// TODO(2.0 optional): Implement put file split in V2?
-	t.Skip("Split file header not implemented in V2")
-	//	if testing.Short() {
-	//		t.Skip("Skipping integration tests in short mode")
-	//	}
-	//
-	//	c := tu.GetPachClient(t)
-	//	require.NoError(t, c.DeleteAll())
-	//
-	//	// put a SQL file w/ header
-	//	repo := tu.UniqueString("TestSplitFileHeader")
-	//	require.NoError(t, c.CreateRepo(repo))
-	//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDump), client.WithAppendPutFile()))
-	//
-	//	// Create a pipeline that roughly validates the header
-	//	pipeline := tu.UniqueString("TestSplitFileReprocessPL")
-	//	require.NoError(t, c.CreatePipeline(
-	//		pipeline,
-	//		"",
-	//		[]string{"/bin/bash"},
-	//		[]string{
-	//			`ls /pfs/*/d/*`, // for debugging
-	//			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
-	//			`(( cars_tables == 1 )) && exit 0 || exit 1`,
-	//		},
-	//		&pps.ParallelismSpec{Constant: 1},
-	//		client.NewPFSInput(repo, "/d/*"),
-	//		"",
-	//		false,
-	//	))
-	//
-	//	// wait for job to run & check that all rows were processed
-	//	var jobCount int
-	//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	//		func(jobInfo *pps.JobInfo) error {
-	//			jobCount++
-	//			require.Equal(t, 1, jobCount)
-	//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	//			require.Equal(t, int64(5), jobInfo.DataProcessed)
-	//			require.Equal(t, int64(0), jobInfo.DataSkipped)
-	//			return nil
-	//		})
-	//
-	//	// put empty dataset w/ new header
-	//	require.NoError(t, c.PutFileSplit(repo, "master", "d", pfs.Delimiter_SQL, 0, 0, 0, false, strings.NewReader(tu.TestPGDumpNewHeader), client.WithAppendPutFile()))
-	//
-	//	// everything gets reprocessed (hashes all change even though the files
-	//	// themselves weren't altered)
-	//	jobCount = 0
-	//	c.FlushJob([]*pfs.Commit{client.NewCommit(repo, "master")}, nil,
-	//		func(jobInfo *pps.JobInfo) error {
-	//			jobCount++
-	//			require.Equal(t, 1, jobCount)
-	//			require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
-	//			require.Equal(t, int64(5), jobInfo.DataProcessed) // added 3 new rows
-	//			require.Equal(t, int64(0), jobInfo.DataSkipped)
-	//			return nil
-	//		})
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	// put a SQL file w/ header
+	repo := tu.UniqueString("TestSplitFileHeader")
+	require.NoError(t, c.CreateRepo(repo))
+	dataCommit := client.NewCommit(repo, "master", "")
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDump), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// Create a pipeline that roughly validates the header
+	pipeline := tu.UniqueString("TestSplitFileReprocessPL")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"/bin/bash"},
+		[]string{
+			`ls /pfs/*/d/*`, // for debugging
+			`cars_tables="$(grep "CREATE TABLE public.cars" /pfs/*/d/* | sort -u  | wc -l)"`,
+			`(( cars_tables == 1 )) && exit 0 || exit 1`,
+		},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/d/*"),
+		"",
+		false,
+	))
+
+	// wait for job to run & check that all rows were processed
+	commitInfo, err := c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err := c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(5), jobInfo.DataProcessed)
+	require.Equal(t, int64(0), jobInfo.DataSkipped)
+
+	// put empty dataset w/ new header
+	require.NoError(t, c.PutFile(dataCommit, "d", strings.NewReader(tu.TestPGDumpNewHeader), client.WithAppendPutFile(), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+
+	// The header is written to each of the split files, so a new header only
+	// applies to the records uploaded with it, and the existing files (and
+	// their datums) are unchanged.
+	commitInfo, err = c.WaitCommit(pipeline, "master", "")
+	require.NoError(t, err)
+	jobInfo, err = c.InspectJob(pipeline, commitInfo.Commit.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(0), jobInfo.DataProcessed)
+	require.Equal(t, int64(5), jobInfo.DataSkipped)
 }
 
 // TestDeferredCross is a repro for https://github.com/pachyderm/pachyderm/v2/issues/5172
@@ -9151,7 +9278,7 @@ func TestListDatum(t *testing.T) {
 		require.NoError(t, c.PutFile(client.NewCommit(repo2, "master", ""), fmt.Sprintf("file-%d", i), strings.NewReader("foo"), client.WithAppendPutFile()))
 	}
 
-	dis, err := c.ListDatumInputAll(&pps.Input{
+	input := &pps.Input{
 		Cross: []*pps.Input{{
 			Pfs: &pps.PFSInput{
 				Repo: repo1,
@@ -9163,9 +9290,30 @@ func TestListDatum(t *testing.T) {
 				Glob: "/*",
 			},
 		}},
-	})
+	}
+	dis, err := c.ListDatumInputAll(input)
 	require.NoError(t, err)
 	require.Equal(t, 25, len(dis))
+
+	// Paging through the datums should return them in the same order
+	var paged []*pps.DatumInfo
+	var marker string
+	for {
+		var page []*pps.DatumInfo
+		require.NoError(t, c.ListDatumInputPage(input, marker, 10, func(di *pps.DatumInfo) error {
+			page = append(page, di)
+			return nil
+		}))
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		marker = page[len(page)-1].Datum.ID
+	}
+	require.Equal(t, len(dis), len(paged))
+	for i := range dis {
+		require.Equal(t, dis[i].Datum.ID, paged[i].Datum.ID)
+	}
 }
 
 func TestDebug(t *testing.T) {
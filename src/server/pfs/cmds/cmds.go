@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/proto"
@@ -65,6 +66,8 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var metadata map[string]string
+	var compression string
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -76,12 +79,18 @@ or type (e.g. csv, binary, images, etc).`,
 			}
 			defer c.Close()
 
+			compressionAlgo, err := parseCompression(compression)
+			if err != nil {
+				return err
+			}
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Metadata:    metadata,
+						Compression: compressionAlgo,
 					},
 				)
 				return err
@@ -90,6 +99,8 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().StringToStringVar(&metadata, "metadata", nil, "Key/value metadata describing the repo, e.g. 'owner=data-eng'. May be repeated.")
+	createRepo.Flags().StringVar(&compression, "compression", "", "The algorithm used to compress data written to the repo: none, gzip, zstd or lz4. Defaults to pachd's configured algorithm.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -103,12 +114,18 @@ or type (e.g. csv, binary, images, etc).`,
 			}
 			defer c.Close()
 
+			compressionAlgo, err := parseCompression(compression)
+			if err != nil {
+				return err
+			}
 			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
 				_, err = c.PfsAPIClient.CreateRepo(
 					c.Ctx(),
 					&pfs.CreateRepoRequest{
 						Repo:        cmdutil.ParseRepo(args[0]),
 						Description: description,
+						Metadata:    metadata,
+						Compression: compressionAlgo,
 						Update:      true,
 					},
 				)
@@ -118,20 +135,60 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().StringToStringVar(&metadata, "metadata", nil, "Key/value metadata describing the repo, replacing its existing metadata. May be repeated.")
+	updateRepo.Flags().StringVar(&compression, "compression", "", "The algorithm used to compress data subsequently written to the repo: none, gzip, zstd or lz4. Existing data is unaffected.")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
+	var storage bool
+	var storageFrom, storageTo string
 	inspectRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Return info about a repo.",
-		Long:  "Return info about a repo.",
+		Long:  "Return info about a repo. With --storage, report the object storage the repo pins after chunk deduplication.",
+		Example: `
+# Return info about repo "foo"
+$ {{alias}} foo
+
+# Return the storage used by repo "foo", and how much of it is shared with other repos
+$ {{alias}} foo --storage
+
+# Return the storage used by the commits on branch "master" of repo "foo" since commit XXX
+$ {{alias}} foo --storage --from XXX --to master`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if !storage && (storageFrom != "" || storageTo != "") {
+				return errors.New("cannot set --from or --to without --storage")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
-			repoInfo, err := c.PfsAPIClient.InspectRepo(c.Ctx(), &pfs.InspectRepoRequest{Repo: cmdutil.ParseRepo(args[0])})
+			repo := cmdutil.ParseRepo(args[0])
+			if storage {
+				req := &pfs.InspectRepoStorageRequest{Repo: repo}
+				if storageFrom != "" {
+					if req.From, err = cmdutil.ParseCommit(args[0] + "@" + storageFrom); err != nil {
+						return err
+					}
+				}
+				if storageTo != "" {
+					if req.To, err = cmdutil.ParseCommit(args[0] + "@" + storageTo); err != nil {
+						return err
+					}
+				}
+				storageInfo, err := c.PfsAPIClient.InspectRepoStorage(c.Ctx(), req)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					return cmdutil.Encoder(output, os.Stdout).EncodeProto(storageInfo)
+				} else if output != "" {
+					return errors.New("cannot set --output (-o) without --raw")
+				}
+				return pretty.PrintDetailedRepoStorageInfo(storageInfo)
+			}
+			repoInfo, err := c.PfsAPIClient.InspectRepo(c.Ctx(), &pfs.InspectRepoRequest{Repo: repo})
 			if err != nil {
 				return err
 			}
@@ -150,6 +207,9 @@ or type (e.g. csv, binary, images, etc).`,
 			return pretty.PrintDetailedRepoInfo(ri)
 		}),
 	}
+	inspectRepo.Flags().BoolVar(&storage, "storage", false, "report the deduplicated object storage used by the repo")
+	inspectRepo.Flags().StringVar(&storageFrom, "from", "", "with --storage, only consider commits since this commit")
+	inspectRepo.Flags().StringVar(&storageTo, "to", "", "with --storage, only consider commits up to this commit or branch")
 	inspectRepo.Flags().AddFlagSet(outputFlags)
 	inspectRepo.Flags().AddFlagSet(timestampFlags)
 	shell.RegisterCompletionFunc(inspectRepo, shell.RepoCompletion)
@@ -275,7 +335,10 @@ $ {{alias}} test@master
 $ {{alias}} test@patch -p master
 
 # Start a commit with XXX as the parent in repo "test" on the branch "fork"
-$ {{alias}} test@fork -p XXX`,
+$ {{alias}} test@fork -p XXX
+
+# Start a commit in repo "test" on branch "master" with metadata
+$ {{alias}} test@master --metadata source=crm --metadata ticket=DATA-123`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			branch, err := cmdutil.ParseBranch(args[0])
 			if err != nil {
@@ -306,6 +369,7 @@ $ {{alias}} test@fork -p XXX`,
 						Branch:      branch,
 						Parent:      parentCommit,
 						Description: description,
+						Metadata:    metadata,
 					},
 				)
 				return err
@@ -320,6 +384,7 @@ $ {{alias}} test@fork -p XXX`,
 	startCommit.MarkFlagCustom("parent", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	startCommit.Flags().StringToStringVar(&metadata, "metadata", nil, "Key/value metadata describing this commit, e.g. 'ticket=DATA-123'. May be repeated.")
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(startCommit, "start commit"))
 
@@ -344,6 +409,7 @@ $ {{alias}} test@fork -p XXX`,
 					&pfs.FinishCommitRequest{
 						Commit:      commit,
 						Description: description,
+						Metadata:    metadata,
 						Force:       force,
 					},
 				)
@@ -354,6 +420,7 @@ $ {{alias}} test@fork -p XXX`,
 	}
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 	finishCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	finishCommit.Flags().StringToStringVar(&metadata, "metadata", nil, "Key/value metadata describing this commit, merged into the metadata set when it was started. May be repeated.")
 	finishCommit.Flags().BoolVarP(&force, "force", "f", false, "finish the commit even if it has provenance, which could break jobs; prefer 'stop job'")
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
@@ -406,6 +473,7 @@ $ {{alias}} test@fork -p XXX`,
 	var number int64
 	var originStr string
 	var expand bool
+	var since, until string
 	listCommit := &cobra.Command{
 		Use:   "{{alias}} [<repo>[@<branch-or-commit>]]",
 		Short: "Return all commits on a repo.",
@@ -421,7 +489,16 @@ $ {{alias}} foo@master
 $ {{alias}} foo@master -n 20
 
 # return commits in repo "foo" since commit XXX
-$ {{alias}} foo@master --from XXX`,
+$ {{alias}} foo@master --from XXX
+
+# return commits in repo "foo" with the metadata "source=crm"
+$ {{alias}} foo --metadata source=crm
+
+# return commits in repo "foo" started in the last day
+$ {{alias}} foo --since 24h
+
+# return commits in repo "foo" started in March 2021
+$ {{alias}} foo --since 2021-03-01T00:00:00Z --until 2021-04-01T00:00:00Z`,
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -441,6 +518,10 @@ $ {{alias}} foo@master --from XXX`,
 					return errors.Errorf("cannot specify --origin when listing all commits")
 				} else if from != "" {
 					return errors.Errorf("cannot specify --from when listing all commits")
+				} else if len(metadata) > 0 {
+					return errors.Errorf("cannot specify --metadata when listing all commits")
+				} else if since != "" || until != "" {
+					return errors.Errorf("cannot specify --since or --until when listing all commits")
 				}
 
 				listCommitSetClient, err := c.PfsAPIClient.ListCommitSet(c.Ctx(), &pfs.ListCommitSetRequest{})
@@ -520,6 +601,10 @@ $ {{alias}} foo@master --from XXX`,
 					return errors.Errorf("cannot specify --all when listing subcommits")
 				} else if originStr != "" {
 					return errors.Errorf("cannot specify --origin when listing subcommits")
+				} else if len(metadata) > 0 {
+					return errors.Errorf("cannot specify --metadata when listing subcommits")
+				} else if since != "" || until != "" {
+					return errors.Errorf("cannot specify --since or --until when listing subcommits")
 				}
 
 				commitInfos, err := c.InspectCommitSet(args[0])
@@ -571,14 +656,25 @@ $ {{alias}} foo@master --from XXX`,
 				if err != nil {
 					return err
 				}
+				startedAfter, err := parseTimeBound("since", since)
+				if err != nil {
+					return err
+				}
+				startedBefore, err := parseTimeBound("until", until)
+				if err != nil {
+					return err
+				}
 
 				listClient, err := c.PfsAPIClient.ListCommit(c.Ctx(), &pfs.ListCommitRequest{
-					Repo:       repo,
-					From:       fromCommit,
-					To:         toCommit,
-					Number:     number,
-					All:        all,
-					OriginKind: origin,
+					Repo:          repo,
+					From:          fromCommit,
+					To:            toCommit,
+					Number:        number,
+					All:           all,
+					OriginKind:    origin,
+					Metadata:      metadata,
+					StartedAfter:  startedAfter,
+					StartedBefore: startedBefore,
 				})
 				if err != nil {
 					return grpcutil.ScrubGRPC(err)
@@ -607,6 +703,9 @@ $ {{alias}} foo@master --from XXX`,
 	listCommit.Flags().BoolVar(&all, "all", false, "return all types of commits, including aliases")
 	listCommit.Flags().BoolVarP(&expand, "expand", "x", false, "show one line for each sub-commmit and include more columns")
 	listCommit.Flags().StringVar(&originStr, "origin", "", "only return commits of a specific type")
+	listCommit.Flags().StringToStringVar(&metadata, "metadata", nil, "only return commits with this key/value metadata; an empty value matches any commit with the key")
+	listCommit.Flags().StringVar(&since, "since", "", "only return commits started at or after this time, given as a duration before now (e.g. 24h) or an RFC 3339 timestamp")
+	listCommit.Flags().StringVar(&until, "until", "", "only return commits started before this time, given as a duration before now (e.g. 1h) or an RFC 3339 timestamp")
 	listCommit.Flags().AddFlagSet(outputFlags)
 	listCommit.Flags().AddFlagSet(timestampFlags)
 	shell.RegisterCompletionFunc(listCommit, shell.RepoCompletion)
@@ -752,13 +851,67 @@ $ {{alias}} test@master --new`,
 			defer c.Close()
 
 			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
-				return c.SquashCommitSet(args[0])
+				_, err := c.PfsAPIClient.SquashCommitSet(c.Ctx(), &pfs.SquashCommitSetRequest{
+					CommitSet: client.NewCommitSet(args[0]),
+					Force:     force,
+				})
+				return grpcutil.ScrubGRPC(err)
 			})
 		}),
 	}
+	squashCommit.Flags().BoolVarP(&force, "force", "f", false, "squash the commit even if it is tagged, deleting its tags")
 	shell.RegisterCompletionFunc(squashCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(squashCommit, "squash commit"))
 
+	var ours, theirs bool
+	var revertBranch string
+	revertCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Undo the changes made by a commit.",
+		Long: `Make a new commit that undoes the changes made by a commit relative to its parent, leaving the commit itself in the history. The new commit is made on the commit's branch, or on --branch, and triggers downstream pipelines like any other commit.
+
+Paths that were changed again after the commit are conflicts. Conflicts are resolved with --ours (keep the branch's current version) or --theirs (restore the version from before the commit). If any conflict is left unresolved, no commit is made and the conflicts are printed.`,
+		Example: `
+# undo the changes made by the head of master
+$ {{alias}} images@master
+
+# undo the changes made by a commit, keeping any files changed since then
+$ {{alias}} images@0001a0100b1c10d01111e001fg00h00i --ours`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			request := &pfs.RevertCommitRequest{
+				Commit:      commit,
+				Description: description,
+			}
+			if revertBranch != "" {
+				request.Branch = client.NewBranch(commit.Branch.Repo.Name, revertBranch)
+			}
+			if request.Resolution, err = parseMergeResolution(ours, theirs); err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			response, err := c.PfsAPIClient.RevertCommit(c.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return printMergeResult(response.Commit, response.Conflicts, "Nothing to revert.")
+		}),
+	}
+	revertCommit.Flags().StringVarP(&revertBranch, "branch", "b", "", "The branch to make the revert commit on, defaults to the commit's branch.")
+	revertCommit.Flags().BoolVar(&ours, "ours", false, "Resolve conflicts by keeping the branch's current version.")
+	revertCommit.Flags().BoolVar(&theirs, "theirs", false, "Resolve conflicts by restoring the version from before the commit.")
+	revertCommit.Flags().StringVarP(&description, "message", "m", "", "A description of the revert commit.")
+	revertCommit.Flags().StringVar(&description, "description", "", "A description of the revert commit (synonym for --message).")
+	shell.RegisterCompletionFunc(revertCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(revertCommit, "revert commit"))
+
 	branchDocs := &cobra.Command{
 		Short: "Docs for branches.",
 		Long: `A branch in Pachyderm is an alias for a Commit ID.
@@ -939,6 +1092,256 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	var resolve []string
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <target-branch>",
+		Short: "Merge the changes on one branch into another.",
+		Long: `Merge the changes made on a branch since its common ancestor with another branch into the other branch, in a new commit.
+
+Paths changed differently on both branches are conflicts. Conflicts are resolved with --ours (keep the target branch's version), --theirs (take the source branch's version) or per path with --resolve. If any conflict is left unresolved, no commit is made and the conflicts are printed.`,
+		Example: `
+# merge the changes on feature into master
+$ {{alias}} images@feature master
+
+# merge, taking feature's version of every conflicting path except /labels.csv
+$ {{alias}} images@feature master --theirs --resolve /labels.csv=ours`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			request := &pfs.MergeBranchRequest{
+				Source:      source,
+				Target:      client.NewBranch(source.Repo.Name, args[1]),
+				Description: description,
+				Resolutions: make(map[string]pfs.MergeResolution),
+			}
+			if request.Resolution, err = parseMergeResolution(ours, theirs); err != nil {
+				return err
+			}
+			for _, r := range resolve {
+				parts := strings.SplitN(r, "=", 2)
+				if len(parts) != 2 {
+					return errors.Errorf("invalid resolution %q, must be <path>=ours or <path>=theirs", r)
+				}
+				resolution, ok := pfs.MergeResolution_value[strings.ToUpper(parts[1])]
+				if !ok || resolution == int32(pfs.MergeResolution_UNRESOLVED) {
+					return errors.Errorf("invalid resolution %q, must be <path>=ours or <path>=theirs", r)
+				}
+				request.Resolutions[parts[0]] = pfs.MergeResolution(resolution)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			response, err := c.PfsAPIClient.MergeBranch(c.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return printMergeResult(response.Commit, response.Conflicts, "Already up to date.")
+		}),
+	}
+	mergeBranch.Flags().BoolVar(&ours, "ours", false, "Resolve conflicts by keeping the target branch's version.")
+	mergeBranch.Flags().BoolVar(&theirs, "theirs", false, "Resolve conflicts by taking the source branch's version.")
+	mergeBranch.Flags().StringSliceVar(&resolve, "resolve", nil, "Resolve the conflict on a path, as <path>=ours or <path>=theirs. May be repeated.")
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit.")
+	mergeBranch.Flags().StringVar(&description, "description", "", "A description of the merge commit (synonym for --message).")
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(mergeBranch, "merge branch"))
+
+	var protection pfs.BranchProtection
+	protectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Set the protection rules on a branch.",
+		Long:  "Set the protection rules on a branch, replacing any existing rules. Protection applies to every user, including repo owners, until it is removed with 'pachctl unprotect branch'.",
+		Example: `
+# only allow commits on master by moving its head forward to commits from staging
+$ {{alias}} images@master --promote-from staging --deny-rewind
+
+# only allow the "edges" pipeline to start commits on master
+$ {{alias}} edges@master --required-pipeline edges`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			if proto.Equal(&protection, &pfs.BranchProtection{}) {
+				return errors.Errorf("at least one protection rule must be specified")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.SetBranchProtection(branch.Repo.Name, branch.Name, &protection)
+		}),
+	}
+	protectBranch.Flags().BoolVar(&protection.DenyDirectCommits, "deny-direct-commits", false, "Reject commits started directly on the branch.")
+	protectBranch.Flags().BoolVar(&protection.DenyRewind, "deny-rewind", false, "Reject moving the head to a commit that doesn't descend from it, and reject deleting the branch.")
+	protectBranch.Flags().StringVar(&protection.RequiredPipeline, "required-pipeline", "", "Only accept commits from this pipeline (requires auth).")
+	protectBranch.Flags().StringVar(&protection.PromoteFrom, "promote-from", "", "Only accept commits by moving the head to a commit on this branch.")
+	shell.RegisterCompletionFunc(protectBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(protectBranch, "protect branch"))
+
+	unprotectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Remove the protection rules from a branch.",
+		Long:  "Remove the protection rules from a branch.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.SetBranchProtection(branch.Repo.Name, branch.Name, nil)
+		}),
+	}
+	shell.RegisterCompletionFunc(unprotectBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(unprotectBranch, "unprotect branch"))
+
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: `A tag in Pachyderm is an immutable, human-readable name for a commit.
+
+Unlike a branch, a tag never moves once it's created. Any pachctl command that
+can take a Commit ID can take a tag name instead, e.g. 'images@v1.0'. Tags and
+branches in a repo share a namespace, so a tag cannot have the same name as a
+branch.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(tagDocs, "tag", " tag$"))
+
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag> <branch-or-commit>",
+		Short: "Create a new tag.",
+		Long:  "Create a new tag pointing at a commit in the same repo. Tags cannot be moved once created.",
+		Example: `
+# tag the current head of master in the "images" repo as "v1"
+$ {{alias}} images@v1 master
+
+# tag a specific commit
+$ {{alias}} images@v1 7ac2d42ab6f748a3b3b1bf0c5c8e1fc6`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			commit, err := cmdutil.ParseCommit(tag.Repo.String() + "@" + args[1])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.CreateTag(c.Ctx(), &pfs.CreateTagRequest{
+				Tag:         tag.Repo.NewTag(tag.Name),
+				Commit:      commit,
+				Description: description,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createTag.Flags().StringVarP(&description, "description", "d", "", "A description of the tag.")
+	shell.RegisterCompletionFunc(createTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(createTag, "create tag"))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long:  "Return info about a tag.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagInfo, err := c.PfsAPIClient.InspectTag(c.Ctx(), &pfs.InspectTagRequest{Tag: tag.Repo.NewTag(tag.Name)})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return cmdutil.Encoder(output, os.Stdout).EncodeProto(tagInfo)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintDetailedTagInfo(tagInfo)
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(inspectTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(inspectTag, "inspect tag"))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags in a repo.",
+		Long:  "Return all tags in a repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagClient, err := c.PfsAPIClient.ListTag(c.Ctx(), &pfs.ListTagRequest{Repo: cmdutil.ParseRepo(args[0])})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+
+			if raw {
+				encoder := cmdutil.Encoder(output, os.Stdout)
+				err := clientsdk.ForEachTagInfo(tagClient, func(tag *pfs.TagInfo) error {
+					return encoder.EncodeProto(tag)
+				})
+				return grpcutil.ScrubGRPC(err)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			if err := clientsdk.ForEachTagInfo(tagClient, func(tag *pfs.TagInfo) error {
+				pretty.PrintTagInfo(writer, tag, fullTimestamps)
+				return nil
+			}); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(outputFlags)
+	listTag.Flags().AddFlagSet(timestampFlags)
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(listTag, "list tag"))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag.",
+		Long:  "Delete a tag, while leaving the commit it points to intact.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.DeleteTag(c.Ctx(), &pfs.DeleteTagRequest{Tag: tag.Repo.NewTag(tag.Name)})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	shell.RegisterCompletionFunc(deleteTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(deleteTag, "delete tag"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -957,6 +1360,10 @@ from commits with 'get file'.`,
 	var compress bool
 	var enableProgress bool
 	var fullPath bool
+	var split string
+	var targetFileDatums int64
+	var targetFileBytes int64
+	var headerRecords int64
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -999,7 +1406,11 @@ $ {{alias}} repo@branch -i file
 # Put several files or URLs that are listed at URL.
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
-$ {{alias}} repo@branch -i http://host/path`,
+$ {{alias}} repo@branch -i http://host/path
+
+# Split a CSV file into files of 100 rows under repo/branch/path, with the
+# header row at the start of every file:
+$ {{alias}} repo@branch:/path -f file.csv --split csv --target-file-datums 100 --header-records 1`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			if !enableProgress {
 				progress.Disable()
@@ -1008,6 +1419,19 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			var putOpts []client.PutFileOption
+			if appendFile {
+				putOpts = append(putOpts, client.WithAppendPutFile())
+			}
+			if split != "" {
+				delimiter, ok := pfs.Delimiter_value[strings.ToUpper(split)]
+				if !ok || pfs.Delimiter(delimiter) == pfs.Delimiter_NONE {
+					return errors.Errorf("unrecognized delimiter %q; only accepts one of {json,line,sql,csv}", split)
+				}
+				putOpts = append(putOpts, client.WithSplitPutFile(pfs.Delimiter(delimiter), targetFileDatums, targetFileBytes, headerRecords))
+			} else if targetFileDatums != 0 || targetFileBytes != 0 || headerRecords != 0 {
+				return errors.New("cannot set --target-file-datums, --target-file-bytes or --header-records without --split")
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -1063,40 +1487,42 @@ $ {{alias}} repo@branch -i http://host/path`,
 				sources = filePaths
 			}
 
-			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
-				for _, source := range sources {
-					source := source
-					if file.Path == "" {
-						// The user has not specified a path so we use source as path.
-						if source == "-" {
-							return errors.Errorf("must specify filename when reading data from stdin")
-						}
-						target := source
-						if !fullPath {
-							target = filepath.Base(source)
-						}
-						if err := putFileHelper(mf, joinPaths("", target), source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else if len(sources) == 1 {
-						// We have a single source and the user has specified a path,
-						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else {
-						// We have multiple sources and the user has specified a path,
-						// we use that path as a prefix for the filepaths.
-						target := source
-						if !fullPath {
-							target = filepath.Base(source)
-						}
-						if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, appendFile); err != nil {
-							return err
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
+					for _, source := range sources {
+						source := source
+						if file.Path == "" {
+							// The user has not specified a path so we use source as path.
+							if source == "-" {
+								return errors.Errorf("must specify filename when reading data from stdin")
+							}
+							target := source
+							if !fullPath {
+								target = filepath.Base(source)
+							}
+							if err := putFileHelper(mf, joinPaths("", target), source, recursive, putOpts); err != nil {
+								return err
+							}
+						} else if len(sources) == 1 {
+							// We have a single source and the user has specified a path,
+							// we use the path and ignore source (in terms of naming the file).
+							if err := putFileHelper(mf, file.Path, source, recursive, putOpts); err != nil {
+								return err
+							}
+						} else {
+							// We have multiple sources and the user has specified a path,
+							// we use that path as a prefix for the filepaths.
+							target := source
+							if !fullPath {
+								target = filepath.Base(source)
+							}
+							if err := putFileHelper(mf, joinPaths(file.Path, target), source, recursive, putOpts); err != nil {
+								return err
+							}
 						}
 					}
-				}
-				return nil
+					return nil
+				})
 			})
 		}),
 	}
@@ -1108,6 +1534,10 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	putFile.Flags().BoolVar(&fullPath, "full-path", false, "If true, use the entire path provided to -f as the target filename in PFS. By default only the base of the path is used.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input into records, and write them to numbered files in a directory at the target path. Accepts one of {json,line,sql,csv}.")
+	putFile.Flags().Int64Var(&targetFileDatums, "target-file-datums", 0, "The maximum number of records in each file written by --split. By default, each file holds a single record.")
+	putFile.Flags().Int64Var(&targetFileBytes, "target-file-bytes", 0, "The target size in bytes of each file written by --split. A file is started once the previous one reaches this size.")
+	putFile.Flags().Int64Var(&headerRecords, "header-records", 0, "The number of records at the start of the input to write to the start of every file written by --split. Only accepted for csv and sql.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -1144,11 +1574,13 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if appendFile {
 				opts = append(opts, client.WithAppendCopyFile())
 			}
-			return c.CopyFile(
-				destFile.Commit, destFile.Path,
-				srcFile.Commit, srcFile.Path,
-				opts...,
-			)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.CopyFile(
+					destFile.Commit, destFile.Path,
+					srcFile.Commit, srcFile.Path,
+					opts...,
+				)
+			})
 		}),
 	}
 	copyFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
@@ -1496,7 +1928,9 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			if recursive {
 				opts = append(opts, client.WithRecursiveDeleteFile())
 			}
-			return c.DeleteFile(file.Commit, file.Path, opts...)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.DeleteFile(file.Commit, file.Path, opts...)
+			})
 		}),
 	}
 	deleteFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively delete the files in a directory.")
@@ -1543,6 +1977,33 @@ Objects are a low-level resource and should not be accessed directly by most use
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	var dryRun bool
+	garbageCollect := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Run a single pass of storage garbage collection.",
+		Long:  "Run a single pass of storage garbage collection, deleting the filesets and chunks which are no longer referenced. With --dry-run, report what would be deleted without deleting anything.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.GarbageCollect(dryRun)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return cmdutil.Encoder(output, os.Stdout).EncodeProto(resp)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			return pretty.PrintGarbageCollectResponse(resp)
+		}),
+	}
+	garbageCollect.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be deleted, without deleting anything.")
+	garbageCollect.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(garbageCollect, "garbage-collect"))
+
 	var branchStr string
 	var seed int64
 	runLoadTest := &cobra.Command{
@@ -1604,13 +2065,9 @@ Objects are a low-level resource and should not be accessed directly by most use
 	return commands
 }
 
-func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile bool) (retErr error) {
+func putFileHelper(mf client.ModifyFile, path, source string, recursive bool, opts []client.PutFileOption) (retErr error) {
 	// Resolve the path and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
-	var opts []client.PutFileOption
-	if appendFile {
-		opts = append(opts, client.WithAppendPutFile())
-	}
 	// try parsing the filename as a url, if it is one do a PutFileURL
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		return mf.PutFileURL(path, url.String(), recursive, opts...)
@@ -1638,7 +2095,7 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 			// don't do a second recursive 'put file', just put the one file at
 			// filePath into childDest, and then this walk loop will go on to the
 			// next one
-			return putFileHelper(mf, childDest, filePath, false, appendFile)
+			return putFileHelper(mf, childDest, filePath, false, opts)
 		})
 	}
 	f, err := progress.Open(source)
@@ -1761,3 +2218,76 @@ func parseOriginKind(input string) (pfs.OriginKind, error) {
 
 	return result, nil
 }
+
+// parseTimeBound parses a --since or --until flag, which is either a duration
+// before now, e.g. "24h", or an RFC 3339 timestamp.
+func parseTimeBound(flag, input string) (*types.Timestamp, error) {
+	if input == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		d, durationErr := time.ParseDuration(input)
+		if durationErr != nil {
+			return nil, errors.Errorf("invalid --%s %q, must be a duration such as \"24h\" or an RFC 3339 timestamp", flag, input)
+		}
+		t = time.Now().Add(-d)
+	}
+	ts, err := types.TimestampProto(t)
+	return ts, errors.EnsureStack(err)
+}
+
+func parseMergeResolution(ours, theirs bool) (pfs.MergeResolution, error) {
+	switch {
+	case ours && theirs:
+		return pfs.MergeResolution_UNRESOLVED, errors.Errorf("only one of --ours and --theirs may be specified")
+	case ours:
+		return pfs.MergeResolution_OURS, nil
+	case theirs:
+		return pfs.MergeResolution_THEIRS, nil
+	}
+	return pfs.MergeResolution_UNRESOLVED, nil
+}
+
+// printMergeResult prints the conflicts from a merge or revert, followed by the
+// ID of the commit it made. If no commit was made, it returns an error if that
+// was due to unresolved conflicts, and prints noop otherwise.
+func printMergeResult(commit *pfs.Commit, conflicts []*pfs.MergeConflict, noop string) error {
+	if len(conflicts) > 0 {
+		writer := tabwriter.NewWriter(os.Stdout, pretty.MergeConflictHeader)
+		for _, conflict := range conflicts {
+			pretty.PrintMergeConflict(writer, conflict)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+	}
+	if commit == nil {
+		for _, conflict := range conflicts {
+			if conflict.Resolution == pfs.MergeResolution_UNRESOLVED {
+				return errors.Errorf("there are unresolved conflicts, no commit was made")
+			}
+		}
+		fmt.Println(noop)
+		return nil
+	}
+	fmt.Println(commit.ID)
+	return nil
+}
+
+func parseCompression(input string) (pfs.Compression, error) {
+	switch strings.ToLower(input) {
+	case "":
+		return pfs.Compression_DEFAULT_COMPRESSION, nil
+	case "none":
+		return pfs.Compression_NO_COMPRESSION, nil
+	case "gzip":
+		return pfs.Compression_GZIP, nil
+	case "zstd":
+		return pfs.Compression_ZSTD, nil
+	case "lz4":
+		return pfs.Compression_LZ4, nil
+	default:
+		return pfs.Compression_DEFAULT_COMPRESSION, errors.Errorf("unknown compression algorithm '%s', must be one of: none, gzip, zstd, lz4", input)
+	}
+}
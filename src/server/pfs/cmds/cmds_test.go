@@ -40,8 +40,6 @@ func TestCommit(t *testing.T) {
 }
 
 func TestPutFileSplit(t *testing.T) {
-	// TODO: Need to implement put file split in V2.
-	t.Skip("Put file split not implemented in V2")
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
 	}
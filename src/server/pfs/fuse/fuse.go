@@ -21,6 +21,10 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 	if err := opts.validate(c); err != nil {
 		return err
 	}
+	tagCommits, err := opts.resolveTags(c)
+	if err != nil {
+		return err
+	}
 	commits := make(map[string]string)
 	for repo, branch := range opts.getBranches() {
 		if uuid.IsUUIDWithoutDashes(branch) {
@@ -40,6 +44,9 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 	if err != nil {
 		return err
 	}
+	for repo, commit := range tagCommits {
+		root.commits[repo] = commit
+	}
 	server, err := fs.Mount(target, root, opts.getFuse())
 	if err != nil {
 		return errors.WithStack(err)
@@ -78,6 +78,37 @@ func (o *Options) getUnmount() chan struct{} {
 	return o.Unmount
 }
 
+// resolveTags replaces any tags given in place of a branch in o's repo options
+// with the branch of the tagged commit, and returns a map from repo name to
+// the tagged commit's ID. Tags can't be mounted for writing.
+func (o *Options) resolveTags(c *client.APIClient) (map[string]string, error) {
+	result := make(map[string]string)
+	if o == nil {
+		return result, nil
+	}
+	for repo, opts := range o.RepoOptions {
+		if opts.Branch == "" || uuid.IsUUIDWithoutDashes(opts.Branch) {
+			continue
+		}
+		if _, err := c.InspectBranch(repo, opts.Branch); err == nil || !errutil.IsNotFoundError(err) {
+			continue
+		}
+		tagInfo, err := c.InspectTag(repo, opts.Branch)
+		if err != nil {
+			if errutil.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if opts.Write {
+			return nil, errors.Errorf("can't mount tag %s@%s in Write mode (mount a branch instead)", repo, opts.Branch)
+		}
+		opts.Branch = tagInfo.Commit.Branch.Name
+		result[repo] = tagInfo.Commit.ID
+	}
+	return result, nil
+}
+
 func (o *Options) validate(c *client.APIClient) error {
 	if o == nil {
 		return nil
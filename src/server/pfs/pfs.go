@@ -36,6 +36,16 @@ type ErrBranchExists struct {
 	Branch *pfs.Branch
 }
 
+// ErrTagNotFound represents a tag-not-found error.
+type ErrTagNotFound struct {
+	Tag *pfs.Tag
+}
+
+// ErrTagExists represents a tag-exists error.
+type ErrTagExists struct {
+	Tag *pfs.Tag
+}
+
 // ErrCommitNotFound represents a commit-not-found error.
 type ErrCommitNotFound struct {
 	Commit *pfs.Commit
@@ -149,6 +159,14 @@ func (e ErrBranchExists) Error() string {
 	return fmt.Sprintf("branch %q already exists in repo %v", e.Branch.Name, e.Branch.Repo)
 }
 
+func (e ErrTagNotFound) Error() string {
+	return fmt.Sprintf("tag %q not found in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
+func (e ErrTagExists) Error() string {
+	return fmt.Sprintf("tag %q already exists in repo %v", e.Tag.Name, e.Tag.Repo)
+}
+
 func (e ErrCommitNotFound) Error() string {
 	return fmt.Sprintf("commit %v not found in repo %v", e.Commit.ID, e.Commit.Branch.Repo)
 }
@@ -214,6 +232,7 @@ var (
 	repoNotFoundRe            = regexp.MustCompile(`repos [a-zA-Z0-9.\-_]{1,255} not found`)
 	repoExistsRe              = regexp.MustCompile(`repo ?[a-zA-Z0-9.\-_]{1,255} already exists`)
 	branchNotFoundRe          = regexp.MustCompile(`branch [^ ]+ not found in repo [^ ]+`)
+	tagNotFoundRe             = regexp.MustCompile(`tag [^ ]+ not found in repo [^ ]+`)
 	fileNotFoundRe            = regexp.MustCompile(`file .+ not found`)
 	outputCommitNotFinishedRe = regexp.MustCompile("output commit .+ not finished")
 	commitNotFinishedRe       = regexp.MustCompile("commit .+ not finished")
@@ -296,6 +315,15 @@ func IsBranchNotFoundErr(err error) bool {
 	return branchNotFoundRe.MatchString(err.Error())
 }
 
+// IsTagNotFoundErr returns true if 'err' is an error message about a tag not
+// being found
+func IsTagNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return tagNotFoundRe.MatchString(err.Error())
+}
+
 // IsFileNotFoundErr returns true if 'err' is an error message about a PFS
 // file not being found
 func IsFileNotFoundErr(err error) bool {
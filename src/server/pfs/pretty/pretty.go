@@ -25,6 +25,10 @@ const (
 	CommitSetHeader = "ID\tSUBCOMMITS\tPROGRESS\tCREATED\tMODIFIED\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\tTRIGGER\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\tDESCRIPTION\t\n"
+	// MergeConflictHeader is the header for merge conflicts.
+	MergeConflictHeader = "PATH\tOURS\tTHEIRS\tRESOLUTION\t\n"
 	// FileHeader is the header for files.
 	FileHeader = "NAME\tTYPE\tSIZE\t\n"
 	// FileHeaderWithCommit is the header for files that includes a commit field.
@@ -71,9 +75,11 @@ func NewPrintableRepoInfo(ri *pfs.RepoInfo) *PrintableRepoInfo {
 func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 	template, err := template.New("RepoInfo").Funcs(funcMap).Parse(
 		`Name: {{.Repo.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .FullTimestamps}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{prettyMetadata .Metadata}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
-Created: {{prettyAgo .Created}}{{end}}{{if .Details}}
+Created: {{prettyAgo .Created}}{{end}}{{if .Compression}}
+Compression: {{.Compression}}{{end}}{{if .Details}}
 Size of HEAD on master: {{prettySize .Details.SizeBytes}}{{end}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
@@ -87,6 +93,37 @@ Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 	return nil
 }
 
+// PrintDetailedRepoStorageInfo pretty-prints the storage used by a repo.
+func PrintDetailedRepoStorageInfo(storageInfo *pfs.RepoStorageInfo) error {
+	template, err := template.New("RepoStorageInfo").Funcs(funcMap).Parse(
+		`Name: {{.Repo.Name}}
+Logical size (uncompressed): {{prettySize .LogicalBytes}}
+Stored chunk size: {{prettySize .UniqueChunkBytes}}
+Shared with other repos or commits: {{prettySize .SharedBytes}}
+Reclaimable: {{prettySize .ReclaimableBytes}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, storageInfo)
+}
+
+// PrintGarbageCollectResponse pretty-prints the outcome of a garbage collection pass.
+func PrintGarbageCollectResponse(resp *pfs.GarbageCollectResponse) error {
+	template, err := template.New("GarbageCollectResponse").Funcs(funcMap).Parse(
+		`{{if .DryRun}}Dry run, nothing was deleted. A pass would delete:
+{{end}}Tracked objects: {{.TrackerObjects}}
+Chunk objects: {{.ChunkObjects}}
+Chunk size: {{prettySize .ChunkBytes}}{{if .OldestChunk}}
+Oldest chunk created: {{prettyAgo .OldestChunk}}{{end}}
+Duration: {{prettyDuration .Duration}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, resp)
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -107,6 +144,23 @@ func printTrigger(trigger *pfs.Trigger) string {
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
+func printProtection(protection *pfs.BranchProtection) string {
+	var rules []string
+	if protection.DenyDirectCommits {
+		rules = append(rules, "deny direct commits")
+	}
+	if protection.DenyRewind {
+		rules = append(rules, "deny rewind")
+	}
+	if protection.RequiredPipeline != "" {
+		rules = append(rules, fmt.Sprintf("require pipeline %s", protection.RequiredPipeline))
+	}
+	if protection.PromoteFrom != "" {
+		rules = append(rules, fmt.Sprintf("promote from %s", protection.PromoteFrom))
+	}
+	return strings.Join(rules, ", ")
+}
+
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branchInfo.Branch.Name)
@@ -129,7 +183,8 @@ func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Branch.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .Protection}}
+Protection: {{printProtection .Protection}} {{end}}
 `)
 	if err != nil {
 		return err
@@ -141,6 +196,48 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return nil
 }
 
+// PrintTagInfo pretty-prints a Tag.
+func PrintTagInfo(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Tag.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", tagInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t", tagInfo.Description)
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedTagInfo pretty-prints detailed tag info.
+func PrintDetailedTagInfo(tagInfo *pfs.TagInfo) error {
+	template, err := template.New("TagInfo").Funcs(funcMap).Parse(
+		`Name: {{.Tag.Repo.Name}}@{{.Tag.Name}}
+Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
+Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
+Description: {{.Description}}{{end}}
+Created: {{prettyAgo .Created}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, tagInfo)
+}
+
+// PrintMergeConflict pretty-prints a merge conflict.
+func PrintMergeConflict(w io.Writer, conflict *pfs.MergeConflict) {
+	fmt.Fprintf(w, "%s\t", conflict.Path)
+	for _, fi := range []*pfs.FileInfo{conflict.Ours, conflict.Theirs} {
+		if fi == nil {
+			fmt.Fprintf(w, "deleted\t")
+		} else {
+			fmt.Fprintf(w, "%s\t", units.BytesSize(float64(fi.SizeBytes)))
+		}
+	}
+	fmt.Fprintf(w, "%s\t", strings.ToLower(conflict.Resolution.String()))
+	fmt.Fprintln(w)
+}
+
 // PrintCommitInfo pretty-prints commit info.
 func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Branch.Repo)
@@ -237,7 +334,8 @@ func PrintDetailedCommitInfo(w io.Writer, commitInfo *PrintableCommitInfo) error
 	template, err := template.New("CommitInfo").Funcs(funcMap).Parse(
 		`Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
 Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .ParentCommit}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{prettyMetadata .Metadata}}{{end}}{{if .ParentCommit}}
 Parent: {{.ParentCommit.ID}}{{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
@@ -309,10 +407,13 @@ func fileType(fileType pfs.FileType) string {
 }
 
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
+	"prettyAgo":       pretty.Ago,
+	"prettyDuration":  pretty.Duration,
+	"prettySize":      pretty.Size,
+	"prettyMetadata":  pretty.Metadata,
+	"fileType":        fileType,
+	"printTrigger":    printTrigger,
+	"printProtection": printProtection,
 }
 
 // CompactPrintCommit renders 'c' as a compact string, e.g.
@@ -3,10 +3,13 @@ package s3
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	glob "github.com/pachyderm/ohmyglob"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
@@ -258,12 +261,223 @@ func (c *controller) DeleteBucket(r *http.Request, bucketName string) error {
 }
 
 func (c *controller) ListObjectVersions(r *http.Request, bucketName, prefix, keyMarker, versionIDMarker string, delimiter string, maxKeys int) (*s2.ListObjectVersionsResult, error) {
-	// NOTE: because this endpoint isn't implemented, conformance tests will
-	// fail on teardown. It's nevertheless unimplemented because it's too
-	// expensive to pull off with PFS until this is implemented:
-	// https://github.com/pachyderm/pachyderm/v2/issues/3896
 	c.logger.Debugf("ListObjectVersions: bucketName=%+v, prefix=%+v, keyMarker=%+v, versionIDMarker=%+v, delimiter=%+v, maxKeys=%+v", bucketName, prefix, keyMarker, versionIDMarker, delimiter, maxKeys)
-	return nil, s2.NotImplementedError(r)
+
+	// Strip / from prefix to normalize: "/" means "all objects" and "/foo"
+	// means the same as "foo"
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	pc, err := c.requestClient(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if delimiter != "" && delimiter != "/" {
+		return nil, invalidDelimiterError(r)
+	}
+
+	bucket, err := c.driver.bucket(pc, r, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	bucketCaps, err := c.driver.bucketCapabilities(pc, r, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s2.ListObjectVersionsResult{
+		Versions:      []*s2.Version{},
+		DeleteMarkers: []*s2.DeleteMarker{},
+	}
+
+	if !bucketCaps.readable {
+		// serve empty results if we can't read the bucket; this helps with s3
+		// conformance
+		return &result, nil
+	}
+	if !bucketCaps.historicVersions {
+		return nil, s2.NotImplementedError(r)
+	}
+
+	history, err := c.objectHistory(pc, bucket.Commit, prefix, delimiter == "")
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(history))
+	for key := range history {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Find where to resume. Without a version marker, listing starts at the
+	// first key after the key marker. With one, it starts at the version
+	// after the marker within the key marker's history.
+	start := sort.SearchStrings(keys, keyMarker)
+	var skipVersions int
+	if start < len(keys) && keys[start] == keyMarker {
+		if versionIDMarker == "" {
+			start++
+		} else {
+			versions := history[keyMarker]
+			skipVersions = -1
+			for i, v := range versions {
+				if v.id == versionIDMarker {
+					skipVersions = i + 1
+					break
+				}
+			}
+			if skipVersions < 0 {
+				return nil, s2.InvalidArgumentError(r)
+			}
+		}
+	} else if versionIDMarker != "" {
+		return nil, s2.InvalidArgumentError(r)
+	}
+
+	count := 0
+	for _, key := range keys[start:] {
+		versions := history[key]
+		if key == keyMarker {
+			versions = versions[skipVersions:]
+		}
+		for _, v := range versions {
+			if count >= maxKeys {
+				if maxKeys > 0 {
+					result.IsTruncated = true
+				}
+				return &result, nil
+			}
+			if v.deleteMarker {
+				result.DeleteMarkers = append(result.DeleteMarkers, &s2.DeleteMarker{
+					Key:          key,
+					Version:      v.id,
+					IsLatest:     v.isLatest,
+					LastModified: v.lastModified,
+					Owner:        defaultUser,
+				})
+			} else {
+				result.Versions = append(result.Versions, &s2.Version{
+					Key:          key,
+					Version:      v.id,
+					IsLatest:     v.isLatest,
+					LastModified: v.lastModified,
+					ETag:         v.etag,
+					Size:         v.size,
+					StorageClass: globalStorageClass,
+					Owner:        defaultUser,
+				})
+			}
+			count++
+		}
+	}
+
+	return &result, nil
+}
+
+// objectVersion is a single entry in the history of an object, as reported
+// by ListObjectVersions. Version IDs are the IDs of the commits in which an
+// object was written or deleted, so they can be passed back to GetObject.
+type objectVersion struct {
+	id           string
+	isLatest     bool
+	deleteMarker bool
+	lastModified time.Time
+	etag         string
+	size         uint64
+}
+
+// objectHistory walks the ancestry of `commit` from oldest to newest and
+// returns, for each key under `prefix`, the distinct versions of that key
+// ordered from newest to oldest. A new version is recorded whenever a file's
+// hash changes between two commits, and a delete marker is recorded whenever
+// a file disappears. If `recursive` is false, only keys directly under
+// `prefix` are considered.
+func (c *controller) objectHistory(pc *client.APIClient, commit *pfsClient.Commit, prefix string, recursive bool) (map[string][]*objectVersion, error) {
+	var pattern string
+	if recursive {
+		pattern = fmt.Sprintf("%s**", glob.QuoteMeta(prefix))
+	} else {
+		pattern = fmt.Sprintf("%s*", glob.QuoteMeta(prefix))
+	}
+
+	history := make(map[string][]*objectVersion)
+	// live maps each key that exists as of the previous commit to the hash
+	// of its contents
+	live := make(map[string]string)
+	// ListCommit walks the ancestry of a resolved commit ID, and can't be
+	// reversed while it does, so collect the ancestry newest first and walk it
+	// backwards
+	head, err := pc.PfsAPIClient.InspectCommit(pc.Ctx(), &pfsClient.InspectCommitRequest{Commit: commit})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	var commitInfos []*pfsClient.CommitInfo
+	if err := pc.ListCommitF(commit.Branch.Repo, head.Commit, nil, 0, false, func(ci *pfsClient.CommitInfo) error {
+		commitInfos = append(commitInfos, ci)
+		return nil
+	}); err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	for i := len(commitInfos) - 1; i >= 0; i-- {
+		ci := commitInfos[i]
+		if ci.Finished == nil {
+			// the contents of open commits are not yet settled, so they
+			// don't have versions
+			continue
+		}
+		finished, err := types.TimestampFromProto(ci.Finished)
+		if err != nil {
+			return nil, err
+		}
+		current := make(map[string]string)
+		if err := pc.GlobFile(ci.Commit, pattern, func(fileInfo *pfsClient.FileInfo) error {
+			if fileInfo.FileType != pfsClient.FileType_FILE {
+				return nil
+			}
+			key := fileInfo.File.Path[1:] // strip leading slash
+			if !strings.HasPrefix(key, prefix) {
+				return nil
+			}
+			etag := fmt.Sprintf("%x", fileInfo.Hash)
+			current[key] = etag
+			if prev, ok := live[key]; ok && prev == etag {
+				return nil
+			}
+			t, err := types.TimestampFromProto(fileInfo.Committed)
+			if err != nil {
+				return err
+			}
+			history[key] = append(history[key], &objectVersion{
+				id:           ci.Commit.ID,
+				lastModified: t,
+				etag:         etag,
+				size:         uint64(fileInfo.SizeBytes),
+			})
+			return nil
+		}); err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		for key := range live {
+			if _, ok := current[key]; !ok {
+				history[key] = append(history[key], &objectVersion{
+					id:           ci.Commit.ID,
+					deleteMarker: true,
+					lastModified: finished,
+				})
+			}
+		}
+		live = current
+	}
+
+	for _, versions := range history {
+		// reverse so that the newest version comes first
+		for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+			versions[i], versions[j] = versions[j], versions[i]
+		}
+		versions[0].isLatest = true
+	}
+	return history, nil
 }
 
 func (c *controller) GetBucketVersioning(r *http.Request, bucketName string) (string, error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/s2"
@@ -86,7 +87,8 @@ func (d *MasterDriver) bucket(pc *client.APIClient, r *http.Request, name string
 	var repo *pfs.Repo
 
 	// the name is [commitID.][branch. | branch.type.]repoName
-	// in particular, to access a non-user system repo, the branch name must be given
+	// in particular, to access a non-user system repo, the branch name must be given.
+	// A tag may be given in place of the branch to read the tagged commit.
 	parts := strings.SplitN(name, ".", 4)
 	if uuid.IsUUIDWithoutDashes(parts[0]) {
 		id = parts[0]
@@ -111,7 +113,16 @@ func (d *MasterDriver) bucket(pc *client.APIClient, r *http.Request, name string
 func (d *MasterDriver) bucketCapabilities(pc *client.APIClient, r *http.Request, bucket *Bucket) (bucketCapabilities, error) {
 	_, err := pc.PfsAPIClient.InspectBranch(pc.Ctx(), &pfs.InspectBranchRequest{Branch: bucket.Commit.Branch})
 	if err != nil {
-		return bucketCapabilities{}, maybeNotFoundError(r, grpcutil.ScrubGRPC(err))
+		err = grpcutil.ScrubGRPC(err)
+		if pfsServer.IsBranchNotFoundErr(err) && bucket.Commit.ID == "" {
+			// The bucket may name a tag rather than a branch, in which case it
+			// is read-only, since tags can't move.
+			tag := bucket.Commit.Branch.Repo.NewTag(bucket.Commit.Branch.Name)
+			if _, tagErr := pc.PfsAPIClient.InspectTag(pc.Ctx(), &pfs.InspectTagRequest{Tag: tag}); tagErr == nil {
+				return bucketCapabilities{readable: true}, nil
+			}
+		}
+		return bucketCapabilities{}, maybeNotFoundError(r, err)
 	}
 
 	return bucketCapabilities{
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -446,6 +447,71 @@ func masterListObjectsRecursive(t *testing.T, pachClient *client.APIClient, mini
 	checkListObjects(t, ch, &startTime, &endTime, expectedFiles, []string{})
 }
 
+func masterListObjectVersions(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
+	repo := tu.UniqueString("testlistobjectversions")
+	require.NoError(t, pachClient.CreateRepo(repo))
+	commit := client.NewCommit(repo, "master", "")
+	bucket := fmt.Sprintf("master.%s", repo)
+
+	require.NoError(t, pachClient.PutFile(commit, "a", strings.NewReader("a1")))
+	require.NoError(t, pachClient.PutFile(commit, "dir/b", strings.NewReader("b1")))
+	ci, err := pachClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	v1 := ci.Commit.ID
+
+	// dir/b is unchanged, so it shouldn't get a new version
+	require.NoError(t, pachClient.PutFile(commit, "a", strings.NewReader("a2")))
+	ci, err = pachClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	v2 := ci.Commit.ID
+
+	require.NoError(t, pachClient.DeleteFile(commit, "dir/b"))
+	ci, err = pachClient.InspectCommit(repo, "master", "")
+	require.NoError(t, err)
+	v3 := ci.Commit.ID
+
+	result := listObjectVersions(t, minioClient, bucket, url.Values{})
+	require.False(t, result.IsTruncated)
+	require.Equal(t, []listedVersion{
+		{Key: "a", Version: v2, IsLatest: true},
+		{Key: "a", Version: v1},
+		{Key: "dir/b", Version: v1},
+	}, result.Versions)
+	require.Equal(t, []listedVersion{
+		{Key: "dir/b", Version: v3, IsLatest: true},
+	}, result.DeleteMarkers)
+
+	// old versions remain readable
+	require.Equal(t, "a1", getObjectVersion(t, minioClient, bucket, "a", v1))
+	require.Equal(t, "a2", getObjectVersion(t, minioClient, bucket, "a", v2))
+
+	// prefix
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"prefix": []string{"dir/"}})
+	require.Equal(t, []listedVersion{{Key: "dir/b", Version: v1}}, result.Versions)
+	require.Equal(t, 1, len(result.DeleteMarkers))
+
+	// delimiter only lists keys directly under the prefix
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"delimiter": []string{"/"}})
+	require.Equal(t, 2, len(result.Versions))
+	require.Equal(t, 0, len(result.DeleteMarkers))
+
+	// pagination
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"max-keys": []string{"1"}})
+	require.True(t, result.IsTruncated)
+	require.Equal(t, []listedVersion{{Key: "a", Version: v2, IsLatest: true}}, result.Versions)
+	result = listObjectVersions(t, minioClient, bucket, url.Values{
+		"max-keys":          []string{"1"},
+		"key-marker":        []string{"a"},
+		"version-id-marker": []string{v2},
+	})
+	require.True(t, result.IsTruncated)
+	require.Equal(t, []listedVersion{{Key: "a", Version: v1}}, result.Versions)
+	result = listObjectVersions(t, minioClient, bucket, url.Values{"key-marker": []string{"a"}})
+	require.False(t, result.IsTruncated)
+	require.Equal(t, []listedVersion{{Key: "dir/b", Version: v1}}, result.Versions)
+	require.Equal(t, 1, len(result.DeleteMarkers))
+}
+
 func masterListSystemRepoBuckets(t *testing.T, pachClient *client.APIClient, minioClient *minio.Client) {
 	repo := tu.UniqueString("listsystemrepo")
 	require.NoError(t, pachClient.CreateRepo(repo))
@@ -599,6 +665,9 @@ func TestMasterDriver(t *testing.T) {
 		t.Run("ListObjectsRecursive", func(t *testing.T) {
 			masterListObjectsRecursive(t, pachClient, minioClient)
 		})
+		t.Run("ListObjectVersions", func(t *testing.T) {
+			masterListObjectVersions(t, pachClient, minioClient)
+		})
 		t.Run("ListSystemRepoBucket", func(t *testing.T) {
 			masterListSystemRepoBuckets(t, pachClient, minioClient)
 		})
@@ -36,15 +36,15 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, s2.NoSuchKeyError(r)
 	}
 
-	commitID := bucket.Commit.ID
+	commit := bucket.Commit
 	if version != "" {
 		if !bucketCaps.historicVersions {
 			return nil, s2.NotImplementedError(r)
 		}
-		commitID = version
+		commit = bucket.Commit.Branch.NewCommit(version)
 	}
 
-	fileInfo, err := pc.InspectFile(bucket.Commit, file)
+	fileInfo, err := pc.InspectFile(commit, file)
 	if err != nil {
 		return nil, maybeNotFoundError(r, err)
 	}
@@ -54,7 +54,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, err
 	}
 
-	content, err := pc.GetFileReadSeeker(bucket.Commit, file)
+	content, err := pc.GetFileReadSeeker(commit, file)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		ModTime:      modTime,
 		Content:      content,
 		ETag:         fmt.Sprintf("%x", fileInfo.Hash),
-		Version:      commitID,
+		Version:      commit.ID,
 		DeleteMarker: false,
 	}
 
@@ -185,6 +185,9 @@ func (c *controller) DeleteObject(r *http.Request, bucketName, file, version str
 	if strings.HasSuffix(file, "/") {
 		return nil, invalidFilePathError(r)
 	}
+	// Versions are commits, which can't be rewritten, so a specific version
+	// of an object can't be deleted. Only unversioned deletes, which remove
+	// the object from the head of the branch, are supported.
 	if version != "" {
 		return nil, s2.NotImplementedError(r)
 	}
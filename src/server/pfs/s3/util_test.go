@@ -3,10 +3,13 @@ package s3
 import (
 	"context"
 	"crypto/md5"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -34,6 +37,55 @@ func getObject(t *testing.T, minioClient *minio.Client, bucket, file string) (st
 	return string(bytes), err
 }
 
+type listedVersion struct {
+	Key      string `xml:"Key"`
+	Version  string `xml:"VersionId"`
+	IsLatest bool   `xml:"IsLatest"`
+}
+
+type listObjectVersionsResult struct {
+	IsTruncated   bool            `xml:"IsTruncated"`
+	Versions      []listedVersion `xml:"Version"`
+	DeleteMarkers []listedVersion `xml:"DeleteMarker"`
+}
+
+// listObjectVersions issues a raw ListObjectVersions request, since the
+// minio client doesn't support it
+func listObjectVersions(t *testing.T, minioClient *minio.Client, bucket string, params url.Values) *listObjectVersionsResult {
+	t.Helper()
+
+	u := *minioClient.EndpointURL()
+	u.Path = "/" + bucket
+	params.Set("versions", "")
+	u.RawQuery = params.Encode()
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	result := &listObjectVersionsResult{}
+	require.NoError(t, xml.NewDecoder(resp.Body).Decode(result))
+	return result
+}
+
+// getObjectVersion issues a raw GetObject request for a specific version of
+// an object
+func getObjectVersion(t *testing.T, minioClient *minio.Client, bucket, file, version string) string {
+	t.Helper()
+
+	u := *minioClient.EndpointURL()
+	u.Path = fmt.Sprintf("/%s/%s", bucket, file)
+	u.RawQuery = url.Values{"versionId": []string{version}}.Encode()
+	resp, err := http.Get(u.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(bytes)
+}
+
 func checkListObjects(t *testing.T, ch <-chan minio.ObjectInfo, startTime *time.Time, endTime *time.Time, expectedFiles []string, expectedDirs []string) {
 	t.Helper()
 
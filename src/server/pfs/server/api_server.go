@@ -22,7 +22,9 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
@@ -35,6 +37,7 @@ import (
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 
 	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
 )
 
 // apiServer implements the public interface of the Pachyderm File System,
@@ -92,7 +95,7 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txncontext.TransactionContex
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Metadata, request.Compression, request.Update)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -138,6 +141,13 @@ func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoReq
 	return repoInfo, nil
 }
 
+// InspectRepoStorage implements the protobuf pfs.InspectRepoStorage RPC
+func (a *apiServer) InspectRepoStorage(ctx context.Context, request *pfs.InspectRepoStorageRequest) (response *pfs.RepoStorageInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectRepoStorage(ctx, request.Repo, request.From, request.To)
+}
+
 // ListRepo implements the protobuf pfs.ListRepo RPC
 func (a *apiServer) ListRepo(request *pfs.ListRepoRequest, srv pfs.API_ListRepoServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -166,7 +176,10 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
-	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description)
+	if err := validateMetadata(request.Metadata); err != nil {
+		return nil, err
+	}
+	return a.driver.startCommit(txnCtx, request.Parent, request.Branch, request.Description, request.Metadata)
 }
 
 // StartCommit implements the protobuf pfs.StartCommit RPC
@@ -187,8 +200,11 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 // FinishCommitInTransaction is identical to FinishCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) FinishCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.FinishCommitRequest) error {
+	if err := validateMetadata(request.Metadata); err != nil {
+		return err
+	}
 	return metrics.ReportRequest(func() error {
-		return a.driver.finishCommit(txnCtx, request.Commit, request.Description, request.Error, request.Force)
+		return a.driver.finishCommit(txnCtx, request.Commit, request.Description, request.Metadata, request.Error, request.Force)
 	})
 }
 
@@ -225,7 +241,11 @@ func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.AP
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, func(ci *pfs.CommitInfo) error {
+	filter, err := pfsdb.NewCommitFilter(request)
+	if err != nil {
+		return err
+	}
+	return a.driver.listCommit(respServer.Context(), request.Repo, request.To, request.From, request.Number, request.Reverse, request.All, request.OriginKind, filter, func(ci *pfs.CommitInfo) error {
 		sent++
 		return respServer.Send(ci)
 	})
@@ -261,7 +281,7 @@ func (a *apiServer) ListCommitSet(request *pfs.ListCommitSetRequest, serv pfs.AP
 // SquashCommitSetInTransaction is identical to SquashCommitSet except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) SquashCommitSetInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.SquashCommitSetRequest) error {
-	return a.driver.squashCommitSet(txnCtx, request.CommitSet)
+	return a.driver.squashCommitSet(txnCtx, request.CommitSet, request.Force)
 }
 
 // SquashCommitSet implements the protobuf pfs.SquashCommitSet RPC
@@ -281,7 +301,7 @@ func (a *apiServer) DropCommitSet(ctx context.Context, request *pfs.DropCommitSe
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.driver.dropCommitSet(txnCtx, request.CommitSet)
+		return a.driver.dropCommitSet(txnCtx, request.CommitSet, request.Force)
 	}); err != nil {
 		return nil, err
 	}
@@ -295,6 +315,13 @@ func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream
 	return a.driver.subscribeCommit(stream.Context(), request.Repo, request.Branch, request.From, request.State, request.All, request.OriginKind, stream.Send)
 }
 
+// RevertCommit implements the protobuf pfs.RevertCommit RPC
+func (a *apiServer) RevertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (response *pfs.RevertCommitResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.revertCommit(ctx, request)
+}
+
 // ClearCommit deletes all data in the commit.
 func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitRequest) (_ *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -388,6 +415,73 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.mergeBranch(ctx, request)
+}
+
+// SetBranchProtection implements the protobuf pfs.SetBranchProtection RPC
+func (a *apiServer) SetBranchProtection(ctx context.Context, request *pfs.SetBranchProtectionRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.setBranchProtection(txnCtx, request.Branch, request.Protection)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.createTag(txnCtx, request.Tag, request.Commit, request.Description)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (response *pfs.TagInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	tagInfo := &pfs.TagInfo{}
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		tagInfo, err = a.driver.inspectTag(txnCtx, request.Tag)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(request *pfs.ListTagRequest, srv pfs.API_ListTagServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	return a.txnEnv.WithReadContext(srv.Context(), func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.listTag(txnCtx, request.Repo, srv.Send)
+	})
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		return a.driver.deleteTag(txnCtx, request.Tag)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	commit, err := readCommit(server)
 	if err != nil {
@@ -398,7 +492,7 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	return metrics.ReportRequestWithThroughput(func() (int64, error) {
 		var bytesRead int64
 		if err := a.driver.modifyFile(server.Context(), commit, func(uw *fileset.UnorderedWriter) error {
-			n, err := a.modifyFile(server.Context(), uw, server)
+			n, err := a.modifyFile(server.Context(), uw, server, commit)
 			if err != nil {
 				return err
 			}
@@ -415,10 +509,43 @@ type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
 
+// prefixedModifyFileSource returns first, which has already been received,
+// before the rest of source.
+type prefixedModifyFileSource struct {
+	first  *pfs.ModifyFileRequest
+	source modifyFileSource
+}
+
+func (s *prefixedModifyFileSource) Recv() (*pfs.ModifyFileRequest, error) {
+	if s.first != nil {
+		msg := s.first
+		s.first = nil
+		return msg, nil
+	}
+	return s.source.Recv()
+}
+
 // modifyFile reads from a modifyFileSource until io.EOF and writes changes to an UnorderedWriter.
 // SetCommit messages will result in an error.
-func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource) (int64, error) {
+// Split files are numbered after the existing split files in commit, if commit is not nil.
+func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter, server modifyFileSource, commit *pfs.Commit) (_ int64, retErr error) {
 	var bytesRead int64
+	var splitter *fileSplitter
+	splitIndexes := make(map[splitKey]int64)
+	closeSplitter := func() error {
+		if splitter == nil {
+			return nil
+		}
+		next, err := splitter.Close()
+		splitIndexes[newSplitKey(splitter.addFile)] = next
+		splitter = nil
+		return err
+	}
+	defer func() {
+		if err := closeSplitter(); retErr == nil {
+			retErr = err
+		}
+	}()
 	for {
 		msg, err := server.Recv()
 		if err != nil {
@@ -427,12 +554,43 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			}
 			return bytesRead, err
 		}
+		if mod, ok := msg.Body.(*pfs.ModifyFileRequest_AddFile); ok && splitter != nil && splitter.matches(mod.AddFile) {
+			n, err := splitter.Write(mod.AddFile.GetRaw().GetValue())
+			bytesRead += int64(n)
+			if err != nil {
+				return bytesRead, err
+			}
+			continue
+		}
+		if err := closeSplitter(); err != nil {
+			return bytesRead, err
+		}
 		switch mod := msg.Body.(type) {
 		case *pfs.ModifyFileRequest_AddFile:
 			var err error
 			var n int64
 			p := mod.AddFile.Path
 			t := mod.AddFile.Datum
+			if mod.AddFile.Delimiter != pfs.Delimiter_NONE {
+				key := newSplitKey(mod.AddFile)
+				index, ok := splitIndexes[key]
+				if !ok && commit != nil {
+					index, err = a.driver.nextSplitIndex(ctx, client.NewFile(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID, p), t)
+					if err != nil {
+						return bytesRead, err
+					}
+				}
+				splitter, err = newFileSplitter(uw, mod.AddFile, index)
+				if err != nil {
+					return bytesRead, err
+				}
+				n, err := splitter.Write(mod.AddFile.GetRaw().GetValue())
+				bytesRead += int64(n)
+				if err != nil {
+					return bytesRead, err
+				}
+				continue
+			}
 			switch src := mod.AddFile.Source.(type) {
 			case *pfs.AddFile_Raw:
 				n, err = putFileRaw(uw, p, t, src.Raw)
@@ -450,6 +608,7 @@ func (a *apiServer) modifyFile(ctx context.Context, uw *fileset.UnorderedWriter,
 			if err := deleteFile(uw, mod.DeleteFile); err != nil {
 				return bytesRead, err
 			}
+			resetSplitIndexes(splitIndexes, mod.DeleteFile)
 		case *pfs.ModifyFileRequest_CopyFile:
 			cf := mod.CopyFile
 			if err := func() (retErr error) {
@@ -539,6 +698,9 @@ func (a *apiServer) GetFileTAR(request *pfs.GetFileRequest, server pfs.API_GetFi
 			return 0, err
 		}
 		if request.URL != "" {
+			if request.Since != nil {
+				return a.getFileURLSince(ctx, request, src)
+			}
 			return getFileURL(ctx, request.URL, src)
 		}
 		var bytesWritten int64
@@ -564,6 +726,9 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 			return 0, err
 		}
 		if request.URL != "" {
+			if request.Since != nil {
+				return a.getFileURLSince(ctx, request, src)
+			}
 			return getFileURL(ctx, request.URL, src)
 		}
 		if err := checkSingleFile(ctx, src); err != nil {
@@ -610,6 +775,104 @@ func getFileURL(ctx context.Context, URL string, src Source) (int64, error) {
 	return bytesWritten, err
 }
 
+// getFileURLSince writes the files in src that differ from the same paths in
+// request.Since to request.URL, and deletes the files that were removed if
+// request.DeleteRemoved is set.
+func (a *apiServer) getFileURLSince(ctx context.Context, request *pfs.GetFileRequest, src Source) (int64, error) {
+	parsedURL, err := obj.ParseURL(request.URL)
+	if err != nil {
+		return 0, err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(parsedURL, false)
+	if err != nil {
+		return 0, err
+	}
+	old, err := a.driver.getFile(ctx, &pfs.File{
+		Commit: request.Since,
+		Path:   request.File.Path,
+		Datum:  request.File.Datum,
+	})
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+	oldInfos := make(chan *pfs.FileInfo)
+	eg.Go(func() error {
+		defer close(oldInfos)
+		return ignoreFileNotFound(old.Iterate(ctx, func(fi *pfs.FileInfo, _ fileset.File) error {
+			if fi.FileType != pfs.FileType_FILE {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case oldInfos <- fi:
+				return nil
+			}
+		}))
+	})
+	var bytesWritten int64
+	eg.Go(func() error {
+		removed := func(fi *pfs.FileInfo) error {
+			if !request.DeleteRemoved {
+				return nil
+			}
+			// The file may have already been removed from the destination.
+			if err := objClient.Delete(ctx, filepath.Join(parsedURL.Object, fi.File.Path)); err != nil && !pacherr.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+		oldFi, oldOpen := <-oldInfos
+		if err := ignoreFileNotFound(src.Iterate(ctx, func(fi *pfs.FileInfo, file fileset.File) error {
+			if fi.FileType != pfs.FileType_FILE {
+				return nil
+			}
+			for oldOpen && oldFi.File.Path < fi.File.Path {
+				if err := removed(oldFi); err != nil {
+					return err
+				}
+				oldFi, oldOpen = <-oldInfos
+			}
+			if oldOpen && oldFi.File.Path == fi.File.Path {
+				unchanged := equalFileInfos(oldFi, fi)
+				oldFi, oldOpen = <-oldInfos
+				if unchanged {
+					return nil
+				}
+			}
+			if err := miscutil.WithPipe(func(w io.Writer) error {
+				return file.Content(ctx, w)
+			}, func(r io.Reader) error {
+				return objClient.Put(ctx, filepath.Join(parsedURL.Object, fi.File.Path), r)
+			}); err != nil {
+				return err
+			}
+			bytesWritten += fi.SizeBytes
+			return nil
+		})); err != nil {
+			return err
+		}
+		for ; oldOpen; oldFi, oldOpen = <-oldInfos {
+			if err := removed(oldFi); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return bytesWritten, eg.Wait()
+}
+
+// ignoreFileNotFound treats a path that matches no files as empty.
+func ignoreFileNotFound(err error) error {
+	if pfsserver.IsFileNotFoundErr(err) {
+		return nil
+	}
+	return err
+}
+
 func withGetFileWriter(w io.Writer, cb func(io.Writer) error) (int64, error) {
 	gfw := &getFileWriter{w: w}
 	err := cb(gfw)
@@ -732,14 +995,42 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	return nil
 }
 
+// GarbageCollect implements the protobuf pfs.GarbageCollect RPC
+func (a *apiServer) GarbageCollect(ctx context.Context, request *pfs.GarbageCollectRequest) (response *pfs.GarbageCollectResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.garbageCollect(ctx, request.DryRun)
+}
+
 // CreateFileSet implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileSet(server pfs.API_CreateFileSetServer) (retErr error) {
 	func() { a.Log(nil, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
+	// A leading SetCommit message selects the repo whose storage settings
+	// apply to the file set; the commit itself is not modified.
+	var source modifyFileSource = server
+	var opts []fileset.UnorderedWriterOption
+	msg, err := server.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if msg != nil {
+		if x, ok := msg.Body.(*pfs.ModifyFileRequest_SetCommit); ok {
+			opts, err = a.driver.repoWriterOptions(server.Context(), x.SetCommit.Branch.Repo)
+			if err != nil {
+				return err
+			}
+		} else {
+			source = &prefixedModifyFileSource{first: msg, source: server}
+		}
+	}
 	fsID, err := a.driver.createFileSet(server.Context(), func(uw *fileset.UnorderedWriter) error {
-		_, err := a.modifyFile(server.Context(), uw, server)
+		if msg == nil {
+			return nil
+		}
+		_, err := a.modifyFile(server.Context(), uw, source, nil)
 		return err
-	})
+	}, opts...)
 	if err != nil {
 		return err
 	}
@@ -763,11 +1054,26 @@ func (a *apiServer) GetFileSet(ctx context.Context, req *pfs.GetFileSetRequest)
 func (a *apiServer) AddFileSet(ctx context.Context, req *pfs.AddFileSetRequest) (_ *types.Empty, retErr error) {
 	func() { a.Log(req, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(req, nil, retErr, time.Since(start)) }(time.Now())
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.AddFileSetInTransaction(txnCtx, req)
-	}); err != nil {
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.AddFileSet(req)
+	}, nil); err != nil {
 		return nil, err
 	}
+	// The request has been checked and appended to the active transaction (if
+	// any), which is the only reference to the file set until it finishes.
+	activeTxn, err := client.GetTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if activeTxn != nil {
+		fsid, err := fileset.ParseID(req.FileSetId)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := a.driver.storage.SetTTL(ctx, *fsid, txnFileSetTTL); err != nil {
+			return nil, err
+		}
+	}
 	return &types.Empty{}, nil
 }
 
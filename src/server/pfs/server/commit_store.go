@@ -3,12 +3,14 @@ package server
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -37,6 +39,9 @@ type commitStore interface {
 	DropFileSets(ctx context.Context, commit *pfs.Commit) error
 	// DropFileSetsTx is identical to DropFileSets except it runs in the provided transaction.
 	DropFileSetsTx(tx *sqlx.Tx, commit *pfs.Commit) error
+	// ChunkUsage returns the size of the distinct chunks referenced by the commits, and the size of those
+	// which are also referenced from outside of the commits. If commits is empty, every commit in repo is used.
+	ChunkUsage(ctx context.Context, repo *pfs.Repo, commits []*pfs.Commit) (total, shared int64, _ error)
 }
 
 var _ commitStore = &postgresCommitStore{}
@@ -221,6 +226,42 @@ func setTotal(tx *sqlx.Tx, tr track.Tracker, commit *pfs.Commit, id fileset.ID)
 	return err
 }
 
+func (cs *postgresCommitStore) ChunkUsage(ctx context.Context, repo *pfs.Repo, commits []*pfs.Commit) (int64, int64, error) {
+	prefixes := []string{commitTrackerPrefix + pfsdb.RepoKey(repo) + "@"}
+	if len(commits) > 0 {
+		prefixes = prefixes[:0]
+		for _, commit := range commits {
+			prefixes = append(prefixes, commitTrackerPrefix+pfsdb.CommitKey(commit)+"/")
+		}
+	}
+	var all, shared []chunk.ID
+	if err := cs.tr.IterateReachable(ctx, prefixes, func(id string, isShared bool) error {
+		if !strings.HasPrefix(id, chunk.TrackerPrefix) {
+			return nil
+		}
+		chunkID, err := chunk.ParseTrackerID(id)
+		if err != nil {
+			return err
+		}
+		all = append(all, chunkID)
+		if isShared {
+			shared = append(shared, chunkID)
+		}
+		return nil
+	}); err != nil {
+		return 0, 0, errors.EnsureStack(err)
+	}
+	totalSize, err := cs.s.ChunkStorage().SizeOf(ctx, all)
+	if err != nil {
+		return 0, 0, err
+	}
+	sharedSize, err := cs.s.ChunkStorage().SizeOf(ctx, shared)
+	if err != nil {
+		return 0, 0, err
+	}
+	return totalSize, sharedSize, nil
+}
+
 func commitDiffTrackerID(commit *pfs.Commit, fs fileset.ID) string {
 	return commitTrackerPrefix + pfsdb.CommitKey(commit) + "/diff/" + fs.HexString()
 }
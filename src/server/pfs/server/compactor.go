@@ -11,6 +11,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
@@ -45,6 +46,12 @@ func newCompactor(ctx context.Context, storage *fileset.Storage, etcdClient *etc
 }
 
 func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
+	return c.compactWithCompression(ctx, ids, ttl, pfs.Compression_DEFAULT_COMPRESSION)
+}
+
+// compactWithCompression is like Compact, but the chunks written during the
+// compaction use the specified repo compression.
+func (c *compactor) compactWithCompression(ctx context.Context, ids []fileset.ID, ttl time.Duration, compression pfs.Compression) (*fileset.ID, error) {
 	return c.storage.CompactLevelBased(ctx, ids, defaultTTL, func(ctx context.Context, ids []fileset.ID, ttl time.Duration) (*fileset.ID, error) {
 		var id *fileset.ID
 		if err := c.compactionQueue.RunTaskBlock(ctx, func(master *work.Master) error {
@@ -62,6 +69,7 @@ func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Dura
 							Lower: task.PathRange.Lower,
 							Upper: task.PathRange.Upper,
 						},
+						Compression: compression,
 					})
 					if err != nil {
 						return nil, err
@@ -118,7 +126,11 @@ func (c *compactor) compactionWorker(ctx context.Context) error {
 				Lower: task.Range.Lower,
 				Upper: task.Range.Upper,
 			}
-			id, err := c.storage.Compact(ctx, ids, defaultTTL, index.WithRange(pathRange))
+			var writerOpts []fileset.WriterOption
+			if algo, ok := chunkCompression(task.Compression); ok {
+				writerOpts = append(writerOpts, fileset.WithCompression(algo))
+			}
+			id, err := c.storage.CompactWithWriterOptions(ctx, ids, defaultTTL, writerOpts, index.WithRange(pathRange))
 			if err != nil {
 				return nil, err
 			}
@@ -48,6 +48,9 @@ const (
 	fileSetsRepo         = client.FileSetsRepoName
 	defaultTTL           = client.DefaultTTL
 	maxTTL               = 30 * time.Minute
+	// txnFileSetTTL is how long a file set added to an open transaction is
+	// kept, since nothing else references it until the transaction finishes.
+	txnFileSetTTL = 24 * time.Hour
 )
 
 // IsPermissionError returns true if a given error is a permission error.
@@ -78,6 +81,7 @@ type driver struct {
 	repos    col.PostgresCollection
 	commits  col.PostgresCollection
 	branches col.PostgresCollection
+	tags     col.PostgresCollection
 
 	storage     *fileset.Storage
 	commitStore commitStore
@@ -102,6 +106,7 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	repos := pfsdb.Repos(env.GetDBClient(), env.GetPostgresListener())
 	commits := pfsdb.Commits(env.GetDBClient(), env.GetPostgresListener())
 	branches := pfsdb.Branches(env.GetDBClient(), env.GetPostgresListener())
+	tags := pfsdb.Tags(env.GetDBClient(), env.GetPostgresListener())
 
 	// Setup driver struct.
 	d := &driver{
@@ -112,6 +117,7 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 		repos:      repos,
 		commits:    commits,
 		branches:   branches,
+		tags:       tags,
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -140,11 +146,14 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	return d, nil
 }
 
-func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, description string, metadata map[string]string, compression pfs.Compression, update bool) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	if err := validateMetadata(metadata); err != nil {
+		return err
+	}
 
 	// Check that the user is logged in (user doesn't need any access level to
 	// create a repo, but they must be authenticated if auth is active)
@@ -179,7 +188,17 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			}
 		}
 
-		if existingRepoInfo.Description == description {
+		// An update that doesn't specify a compression algorithm leaves the
+		// existing one in place.
+		if compression == pfs.Compression_DEFAULT_COMPRESSION {
+			compression = existingRepoInfo.Compression
+		}
+		// Likewise for metadata.
+		if len(metadata) == 0 {
+			metadata = existingRepoInfo.Metadata
+		}
+		if existingRepoInfo.Description == description && existingRepoInfo.Compression == compression &&
+			metadataEqual(existingRepoInfo.Metadata, metadata) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the spec
 			// repo to make sure it exists.
@@ -193,9 +212,11 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 		// they don't actually change anything, even if the caller doesn't have
 		// WRITER access, we make the pattern more generally useful.
 		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_WRITE); err != nil {
-			return errors.Wrapf(err, "could not update description of %q", repo)
+			return errors.Wrapf(err, "could not update %q", repo)
 		}
 		existingRepoInfo.Description = description
+		existingRepoInfo.Compression = compression
+		existingRepoInfo.Metadata = metadata
 		return repos.Put(repo, &existingRepoInfo)
 	} else {
 		// if this is a system repo, make sure the corresponding user repo already exists
@@ -228,6 +249,8 @@ func (d *driver) createRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 			Repo:        repo,
 			Created:     txnCtx.Timestamp,
 			Description: description,
+			Compression: compression,
+			Metadata:    metadata,
 		})
 	}
 }
@@ -316,6 +339,16 @@ func (d *driver) deleteAllBranchesFromRepos(txnCtx *txncontext.TransactionContex
 		// branch is provenant on another (which is likely the case when
 		// multiple repos are provided) we delete them in the right order.
 		branch := branchInfos[len(branchInfos)-1-i].Branch
+		// Deleting a repo removes its branches regardless of their protection.
+		if branchInfos[len(branchInfos)-1-i].Protection != nil {
+			bi := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Update(branch, bi, func() error {
+				bi.Protection = nil
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "error removing protection from branch %s", branch)
+			}
+		}
 		if err := d.deleteBranch(txnCtx, branch, force); err != nil {
 			return errors.Wrapf(err, "delete branch %s", branch)
 		}
@@ -408,6 +441,9 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return err
 	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return err
+	}
 	if err := repos.Delete(repo); err != nil && !col.IsErrNotFound(err) {
 		return errors.Wrapf(err, "repos.Delete")
 	}
@@ -431,6 +467,7 @@ func (d *driver) startCommit(
 	parent *pfs.Commit,
 	branch *pfs.Branch,
 	description string,
+	metadata map[string]string,
 ) (*pfs.Commit, error) {
 	// Validate arguments:
 	if branch == nil || branch.Name == "" {
@@ -451,6 +488,7 @@ func (d *driver) startCommit(
 		Origin:      &pfs.CommitOrigin{Kind: pfs.OriginKind_USER},
 		Description: description,
 		Started:     txnCtx.Timestamp,
+		Metadata:    metadata,
 	}
 	if err := ancestry.ValidateName(branch.Name); err != nil {
 		return nil, err
@@ -470,6 +508,9 @@ func (d *driver) startCommit(
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(branch, branchInfo, func() error {
 		if branchInfo.Branch == nil {
+			if err := d.checkTagName(txnCtx, branch); err != nil {
+				return err
+			}
 			// New branch, update the RepoInfo
 			add(&repoInfo.Branches, branch)
 			if err := d.repos.ReadWrite(txnCtx.SqlTx).Put(repoInfo.Repo, repoInfo); err != nil {
@@ -477,6 +518,9 @@ func (d *driver) startCommit(
 			}
 			branchInfo.Branch = branch
 		}
+		if err := checkProtectedCommit(txnCtx, branchInfo); err != nil {
+			return err
+		}
 		// If the parent is unspecified, use the current head of the branch
 		if parent == nil {
 			parent = branchInfo.Head
@@ -548,7 +592,7 @@ func (d *driver) startCommit(
 	return newCommit, nil
 }
 
-func (d *driver) finishCommit(txnCtx *txncontext.TransactionContext, commit *pfs.Commit, description, commitError string, force bool) error {
+func (d *driver) finishCommit(txnCtx *txncontext.TransactionContext, commit *pfs.Commit, description string, metadata map[string]string, commitError string, force bool) error {
 	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
 	if err != nil {
 		return err
@@ -574,6 +618,12 @@ func (d *driver) finishCommit(txnCtx *txncontext.TransactionContext, commit *pfs
 	if description != "" {
 		commitInfo.Description = description
 	}
+	if len(metadata) > 0 && commitInfo.Metadata == nil {
+		commitInfo.Metadata = make(map[string]string)
+	}
+	for k, v := range metadata {
+		commitInfo.Metadata[k] = v
+	}
 	commitInfo.Finishing = txnCtx.Timestamp
 	commitInfo.Error = commitError
 	return d.commits.ReadWrite(txnCtx.SqlTx).Put(commitInfo.Commit, commitInfo)
@@ -711,6 +761,49 @@ func (d *driver) repoSize(ctx context.Context, repo *pfs.Repo) (int64, error) {
 	return 0, nil
 }
 
+// inspectRepoStorage reports the chunk storage pinned by a repo, or by the
+// commits that listCommit returns for from and to if either is set.
+func (d *driver) inspectRepoStorage(ctx context.Context, repo *pfs.Repo, from, to *pfs.Commit) (*pfs.RepoStorageInfo, error) {
+	if repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo, auth.Permission_REPO_READ); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	info := &pfs.RepoStorageInfo{Repo: repo}
+	var commits []*pfs.Commit
+	if from == nil && to == nil {
+		size, err := d.repoSize(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		info.LogicalBytes = size
+	} else {
+		// Alias commits are included since they reference the same filesets as
+		// their parents, but only count toward the logical size once.
+		if err := d.listCommit(ctx, repo, to, from, 0, false, true, pfs.OriginKind_ORIGIN_KIND_UNKNOWN, nil, func(ci *pfs.CommitInfo) error {
+			commits = append(commits, ci.Commit)
+			if ci.Details != nil && ci.Origin.Kind != pfs.OriginKind_ALIAS {
+				info.LogicalBytes += ci.Details.SizeBytes
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if len(commits) == 0 {
+			return info, nil
+		}
+	}
+	total, shared, err := d.commitStore.ChunkUsage(ctx, repo, commits)
+	if err != nil {
+		return nil, err
+	}
+	info.UniqueChunkBytes = total
+	info.SharedBytes = shared
+	info.ReclaimableBytes = total - shared
+	return info, nil
+}
+
 // propagateBranches selectively starts commits in or downstream of 'branches'
 // in order to restore the invariant that branch provenance matches HEAD commit
 // provenance:
@@ -971,12 +1064,24 @@ func (d *driver) resolveCommit(sqlTx *sqlx.Tx, userCommit *pfs.Commit) (*pfs.Com
 	}
 
 	if commit.ID == "" {
-		// If commit.ID is unspecified, get it from the branch head
+		// If commit.ID is unspecified, get it from the branch head, or from the
+		// tag with the same name if there is no such branch
 		branchInfo := &pfs.BranchInfo{}
 		if err := d.branches.ReadWrite(sqlTx).Get(commit.Branch, branchInfo); err != nil {
-			return nil, err
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+			tagInfo := &pfs.TagInfo{}
+			if tagErr := d.tags.ReadWrite(sqlTx).Get(commit.Branch.Repo.NewTag(commit.Branch.Name), tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, err
+				}
+				return nil, tagErr
+			}
+			commit = proto.Clone(tagInfo.Commit).(*pfs.Commit)
+		} else {
+			commit.ID = branchInfo.Head.ID
 		}
-		commit.ID = branchInfo.Head.ID
 	} else if commit.Branch.Name == "" {
 		// If the branch is unspecified, make sure the ID is unique (a repo may have
 		// one commit on each branch with the same ID) and load the branch name.
@@ -1076,6 +1181,37 @@ func passesCommitOriginFilter(commitInfo *pfs.CommitInfo, all bool, originKind p
 	return commitInfo.Origin.Kind != pfs.OriginKind_ALIAS
 }
 
+// reservedMetadataPrefix is the prefix of metadata keys that are set by PFS
+// itself (e.g. to record the parents of a merge commit), and so can't be set
+// by users.
+const reservedMetadataPrefix = "pfs."
+
+// validateMetadata checks that user-provided metadata has no empty or
+// reserved keys.
+func validateMetadata(metadata map[string]string) error {
+	for k := range metadata {
+		if k == "" {
+			return errors.Errorf("metadata keys cannot be empty")
+		}
+		if strings.HasPrefix(k, reservedMetadataPrefix) {
+			return errors.Errorf("metadata key %q is reserved: keys starting with %q are set by pachyderm", k, reservedMetadataPrefix)
+		}
+	}
+	return nil
+}
+
+func metadataEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if actual, ok := b[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *driver) listCommit(
 	ctx context.Context,
 	repo *pfs.Repo,
@@ -1085,6 +1221,7 @@ func (d *driver) listCommit(
 	reverse bool,
 	all bool,
 	originKind pfs.OriginKind,
+	filter *pfsdb.CommitFilter,
 	cb func(*pfs.CommitInfo) error,
 ) error {
 	// Validate arguments
@@ -1168,7 +1305,7 @@ func (d *driver) listCommit(
 				}
 				lastRev = createRev
 			}
-			if passesCommitOriginFilter(ci, all, originKind) {
+			if passesCommitOriginFilter(ci, all, originKind) && filter.Matches(ci) {
 				cis = append(cis, proto.Clone(ci).(*pfs.CommitInfo))
 			}
 			return nil
@@ -1205,7 +1342,7 @@ func (d *driver) listCommit(
 			if err := d.commits.ReadOnly(ctx).Get(cursor, commitInfo); err != nil {
 				return err
 			}
-			if passesCommitOriginFilter(commitInfo, all, originKind) {
+			if passesCommitOriginFilter(commitInfo, all, originKind) && filter.Matches(commitInfo) {
 				if err := cb(commitInfo); err != nil {
 					if errors.Is(err, errutil.ErrBreak) {
 						return nil
@@ -1347,6 +1484,14 @@ func (d *driver) listCommitSet(ctx context.Context, cb func(*pfs.CommitSetInfo)
 func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo) error {
 	deleted := make(map[string]*pfs.CommitInfo) // deleted commits
 
+	// Check every branch before anything is deleted, since the checks read
+	// the commits being removed
+	for _, commitInfo := range commitInfos {
+		if err := d.checkProtectedSquash(txnCtx, commitInfo); err != nil {
+			return err
+		}
+	}
+
 	// 1) Delete each commit in the CommitSet
 	affectedBranches := []*pfs.Branch{}
 	for _, commitInfo := range commitInfos {
@@ -1493,7 +1638,7 @@ func (d *driver) squashCommitSetInternal(txnCtx *txncontext.TransactionContext,
 
 // dropCommitSet is only implemented for commits with no children, so if any
 // commits in the commitSet have children the operation will fail.
-func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet) error {
+func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet, force bool) error {
 	// Look up the commits in the CommitSet
 	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitset)
 	if err != nil {
@@ -1505,6 +1650,9 @@ func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset
 			return &pfsserver.ErrDropWithChildren{Commit: ci.Commit}
 		}
 	}
+	if err := d.checkTaggedCommits(txnCtx, commitInfos, force); err != nil {
+		return err
+	}
 
 	// While this is a 'drop' operation and not a 'squash', proper drop semantics
 	// aren't implemented at the moment.  Squashing the head of a branch is
@@ -1521,7 +1669,7 @@ func (d *driver) dropCommitSet(txnCtx *txncontext.TransactionContext, commitset
 	return nil
 }
 
-func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet) error {
+func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitset *pfs.CommitSet, force bool) error {
 	// Look up the commits in the CommitSet
 	commitInfos, err := d.inspectCommitSetImmediate(txnCtx, commitset)
 	if err != nil {
@@ -1536,6 +1684,9 @@ func (d *driver) squashCommitSet(txnCtx *txncontext.TransactionContext, commitse
 			return &pfsserver.ErrSquashWithoutChildren{Commit: ci.Commit}
 		}
 	}
+	if err := d.checkTaggedCommits(txnCtx, commitInfos, force); err != nil {
+		return err
+	}
 
 	if err := d.squashCommitSetInternal(txnCtx, commitInfos); err != nil {
 		return err
@@ -1649,6 +1800,13 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	// Retrieve (and create, if necessary) the current version of this branch
 	branchInfo := &pfs.BranchInfo{}
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).Upsert(branch, branchInfo, func() error {
+		if branchInfo.Branch == nil {
+			if err := d.checkTagName(txnCtx, branch); err != nil {
+				return err
+			}
+		} else if err := checkProtectedBranchUpdate(branchInfo, provenance, trigger); err != nil {
+			return err
+		}
 		branchInfo.Branch = branch
 		branchInfo.DirectProvenance = nil
 		for _, provBranch := range provenance {
@@ -1687,6 +1845,9 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 			// We can reuse the existing commit only if it is already on this branch
 			branchInfo.Head = commit
 		} else if branchInfo.Head == nil || branchInfo.Head.ID != commit.ID {
+			if err := d.checkProtectedHeadMove(txnCtx, branchInfo, ci); err != nil {
+				return err
+			}
 			// Create an alias of the head commit onto this branch - this will move the
 			// head of the branch and update the repo size if necessary
 			aliasCommitInfo, err := d.aliasCommit(txnCtx, commit, branch)
@@ -1905,6 +2066,9 @@ func (d *driver) deleteBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	}
 
 	if branchInfo.Branch != nil {
+		if err := checkProtectedDelete(txnCtx, branchInfo); err != nil {
+			return err
+		}
 		if !force {
 			if len(branchInfo.Subvenance) > 0 {
 				return errors.Errorf("branch %s has %v as subvenance, deleting it would break those branches", branch.Name, branchInfo.Subvenance)
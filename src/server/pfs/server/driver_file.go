@@ -8,9 +8,11 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -29,12 +31,16 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			branch.Name = commitID
 			commitID = ""
 		}
+		opts, err := d.repoWriterOptions(ctx, branch.Repo)
+		if err != nil {
+			return err
+		}
 		commitInfo, err := d.inspectCommit(ctx, commit, pfs.CommitState_STARTED)
 		if err != nil {
 			if !errutil.IsNotFoundError(err) || branch.Name == "" {
 				return err
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb)
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, opts...)
 		}
 		if commitInfo.Finishing != nil {
 			// The commit is already finished - if the commit was explicitly specified,
@@ -42,7 +48,7 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 			if commitID != "" {
 				return pfsserver.ErrCommitFinished{Commit: commitInfo.Commit}
 			}
-			return d.oneOffModifyFile(ctx, renewer, branch, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+			opts = append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 				parentID, err := d.getFileSet(ctx, commitInfo.Commit)
 				if err != nil {
 					return nil, err
@@ -50,31 +56,73 @@ func (d *driver) modifyFile(ctx context.Context, commit *pfs.Commit, cb func(*fi
 				renewer.Add(*parentID)
 				return parentID, nil
 			}))
+			return d.oneOffModifyFile(ctx, renewer, branch, cb, opts...)
 		}
-		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb)
+		return d.withCommitUnorderedWriter(ctx, renewer, commitInfo.Commit, cb, opts...)
 	})
 }
 
+// repoWriterOptions returns the unordered writer options that apply the
+// repo's storage policy to the data written to it.
+func (d *driver) repoWriterOptions(ctx context.Context, repo *pfs.Repo) ([]fileset.UnorderedWriterOption, error) {
+	compression, err := d.repoCompression(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	algo, ok := chunkCompression(compression)
+	if !ok {
+		return nil, nil
+	}
+	return []fileset.UnorderedWriterOption{fileset.WithChunkCompression(algo)}, nil
+}
+
+func (d *driver) repoCompression(ctx context.Context, repo *pfs.Repo) (pfs.Compression, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfs.Compression_DEFAULT_COMPRESSION, pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return pfs.Compression_DEFAULT_COMPRESSION, err
+	}
+	return repoInfo.Compression, nil
+}
+
+// chunkCompression returns the chunk compression algorithm for a repo's
+// compression setting, or false if the storage's default should be used.
+func chunkCompression(compression pfs.Compression) (chunk.CompressionAlgo, bool) {
+	switch compression {
+	case pfs.Compression_NO_COMPRESSION:
+		return chunk.CompressionAlgo_NONE, true
+	case pfs.Compression_GZIP:
+		return chunk.CompressionAlgo_GZIP_BEST_SPEED, true
+	case pfs.Compression_ZSTD:
+		return chunk.CompressionAlgo_ZSTD, true
+	case pfs.Compression_LZ4:
+		return chunk.CompressionAlgo_LZ4, true
+	}
+	return chunk.CompressionAlgo_NONE, false
+}
+
 func (d *driver) oneOffModifyFile(ctx context.Context, renewer *fileset.Renewer, branch *pfs.Branch, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
 	id, err := d.withUnorderedWriter(ctx, renewer, false, cb, opts...)
 	if err != nil {
 		return err
 	}
 	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		commit, err := d.startCommit(txnCtx, nil, branch, "")
+		commit, err := d.startCommit(txnCtx, nil, branch, "", nil)
 		if err != nil {
 			return err
 		}
 		if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, *id); err != nil {
 			return err
 		}
-		return d.finishCommit(txnCtx, commit, "", "", false)
+		return d.finishCommit(txnCtx, commit, "", nil, "", false)
 	})
 }
 
 // withCommitWriter calls cb with an unordered writer. All data written to cb is added to the commit, or an error is returned.
-func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
-	id, err := d.withUnorderedWriter(ctx, renewer, false, cb, fileset.WithParentID(func() (*fileset.ID, error) {
+func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset.Renewer, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
+	opts = append(opts, fileset.WithParentID(func() (*fileset.ID, error) {
 		parentID, err := d.getFileSet(ctx, commit)
 		if err != nil {
 			return nil, err
@@ -82,6 +130,7 @@ func (d *driver) withCommitUnorderedWriter(ctx context.Context, renewer *fileset
 		renewer.Add(*parentID)
 		return parentID, nil
 	}))
+	id, err := d.withUnorderedWriter(ctx, renewer, false, cb, opts...)
 	if err != nil {
 		return err
 	}
@@ -394,11 +443,11 @@ func (d *driver) diffFile(ctx context.Context, oldFile, newFile *pfs.File, cb fu
 }
 
 // createFileSet creates a new temporary fileset and returns it.
-func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error) (*fileset.ID, error) {
+func (d *driver) createFileSet(ctx context.Context, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) (*fileset.ID, error) {
 	var id *fileset.ID
 	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
 		var err error
-		id, err = d.withUnorderedWriter(ctx, renewer, false, cb)
+		id, err = d.withUnorderedWriter(ctx, renewer, false, cb, opts...)
 		return err
 	}); err != nil {
 		return nil, err
@@ -422,6 +471,9 @@ func (d *driver) addFileSet(txnCtx *txncontext.TransactionContext, commit *pfs.C
 	if err != nil {
 		return err
 	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, commitInfo.Commit.Branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return err
+	}
 	// TODO: This check needs to be in the add transaction.
 	if commitInfo.Finishing != nil {
 		return pfsserver.ErrCommitFinished{Commit: commitInfo.Commit}
@@ -488,3 +540,23 @@ func newFileNotFound(commitID string, path string) pacherr.ErrNotExist {
 		ID:         path,
 	}
 }
+
+// nextSplitIndex returns the index after the last file split under file, or
+// zero if nothing has been split under it.
+func (d *driver) nextSplitIndex(ctx context.Context, file *pfs.File, datum string) (int64, error) {
+	file = proto.Clone(file).(*pfs.File)
+	file.Datum = datum
+	var next int64
+	if err := d.listFile(ctx, file, func(fi *pfs.FileInfo) error {
+		if index, ok := parseSplitIndex(fi.File.Path); ok && index >= next {
+			next = index + 1
+		}
+		return nil
+	}); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return next, nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const (
+	gcLockPath = "pfs-gc-lock"
+	// trackerGCPeriod and chunkGCPeriod are how often the pfs master runs
+	// tracker and chunk garbage collection in the background.
+	trackerGCPeriod = 10 * time.Second
+	chunkGCPeriod   = time.Minute
+)
+
+// withGCLock calls cb while holding the lock that serializes passes of
+// garbage collection across pachds, so that an on-demand pass doesn't run
+// concurrently with the background passes of the pfs master.
+func (d *driver) withGCLock(ctx context.Context, cb func(context.Context) error) error {
+	gcLock := dlock.NewDLock(d.etcdClient, path.Join(d.prefix, gcLockPath))
+	lockCtx, err := gcLock.Lock(ctx)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer gcLock.Unlock(lockCtx)
+	return cb(lockCtx)
+}
+
+// runGC calls pass with the GC lock held every period, until ctx is
+// cancelled.
+func (d *driver) runGC(ctx context.Context, name string, period time.Duration, pass func(context.Context) error) error {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		if err := d.withGCLock(ctx, pass); err != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			log.Errorf("during %s GC: %v", name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// garbageCollect runs a single pass of tracker garbage collection
+// followed by chunk garbage collection, which is what the pfs master does
+// in the background. If dryRun is set, it reports what the pass would delete
+// instead.
+func (d *driver) garbageCollect(ctx context.Context, dryRun bool) (*pfs.GarbageCollectResponse, error) {
+	var resp *pfs.GarbageCollectResponse
+	if err := d.withGCLock(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = d.garbageCollectPass(ctx, dryRun)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (d *driver) garbageCollectPass(ctx context.Context, dryRun bool) (*pfs.GarbageCollectResponse, error) {
+	start := time.Now()
+	resp := &pfs.GarbageCollectResponse{DryRun: dryRun}
+	trackerGC := d.storage.NewGC()
+	chunkGC := chunk.NewGC(d.storage.ChunkStorage())
+	var stats *chunk.GCStats
+	if dryRun {
+		// The chunks deleted from the tracker are deleted from object storage
+		// in the same pass.
+		var pending []chunk.ID
+		if err := trackerGC.DryRun(ctx, func(id string) error {
+			resp.TrackerObjects++
+			if !strings.HasPrefix(id, chunk.TrackerPrefix) {
+				return nil
+			}
+			chunkID, err := chunk.ParseTrackerID(id)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, chunkID)
+			return nil
+		}); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		var err error
+		if stats, err = chunkGC.DryRun(ctx, pending); err != nil {
+			return nil, err
+		}
+	} else {
+		n, err := trackerGC.RunUntilEmpty(ctx)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		resp.TrackerObjects = int64(n)
+		if stats, err = chunkGC.RunOnce(ctx); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+	}
+	resp.ChunkObjects = stats.Objects
+	resp.ChunkBytes = stats.SizeBytes
+	if !stats.Oldest.IsZero() {
+		oldest, err := types.TimestampProto(stats.Oldest)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		resp.OldestChunk = oldest
+	}
+	resp.Duration = types.DurationProto(time.Since(start))
+	return resp, nil
+}
@@ -9,7 +9,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
-	_ "github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
@@ -39,11 +39,18 @@ func (d *driver) master(ctx context.Context) {
 		defer masterLock.Unlock(masterCtx)
 		eg, ctx := errgroup.WithContext(masterCtx)
 		eg.Go(func() error {
-			return d.storage.GC(ctx)
+			gc := d.storage.NewGC()
+			return d.runGC(ctx, "tracker", trackerGCPeriod, func(ctx context.Context) error {
+				_, err := gc.RunUntilEmpty(ctx)
+				return errors.EnsureStack(err)
+			})
 		})
 		eg.Go(func() error {
 			gc := chunk.NewGC(d.storage.ChunkStorage())
-			return gc.RunForever(ctx)
+			return d.runGC(ctx, "chunk", chunkGCPeriod, func(ctx context.Context) error {
+				_, err := gc.RunOnce(ctx)
+				return err
+			})
 		})
 		eg.Go(func() error {
 			return d.finishCommits(ctx)
@@ -77,8 +84,15 @@ func (d *driver) finishCommits(ctx context.Context) error {
 				}
 				return err
 			}
+			compression, err := d.repoCompression(ctx, commit.Branch.Repo)
+			if err != nil {
+				if pfsserver.IsRepoNotFoundErr(err) {
+					return nil
+				}
+				return err
+			}
 			// Compact the commit.
-			totalId, err := d.compactor.Compact(ctx, []fileset.ID{*id}, defaultTTL)
+			totalId, err := d.compactor.compactWithCompression(ctx, []fileset.ID{*id}, defaultTTL, compression)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,372 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"golang.org/x/net/context"
+)
+
+const (
+	// mergedBranchKey and mergedCommitKey are the metadata keys of a merge
+	// commit that record the source commit it merged. That commit is treated
+	// as a second parent of the merge commit when finding a merge base.
+	mergedBranchKey = "pfs.merged_branch"
+	mergedCommitKey = "pfs.merged_commit"
+)
+
+// mergeBranch applies the changes made on the source branch since its merge
+// base with the target branch to the target branch, in a new commit. Paths
+// changed differently on both branches are conflicts, and are resolved
+// according to the request. If any conflict is left unresolved, no commit is
+// made and the conflicts are returned.
+func (d *driver) mergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	source, target := request.Source, request.Target
+	if source == nil || source.Repo == nil || target == nil || target.Repo == nil {
+		return nil, errors.New("source and target branches must be specified")
+	}
+	if !proto.Equal(source.Repo, target.Repo) {
+		return nil, errors.Errorf("cannot merge %s into %s, branches must be in the same repo", source, target)
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge %s into itself", source)
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, target.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, err
+	}
+	resolutions := make(map[string]pfs.MergeResolution)
+	for p, resolution := range request.Resolutions {
+		resolutions[cleanPath(p)] = resolution
+	}
+
+	var oursInfo, theirsInfo *pfs.CommitInfo
+	var base *pfs.Commit
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		if oursInfo, err = d.resolveCommit(txnCtx.SqlTx, target.NewCommit("")); err != nil {
+			return err
+		}
+		if theirsInfo, err = d.resolveCommit(txnCtx.SqlTx, source.NewCommit("")); err != nil {
+			return err
+		}
+		base, err = d.mergeBase(txnCtx, oursInfo, theirsInfo)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	for _, ci := range []*pfs.CommitInfo{oursInfo, theirsInfo} {
+		if ci.Finishing == nil {
+			return nil, errors.Errorf("cannot merge while commit %s is open", ci.Commit)
+		}
+	}
+	description := request.Description
+	if description == "" {
+		description = fmt.Sprintf("merge %s into %s", source.Name, target.Name)
+	}
+	metadata := map[string]string{
+		mergedBranchKey: source.Name,
+		mergedCommitKey: theirsInfo.Commit.ID,
+	}
+	commit, conflicts, err := d.threeWayMerge(ctx, target, base, oursInfo, theirsInfo, func(p string) pfs.MergeResolution {
+		if r, ok := resolutions[p]; ok {
+			return r
+		}
+		return request.Resolution
+	}, description, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.MergeBranchResponse{Commit: commit, Conflicts: conflicts}, nil
+}
+
+// revertCommit makes a new commit on a branch that undoes the changes made by
+// a commit relative to its parent. Paths that were changed again after the
+// commit are conflicts, and are resolved according to the request.
+func (d *driver) revertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (*pfs.RevertCommitResponse, error) {
+	if request.Commit == nil {
+		return nil, errors.New("commit must be specified")
+	}
+	branch := request.Branch
+	var commitInfo, parentInfo, headInfo *pfs.CommitInfo
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		if commitInfo, err = d.resolveCommit(txnCtx.SqlTx, request.Commit); err != nil {
+			return err
+		}
+		if branch == nil {
+			branch = commitInfo.Commit.Branch
+		}
+		if !proto.Equal(branch.Repo, commitInfo.Commit.Branch.Repo) {
+			return errors.Errorf("cannot revert %s on %s, branch must be in the same repo", commitInfo.Commit, branch)
+		}
+		if headInfo, err = d.resolveCommit(txnCtx.SqlTx, branch.NewCommit("")); err != nil {
+			return err
+		}
+		if commitInfo.ParentCommit != nil {
+			parentInfo, err = d.resolveCommit(txnCtx.SqlTx, commitInfo.ParentCommit)
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, branch.Repo, auth.Permission_REPO_WRITE); err != nil {
+		return nil, err
+	}
+	for _, ci := range []*pfs.CommitInfo{commitInfo, headInfo} {
+		if ci.Finishing == nil {
+			return nil, errors.Errorf("cannot revert while commit %s is open", ci.Commit)
+		}
+	}
+	description := request.Description
+	if description == "" {
+		description = fmt.Sprintf("revert %s", commitInfo.Commit.ID)
+	}
+	commit, conflicts, err := d.threeWayMerge(ctx, branch, commitInfo.Commit, headInfo, parentInfo, func(string) pfs.MergeResolution {
+		return request.Resolution
+	}, description, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.RevertCommitResponse{Commit: commit, Conflicts: conflicts}, nil
+}
+
+// threeWayMerge applies the changes between base and theirs to ours, which
+// must be the head of target, in a new commit on target with metadata.
+// resolve is called to resolve paths changed differently by ours and theirs.
+// If any conflict is left unresolved, no commit is made. If there is nothing
+// to apply, a commit is only made to record resolved conflicts in metadata,
+// so that they aren't reported again. A nil base or theirs is treated as
+// empty.
+func (d *driver) threeWayMerge(ctx context.Context, target *pfs.Branch, base *pfs.Commit, ours, theirs *pfs.CommitInfo, resolve func(string) pfs.MergeResolution, description string, metadata map[string]string) (*pfs.Commit, []*pfs.MergeConflict, error) {
+	ourChanges, err := d.mergeChanges(ctx, base, ours.Commit)
+	if err != nil {
+		return nil, nil, err
+	}
+	var theirCommit *pfs.Commit
+	if theirs != nil {
+		theirCommit = theirs.Commit
+	}
+	theirChanges, err := d.mergeChanges(ctx, base, theirCommit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conflicts []*pfs.MergeConflict
+	take := make(map[string]bool)
+	var remove []string
+	for p, theirFi := range theirChanges {
+		resolution := pfs.MergeResolution_THEIRS
+		if ourFi, ok := ourChanges[p]; ok {
+			if sameChange(ourFi, theirFi) {
+				continue
+			}
+			resolution = resolve(p)
+			conflicts = append(conflicts, &pfs.MergeConflict{
+				Path:       p,
+				Ours:       ourFi,
+				Theirs:     theirFi,
+				Resolution: resolution,
+			})
+		}
+		if resolution != pfs.MergeResolution_THEIRS {
+			continue
+		}
+		if theirFi == nil {
+			remove = append(remove, p)
+		} else {
+			take[p] = true
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Path < conflicts[j].Path })
+	for _, conflict := range conflicts {
+		if conflict.Resolution == pfs.MergeResolution_UNRESOLVED {
+			return nil, conflicts, nil
+		}
+	}
+	if len(take) == 0 && len(remove) == 0 && (len(conflicts) == 0 || metadata == nil) {
+		return nil, conflicts, nil
+	}
+
+	opts, err := d.repoWriterOptions(ctx, target.Repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	var commit *pfs.Commit
+	if err := d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *fileset.Renewer) error {
+		id, err := d.withUnorderedWriter(ctx, renewer, false, func(uw *fileset.UnorderedWriter) error {
+			for _, p := range remove {
+				if err := uw.Delete(p, ""); err != nil {
+					return err
+				}
+			}
+			if len(take) == 0 {
+				return nil
+			}
+			_, fs, err := d.openCommit(ctx, theirCommit)
+			if err != nil {
+				return err
+			}
+			fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+				return take[idx.Path]
+			})
+			return uw.Copy(ctx, fs, "", false)
+		}, opts...)
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+			// The changes were computed against the target's head, so fail
+			// rather than overwrite anything committed to it in the meantime.
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(target, branchInfo); err != nil {
+				return err
+			}
+			if branchInfo.Head == nil || branchInfo.Head.ID != ours.Commit.ID {
+				return errors.Errorf("branch %s moved while changes were being applied to it, try again", target)
+			}
+			commit, err = d.startCommit(txnCtx, nil, target, description, metadata)
+			if err != nil {
+				return err
+			}
+			if err := d.commitStore.AddFileSetTx(txnCtx.SqlTx, commit, *id); err != nil {
+				return err
+			}
+			return d.finishCommit(txnCtx, commit, "", nil, "", false)
+		})
+	}); err != nil {
+		return nil, nil, err
+	}
+	return commit, conflicts, nil
+}
+
+// mergeBase returns the most recent commit that is an ancestor of both ours
+// and theirs, or nil if they have no common ancestor. The source commit
+// recorded by a merge commit counts as one of its ancestors, so merging the
+// same branches again only applies what changed since the last merge.
+func (d *driver) mergeBase(txnCtx *txncontext.TransactionContext, ours, theirs *pfs.CommitInfo) (*pfs.Commit, error) {
+	ancestors := make(map[string]bool)
+	if err := d.forEachAncestor(txnCtx, theirs, func(ci *pfs.CommitInfo) (bool, error) {
+		ancestors[ci.Commit.ID] = true
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.CommitInfo
+	if err := d.forEachAncestor(txnCtx, ours, func(ci *pfs.CommitInfo) (bool, error) {
+		if !ancestors[ci.Commit.ID] {
+			return true, nil
+		}
+		// The ancestors of a common ancestor are older than it, so they
+		// don't need to be considered.
+		if base == nil || ci.Started.Compare(base.Started) > 0 {
+			base = ci
+		}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, nil
+	}
+	return base.Commit, nil
+}
+
+// forEachAncestor calls cb with commitInfo and each of its ancestors,
+// including the source commits recorded by merge commits. If cb returns false
+// for a commit, its ancestors are skipped, unless they're reachable another
+// way.
+func (d *driver) forEachAncestor(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo, cb func(*pfs.CommitInfo) (bool, error)) error {
+	visited := make(map[string]bool)
+	stack := []*pfs.CommitInfo{commitInfo}
+	for len(stack) > 0 {
+		ci := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[pfsdb.CommitKey(ci.Commit)] {
+			continue
+		}
+		visited[pfsdb.CommitKey(ci.Commit)] = true
+		more, err := cb(ci)
+		if err != nil {
+			return err
+		}
+		if !more {
+			continue
+		}
+		var parents []*pfs.Commit
+		if ci.ParentCommit != nil {
+			parents = append(parents, ci.ParentCommit)
+		}
+		if branch, ok := ci.Metadata[mergedBranchKey]; ok {
+			parents = append(parents, ci.Commit.Branch.Repo.NewBranch(branch).NewCommit(ci.Metadata[mergedCommitKey]))
+		}
+		for _, parent := range parents {
+			parentInfo := &pfs.CommitInfo{}
+			if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(parent, parentInfo); err != nil {
+				if col.IsErrNotFound(err) && parent != ci.ParentCommit {
+					// the merged commit has since been squashed or dropped
+					continue
+				}
+				return err
+			}
+			stack = append(stack, parentInfo)
+		}
+	}
+	return nil
+}
+
+// mergeChanges returns the files that differ between base and head, keyed by
+// path. Files deleted in head map to nil. A nil base or head is treated as
+// empty.
+func (d *driver) mergeChanges(ctx context.Context, base, head *pfs.Commit) (map[string]*pfs.FileInfo, error) {
+	old, err := d.mergeSource(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	new, err := d.mergeSource(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[string]*pfs.FileInfo)
+	if err := NewDiffer(old, new).Iterate(ctx, func(oldFi, newFi *pfs.FileInfo) error {
+		if newFi != nil && newFi.FileType == pfs.FileType_FILE {
+			changes[newFi.File.Path] = newFi
+		} else if oldFi != nil && oldFi.FileType == pfs.FileType_FILE {
+			changes[oldFi.File.Path] = nil
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (d *driver) mergeSource(ctx context.Context, commit *pfs.Commit) (Source, error) {
+	if commit == nil {
+		return emptySource{}, nil
+	}
+	commitInfo, fs, err := d.openCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	return NewSource(commitInfo, fs), nil
+}
+
+// sameChange returns true if both sides of a merge left a path in the same
+// state, in which case it isn't a conflict.
+func sameChange(ours, theirs *pfs.FileInfo) bool {
+	if ours == nil || theirs == nil {
+		return ours == nil && theirs == nil
+	}
+	return bytes.Equal(ours.Hash, theirs.Hash)
+}
@@ -6,6 +6,7 @@ package server
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,12 +24,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type CompactionTask struct {
-	Index                int64      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Inputs               []string   `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	Range                *PathRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Index                int64           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Inputs               []string        `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Range                *PathRange      `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	Compression          pfs.Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=pfs_v2.Compression" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *CompactionTask) Reset()         { *m = CompactionTask{} }
@@ -85,6 +87,13 @@ func (m *CompactionTask) GetRange() *PathRange {
 	return nil
 }
 
+func (m *CompactionTask) GetCompression() pfs.Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return pfs.Compression_DEFAULT_COMPRESSION
+}
+
 type CompactionTaskResult struct {
 	Index                int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -204,23 +213,25 @@ func init() {
 func init() { proto.RegisterFile("server/pfs/server/pfsserver.proto", fileDescriptor_a5a92e512e703e9c) }
 
 var fileDescriptor_a5a92e512e703e9c = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x4e, 0x2d, 0x2a,
-	0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2b, 0xd6, 0x47, 0x30, 0x21, 0x2c, 0xbd, 0x82, 0xa2, 0xfc, 0x92,
-	0x7c, 0x21, 0x4e, 0xb8, 0x80, 0x52, 0x16, 0x17, 0x9f, 0x73, 0x7e, 0x6e, 0x41, 0x62, 0x72, 0x49,
-	0x66, 0x7e, 0x5e, 0x48, 0x62, 0x71, 0xb6, 0x90, 0x08, 0x17, 0x6b, 0x66, 0x5e, 0x4a, 0x6a, 0x85,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x84, 0x23, 0x24, 0xc6, 0xc5, 0x96, 0x99, 0x57, 0x50,
-	0x5a, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe5, 0x09, 0x69, 0x71, 0xb1, 0x16,
-	0x25, 0xe6, 0xa5, 0xa7, 0x4a, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0xec, 0x0a,
-	0x48, 0x2c, 0xc9, 0x08, 0x02, 0xc9, 0x05, 0x41, 0x94, 0x28, 0xd9, 0x70, 0x89, 0xa0, 0xda, 0x15,
-	0x94, 0x5a, 0x5c, 0x9a, 0x53, 0x82, 0xc3, 0x46, 0x3e, 0x2e, 0xa6, 0xcc, 0x14, 0x09, 0x26, 0x05,
-	0x46, 0x0d, 0xce, 0x20, 0xa6, 0xcc, 0x14, 0x25, 0x73, 0x2e, 0x4e, 0xb8, 0x89, 0x20, 0x2d, 0x39,
-	0xf9, 0xe5, 0xa9, 0x45, 0x60, 0x2d, 0x9c, 0x41, 0x10, 0x0e, 0x48, 0xb4, 0xb4, 0xa0, 0x20, 0xb5,
-	0x08, 0xaa, 0x0b, 0xc2, 0x71, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0xa3, 0xcc, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x0b, 0x12, 0x93, 0x33, 0x2a, 0x53, 0x52, 0x8b, 0x90, 0x59, 0x65, 0x46, 0xfa, 0xc5, 0x45, 0xc9,
-	0xfa, 0x18, 0x81, 0x98, 0xc4, 0x06, 0x0e, 0x3b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d,
-	0xaf, 0x30, 0x7a, 0x60, 0x01, 0x00, 0x00,
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcf, 0x4a, 0xc3, 0x30,
+	0x1c, 0x26, 0x9d, 0x1b, 0x34, 0xc3, 0x1e, 0x6a, 0x91, 0xe2, 0xa1, 0xd4, 0x9e, 0x8a, 0x87, 0x16,
+	0x2a, 0xb2, 0x8b, 0x27, 0x87, 0x77, 0x09, 0x9e, 0xbc, 0x48, 0x96, 0x66, 0x6b, 0x70, 0x4d, 0x42,
+	0x92, 0x56, 0x7d, 0x1c, 0xdf, 0xc6, 0xa3, 0x8f, 0x20, 0x7d, 0x12, 0xc9, 0x32, 0xd6, 0x89, 0x78,
+	0xfb, 0xfe, 0x92, 0x8f, 0xfc, 0xe0, 0xa5, 0xa6, 0xaa, 0xa7, 0xaa, 0x94, 0x6b, 0x5d, 0x8e, 0xd0,
+	0xa1, 0x42, 0x2a, 0x61, 0x44, 0xe8, 0x1f, 0x84, 0x8b, 0x53, 0x1b, 0x93, 0x6b, 0xed, 0x9c, 0xec,
+	0x03, 0xc0, 0x60, 0x29, 0x5a, 0x89, 0x89, 0x61, 0x82, 0x3f, 0x62, 0xfd, 0x12, 0x46, 0x70, 0xca,
+	0x78, 0x4d, 0xdf, 0x62, 0x90, 0x82, 0x7c, 0x82, 0x1c, 0x09, 0xcf, 0xe1, 0x8c, 0x71, 0xd9, 0x19,
+	0x1d, 0x7b, 0xe9, 0x24, 0xf7, 0xd1, 0x9e, 0x85, 0x57, 0x70, 0xaa, 0x30, 0xdf, 0xd0, 0x78, 0x92,
+	0x82, 0x7c, 0x5e, 0x45, 0xc5, 0xf8, 0xf6, 0x03, 0x36, 0x0d, 0xb2, 0x1e, 0x72, 0x91, 0xf0, 0x06,
+	0xce, 0x89, 0x68, 0xa5, 0xa2, 0x5a, 0x33, 0xc1, 0xe3, 0x93, 0x14, 0xe4, 0x41, 0x75, 0x66, 0x1b,
+	0xcf, 0x7d, 0x55, 0x2c, 0x47, 0x0b, 0x1d, 0xe7, 0xb2, 0x5b, 0x18, 0xfd, 0x9e, 0x88, 0xa8, 0xee,
+	0xb6, 0xe6, 0x9f, 0xa1, 0x01, 0xf4, 0x58, 0x1d, 0x7b, 0x29, 0xc8, 0x7d, 0xe4, 0xb1, 0x3a, 0x5b,
+	0x40, 0xff, 0x30, 0xc4, 0x56, 0xb6, 0xe2, 0x95, 0xaa, 0x5d, 0xc5, 0x47, 0x8e, 0x58, 0xb5, 0x93,
+	0x92, 0xaa, 0x7d, 0xcb, 0x91, 0xbb, 0xfb, 0xcf, 0x21, 0x01, 0x5f, 0x43, 0x02, 0xbe, 0x87, 0x04,
+	0x3c, 0x2d, 0x36, 0xcc, 0x34, 0xdd, 0xaa, 0x20, 0xa2, 0x2d, 0x25, 0x26, 0xcd, 0x7b, 0x4d, 0xd5,
+	0x31, 0xea, 0xab, 0x52, 0x2b, 0x52, 0xfe, 0xb9, 0xc5, 0x6a, 0xb6, 0xfb, 0xe8, 0xeb, 0x9f, 0x01,
+	0x00, 0x5f, 0x14, 0x22, 0x99, 0xa7, 0x01, 0x00, 0x00,
 }
 
 func (m *CompactionTask) Marshal() (dAtA []byte, err error) {
@@ -247,6 +258,11 @@ func (m *CompactionTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Compression != 0 {
+		i = encodeVarintPfsserver(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Range != nil {
 		{
 			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
@@ -386,6 +402,9 @@ func (m *CompactionTask) Size() (n int) {
 		l = m.Range.Size()
 		n += 1 + l + sovPfsserver(uint64(l))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovPfsserver(uint64(m.Compression))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -553,6 +572,25 @@ func (m *CompactionTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfsserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= pfs.Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfsserver(dAtA[iNdEx:])
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// setBranchProtection sets (or, if protection is nil, removes) the protection
+// rules on an existing branch.
+func (d *driver) setBranchProtection(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, protection *pfs.BranchProtection) error {
+	if branch == nil || branch.Repo == nil {
+		return errors.New("branch must be specified")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Permission_REPO_MODIFY_BRANCH_PROTECTION); err != nil {
+		return err
+	}
+	if protection != nil {
+		if protection.PromoteFrom != "" {
+			if err := ancestry.ValidateName(protection.PromoteFrom); err != nil {
+				return err
+			}
+			if protection.PromoteFrom == branch.Name {
+				return errors.Errorf("branch %s cannot be promoted from itself", branch)
+			}
+		}
+		if protection.RequiredPipeline != "" {
+			if _, err := txnCtx.WhoAmI(); err != nil {
+				if auth.IsErrNotActivated(err) {
+					return errors.Errorf("requiring a pipeline to commit to %s requires auth to be active", branch)
+				}
+				return err
+			}
+		}
+		if proto.Equal(protection, &pfs.BranchProtection{}) {
+			protection = nil
+		}
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Update(branch, branchInfo, func() error {
+		branchInfo.Protection = protection
+		return nil
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrBranchNotFound{Branch: branch}
+		}
+		return err
+	}
+	return nil
+}
+
+// checkProtectedCommit returns an error if the caller may not start a commit
+// directly on the branch described by branchInfo.
+func checkProtectedCommit(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo) error {
+	protection := branchInfo.Protection
+	if protection == nil {
+		return nil
+	}
+	if protection.DenyDirectCommits {
+		return errors.Errorf("branch %s is protected against direct commits", branchInfo.Branch)
+	}
+	if protection.PromoteFrom != "" {
+		return errors.Errorf("branch %s only accepts commits promoted from %q", branchInfo.Branch, protection.PromoteFrom)
+	}
+	return checkRequiredPipeline(txnCtx, branchInfo)
+}
+
+// checkProtectedHeadMove returns an error if the caller may not move the head
+// of the branch described by branchInfo to newHead.
+func (d *driver) checkProtectedHeadMove(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo, newHead *pfs.CommitInfo) error {
+	protection := branchInfo.Protection
+	if protection == nil || branchInfo.Head == nil {
+		return nil
+	}
+	if protection.PromoteFrom != "" && newHead.Commit.Branch.Name != protection.PromoteFrom {
+		return errors.Errorf("branch %s only accepts commits promoted from %q, not %s", branchInfo.Branch, protection.PromoteFrom, newHead.Commit)
+	}
+	if err := checkRequiredPipeline(txnCtx, branchInfo); err != nil {
+		return err
+	}
+	if protection.DenyRewind {
+		descends, err := d.descendsFrom(txnCtx, newHead, branchInfo.Head)
+		if err != nil {
+			return err
+		}
+		if !descends {
+			return errors.Errorf("branch %s is protected against rewinds, and %s does not descend from its head %s", branchInfo.Branch, newHead.Commit, branchInfo.Head)
+		}
+	}
+	return nil
+}
+
+// checkProtectedSquash returns an error if the caller may not squash or drop
+// commitInfo from its branch. Dropping the head of a branch moves the head to
+// the commit's parent (or to a new empty commit), so it's checked like any
+// other head move. Squashing an older commit leaves the head where it is, but
+// still rewrites the branch's history.
+func (d *driver) checkProtectedSquash(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo) error {
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(commitInfo.Commit.Branch, branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	protection := branchInfo.Protection
+	if protection == nil {
+		return nil
+	}
+	if branchInfo.Head.ID != commitInfo.Commit.ID {
+		if protection.DenyRewind {
+			return errors.Errorf("branch %s is protected against rewinds, and removing %s would rewrite its history", branchInfo.Branch, commitInfo.Commit)
+		}
+		return checkRequiredPipeline(txnCtx, branchInfo)
+	}
+	newHead := &pfs.CommitInfo{Commit: branchInfo.Branch.NewCommit("")}
+	if commitInfo.ParentCommit != nil && proto.Equal(commitInfo.ParentCommit.Branch, commitInfo.Commit.Branch) {
+		newHead = &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(commitInfo.ParentCommit, newHead); err != nil {
+			return err
+		}
+	}
+	return d.checkProtectedHeadMove(txnCtx, branchInfo, newHead)
+}
+
+// checkProtectedBranchUpdate returns an error if the provenance or trigger of
+// the branch described by branchInfo may not be changed to the given ones.
+// Commits made through provenance aren't promoted from anywhere, and a
+// trigger may only promote from the branch that the protection names.
+func checkProtectedBranchUpdate(branchInfo *pfs.BranchInfo, provenance []*pfs.Branch, trigger *pfs.Trigger) error {
+	protection := branchInfo.Protection
+	if protection == nil || protection.PromoteFrom == "" {
+		return nil
+	}
+	for _, provBranch := range provenance {
+		if !has(&branchInfo.DirectProvenance, provBranch) {
+			return errors.Errorf("branch %s only accepts commits promoted from %q, and cannot have %s added to its provenance", branchInfo.Branch, protection.PromoteFrom, provBranch)
+		}
+	}
+	if trigger != nil && trigger.Branch != "" && trigger.Branch != protection.PromoteFrom {
+		return errors.Errorf("branch %s only accepts commits promoted from %q, and cannot be triggered by %q", branchInfo.Branch, protection.PromoteFrom, trigger.Branch)
+	}
+	return nil
+}
+
+// checkProtectedDelete returns an error if the branch described by branchInfo
+// may not be deleted.
+func checkProtectedDelete(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo) error {
+	protection := branchInfo.Protection
+	if protection == nil {
+		return nil
+	}
+	if protection.DenyRewind || protection.PromoteFrom != "" {
+		return errors.Errorf("branch %s is protected and cannot be deleted, remove its protection first", branchInfo.Branch)
+	}
+	return checkRequiredPipeline(txnCtx, branchInfo)
+}
+
+func checkRequiredPipeline(txnCtx *txncontext.TransactionContext, branchInfo *pfs.BranchInfo) error {
+	pipeline := branchInfo.Protection.RequiredPipeline
+	if pipeline == "" {
+		return nil
+	}
+	me, err := txnCtx.WhoAmI()
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return err
+	}
+	if me == nil || me.Username != auth.PipelinePrefix+pipeline {
+		return errors.Errorf("branch %s only accepts commits from pipeline %q", branchInfo.Branch, pipeline)
+	}
+	return nil
+}
+
+// descendsFrom returns true if ancestor is commitInfo or one of its ancestors.
+// Commits are compared by ID, so aliases of ancestor on other branches count.
+// An empty root commit, like the one created for a new branch, is treated as
+// an ancestor of every commit.
+func (d *driver) descendsFrom(txnCtx *txncontext.TransactionContext, commitInfo *pfs.CommitInfo, ancestor *pfs.Commit) (bool, error) {
+	ancestorInfo := &pfs.CommitInfo{}
+	if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(ancestor, ancestorInfo); err != nil {
+		return false, err
+	}
+	if ancestorInfo.ParentCommit == nil && ancestorInfo.Origin.Kind == pfs.OriginKind_AUTO {
+		return true, nil
+	}
+	for ci := commitInfo; ; {
+		if ci.Commit.ID == ancestor.ID {
+			return true, nil
+		}
+		if ci.ParentCommit == nil {
+			return false, nil
+		}
+		parent := ci.ParentCommit
+		ci = &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Get(parent, ci); err != nil {
+			return false, err
+		}
+	}
+}
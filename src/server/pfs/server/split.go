@@ -0,0 +1,297 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sql"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// splitIndexWidth is the number of hex digits in the names of split files.
+const splitIndexWidth = 16
+
+// splitKey identifies the files split under a path for a datum.
+type splitKey struct {
+	path, datum string
+}
+
+func newSplitKey(addFile *pfs.AddFile) splitKey {
+	return splitKey{path: cleanPath(addFile.Path), datum: addFile.Datum}
+}
+
+// resetSplitIndexes numbers the files split under a deleted path from zero
+// again, since the deleted files are still visible in the commit.
+func resetSplitIndexes(indexes map[splitKey]int64, deleteFile *pfs.DeleteFile) {
+	p := cleanPath(deleteFile.Path)
+	for key := range indexes {
+		if key.datum == deleteFile.Datum && (key.path == p || strings.HasPrefix(key.path, strings.TrimSuffix(p, "/")+"/")) {
+			indexes[key] = 0
+		}
+	}
+	indexes[splitKey{path: p, datum: deleteFile.Datum}] = 0
+}
+
+type fileWriter interface {
+	Put(p, datum string, appendFile bool, r io.Reader) error
+}
+
+// fileSplitter splits the data added to a path into records in the
+// background, and writes them to files under the path.
+type fileSplitter struct {
+	addFile *pfs.AddFile
+	pw      *io.PipeWriter
+	done    chan struct{}
+	next    int64
+	err     error
+}
+
+func newFileSplitter(w fileWriter, addFile *pfs.AddFile, index int64) (*fileSplitter, error) {
+	if err := validateSplit(addFile); err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	s := &fileSplitter{
+		addFile: addFile,
+		pw:      pw,
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(s.done)
+		s.next, s.err = splitFile(w, addFile, index, pr)
+		pr.CloseWithError(s.err)
+	}()
+	return s, nil
+}
+
+func validateSplit(addFile *pfs.AddFile) error {
+	if addFile.TargetFileDatums < 0 || addFile.TargetFileBytes < 0 || addFile.HeaderRecords < 0 {
+		return errors.Errorf("split targets and header records cannot be negative")
+	}
+	if addFile.HeaderRecords > 0 && addFile.Delimiter != pfs.Delimiter_CSV && addFile.Delimiter != pfs.Delimiter_SQL {
+		return errors.Errorf("header records are only supported for CSV and SQL delimiters")
+	}
+	if _, ok := addFile.Source.(*pfs.AddFile_Url); ok {
+		return errors.Errorf("cannot split data from a URL")
+	}
+	return nil
+}
+
+// matches returns true if addFile continues the data being split.
+func (s *fileSplitter) matches(addFile *pfs.AddFile) bool {
+	return addFile.Path == s.addFile.Path &&
+		addFile.Datum == s.addFile.Datum &&
+		addFile.Delimiter == s.addFile.Delimiter &&
+		addFile.TargetFileDatums == s.addFile.TargetFileDatums &&
+		addFile.TargetFileBytes == s.addFile.TargetFileBytes &&
+		addFile.HeaderRecords == s.addFile.HeaderRecords
+}
+
+func (s *fileSplitter) Write(data []byte) (int, error) {
+	n, err := s.pw.Write(data)
+	return n, errors.EnsureStack(err)
+}
+
+// Close finishes splitting, and returns the index of the next split file.
+func (s *fileSplitter) Close() (int64, error) {
+	if err := s.pw.Close(); err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	<-s.done
+	return s.next, s.err
+}
+
+// splitFile writes the records read from r to the files under
+// addFile.Path, starting at index, and returns the index of the next file.
+func splitFile(w fileWriter, addFile *pfs.AddFile, index int64, r io.Reader) (int64, error) {
+	rr := newRecordReader(addFile.Delimiter, r)
+	var headerRecords []byte
+	for i := int64(0); i < addFile.HeaderRecords; i++ {
+		record, err := rr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, err
+		}
+		headerRecords = append(headerRecords, record...)
+	}
+	start := index
+	buf := &bytes.Buffer{}
+	var records int64
+	flush := func() error {
+		if records == 0 {
+			return nil
+		}
+		header := append(append([]byte{}, rr.Header()...), headerRecords...)
+		if err := w.Put(splitFilePath(addFile.Path, index), addFile.Datum, false, io.MultiReader(bytes.NewReader(header), buf)); err != nil {
+			return err
+		}
+		index++
+		buf.Reset()
+		records = 0
+		return nil
+	}
+	for {
+		record, err := rr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, err
+		}
+		buf.Write(record)
+		records++
+		if (addFile.TargetFileDatums == 0 && addFile.TargetFileBytes == 0) ||
+			(addFile.TargetFileDatums > 0 && records >= addFile.TargetFileDatums) ||
+			(addFile.TargetFileBytes > 0 && int64(buf.Len()) >= addFile.TargetFileBytes) {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	// The footer is only known once all of the records have been read.
+	if footer := rr.Footer(); len(footer) > 0 {
+		for i := start; i < index; i++ {
+			if err := w.Put(splitFilePath(addFile.Path, i), addFile.Datum, true, bytes.NewReader(footer)); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return index, nil
+}
+
+func splitFilePath(dir string, index int64) string {
+	return path.Join(dir, fmt.Sprintf("%0*x", splitIndexWidth, index))
+}
+
+// parseSplitIndex returns the index of a split file from its path.
+func parseSplitIndex(p string) (int64, bool) {
+	name := path.Base(p)
+	if len(name) != splitIndexWidth {
+		return 0, false
+	}
+	index, err := strconv.ParseInt(name, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+type recordReader interface {
+	// Read returns the next record, or io.EOF.
+	Read() ([]byte, error)
+	// Header returns the data written to the start of every file.
+	Header() []byte
+	// Footer returns the data written to the end of every file. It is only
+	// complete once Read has returned io.EOF.
+	Footer() []byte
+}
+
+func newRecordReader(delimiter pfs.Delimiter, r io.Reader) recordReader {
+	switch delimiter {
+	case pfs.Delimiter_JSON:
+		return &jsonRecordReader{dec: json.NewDecoder(r)}
+	case pfs.Delimiter_CSV:
+		return &csvRecordReader{r: csv.NewReader(r)}
+	case pfs.Delimiter_SQL:
+		return &sqlRecordReader{r: sql.NewPGDumpReader(bufio.NewReader(r))}
+	default:
+		return &lineRecordReader{r: bufio.NewReader(r)}
+	}
+}
+
+type lineRecordReader struct {
+	r *bufio.Reader
+}
+
+func (lr *lineRecordReader) Read() ([]byte, error) {
+	line, err := lr.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			return line, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	return line, nil
+}
+
+func (lr *lineRecordReader) Header() []byte { return nil }
+
+func (lr *lineRecordReader) Footer() []byte { return nil }
+
+type jsonRecordReader struct {
+	dec *json.Decoder
+}
+
+func (jr *jsonRecordReader) Read() ([]byte, error) {
+	var record json.RawMessage
+	if err := jr.dec.Decode(&record); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrapf(err, "error parsing JSON record")
+	}
+	return record, nil
+}
+
+func (jr *jsonRecordReader) Header() []byte { return nil }
+
+func (jr *jsonRecordReader) Footer() []byte { return nil }
+
+// csvRecordReader re-encodes each record, since a record may span multiple
+// lines when a field is quoted.
+type csvRecordReader struct {
+	r *csv.Reader
+}
+
+func (cr *csvRecordReader) Read() ([]byte, error) {
+	record, err := cr.r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrapf(err, "error parsing CSV record")
+	}
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write(record); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	w.Flush()
+	return buf.Bytes(), errors.EnsureStack(w.Error())
+}
+
+func (cr *csvRecordReader) Header() []byte { return nil }
+
+func (cr *csvRecordReader) Footer() []byte { return nil }
+
+type sqlRecordReader struct {
+	r *sql.PGDumpReader
+}
+
+func (sr *sqlRecordReader) Read() ([]byte, error) {
+	row, err := sr.r.ReadRow()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return row, nil
+}
+
+func (sr *sqlRecordReader) Header() []byte { return sr.r.Header }
+
+func (sr *sqlRecordReader) Footer() []byte { return sr.r.Footer }
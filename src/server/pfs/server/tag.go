@@ -0,0 +1,162 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func (d *driver) createTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag, commit *pfs.Commit, description string) error {
+	// Validate arguments
+	if tag == nil || tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if commit.Branch == nil || commit.Branch.Repo == nil {
+		return errors.New("commit repo cannot be nil")
+	}
+	if !proto.Equal(commit.Branch.Repo, tag.Repo) {
+		return errors.Errorf("cannot tag commit %s in repo %s", commit, tag.Repo)
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_CREATE_TAG); err != nil {
+		return err
+	}
+	// Tags and branches share a namespace, since both may be used in place of
+	// a commit ID.
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(tag.Repo.NewBranch(tag.Name), &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("cannot create tag %s because a branch with the same name exists", tag)
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+	commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+	if err != nil {
+		return err
+	}
+	return d.tags.ReadWrite(txnCtx.SqlTx).Create(tag, &pfs.TagInfo{
+		Tag:         tag,
+		Commit:      commitInfo.Commit,
+		Created:     txnCtx.Timestamp,
+		Description: description,
+	})
+}
+
+func (d *driver) inspectTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	// Validate arguments
+	if tag == nil || tag.Repo == nil {
+		return nil, errors.New("tag repo cannot be nil")
+	}
+
+	// Check that the user is logged in, but don't require any access level
+	if _, err := txnCtx.WhoAmI(); err != nil {
+		if !auth.IsErrNotActivated(err) {
+			return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "error authenticating (must log in to inspect a tag)")
+		}
+	}
+
+	result := &pfs.TagInfo{}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(tag, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *driver) listTag(txnCtx *txncontext.TransactionContext, repo *pfs.Repo, cb func(*pfs.TagInfo) error) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, repo, auth.Permission_REPO_LIST_BRANCH); err != nil {
+		return err
+	}
+	// Make sure that the repo exists
+	if err := d.repos.ReadWrite(txnCtx.SqlTx).Get(repo, &pfs.RepoInfo{}); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return err
+	}
+	tagInfos, err := d.repoTags(txnCtx, repo)
+	if err != nil {
+		return err
+	}
+	sort.Slice(tagInfos, func(i, j int) bool { return tagInfos[i].Tag.Name < tagInfos[j].Tag.Name })
+	for _, tagInfo := range tagInfos {
+		if err := cb(tagInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) deleteTag(txnCtx *txncontext.TransactionContext, tag *pfs.Tag) error {
+	// Validate arguments
+	if tag == nil || tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo, auth.Permission_REPO_DELETE_TAG); err != nil {
+		return err
+	}
+	return d.tags.ReadWrite(txnCtx.SqlTx).Delete(tag)
+}
+
+// repoTags returns all the tags in repo.
+func (d *driver) repoTags(txnCtx *txncontext.TransactionContext, repo *pfs.Repo) ([]*pfs.TagInfo, error) {
+	var result []*pfs.TagInfo
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).GetByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo), tagInfo, col.DefaultOptions(), func(string) error {
+		result = append(result, proto.Clone(tagInfo).(*pfs.TagInfo))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkTaggedCommits returns an error if any of commitInfos is tagged, unless
+// force is set, in which case the tags are deleted along with the commits.
+func (d *driver) checkTaggedCommits(txnCtx *txncontext.TransactionContext, commitInfos []*pfs.CommitInfo, force bool) error {
+	for _, ci := range commitInfos {
+		tagInfos, err := d.repoTags(txnCtx, ci.Commit.Branch.Repo)
+		if err != nil {
+			return err
+		}
+		for _, tagInfo := range tagInfos {
+			if tagInfo.Commit.ID != ci.Commit.ID {
+				continue
+			}
+			if !force {
+				return errors.Errorf("commit %s is tagged as %s, use force to remove it anyway", ci.Commit, tagInfo.Tag)
+			}
+			if err := d.tags.ReadWrite(txnCtx.SqlTx).Delete(tagInfo.Tag); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkTagName returns an error if a tag exists with the same name as branch,
+// which would make the branch name ambiguous.
+func (d *driver) checkTagName(txnCtx *txncontext.TransactionContext, branch *pfs.Branch) error {
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(branch.Repo.NewTag(branch.Name), &pfs.TagInfo{}); err == nil {
+		return errors.Errorf("cannot create branch %s because a tag with the same name exists", branch)
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+	return nil
+}
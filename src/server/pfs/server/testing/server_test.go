@@ -2,6 +2,7 @@ package testing
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -32,6 +33,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/sql"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
@@ -395,6 +397,41 @@ func TestPFS(suite *testing.T) {
 		require.ElementsEqualUnderFn(t, repoNames, repoInfos, RepoInfoToName)
 	})
 
+	suite.Run("InspectRepoStorage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		sharedData := random.String(units.MB)
+		uniqueData := random.String(units.MB)
+		require.NoError(t, env.PachClient.CreateRepo("repo1"))
+		require.NoError(t, env.PachClient.CreateRepo("repo2"))
+		commit1, err := env.PachClient.StartCommit("repo1", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit1, "shared", strings.NewReader(sharedData)))
+		require.NoError(t, env.PachClient.FinishCommit("repo1", "master", commit1.ID))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("repo2", "master", ""), "shared", strings.NewReader(sharedData)))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("repo1", "master", ""), "unique", strings.NewReader(uniqueData)))
+		_, err = env.PachClient.WaitCommit("repo1", "master", "")
+		require.NoError(t, err)
+
+		info, err := env.PachClient.InspectRepoStorage("repo1", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(sharedData)+len(uniqueData)), info.LogicalBytes)
+		require.True(t, info.SharedBytes >= int64(len(sharedData)))
+		require.True(t, info.ReclaimableBytes >= int64(len(uniqueData)))
+		require.Equal(t, info.UniqueChunkBytes, info.SharedBytes+info.ReclaimableBytes)
+
+		// Only the second commit, whose new data is not referenced by any other commit.
+		info, err = env.PachClient.InspectRepoStorage("repo1", commit1, client.NewCommit("repo1", "master", ""))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(sharedData)+len(uniqueData)), info.LogicalBytes)
+		require.True(t, info.ReclaimableBytes >= int64(len(uniqueData)))
+		require.True(t, info.ReclaimableBytes < int64(len(sharedData)+len(uniqueData)))
+
+		_, err = env.PachClient.InspectRepoStorage("nonexistent", nil, nil)
+		require.YesError(t, err)
+	})
+
 	// Make sure that artifacts of deleted repos do not resurface
 	suite.Run("CreateDeletedRepo", func(t *testing.T) {
 		t.Parallel()
@@ -2824,234 +2861,172 @@ func TestPFS(suite *testing.T) {
 	})
 
 	suite.Run("PutFileSplit", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "none", pfs.Delimiter_NONE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line2", pfs.Delimiter_LINE, 2, 0, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "line3", pfs.Delimiter_LINE, 0, 8, 0, false, strings.NewReader("foo\nbar\nbuz\nfiz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json2", pfs.Delimiter_JSON, 2, 0, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "json3", pfs.Delimiter_JSON, 0, 4, 0, false, strings.NewReader("{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	commit2, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false, strings.NewReader("foo\nbar\nbuz\n"))
-		//	require.NoError(t, err)
-		//	_, err = env.PachClient.PutFileSplit(repo, commit2.ID, "json", pfs.Delimiter_JSON, 0, 0, 0, false, strings.NewReader("{}{}{}{}{}{}{}{}{}{}"))
-		//	require.NoError(t, err)
-		//
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit2.ID))
-		//	fileInfo, err := env.PachClient.InspectFile(repo, commit.ID, "none")
-		//	require.NoError(t, err)
-		//	require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 6, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 9, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "line3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(8), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 20, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit2.ID, "json")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 30, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(2), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json2")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
-		//	files, err = env.PachClient.ListFileAll(repo, commit.ID, "json3")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		split := func(commit *pfs.Commit, path string, delimiter pfs.Delimiter, targetDatums, targetBytes int64, data string, opts ...client.PutFileOption) {
+			opts = append(opts, client.WithSplitPutFile(delimiter, targetDatums, targetBytes, 0))
+			require.NoError(t, env.PachClient.PutFile(commit, path, strings.NewReader(data), opts...))
+		}
+		checkFiles := func(commit *pfs.Commit, path string, n int, size int64) {
+			files, err := env.PachClient.ListFileAll(commit, path)
+			require.NoError(t, err)
+			require.Equal(t, n, len(files))
+			for _, fileInfo := range files {
+				require.Equal(t, size, fileInfo.SizeBytes)
+			}
+		}
+		require.NoError(t, env.PachClient.PutFile(commit, "none", strings.NewReader("foo\nbar\nbuz\n")))
+		split(commit, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n")
+		split(commit, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n", client.WithAppendPutFile())
+		split(commit, "line2", pfs.Delimiter_LINE, 2, 0, "foo\nbar\nbuz\nfiz\n")
+		split(commit, "line3", pfs.Delimiter_LINE, 0, 8, "foo\nbar\nbuz\nfiz\n")
+		split(commit, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}")
+		split(commit, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}", client.WithAppendPutFile())
+		split(commit, "json2", pfs.Delimiter_JSON, 2, 0, "{}{}{}{}")
+		split(commit, "json3", pfs.Delimiter_JSON, 0, 4, "{}{}{}{}")
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit.ID))
+
+		fileInfo, err := env.PachClient.InspectFile(commit, "none")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+		checkFiles(commit, "line", 6, 4)
+		checkFiles(commit, "line2", 2, 8)
+		checkFiles(commit, "line3", 2, 8)
+		checkFiles(commit, "json", 20, 2)
+		checkFiles(commit, "json2", 2, 4)
+		checkFiles(commit, "json3", 2, 4)
+
+		// Appending continues numbering from the files in the parent commit,
+		// while overwriting replaces them.
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		split(commit2, "line", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n", client.WithAppendPutFile())
+		split(commit2, "json", pfs.Delimiter_JSON, 0, 0, "{}{}{}{}{}{}{}{}{}{}", client.WithAppendPutFile())
+		split(commit2, "line2", pfs.Delimiter_LINE, 0, 0, "foo\nbar\nbuz\n")
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit2.ID))
+		checkFiles(commit2, "line", 9, 4)
+		checkFiles(commit2, "json", 30, 2)
+		checkFiles(commit2, "line2", 3, 4)
+		checkFiles(commit, "line", 6, 4)
+
+		require.YesError(t, env.PachClient.PutFile(commit2, "line", strings.NewReader("foo\n"), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0, 1)))
+		require.YesError(t, env.PachClient.PutFileURL(commit2, "url", "http://127.0.0.1/file", false, client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0, 0)))
 	})
 
 	suite.Run("PutFileSplitBig", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	if testing.Short() {
-		//		t.Skip("Skipping integration tests in short mode")
-		//	}
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	w, err := env.PachClient.PutFileSplitWriter(repo, commit.ID, "line", pfs.Delimiter_LINE, 0, 0, 0, false)
-		//	require.NoError(t, err)
-		//	for i := 0; i < 1000; i++ {
-		//		_, err = w.Write([]byte("foo\n"))
-		//		require.NoError(t, err)
-		//	}
-		//	require.NoError(t, w.Close())
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	files, err := env.PachClient.ListFileAll(repo, commit.ID, "line")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 1000, len(files))
-		//	for _, fileInfo := range files {
-		//		require.Equal(t, uint64(4), fileInfo.SizeBytes)
-		//	}
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		data := strings.Repeat("foo\n", 1000)
+		require.NoError(t, env.PachClient.PutFile(commit, "line", strings.NewReader(data), client.WithSplitPutFile(pfs.Delimiter_LINE, 0, 0, 0)))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit.ID))
+		files, err := env.PachClient.ListFileAll(commit, "line")
+		require.NoError(t, err)
+		require.Equal(t, 1000, len(files))
+		for _, fileInfo := range files {
+			require.Equal(t, int64(4), fileInfo.SizeBytes)
+		}
 	})
 
 	suite.Run("PutFileSplitCSV", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "data", pfs.Delimiter_CSV, 0, 0, 0, false,
-		//		// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
-		//		strings.NewReader("this,is,a,test\n"+
-		//			"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/data")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 2, len(fileInfos))
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000000", &contents)
-		//	require.Equal(t, "this,is,a,test\n", contents.String())
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/data/0000000000000001", &contents)
-		//	require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(commit, "data",
+			// Weird, but this is actually two lines ("is\na" is quoted, so one cell)
+			strings.NewReader("this,is,a,test\n"+
+				"\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n"),
+			client.WithSplitPutFile(pfs.Delimiter_CSV, 0, 0, 0)))
+		fileInfos, err := env.PachClient.ListFileAll(commit, "/data")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000000", &contents))
+		require.Equal(t, "this,is,a,test\n", contents.String())
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "/data/0000000000000001", &contents))
+		require.Equal(t, "\"\"\"this\"\"\",\"is\nonly\",\"a,test\"\n", contents.String())
+
+		// With a header record, every file starts with the header.
+		require.NoError(t, env.PachClient.PutFile(commit, "header",
+			strings.NewReader("name,job\nalice,accountant\nbob,baker\n"),
+			client.WithSplitPutFile(pfs.Delimiter_CSV, 0, 0, 1)))
+		fileInfos, err = env.PachClient.ListFileAll(commit, "/header")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(fileInfos))
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(commit, "/header/0000000000000001", &contents))
+		require.Equal(t, "name,job\nbob,baker\n", contents.String())
 	})
 
 	suite.Run("PutFileSplitSQL", func(t *testing.T) {
-		// TODO(2.0 optional): Implement put file split.
-		t.Skip("Put file split not implemented in V2")
-		//	t.Parallel()
-		//  env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
-		//
-		//	// create repos
-		//	repo := "test"
-		//	require.NoError(t, env.PachClient.CreateRepo(repo))
-		//
-		//	_, err := env.PachClient.PutFileSplit(repo, "master", "/sql", pfs.Delimiter_SQL, 0, 0, 0,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 5, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	var contents bytes.Buffer
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000000", &contents)
-		//	// Validate that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
-		//	record, err := pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
-		//
-		//	// Create a new commit that overwrites all existing data & puts it back with
-		//	// --header-records=1
-		//	commit, err := env.PachClient.StartCommit(repo, "master")
-		//	require.NoError(t, err)
-		//	require.NoError(t, env.PachClient.DeleteFile(repo, commit.ID, "/sql"))
-		//	_, err = env.PachClient.PutFileSplit(repo, commit.ID, "/sql", pfs.Delimiter_SQL, 0, 0, 1,
-		//		false, strings.NewReader(tu.TestPGDump))
-		//	require.NoError(t, err)
-		//	require.NoError(t, finishCommit(env.PachClient, repo, commit.ID))
-		//	fileInfos, err = env.PachClient.ListFileAll(repo, "master", "/sql")
-		//	require.NoError(t, err)
-		//	require.Equal(t, 4, len(fileInfos))
-		//
-		//	// Get one of the SQL records & validate it
-		//	contents.Reset()
-		//	env.PachClient.GetFile(repo, "master", "/sql/0000000000000003", &contents)
-		//	// Validate a that the recieved pgdump file creates the cars table
-		//	require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
-		//	// Validate the SQL header more generally by passing the output of GetFile
-		//	// back through the SQL library & confirm that it parses correctly but only
-		//	// has one row
-		//	pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
-		//	record, err = pgReader.ReadRow()
-		//	require.NoError(t, err)
-		//	require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
-		//	_, err = pgReader.ReadRow()
-		//	require.YesError(t, err)
-		//	require.True(t, errors.Is(err, io.EOF))
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, env.PachClient.PutFile(master, "/sql", strings.NewReader(tu.TestPGDump), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 0)))
+		fileInfos, err := env.PachClient.ListFileAll(master, "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 5, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		var contents bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(master, "/sql/0000000000000000", &contents))
+		// Validate that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but only
+		// has one row
+		pgReader := sql.NewPGDumpReader(bufio.NewReader(bytes.NewReader(contents.Bytes())))
+		record, err := pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
+
+		// Create a new commit that overwrites all existing data & puts it back with
+		// --header-records=1
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit, "/sql", strings.NewReader(tu.TestPGDump), client.WithSplitPutFile(pfs.Delimiter_SQL, 0, 0, 1)))
+		require.NoError(t, finishCommit(env.PachClient, repo, "master", commit.ID))
+		fileInfos, err = env.PachClient.ListFileAll(master, "/sql")
+		require.NoError(t, err)
+		require.Equal(t, 4, len(fileInfos))
+
+		// Get one of the SQL records & validate it
+		contents.Reset()
+		require.NoError(t, env.PachClient.GetFile(master, "/sql/0000000000000003", &contents))
+		// Validate that the recieved pgdump file creates the cars table
+		require.Matches(t, "CREATE TABLE public\\.cars", contents.String())
+		// Validate the SQL header more generally by passing the output of GetFile
+		// back through the SQL library & confirm that it parses correctly but has
+		// the header row and its own row
+		pgReader = sql.NewPGDumpReader(bufio.NewReader(strings.NewReader(contents.String())))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Tesla\tRoadster\t2008\tliterally a rocket\n", string(record))
+		record, err = pgReader.ReadRow()
+		require.NoError(t, err)
+		require.Equal(t, "Toyota\tCorolla\t2005\tgreatest car ever made\n", string(record))
+		_, err = pgReader.ReadRow()
+		require.YesError(t, err)
+		require.True(t, errors.Is(err, io.EOF))
 	})
 
 	suite.Run("DiffFile", func(t *testing.T) {
@@ -4552,6 +4527,51 @@ func TestPFS(suite *testing.T) {
 		check()
 	})
 
+	suite.Run("GetFilesObjURLSince", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		for _, path := range []string{"foo", "bar", "buz"} {
+			require.NoError(t, env.PachClient.PutFile(commit1, path, strings.NewReader(path)))
+		}
+		require.NoError(t, finishCommit(env.PachClient, repo, commit1.Branch.Name, commit1.ID))
+		objC, bucket := tu.NewObjectClient(t)
+		url := fmt.Sprintf("local://%s/", bucket)
+		require.NoError(t, env.PachClient.GetFileURL(commit1, "/", url))
+		// Mark the unchanged file, so that we can tell whether it is written again.
+		require.NoError(t, objC.Delete(context.Background(), "foo"))
+		writeObj(t, objC, "foo", "unchanged")
+
+		commit2, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(commit2, "bar", strings.NewReader("bar2")))
+		require.NoError(t, env.PachClient.PutFile(commit2, "baz", strings.NewReader("baz")))
+		require.NoError(t, env.PachClient.DeleteFile(commit2, "buz"))
+		require.NoError(t, finishCommit(env.PachClient, repo, commit2.Branch.Name, commit2.ID))
+		require.NoError(t, env.PachClient.GetFileURL(commit2, "/", url, client.WithSinceGetFile(commit1, false)))
+		checkObj := func(path, expected string) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, objC.Get(context.Background(), path, buf))
+			require.Equal(t, expected, buf.String())
+		}
+		checkObj("foo", "unchanged")
+		checkObj("bar", "bar2")
+		checkObj("baz", "baz")
+		checkObj("buz", "buz")
+
+		require.NoError(t, env.PachClient.GetFileURL(commit2, "/", url, client.WithSinceGetFile(commit1, true)))
+		checkObj("foo", "unchanged")
+		exists, err := objC.Exists(context.Background(), "buz")
+		require.NoError(t, err)
+		require.False(t, exists)
+		// Removed files that are already gone from the destination are ignored.
+		require.NoError(t, env.PachClient.GetFileURL(commit2, "/", url, client.WithSinceGetFile(commit1, true)))
+	})
+
 	suite.Run("PutFileOutputRepo", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -4654,6 +4674,372 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, desc, ri.Description)
 	})
 
+	suite.Run("Metadata", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		repo := "test"
+		_, err := c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo:     client.NewRepo(repo),
+			Metadata: map[string]string{"owner": "data-eng"},
+		})
+		require.NoError(t, err)
+		// an update without metadata leaves it in place
+		_, err = c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo:        client.NewRepo(repo),
+			Description: "foo",
+			Update:      true,
+		})
+		require.NoError(t, err)
+		ri, err := c.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"owner": "data-eng"}, ri.Metadata)
+
+		commit1, err := c.PfsAPIClient.StartCommit(c.Ctx(), &pfs.StartCommitRequest{
+			Branch:   client.NewBranch(repo, "master"),
+			Metadata: map[string]string{"source": "crm", "ticket": "DATA-1"},
+		})
+		require.NoError(t, err)
+		_, err = c.PfsAPIClient.FinishCommit(c.Ctx(), &pfs.FinishCommitRequest{
+			Commit:   commit1,
+			Metadata: map[string]string{"ticket": "DATA-2", "rows": "10"},
+		})
+		require.NoError(t, err)
+		ci, err := c.InspectCommit(repo, "", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"source": "crm", "ticket": "DATA-2", "rows": "10"}, ci.Metadata)
+
+		commit2, err := c.PfsAPIClient.StartCommit(c.Ctx(), &pfs.StartCommitRequest{
+			Branch:   client.NewBranch(repo, "master"),
+			Metadata: map[string]string{"source": "erp"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, finishCommit(c, repo, "", commit2.ID))
+
+		listCommit := func(metadata map[string]string) []string {
+			listClient, err := c.PfsAPIClient.ListCommit(c.Ctx(), &pfs.ListCommitRequest{
+				Repo:     client.NewRepo(repo),
+				Metadata: metadata,
+			})
+			require.NoError(t, err)
+			var ids []string
+			require.NoError(t, clientsdk.ForEachCommit(listClient, func(ci *pfs.CommitInfo) error {
+				ids = append(ids, ci.Commit.ID)
+				return nil
+			}))
+			return ids
+		}
+		require.ElementsEqual(t, []string{commit1.ID}, listCommit(map[string]string{"source": "crm"}))
+		require.ElementsEqual(t, []string{commit1.ID, commit2.ID}, listCommit(map[string]string{"source": ""}))
+		require.ElementsEqual(t, []string{commit1.ID}, listCommit(map[string]string{"source": "", "rows": "10"}))
+		require.Equal(t, 0, len(listCommit(map[string]string{"source": "hr"})))
+
+		_, err = c.PfsAPIClient.StartCommit(c.Ctx(), &pfs.StartCommitRequest{
+			Branch:   client.NewBranch(repo, "master"),
+			Metadata: map[string]string{"": "foo"},
+		})
+		require.YesError(t, err)
+
+		// keys starting with "pfs." are reserved for metadata set by pachyderm
+		_, err = c.PfsAPIClient.StartCommit(c.Ctx(), &pfs.StartCommitRequest{
+			Branch:   client.NewBranch(repo, "master"),
+			Metadata: map[string]string{"pfs.merged_branch": "master"},
+		})
+		require.YesError(t, err)
+		require.Matches(t, "reserved", err.Error())
+		commit3, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PfsAPIClient.FinishCommit(c.Ctx(), &pfs.FinishCommitRequest{
+			Commit:   commit3,
+			Metadata: map[string]string{"pfs.merged_commit": commit1.ID},
+		})
+		require.YesError(t, err)
+		require.Matches(t, "reserved", err.Error())
+	})
+
+	suite.Run("BranchProtection", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		commit1, err := c.StartCommit(repo, "staging")
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit1.Branch.Name, commit1.ID))
+		require.NoError(t, c.CreateBranch(repo, "master", "staging", commit1.ID, nil))
+
+		require.NoError(t, c.SetBranchProtection(repo, "master", &pfs.BranchProtection{
+			DenyRewind:  true,
+			PromoteFrom: "staging",
+		}))
+		bi, err := c.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, "staging", bi.Protection.PromoteFrom)
+
+		// direct commits are rejected
+		_, err = c.StartCommit(repo, "master")
+		require.YesError(t, err)
+		require.Matches(t, "only accepts commits promoted from", err.Error())
+
+		// promoting a descendant from staging is allowed
+		commit2, err := c.StartCommit(repo, "staging")
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit2.Branch.Name, commit2.ID))
+		require.NoError(t, c.CreateBranch(repo, "master", "staging", commit2.ID, nil))
+
+		// rewinding is not
+		err = c.CreateBranch(repo, "master", "staging", commit1.ID, nil)
+		require.YesError(t, err)
+		require.Matches(t, "protected against rewinds", err.Error())
+
+		// nor is promoting from another branch
+		commit3, err := c.StartCommit(repo, "other")
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit3.Branch.Name, commit3.ID))
+		require.YesError(t, c.CreateBranch(repo, "master", "other", commit3.ID, nil))
+
+		// dropping the head would move it back to its parent on master
+		err = c.DropCommitSet(commit2.ID)
+		require.YesError(t, err)
+		require.Matches(t, "only accepts commits promoted from", err.Error())
+		// and squashing an older commit would rewrite master's history
+		err = c.SquashCommitSet(commit1.ID)
+		require.YesError(t, err)
+		require.Matches(t, "protected against rewinds", err.Error())
+		bi, err = c.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, commit2.ID, bi.Head.ID)
+
+		// provenance and triggers can't bypass promotion either
+		require.NoError(t, c.CreateRepo("upstream"))
+		require.NoError(t, c.CreateBranch("upstream", "master", "", "", nil))
+		err = c.CreateBranch(repo, "master", "", "", []*pfs.Branch{client.NewBranch("upstream", "master")})
+		require.YesError(t, err)
+		require.Matches(t, "cannot have .* added to its provenance", err.Error())
+		err = c.CreateBranchTrigger(repo, "master", "", "", &pfs.Trigger{Branch: "other", Commits: 1})
+		require.YesError(t, err)
+		require.Matches(t, "cannot be triggered by", err.Error())
+
+		require.YesError(t, c.DeleteBranch(repo, "master", false))
+
+		// requiring a pipeline needs auth
+		require.YesError(t, c.SetBranchProtection(repo, "other", &pfs.BranchProtection{RequiredPipeline: "pipeline"}))
+
+		require.NoError(t, c.SetBranchProtection(repo, "master", nil))
+		bi, err = c.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.Nil(t, bi.Protection)
+		commit4, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(repo, commit4.Branch.Name, commit4.ID))
+		require.NoError(t, c.DropCommitSet(commit4.ID))
+
+		// protection doesn't prevent the repo from being deleted
+		require.NoError(t, c.SetBranchProtection(repo, "other", &pfs.BranchProtection{DenyRewind: true}))
+		require.NoError(t, c.DeleteRepo(repo, false))
+	})
+
+	suite.Run("MergeBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		feature := client.NewCommit(repo, "feature", "")
+		require.NoError(t, c.WithModifyFileClient(master, func(mf client.ModifyFile) error {
+			for _, name := range []string{"a", "b", "c"} {
+				if err := mf.PutFile(name, strings.NewReader(name)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+		require.NoError(t, c.CreateBranch(repo, "feature", "master", "", nil))
+		require.NoError(t, c.PutFile(feature, "a", strings.NewReader("a-feature")))
+		require.NoError(t, c.DeleteFile(feature, "b"))
+		require.NoError(t, c.PutFile(feature, "d", strings.NewReader("d")))
+		require.NoError(t, c.PutFile(master, "c", strings.NewReader("c-master")))
+
+		checkFile := func(path, expected string) {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(master, path, &buf))
+			require.Equal(t, expected, buf.String())
+		}
+
+		// changes to different paths merge cleanly
+		response, err := c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(response.Conflicts))
+		require.NotNil(t, response.Commit)
+		checkFile("a", "a-feature")
+		checkFile("c", "c-master")
+		checkFile("d", "d")
+		_, err = c.InspectFile(master, "b")
+		require.YesError(t, err)
+
+		// merging again has nothing to do
+		response, err = c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.Nil(t, response.Commit)
+
+		// changes to the same path conflict, and nothing is committed until
+		// they're resolved
+		require.NoError(t, c.PutFile(feature, "c", strings.NewReader("c-feature")))
+		head, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		response, err = c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.Nil(t, response.Commit)
+		require.Equal(t, 1, len(response.Conflicts))
+		require.Equal(t, "/c", response.Conflicts[0].Path)
+		require.Equal(t, pfs.MergeResolution_UNRESOLVED, response.Conflicts[0].Resolution)
+		ci, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, head.Commit.ID, ci.Commit.ID)
+
+		response, err = c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_THEIRS)
+		require.NoError(t, err)
+		require.NotNil(t, response.Commit)
+		require.Equal(t, 1, len(response.Conflicts))
+		checkFile("c", "c-feature")
+
+		// a conflict resolved with ours is recorded by the merge, and isn't
+		// reported by the next one
+		require.NoError(t, c.PutFile(master, "c", strings.NewReader("c-master2")))
+		require.NoError(t, c.PutFile(feature, "c", strings.NewReader("c-feature2")))
+		response, err = c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_OURS)
+		require.NoError(t, err)
+		require.NotNil(t, response.Commit)
+		require.Equal(t, 1, len(response.Conflicts))
+		checkFile("c", "c-master2")
+		ci, err = c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, "feature", ci.Metadata["pfs.merged_branch"])
+		require.NoError(t, c.PutFile(feature, "e", strings.NewReader("e")))
+		response, err = c.MergeBranch(repo, "feature", "master", pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.NotNil(t, response.Commit)
+		require.Equal(t, 0, len(response.Conflicts))
+		checkFile("c", "c-master2")
+		checkFile("e", "e")
+
+		_, err = c.MergeBranch(repo, "master", "master", pfs.MergeResolution_UNRESOLVED)
+		require.YesError(t, err)
+	})
+
+	suite.Run("RevertCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, c.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, c.PutFile(master, "b", strings.NewReader("b")))
+		bad, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(bad, "a", strings.NewReader("a-bad")))
+		require.NoError(t, c.DeleteFile(bad, "b"))
+		require.NoError(t, c.PutFile(bad, "c", strings.NewReader("c")))
+		require.NoError(t, c.FinishCommit(repo, "master", ""))
+		require.NoError(t, c.PutFile(master, "d", strings.NewReader("d")))
+
+		checkFile := func(path, expected string) {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(master, path, &buf))
+			require.Equal(t, expected, buf.String())
+		}
+
+		response, err := c.RevertCommit(repo, "", bad.ID, pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(response.Conflicts))
+		require.NotNil(t, response.Commit)
+		checkFile("a", "a")
+		checkFile("b", "b")
+		checkFile("d", "d")
+		_, err = c.InspectFile(master, "c")
+		require.YesError(t, err)
+		// history is left intact
+		_, err = c.InspectCommit(repo, "", bad.ID)
+		require.NoError(t, err)
+
+		// reverting a commit whose changes were overwritten conflicts
+		require.NoError(t, c.PutFile(master, "e", strings.NewReader("e")))
+		e, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(master, "e", strings.NewReader("e2")))
+		response, err = c.RevertCommit(repo, "", e.Commit.ID, pfs.MergeResolution_UNRESOLVED)
+		require.NoError(t, err)
+		require.Nil(t, response.Commit)
+		require.Equal(t, 1, len(response.Conflicts))
+		require.Equal(t, "/e", response.Conflicts[0].Path)
+		response, err = c.RevertCommit(repo, "", e.Commit.ID, pfs.MergeResolution_THEIRS)
+		require.NoError(t, err)
+		require.NotNil(t, response.Commit)
+		_, err = c.InspectFile(master, "e")
+		require.YesError(t, err)
+	})
+
+	suite.Run("Tags", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		repo := "test"
+		require.NoError(t, c.CreateRepo(repo))
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, c.PutFile(master, "file", strings.NewReader("1")))
+		commit1, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+
+		require.NoError(t, c.CreateTag(repo, "v1", "master", "", "first release"))
+		tagInfo, err := c.InspectTag(repo, "v1")
+		require.NoError(t, err)
+		require.Equal(t, commit1.Commit.ID, tagInfo.Commit.ID)
+		require.Equal(t, "first release", tagInfo.Description)
+		// tags are immutable, and share a namespace with branches
+		require.YesError(t, c.CreateTag(repo, "v1", "master", "", ""))
+		require.YesError(t, c.CreateTag(repo, "master", "master", "", ""))
+
+		// the tag doesn't move with master
+		require.NoError(t, c.PutFile(master, "file", strings.NewReader("2")))
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(client.NewCommit(repo, "v1", ""), "file", &buf))
+		require.Equal(t, "1", buf.String())
+		ci, err := c.InspectCommit(repo, "v1", "")
+		require.NoError(t, err)
+		require.Equal(t, commit1.Commit.ID, ci.Commit.ID)
+
+		// writing to the tag would create a branch with the same name
+		require.YesError(t, c.PutFile(client.NewCommit(repo, "v1", ""), "file", strings.NewReader("3")))
+
+		tagInfos, err := c.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tagInfos))
+
+		// tagged commits can only be squashed with force, which deletes the tag
+		require.YesError(t, c.SquashCommitSet(commit1.Commit.ID))
+		_, err = c.PfsAPIClient.SquashCommitSet(c.Ctx(), &pfs.SquashCommitSetRequest{
+			CommitSet: client.NewCommitSet(commit1.Commit.ID),
+			Force:     true,
+		})
+		require.NoError(t, err)
+		_, err = c.InspectTag(repo, "v1")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsTagNotFoundErr(err))
+
+		require.NoError(t, c.CreateTag(repo, "v2", "master", "", ""))
+		require.NoError(t, c.DeleteTag(repo, "v2"))
+		tagInfos, err = c.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(tagInfos))
+	})
+
 	suite.Run("DeferredProcessing", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -4839,6 +5225,50 @@ func TestPFS(suite *testing.T) {
 		}
 	})
 
+	suite.Run("GarbageCollect", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("repo"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("repo", "master", ""), "file", strings.NewReader(random.String(units.MB))))
+		_, err := env.PachClient.WaitCommit("repo", "master", "")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.DeleteRepo("repo", false))
+		// Temporary objects, including new chunks, are kept until they
+		// expire, so expire them to make the repo's chunks collectable.
+		db := env.ServiceEnv.GetDBClient()
+		_, err = db.Exec(`UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - INTERVAL '1 second' WHERE expires_at IS NOT NULL`)
+		require.NoError(t, err)
+		countChunks := func() int64 {
+			var n int64
+			require.NoError(t, db.Get(&n, `SELECT COUNT(*) FROM storage.chunk_objects`))
+			return n
+		}
+		chunks := countChunks()
+
+		dryRun, err := env.PachClient.GarbageCollect(true)
+		require.NoError(t, err)
+		require.True(t, dryRun.DryRun)
+		require.NotNil(t, dryRun.Duration)
+		require.True(t, dryRun.TrackerObjects > 0)
+		require.True(t, dryRun.ChunkObjects > 0)
+		require.True(t, dryRun.ChunkBytes >= units.MB)
+		require.NotNil(t, dryRun.OldestChunk)
+		require.Equal(t, chunks, countChunks())
+
+		resp, err := env.PachClient.GarbageCollect(false)
+		require.NoError(t, err)
+		require.False(t, resp.DryRun)
+		require.Equal(t, dryRun.ChunkObjects, resp.ChunkObjects)
+		require.Equal(t, dryRun.ChunkBytes, resp.ChunkBytes)
+		require.Equal(t, chunks-resp.ChunkObjects, countChunks())
+
+		// Nothing is left to collect.
+		dryRun, err = env.PachClient.GarbageCollect(true)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), dryRun.ChunkObjects)
+	})
+
 	suite.Run("FsckFix", func(t *testing.T) {
 		// TODO(optional 2.0): force-deleting the repo no longer creates dangling references
 		t.Skip("this test no longer creates invalid metadata")
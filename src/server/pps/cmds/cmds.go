@@ -423,10 +423,21 @@ each datum.`,
 	commands = append(commands, cmdutil.CreateAlias(restartDatum, "restart datum"))
 
 	var pipelineInputPath string
+	var datumStates []string
+	var paginationMarker string
+	var number int64
 	listDatum := &cobra.Command{
 		Use:   "{{alias}} <pipeline>@<job>",
 		Short: "Return the datums in a job.",
-		Long:  "Return the datums in a job.",
+		Long: "Return the datums in a job.\n\n" +
+			"Datums are listed in a stable order, so a long listing can be paged through by passing the ID of the last datum shown to --pagination-marker.",
+		Example: `
+# Return the failed datums in job foo@XXX
+$ {{alias}} foo@XXX --state failed
+
+# Return the first 100 datums in job foo@XXX, then the next 100
+$ {{alias}} foo@XXX -n 100
+$ {{alias}} foo@XXX -n 100 --pagination-marker <last datum ID>`,
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -465,19 +476,29 @@ each datum.`,
 				if err != nil {
 					return err
 				}
-				return client.ListDatumInput(request.Input, printF)
+				if len(datumStates) > 0 {
+					return errors.Errorf("can't filter by state when listing datums from a pipeline spec")
+				}
+				return client.ListDatumInputPage(request.Input, paginationMarker, number, printF)
 			} else if len(args) == 1 {
 				job, err := cmdutil.ParseJob(args[0])
 				if err != nil {
 					return err
 				}
-				return client.ListDatum(job.Pipeline.Name, job.ID, printF)
+				states, err := parseDatumStates(datumStates)
+				if err != nil {
+					return err
+				}
+				return client.ListDatumPage(job.Pipeline.Name, job.ID, states, paginationMarker, number, printF)
 			} else {
 				return errors.Errorf("must specify either a job or a pipeline spec")
 			}
 		}),
 	}
 	listDatum.Flags().StringVarP(&pipelineInputPath, "file", "f", "", "The JSON file containing the pipeline to list datums from, the pipeline need not exist")
+	listDatum.Flags().StringArrayVar(&datumStates, "state", []string{}, "Return only datums with the specified state. Can be repeated to include multiple states")
+	listDatum.Flags().StringVar(&paginationMarker, "pagination-marker", "", "Only return datums after the datum with this ID.")
+	listDatum.Flags().Int64VarP(&number, "number", "n", 0, "The maximum number of datums to return (0 returns all datums).")
 	listDatum.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(listDatum, shell.JobCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listDatum, "list datum"))
@@ -936,10 +957,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if len(args) > 0 {
 				req.Pipeline = pachdclient.NewPipeline(args[0])
 			}
-			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.DeletePipeline(txClient.Ctx(), req)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
@@ -957,10 +978,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StartPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				if err := txClient.StartPipeline(args[0]); err != nil {
+					return errors.Wrap(err, "error from StartPipeline")
+				}
+				return nil
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(startPipeline, "start pipeline"))
@@ -975,10 +998,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
-				return errors.Wrap(err, "error from StopPipeline")
-			}
-			return nil
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				if err := txClient.StopPipeline(args[0]); err != nil {
+					return errors.Wrap(err, "error from StopPipeline")
+				}
+				return nil
+			})
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(stopPipeline, "stop pipeline"))
@@ -1325,6 +1350,18 @@ func ParseJobStates(stateStrs []string) (string, error) {
 	return validateJQConditionString(strings.Join(conditions, " or "))
 }
 
+func parseDatumStates(stateStrs []string) ([]ppsclient.DatumState, error) {
+	var states []ppsclient.DatumState
+	for _, stateStr := range stateStrs {
+		state, err := ppsclient.DatumStateFromName(stateStr)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
 // ParsePipelineStates parses a slice of state names into a jq filter suitable for ListPipeline
 func ParsePipelineStates(stateStrs []string) (string, error) {
 	var conditions []string
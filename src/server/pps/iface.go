@@ -18,5 +18,8 @@ type APIServer interface {
 	StopJobInTransaction(*txncontext.TransactionContext, *pps_client.StopJobRequest) error
 	UpdateJobStateInTransaction(*txncontext.TransactionContext, *pps_client.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*txncontext.TransactionContext, *pps_client.CreatePipelineRequest) error
+	DeletePipelineInTransaction(*txncontext.TransactionContext, *pps_client.DeletePipelineRequest) error
+	StopPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StopPipelineRequest) error
+	StartPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StartPipelineRequest) error
 	InspectPipelineInTransaction(*txncontext.TransactionContext, string) (*pps_client.PipelineInfo, error)
 }
@@ -195,7 +195,8 @@ Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}} {{end}}{{if .Finished}}
 Duration: {{prettyTimeDifference .Started .Finished}} {{end}}
 State: {{jobState .State}}
-Reason: {{.Reason}}
+Reason: {{.Reason}}{{if .Metadata}}
+Metadata: {{prettyMetadata .Metadata}}{{end}}
 Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
 Skipped: {{.DataSkipped}}
@@ -371,6 +372,21 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 		PrintFile(tw, d.File)
 	}
 	tw.Flush()
+	if len(datumInfo.Attempts) > 0 {
+		fmt.Fprintf(w, "Attempts:\n")
+		tw = ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
+		fmt.Fprintf(tw, "  STARTED\tDURATION\tEXIT CODE\tOOM KILLED\tERROR\t\n")
+		for _, attempt := range datumInfo.Attempts {
+			var duration string
+			if d, err := types.DurationFromProto(attempt.Duration); err != nil {
+				duration = err.Error()
+			} else {
+				duration = d.String()
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%d\t%t\t%s\t\n", pretty.Ago(attempt.Started), duration, attempt.ExitCode, attempt.OOMKilled, attempt.Error)
+		}
+		tw.Flush()
+	}
 }
 
 // PrintSecretInfo pretty-prints secret info.
@@ -537,6 +553,8 @@ func ShorthandInput(input *ppsclient.Input) string {
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.URL != nil:
+		return fmt.Sprintf("%s:%s", input.URL.Name, input.URL.URL)
 	}
 	return ""
 }
@@ -552,6 +570,7 @@ var funcMap = template.FuncMap{
 	"prettyTimeDifference": pretty.TimeDifference,
 	"prettyDuration":       pretty.Duration,
 	"prettySize":           pretty.Size,
+	"prettyMetadata":       pretty.Metadata,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/itchyny/gojq"
 	opentracing "github.com/opentracing/opentracing-go"
+	glob "github.com/pachyderm/ohmyglob"
 	"github.com/robfig/cron"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -36,6 +37,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/lokiutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
@@ -100,6 +102,7 @@ type apiServer struct {
 	port                  uint16
 	peerPort              uint16
 	gcPercent             int
+	workerRuntime         workerRuntime
 	// collections
 	pipelines col.PostgresCollection
 	jobs      col.PostgresCollection
@@ -125,6 +128,11 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Cron.Name)
 		}
 		names[input.Cron.Name] = true
+	case input.URL != nil:
+		if names[input.URL.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.URL.Name)
+		}
+		names[input.URL.Name] = true
 	case input.Union != nil:
 		for _, input := range input.Union {
 			namesCopy := make(map[string]bool)
@@ -197,6 +205,41 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 					"'empty_files', as 's3' requires input data to be accessed via " +
 					"Pachyderm's S3 gateway rather than the file system")
 			}
+			for _, pattern := range input.Pfs.Exclude {
+				if _, err := glob.Compile(pattern, '/'); err != nil {
+					return errors.Wrapf(err, "invalid exclude pattern %q", pattern)
+				}
+			}
+			switch {
+			case input.Pfs.MinSizeBytes < 0 || input.Pfs.MaxSizeBytes < 0:
+				return errors.Errorf("input size bounds cannot be negative")
+			case input.Pfs.MaxSizeBytes != 0 && input.Pfs.MinSizeBytes > input.Pfs.MaxSizeBytes:
+				return errors.Errorf("input 'min_size_bytes' (%d) cannot be greater than 'max_size_bytes' (%d)",
+					input.Pfs.MinSizeBytes, input.Pfs.MaxSizeBytes)
+			}
+			if after, before := input.Pfs.ModifiedAfter, input.Pfs.ModifiedBefore; after != nil && before != nil && before.Compare(after) < 0 {
+				return errors.Errorf("input 'modified_after' cannot be later than 'modified_before'")
+			}
+			if ck := input.Pfs.ContentKey; ck != nil {
+				switch {
+				case input.Pfs.JoinOn != "" || input.Pfs.GroupBy != "":
+					return errors.Errorf("input cannot specify both 'content_key' and " +
+						"'join_on' or 'group_by', as the content key is used for both")
+				case input.Pfs.S3:
+					return errors.Errorf("input cannot specify both 's3' and " +
+						"'content_key', as 's3' inputs consist of a single datum")
+				case ck.JSONPath != "" && (ck.Line != 0 || ck.Field != 0 || ck.Delimiter != ""):
+					return errors.Errorf("content key cannot specify both 'json_path' " +
+						"and 'line', 'field' or 'delimiter'")
+				case ck.Line < 0 || ck.Field < 0:
+					return errors.Errorf("content key 'line' and 'field' cannot be negative")
+				}
+				if ck.JSONPath != "" {
+					if _, err := gojq.Parse(ck.JSONPath); err != nil {
+						return errors.Wrapf(err, "invalid content key 'json_path' %q", ck.JSONPath)
+					}
+				}
+			}
 		}
 		if input.Cross != nil {
 			if set {
@@ -250,6 +293,24 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Wrapf(err, "error parsing cron-spec")
 			}
 		}
+		if input.URL != nil {
+			if set {
+				return errors.Errorf("multiple input types set")
+			}
+			set = true
+			if len(input.URL.Name) == 0 {
+				return errors.Errorf("input must specify a name")
+			}
+			if _, err := obj.ParseURL(input.URL.URL); err != nil {
+				return errors.Wrapf(err, "error parsing url")
+			}
+			if _, err := cron.ParseStandard(input.URL.Spec); err != nil {
+				return errors.Wrapf(err, "error parsing spec")
+			}
+			if _, err := glob.Compile(input.URL.Glob, '/'); err != nil {
+				return errors.Wrapf(err, "invalid glob %q", input.URL.Glob)
+			}
+		}
 		if !set {
 			return errors.Errorf("no input set")
 		}
@@ -822,6 +883,8 @@ func (a *apiServer) getJobDetails(ctx context.Context, jobInfo *pps.JobInfo) err
 	details.DatumTimeout = pipelineInfo.Details.DatumTimeout
 	details.JobTimeout = pipelineInfo.Details.JobTimeout
 	details.DatumTries = pipelineInfo.Details.DatumTries
+	details.DatumRetryPolicy = pipelineInfo.Details.DatumRetryPolicy
+	details.QuarantineFailedDatums = pipelineInfo.Details.QuarantineFailedDatums
 	details.SchedulingSpec = pipelineInfo.Details.SchedulingSpec
 	details.PodSpec = pipelineInfo.Details.PodSpec
 	details.PodPatch = pipelineInfo.Details.PodPatch
@@ -1041,16 +1104,59 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	// TODO: Auth?
+	var err error
 	if request.Input != nil {
-		return a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
+		send := newDatumSender(request, false, server.Send)
+		err = a.listDatumInput(server.Context(), request.Input, func(meta *datum.Meta) error {
 			di := convertDatumMetaToInfo(meta, nil)
 			di.State = pps.DatumState_UNKNOWN
-			return server.Send(di)
+			return send(di)
+		})
+	} else {
+		// Datums in a job are stored, and therefore iterated, in datum ID order.
+		send := newDatumSender(request, true, server.Send)
+		err = a.collectDatums(server.Context(), request.Job, func(meta *datum.Meta, _ *pfs.File) error {
+			return send(convertDatumMetaToInfo(meta, request.Job))
 		})
 	}
-	return a.collectDatums(server.Context(), request.Job, func(meta *datum.Meta, _ *pfs.File) error {
-		return server.Send(convertDatumMetaToInfo(meta, request.Job))
-	})
+	if errors.Is(err, errutil.ErrBreak) {
+		return nil
+	}
+	return err
+}
+
+// newDatumSender wraps send to apply the filter and pagination of a
+// ListDatumRequest. If sorted is true, datums are known to arrive in datum ID
+// order, so the pagination marker doesn't need to exist. The returned function
+// returns errutil.ErrBreak once the requested number of datums has been sent.
+func newDatumSender(request *pps.ListDatumRequest, sorted bool, send func(*pps.DatumInfo) error) func(*pps.DatumInfo) error {
+	states := make(map[pps.DatumState]bool)
+	for _, state := range request.GetFilter().GetState() {
+		states[state] = true
+	}
+	pastMarker := request.PaginationMarker == ""
+	var sent int64
+	return func(di *pps.DatumInfo) error {
+		if !pastMarker {
+			if sorted {
+				pastMarker = di.Datum.ID > request.PaginationMarker
+			} else if di.Datum.ID == request.PaginationMarker {
+				pastMarker = true
+				return nil
+			}
+			if !pastMarker {
+				return nil
+			}
+		}
+		if len(states) > 0 && !states[di.State] {
+			return nil
+		}
+		if request.Number > 0 && sent >= request.Number {
+			return errutil.ErrBreak
+		}
+		sent++
+		return send(di)
+	}
 }
 
 func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
@@ -1067,6 +1173,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 		if input.Cron != nil {
 			return errors.Errorf("can't list datums with a cron input, there will be no datums until the pipeline is created")
 		}
+		if input.URL != nil {
+			return errors.Errorf("can't list datums with a url input, there will be no datums until the pipeline is created")
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -1087,8 +1196,9 @@ func convertDatumMetaToInfo(meta *datum.Meta, sourceJob *pps.Job) *pps.DatumInfo
 			Job: meta.Job,
 			ID:  common.DatumID(meta.Inputs),
 		},
-		State: convertDatumState(meta.State),
-		Stats: meta.Stats,
+		State:    convertDatumState(meta.State),
+		Stats:    meta.Stats,
+		Attempts: meta.Attempts,
 	}
 	for _, input := range meta.Inputs {
 		di.Data = append(di.Data, input.FileInfo)
@@ -1206,6 +1316,9 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		}
 	}
 
+	if err := a.requireKube("getting logs without Loki"); err != nil {
+		return err
+	}
 	// Get pods managed by the RC we're scraping (either pipeline or pachd)
 	pods, err := a.rcPods(rcName)
 	if err != nil {
@@ -1475,6 +1588,46 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.Spout != nil && request.Autoscaling {
 		return errors.Errorf("autoscaling can't be used with spouts (spouts aren't triggered externally)")
 	}
+	if request.Egress != nil {
+		if err := validateEgress(request.Egress); err != nil {
+			return errors.Wrapf(err, "invalid egress")
+		}
+	}
+	return nil
+}
+
+func validateEgress(egress *pps.Egress) error {
+	sqlEgress := egress.SQLDatabase
+	if sqlEgress == nil {
+		if egress.URL == "" {
+			return errors.New("must specify a URL or a SQL database")
+		}
+		if egress.DeleteRemoved && !egress.Incremental {
+			return errors.New("delete_removed requires incremental egress")
+		}
+		return nil
+	}
+	if egress.URL != "" {
+		return errors.New("cannot specify both a URL and a SQL database")
+	}
+	if egress.Incremental || egress.DeleteRemoved {
+		return errors.New("incremental egress is not supported for SQL databases")
+	}
+	if sqlEgress.URL == "" {
+		return errors.New("SQL database egress must specify a database URL")
+	}
+	if sqlEgress.Table == "" {
+		return errors.New("SQL database egress must specify a table")
+	}
+	if sqlEgress.FileFormat == nil || sqlEgress.FileFormat.Type == pps.SQLDatabaseEgress_FileFormat_UNKNOWN {
+		return errors.New("SQL database egress must specify a file format of CSV or JSON")
+	}
+	if len(sqlEgress.FileFormat.Columns) == 0 {
+		return errors.New("SQL database egress must specify the columns of the table")
+	}
+	if sqlEgress.FileFormat.Header && sqlEgress.FileFormat.Type != pps.SQLDatabaseEgress_FileFormat_CSV {
+		return errors.New("only CSV files can have a header")
+	}
 	return nil
 }
 
@@ -1561,6 +1714,9 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 			return err
 		}
 	}
+	if err := validateDatumRetryPolicy(pipelineInfo.Details.DatumRetryPolicy); err != nil {
+		return errors.Wrapf(err, "invalid datum retry policy")
+	}
 	if pipelineInfo.Details.PodSpec != "" && !json.Valid([]byte(pipelineInfo.Details.PodSpec)) {
 		return errors.Errorf("malformed PodSpec")
 	}
@@ -1586,6 +1742,40 @@ func (a *apiServer) validatePipeline(pipelineInfo *pps.PipelineInfo) error {
 	return nil
 }
 
+func validateDatumRetryPolicy(policy *pps.DatumRetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxAttempts < 0 {
+		return errors.Errorf("max_attempts cannot be negative")
+	}
+	for _, d := range []*types.Duration{policy.InitialBackoff, policy.MaxBackoff} {
+		if d == nil {
+			continue
+		}
+		duration, err := types.DurationFromProto(d)
+		if err != nil {
+			return err
+		}
+		if duration < 0 {
+			return errors.Errorf("backoff cannot be negative")
+		}
+	}
+	retry := make(map[int32]bool)
+	for _, code := range policy.RetryExitCodes {
+		retry[code] = true
+	}
+	for _, code := range policy.FailFastExitCodes {
+		if retry[code] {
+			return errors.Errorf("exit code %d cannot be in both retry_exit_codes and fail_fast_exit_codes", code)
+		}
+	}
+	if policy.RetryOOMKilledOnly && len(policy.RetryExitCodes) > 0 {
+		return errors.Errorf("retry_exit_codes cannot be set with retry_oom_killed_only")
+	}
+	return nil
+}
+
 func branchProvenance(input *pps.Input) []*pfs.Branch {
 	var result []*pfs.Branch
 	pps.VisitInput(input, func(input *pps.Input) error {
@@ -1595,6 +1785,9 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 		if input.Cron != nil {
 			result = append(result, client.NewBranch(input.Cron.Repo, "master"))
 		}
+		if input.URL != nil {
+			result = append(result, client.NewBranch(input.URL.Repo, "master"))
+		}
 		return nil
 	})
 	return result
@@ -1620,6 +1813,8 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.URL != nil:
+				repo = input.URL.Repo
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -1648,6 +1843,8 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.URL != nil:
+				repo = input.URL.Repo
 			default:
 				return nil // no scope to set: input is not a repo
 			}
@@ -1774,31 +1971,33 @@ func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, o
 		Pipeline: request.Pipeline,
 		Version:  1,
 		Details: &pps.PipelineInfo_Details{
-			Transform:             request.Transform,
-			TFJob:                 request.TFJob,
-			ParallelismSpec:       request.ParallelismSpec,
-			Input:                 request.Input,
-			OutputBranch:          request.OutputBranch,
-			Egress:                request.Egress,
-			CreatedAt:             now(),
-			ResourceRequests:      request.ResourceRequests,
-			ResourceLimits:        request.ResourceLimits,
-			SidecarResourceLimits: request.SidecarResourceLimits,
-			Description:           request.Description,
-			Salt:                  request.Salt,
-			Service:               request.Service,
-			Spout:                 request.Spout,
-			DatumSetSpec:          request.DatumSetSpec,
-			DatumTimeout:          request.DatumTimeout,
-			JobTimeout:            request.JobTimeout,
-			DatumTries:            request.DatumTries,
-			SchedulingSpec:        request.SchedulingSpec,
-			PodSpec:               request.PodSpec,
-			PodPatch:              request.PodPatch,
-			S3Out:                 request.S3Out,
-			Metadata:              request.Metadata,
-			ReprocessSpec:         request.ReprocessSpec,
-			Autoscaling:           request.Autoscaling,
+			Transform:              request.Transform,
+			TFJob:                  request.TFJob,
+			ParallelismSpec:        request.ParallelismSpec,
+			Input:                  request.Input,
+			OutputBranch:           request.OutputBranch,
+			Egress:                 request.Egress,
+			CreatedAt:              now(),
+			ResourceRequests:       request.ResourceRequests,
+			ResourceLimits:         request.ResourceLimits,
+			SidecarResourceLimits:  request.SidecarResourceLimits,
+			Description:            request.Description,
+			Salt:                   request.Salt,
+			Service:                request.Service,
+			Spout:                  request.Spout,
+			DatumSetSpec:           request.DatumSetSpec,
+			DatumTimeout:           request.DatumTimeout,
+			JobTimeout:             request.JobTimeout,
+			DatumTries:             request.DatumTries,
+			DatumRetryPolicy:       request.DatumRetryPolicy,
+			QuarantineFailedDatums: request.QuarantineFailedDatums,
+			SchedulingSpec:         request.SchedulingSpec,
+			PodSpec:                request.PodSpec,
+			PodPatch:               request.PodPatch,
+			S3Out:                  request.S3Out,
+			Metadata:               request.Metadata,
+			ReprocessSpec:          request.ReprocessSpec,
+			Autoscaling:            request.Autoscaling,
 		},
 	}
 
@@ -1867,6 +2066,16 @@ func (a *apiServer) CreatePipelineInTransaction(
 				return err
 			}
 		}
+		if input.URL != nil {
+			if err := a.env.PfsServer().CreateRepoInTransaction(txnCtx,
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(input.URL.Repo),
+					Description: fmt.Sprintf("Copy of %s for pipeline %s.", input.URL.URL, request.Pipeline.Name),
+				},
+			); err != nil && !errutil.IsAlreadyExistError(err) {
+				return err
+			}
+		}
 		return nil
 	}); visitErr != nil {
 		return visitErr
@@ -2124,6 +2333,14 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
+		if input.URL != nil {
+			if input.URL.Repo == "" {
+				input.URL.Repo = fmt.Sprintf("%s_%s", pipelineName, input.URL.Name)
+			}
+			if input.URL.Glob == "" {
+				input.URL.Glob = "/*"
+			}
+		}
 		return nil
 	})
 }
@@ -2213,17 +2430,12 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string, details bo
 	if !details {
 		info.Details = nil // preserve old behavior
 	} else {
-		kubeClient := a.env.GetKubeClient()
 		if info.Details.Service != nil {
-			rcName := ppsutil.PipelineRcName(info.Pipeline.Name, info.Version)
-			service, err := kubeClient.CoreV1().Services(a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+			ip, err := a.workerRuntime.serviceIP(ctx, info)
 			if err != nil {
-				if !errutil.IsNotFoundError(err) {
-					return nil, err
-				}
-			} else {
-				info.Details.Service.IP = service.Spec.ClusterIP
+				return nil, err
 			}
+			info.Details.Service.IP = ip
 		}
 
 		// TODO: move this into ppsutil.GetPipelineDetails?
@@ -2401,7 +2613,6 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	if request.All {
-		request.Pipeline = &pps.Pipeline{}
 		pipelineInfo := &pps.PipelineInfo{}
 		deleted := make(map[string]struct{})
 		if err := a.pipelines.ReadOnly(ctx).List(pipelineInfo, col.DefaultOptions(), func(string) error {
@@ -2410,8 +2621,13 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 				// they could still show up in the list. Ignore them
 				return nil
 			}
-			request.Pipeline.Name = pipelineInfo.Pipeline.Name
-			err := a.deletePipeline(ctx, request)
+			// within a transaction, each pipeline's deletion is appended
+			// separately, so don't pass along request.All
+			err := a.deletePipeline(ctx, &pps.DeletePipelineRequest{
+				Pipeline: client.NewPipeline(pipelineInfo.Pipeline.Name),
+				Force:    request.Force,
+				KeepRepo: request.KeepRepo,
+			})
 			if err == nil {
 				deleted[pipelineInfo.Pipeline.Name] = struct{}{}
 			}
@@ -2427,19 +2643,16 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 }
 
 func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) error {
-	pipelineName := request.Pipeline.Name
-
-	// stop the pipeline to avoid interference from new jobs
-	if _, err := a.StopPipeline(ctx,
-		&pps.StopPipelineRequest{Pipeline: request.Pipeline}); err != nil && errutil.IsNotFoundError(err) {
-		logrus.Errorf("failed to stop pipeline, continuing with delete: %v", err)
-	} else if err != nil {
-		return errors.Wrapf(err, "error stopping pipeline %s", pipelineName)
+	if activeTxn, err := client.GetTransaction(ctx); err != nil {
+		return err
+	} else if activeTxn != nil {
+		return a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+			return txn.DeletePipeline(request)
+		}, nil)
 	}
-	// perform the rest of the deletion in a transaction
 	var deleteErr error
 	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		deleteErr = a.deletePipelineInTransaction(txnCtx, request)
+		deleteErr = a.stopAndDeletePipelineInTransaction(txnCtx, request)
 		// we still want deletion to succeed if it was merely incomplete, but warn the caller
 		if errors.Is(deleteErr, errIncompleteDeletion) {
 			return nil
@@ -2451,6 +2664,39 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	return deleteErr
 }
 
+// DeletePipelineInTransaction is identical to DeletePipeline except that it
+// can run inside an existing postgres transaction.  This is not an RPC.
+// Deleting all pipelines is not supported in a transaction, and an incomplete
+// deletion is only logged, since it can't be returned without failing the
+// transaction.
+func (a *apiServer) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if request.All {
+		return errors.New("cannot delete all pipelines in a transaction")
+	}
+	if err := a.stopAndDeletePipelineInTransaction(txnCtx, request); err != nil {
+		if errors.Is(err, errIncompleteDeletion) {
+			logrus.Warnf("pipeline %s: %v", request.Pipeline.Name, err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *apiServer) stopAndDeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
+	// stop the pipeline to avoid interference from new jobs
+	if err := a.StopPipelineInTransaction(txnCtx,
+		&pps.StopPipelineRequest{Pipeline: request.Pipeline}); err != nil && errutil.IsNotFoundError(err) {
+		logrus.Errorf("failed to stop pipeline, continuing with delete: %v", err)
+	} else if err != nil {
+		return errors.Wrapf(err, "error stopping pipeline %s", request.Pipeline.Name)
+	}
+	return a.deletePipelineInTransaction(txnCtx, request)
+}
+
 func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
 	pipelineName := request.Pipeline.Name
 
@@ -2570,6 +2816,8 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 	}
 	// delete cron after main repo is deleted or has provenance removed
 	// cron repos are only used to trigger jobs, so don't keep them even with KeepRepo
+	// url repos are copies of external data that can be synced again, so
+	// they're deleted too
 	if pipelineInfo.Details != nil {
 		if err := pps.VisitInput(pipelineInfo.Details.Input, func(input *pps.Input) error {
 			if input.Cron != nil {
@@ -2579,6 +2827,12 @@ func (a *apiServer) deletePipelineInTransaction(txnCtx *txncontext.TransactionCo
 					Force: request.Force,
 				})
 			}
+			if input.URL != nil {
+				return a.env.PfsServer().DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+					Repo:  client.NewRepo(input.URL.Repo),
+					Force: request.Force,
+				})
+			}
 			return nil
 		}); err != nil {
 			return err
@@ -2601,43 +2855,53 @@ func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelin
 		return nil, errors.New("request.Pipeline cannot be nil")
 	}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err != nil {
-			return err
-		}
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StartPipeline(request)
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
 
-		// check if the caller is authorized to update this pipeline
-		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return err
-		}
+// StartPipelineInTransaction is identical to StartPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StartPipelineRequest) error {
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
 
-		// Restore branch provenance, which may create a new output commit/job
-		provenance := append(branchProvenance(pipelineInfo.Details.Input),
-			client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
-		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return err
-		}
-		// restore same provenance to meta repo
-		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-			Provenance: provenance,
-		}); err != nil {
-			return err
-		}
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err != nil {
+		return err
+	}
 
-		newPipelineInfo := &pps.PipelineInfo{}
-		return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-			newPipelineInfo.Stopped = false
-			return nil
-		})
+	// check if the caller is authorized to update this pipeline
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+
+	// Restore branch provenance, which may create a new output commit/job
+	provenance := append(branchProvenance(pipelineInfo.Details.Input),
+		client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
+	if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
 	}); err != nil {
-		return nil, err
+		return err
 	}
-	return &types.Empty{}, nil
+	// restore same provenance to meta repo
+	if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+		Provenance: provenance,
+	}); err != nil {
+		return err
+	}
+
+	newPipelineInfo := &pps.PipelineInfo{}
+	return a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+		newPipelineInfo.Stopped = false
+		return nil
+	})
 }
 
 // StopPipeline implements the protobuf pps.StopPipeline RPC
@@ -2648,50 +2912,60 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 		return nil, errors.New("request.Pipeline cannot be nil")
 	}
 
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
-		if err == nil {
-			// check if the caller is authorized to update this pipeline
-			// don't pass in the input - stopping the pipeline means they won't be read anymore,
-			// so we don't need to check any permissions
-			if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
-				return err
-			}
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StopPipeline(request)
+	}, nil); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
 
-			// Remove branch provenance to prevent new output and meta commits from being created
-			if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
-				Provenance: nil,
-			}); err != nil {
-				return err
-			}
-			if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
-				Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
-				Provenance: nil,
-			}); err != nil && !errutil.IsNotFoundError(err) {
-				// don't error if we're stopping a spout or service pipeline
-				return err
-			}
+// StopPipelineInTransaction is identical to StopPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StopPipelineRequest) error {
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
+	}
 
-			newPipelineInfo := &pps.PipelineInfo{}
-			if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
-				newPipelineInfo.Stopped = true
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else if !errutil.IsNotFoundError(err) {
+	pipelineInfo, err := a.InspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
+	if err == nil {
+		// check if the caller is authorized to update this pipeline
+		// don't pass in the input - stopping the pipeline means they won't be read anymore,
+		// so we don't need to check any permissions
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpStartStop, pipelineInfo.Details.Input, pipelineInfo.Pipeline.Name); err != nil {
 			return err
 		}
 
-		// Kill any remaining jobs
-		// if the pipeline output repo doesn't exist, we technically run this without authorization,
-		// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
-		return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
-	}); err != nil {
-		return nil, err
+		// Remove branch provenance to prevent new output and meta commits from being created
+		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil {
+			return err
+		}
+		if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.MetaRepoType).NewBranch(pipelineInfo.Details.OutputBranch),
+			Provenance: nil,
+		}); err != nil && !errutil.IsNotFoundError(err) {
+			// don't error if we're stopping a spout or service pipeline
+			return err
+		}
+
+		newPipelineInfo := &pps.PipelineInfo{}
+		if err := a.updatePipeline(txnCtx, pipelineInfo.Pipeline.Name, newPipelineInfo, func() error {
+			newPipelineInfo.Stopped = true
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if !errutil.IsNotFoundError(err) {
+		return err
 	}
-	return &types.Empty{}, nil
+
+	// Kill any remaining jobs
+	// if the pipeline output repo doesn't exist, we technically run this without authorization,
+	// but it's not clear what authorization means in that case, and those jobs are doomed, anyway
+	return a.stopAllJobsInPipeline(txnCtx, request.Pipeline)
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -2803,6 +3077,9 @@ func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreateSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.requireKube("CreateSecret"); err != nil {
+		return nil, err
+	}
 	var s v1.Secret
 	if err := json.Unmarshal(request.GetFile(), &s); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal secret")
@@ -2832,6 +3109,9 @@ func (a *apiServer) DeleteSecret(ctx context.Context, request *pps.DeleteSecretR
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "DeleteSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.requireKube("DeleteSecret"); err != nil {
+		return nil, err
+	}
 	if err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Delete(request.Secret.Name, &metav1.DeleteOptions{}); err != nil {
 		return nil, errors.Wrapf(err, "failed to delete secret")
 	}
@@ -2845,6 +3125,9 @@ func (a *apiServer) InspectSecret(ctx context.Context, request *pps.InspectSecre
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "InspectSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.requireKube("InspectSecret"); err != nil {
+		return nil, err
+	}
 	secret, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(request.Secret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get secret")
@@ -2872,6 +3155,9 @@ func (a *apiServer) ListSecret(ctx context.Context, in *types.Empty) (response *
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "ListSecret")
 	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
 
+	if err := a.requireKube("ListSecret"); err != nil {
+		return nil, err
+	}
 	secrets, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).List(metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	})
@@ -2910,6 +3196,9 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 		return nil, err
 	}
 
+	if _, ok := a.workerRuntime.(*kubeWorkerRuntime); !ok {
+		return &types.Empty{}, nil // secrets only exist in kubernetes
+	}
 	if err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	}); err != nil {
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// localWorkerRuntime is a workerRuntime that runs each pipeline worker as a
+// process on the same host as pachd, so that pipelines can be run without
+// kubernetes (e.g. on a laptop or a CI machine). Workers connect directly to
+// pachd's peer port instead of to a sidecar, and each one gets its own
+// directory under LOCAL_WORKER_ROOT in which it places its inputs, runs the
+// user's code, and writes its logs (to 'worker.log').
+//
+// Pipeline images are ignored: the user's code runs on the host, so the
+// commands in a pipeline's transform must be available there. Pipelines that
+// need kubernetes to run (i.e. those that use kubernetes secrets or S3
+// inputs/outputs) are rejected.
+type localWorkerRuntime struct {
+	a       *apiServer
+	ctx     context.Context
+	binary  string
+	root    string
+	crashes chan localWorkerCrash
+
+	mu     sync.Mutex
+	groups map[string]*localWorkerGroup // keyed by group name
+}
+
+// newLocalWorkerRuntime returns a localWorkerRuntime for 'a'. Workers are
+// only started by the PPS master, and are stopped (by stopAllWorkers) when 'a'
+// stops being the master, so another API server in the same process can take
+// over without inheriting workers it doesn't know about.
+func newLocalWorkerRuntime(a *apiServer) *localWorkerRuntime {
+	return &localWorkerRuntime{
+		a:       a,
+		ctx:     a.env.Context(),
+		binary:  a.env.Config().LocalWorkerBinary,
+		root:    a.env.Config().LocalWorkerRoot,
+		crashes: make(chan localWorkerCrash, 100),
+		groups:  make(map[string]*localWorkerGroup),
+	}
+}
+
+type localWorkerGroup struct {
+	workerGroup
+	version uint64
+	env     []string // environment variables shared by all workers in the group
+	workers []*localWorker
+}
+
+type localWorker struct {
+	name   string // the worker's equivalent of a pod name
+	dir    string
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type localWorkerCrash struct {
+	pipeline string
+	version  uint64
+	reason   string
+}
+
+func (r *localWorkerRuntime) createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	log.Infof("PPS master: upserting local workers for %q", pipelineInfo.Pipeline.Name)
+	options, err := r.a.getWorkerOptions(pipelineInfo)
+	if err != nil {
+		return noValidOptionsErr{err}
+	}
+	if len(pipelineInfo.Details.Transform.Secrets) > 0 {
+		return noValidOptionsErr{errors.New("secrets are not supported by the local worker runtime")}
+	}
+	if options.s3GatewayPort != 0 {
+		return noValidOptionsErr{errors.New("S3 inputs and outputs are not supported by the local worker runtime")}
+	}
+	env := append(os.Environ(),
+		"PACH_IN_WORKER=true",
+		fmt.Sprintf("%s=%d", client.PeerPortEnv, r.a.peerPort),
+		fmt.Sprintf("%s=%s", client.PPSSpecCommitEnv, options.specCommit),
+		fmt.Sprintf("%s=%s", client.PPSEtcdPrefixEnv, r.a.etcdPrefix),
+	)
+	for _, e := range options.workerEnv {
+		if e.ValueFrom != nil {
+			return noValidOptionsErr{errors.Errorf("environment variable %q is set from a secret, which is not supported by the local worker runtime", e.Name)}
+		}
+		env = append(env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.groups[options.rcName]; ok {
+		return nil
+	}
+	r.groups[options.rcName] = &localWorkerGroup{
+		workerGroup: workerGroup{
+			name:        options.rcName,
+			pipeline:    pipelineInfo.Pipeline.Name,
+			annotations: options.annotations,
+		},
+		version: pipelineInfo.Version,
+		env:     env,
+	}
+	return nil
+}
+
+func (r *localWorkerRuntime) listWorkers(ctx context.Context, pipeline string) ([]*workerGroup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*workerGroup
+	for _, g := range r.groups {
+		if pipeline == "" || g.pipeline == pipeline {
+			group := g.workerGroup
+			result = append(result, &group)
+		}
+	}
+	return result, nil
+}
+
+func (r *localWorkerRuntime) scaleWorkers(ctx context.Context, name string, replicas int32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	g, ok := r.groups[name]
+	if !ok {
+		return errors.Errorf("worker group %q not found", name)
+	}
+	for len(g.workers) < int(replicas) {
+		w, err := r.startWorker(g, len(g.workers))
+		if err != nil {
+			return err
+		}
+		g.workers = append(g.workers, w)
+	}
+	for len(g.workers) > int(replicas) {
+		stopWorker(g.workers[len(g.workers)-1])
+		g.workers = g.workers[:len(g.workers)-1]
+	}
+	g.replicas = replicas
+	return nil
+}
+
+func (r *localWorkerRuntime) deleteWorkers(ctx context.Context, pipeline string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, g := range r.groups {
+		if g.pipeline != pipeline {
+			continue
+		}
+		for _, w := range g.workers {
+			stopWorker(w)
+		}
+		delete(r.groups, name)
+		if err := os.RemoveAll(filepath.Join(r.root, name)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+// stopAllWorkers stops every worker started by 'r' and forgets their groups,
+// leaving 'r' as it was when it was created.
+func (r *localWorkerRuntime) stopAllWorkers() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, g := range r.groups {
+		for _, w := range g.workers {
+			stopWorker(w)
+		}
+		delete(r.groups, name)
+	}
+}
+
+func (r *localWorkerRuntime) watchWorkers(ctx context.Context, crash func(pipeline string, version uint64, reason string) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case c := <-r.crashes:
+			if err := crash(c.pipeline, c.version, c.reason); err != nil {
+				return errors.Wrap(err, "error moving pipeline to CRASHING")
+			}
+		}
+	}
+}
+
+// serviceIP always returns "", as local workers aren't exposed through a
+// service. A pipeline's service can be reached on the pachd host at its
+// internal port.
+func (r *localWorkerRuntime) serviceIP(ctx context.Context, pipelineInfo *pps.PipelineInfo) (string, error) {
+	return "", nil
+}
+
+// startWorker starts the i'th worker in 'g'. The worker process is restarted
+// (with backoff) whenever it exits, until it's stopped by stopWorker.
+func (r *localWorkerRuntime) startWorker(g *localWorkerGroup, i int) (*localWorker, error) {
+	dir := filepath.Join(r.root, g.name, strconv.Itoa(i))
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	ctx, cancel := context.WithCancel(r.ctx)
+	w := &localWorker{
+		name:   fmt.Sprintf("%s-%d", g.name, i),
+		dir:    dir,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		backoff.RetryUntilCancel(ctx, func() error {
+			err := r.runWorker(ctx, g, w)
+			if ctx.Err() != nil {
+				return nil // stopped by stopWorker
+			}
+			return errors.Errorf("worker %q exited: %v", w.name, err)
+		}, backoff.NewInfiniteBackOff(), backoff.NotifyCtx(ctx, "local worker "+w.name))
+	}()
+	return w, nil
+}
+
+// runWorker runs a single worker process for 'w' until it exits
+func (r *localWorkerRuntime) runWorker(ctx context.Context, g *localWorkerGroup, w *localWorker) error {
+	// Local workers share a host, so each one listens on its own port and
+	// registers it (along with its IP) in etcd, where pachd finds it
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	logFile, err := os.OpenFile(filepath.Join(w.dir, "worker.log"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer logFile.Close()
+
+	cmd := exec.Command(r.binary)
+	cmd.SysProcAttr = workerSysProcAttr()
+	cmd.Dir = w.dir
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.Env = append(append([]string{}, g.env...),
+		fmt.Sprintf("%s=%s", client.PPSWorkerIPEnv, net.JoinHostPort("127.0.0.1", strconv.Itoa(port))),
+		fmt.Sprintf("%s=%d", client.PPSWorkerPortEnv, port),
+		fmt.Sprintf("%s=%s", client.PPSPodNameEnv, w.name),
+		fmt.Sprintf("PPS_WORKER_ROOT=%s", w.dir),
+	)
+	if err := cmd.Start(); err != nil {
+		// The worker binary can't be run at all (e.g. it's not in PATH), which
+		// retrying won't fix, so surface the error on the pipeline
+		r.reportCrash(g, err.Error())
+		return errors.EnsureStack(err)
+	}
+	log.Infof("PPS master: started local worker %q (pid %d) in %s", w.name, cmd.Process.Pid, w.dir)
+	waitErr := make(chan error, 1)
+	go func() { waitErr <- cmd.Wait() }()
+	select {
+	case err = <-waitErr:
+	case <-ctx.Done():
+		killProcessGroup(cmd.Process.Pid)
+		err = <-waitErr
+	}
+	// Processes started by the worker may outlive it, so kill whatever is left
+	// in its group even if it exited on its own
+	killProcessGroup(cmd.Process.Pid)
+	return errors.EnsureStack(err)
+}
+
+// killProcessGroup kills every process in the process group led by 'pid'
+func killProcessGroup(pid int) {
+	if err := syscall.Kill(-pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		log.Errorf("PPS master: error killing local worker process group %d: %v", pid, err)
+	}
+}
+
+func (r *localWorkerRuntime) reportCrash(g *localWorkerGroup, reason string) {
+	select {
+	case r.crashes <- localWorkerCrash{pipeline: g.pipeline, version: g.version, reason: reason}:
+	default:
+		// no one is watching (or the watcher is behind), and the pipeline will
+		// be marked as crashing by a later failure anyway
+	}
+}
+
+// stopWorker kills w's process and waits for it to exit
+func stopWorker(w *localWorker) {
+	w.cancel()
+	<-w.done
+}
+
+// freePort returns a TCP port on localhost that's not currently in use
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
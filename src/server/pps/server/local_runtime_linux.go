@@ -0,0 +1,19 @@
+// +build linux
+
+package server
+
+import "syscall"
+
+// workerSysProcAttr returns the attributes of a local worker process. The
+// worker runs in its own process group, along with the user's code that it
+// starts, so that all of them can be killed together, and it's killed if
+// pachd dies so that it doesn't keep running (and stay registered in etcd)
+// alongside the workers started by the next PPS master. (The signal is sent
+// when the thread that started the worker exits, but pachd never locks
+// goroutines to threads, so its threads last as long as it does.)
+func workerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{
+		Setpgid:   true,
+		Pdeathsig: syscall.SIGKILL,
+	}
+}
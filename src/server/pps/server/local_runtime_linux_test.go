@@ -0,0 +1,55 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+// pachdHelperEnv is set when the test binary is re-run as a stand-in for
+// pachd, and names the file in which it records its worker's pid
+const pachdHelperEnv = "LOCAL_RUNTIME_TEST_PACHD_PID_FILE"
+
+func TestLocalRuntimeWorkerDiesWithPachd(t *testing.T) {
+	if pidFile := os.Getenv(pachdHelperEnv); pidFile != "" {
+		// this is the stand-in for pachd: it starts a worker, records its
+		// pid, and then runs until it's killed
+		cmd := exec.Command("sleep", "1000")
+		cmd.SysProcAttr = workerSysProcAttr()
+		require.NoError(t, cmd.Start())
+		require.NoError(t, ioutil.WriteFile(pidFile, []byte(strconv.Itoa(cmd.Process.Pid)), 0600))
+		time.Sleep(time.Hour)
+		return
+	}
+	pidFile := filepath.Join(t.TempDir(), "worker.pid")
+	pachd := exec.Command(os.Args[0], "-test.run=^TestLocalRuntimeWorkerDiesWithPachd$")
+	pachd.Env = append(os.Environ(), pachdHelperEnv+"="+pidFile)
+	require.NoError(t, pachd.Start())
+	var pid int
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		data, err := ioutil.ReadFile(pidFile)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		pid, err = strconv.Atoi(strings.TrimSpace(string(data)))
+		return errors.EnsureStack(err)
+	})
+	require.True(t, processRunning(pid))
+
+	require.NoError(t, pachd.Process.Kill())
+	pachd.Wait()
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if processRunning(pid) {
+			return errors.Errorf("worker process %d is still running", pid)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package server
+
+import "syscall"
+
+// workerSysProcAttr returns the attributes of a local worker process. The
+// worker runs in its own process group, along with the user's code that it
+// starts, so that all of them can be killed together. Unlike on linux, the
+// worker isn't killed if pachd dies.
+func workerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+)
+
+// newTestLocalRuntime returns a localWorkerRuntime that runs 'binary' as its
+// worker, with a single (empty) worker group for the pipeline "test"
+func newTestLocalRuntime(t *testing.T, binary string) *localWorkerRuntime {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	r := &localWorkerRuntime{
+		ctx:     ctx,
+		binary:  binary,
+		root:    t.TempDir(),
+		crashes: make(chan localWorkerCrash, 1),
+		groups:  make(map[string]*localWorkerGroup),
+	}
+	r.groups["test-v1"] = &localWorkerGroup{
+		workerGroup: workerGroup{name: "test-v1", pipeline: "test"},
+		version:     1,
+	}
+	return r
+}
+
+func TestLocalRuntimeScaleAndDelete(t *testing.T) {
+	// the "worker" logs its pod name and then runs until it's killed
+	script := filepath.Join(t.TempDir(), "worker.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"$PPS_POD_NAME\"\nexec sleep 1000\n"), 0755))
+	r := newTestLocalRuntime(t, script)
+	ctx := context.Background()
+
+	require.NoError(t, r.scaleWorkers(ctx, "test-v1", 2))
+	groups, err := r.listWorkers(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groups))
+	require.Equal(t, int32(2), groups[0].replicas)
+	for _, name := range []string{"0", "1"} {
+		logPath := filepath.Join(r.root, "test-v1", name, "worker.log")
+		require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+			log, err := ioutil.ReadFile(logPath)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			return require.EqualOrErr("test-v1-"+name+"\n", string(log))
+		})
+	}
+
+	require.NoError(t, r.scaleWorkers(ctx, "test-v1", 1))
+	require.Equal(t, 1, len(r.groups["test-v1"].workers))
+
+	require.NoError(t, r.deleteWorkers(ctx, "test"))
+	groups, err = r.listWorkers(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groups))
+	_, err = os.Stat(filepath.Join(r.root, "test-v1"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestLocalRuntimeReportsCrash(t *testing.T) {
+	r := newTestLocalRuntime(t, filepath.Join(t.TempDir(), "missing-worker"))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, r.scaleWorkers(ctx, "test-v1", 1))
+	defer r.deleteWorkers(ctx, "test")
+	errCrashed := errors.New("crashed")
+	err := r.watchWorkers(ctx, func(pipeline string, version uint64, reason string) error {
+		require.Equal(t, "test", pipeline)
+		require.Equal(t, uint64(1), version)
+		return errCrashed
+	})
+	require.ErrorIs(t, err, errCrashed)
+}
+
+func TestLocalRuntimeKillsProcessGroup(t *testing.T) {
+	// the "worker" starts a child process (as it would the user's code),
+	// records its pid, and then runs until it's killed
+	script := filepath.Join(t.TempDir(), "worker.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\nsleep 1000 &\necho $! > child.pid\nexec sleep 1000\n"), 0755))
+	r := newTestLocalRuntime(t, script)
+	ctx := context.Background()
+
+	require.NoError(t, r.scaleWorkers(ctx, "test-v1", 1))
+	var pid int
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		data, err := ioutil.ReadFile(filepath.Join(r.root, "test-v1", "0", "child.pid"))
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		pid, err = strconv.Atoi(strings.TrimSpace(string(data)))
+		return errors.EnsureStack(err)
+	})
+	require.True(t, processRunning(pid))
+
+	require.NoError(t, r.deleteWorkers(ctx, "test"))
+	require.NoErrorWithinTRetry(t, 10*time.Second, func() error {
+		if processRunning(pid) {
+			return errors.Errorf("child process %d is still running", pid)
+		}
+		return nil
+	})
+}
+
+// processRunning returns true if the process 'pid' exists and isn't a zombie
+func processRunning(pid int) bool {
+	if err := syscall.Kill(pid, 0); err != nil {
+		return false
+	}
+	stat, err := ioutil.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return !os.IsNotExist(err)
+	}
+	// the state follows the parenthesized command name
+	fields := strings.Fields(string(stat[bytes.LastIndexByte(stat, ')')+1:]))
+	return len(fields) == 0 || fields[0] != "Z"
+}
+
+// setTestEnv sets the environment variable 'key' until the end of the test
+func setTestEnv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestLocalRuntimePipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	binary := filepath.Join(t.TempDir(), "worker")
+	build := exec.Command("go", "build", "-o", binary, "github.com/pachyderm/pachyderm/v2/src/server/cmd/worker")
+	build.Stdout, build.Stderr = os.Stdout, os.Stderr
+	require.NoError(t, build.Run())
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t), func(config *serviceenv.Configuration) {
+		config.WorkerRuntime = serviceenv.LocalWorkerRuntime
+		config.LocalWorkerBinary = binary
+		config.LocalWorkerRoot = t.TempDir()
+	})
+	// Local workers inherit pachd's environment, which is how they find etcd
+	// and postgres
+	config := env.ServiceEnv.Config()
+	for key, value := range map[string]string{
+		"ETCD_SERVICE_HOST": config.EtcdHost,
+		"ETCD_SERVICE_PORT": config.EtcdPort,
+		"PG_BOUNCER_HOST":   config.PGBouncerHost,
+		"PG_BOUNCER_PORT":   strconv.Itoa(config.PGBouncerPort),
+		"POSTGRES_HOST":     config.PostgresHost,
+		"POSTGRES_PORT":     strconv.Itoa(config.PostgresPort),
+		"POSTGRES_DATABASE": config.PostgresDBName,
+		"POSTGRES_USER":     config.PostgresUser,
+		"POSTGRES_PASSWORD": config.PostgresPassword,
+		"PACH_ROOT":         config.StorageRoot,
+		"STORAGE_BACKEND":   config.StorageBackend,
+		"METRICS":           "false",
+	} {
+		setTestEnv(t, key, value)
+	}
+	txnEnv := &txnenv.TransactionEnv{}
+	txnEnv.Initialize(env.ServiceEnv, env.TransactionServer)
+	ppsServer, err := NewAPIServer(env.ServiceEnv, txnEnv, nil)
+	require.NoError(t, err)
+	env.UsePPSServer(ppsServer)
+
+	c := env.PachClient
+	require.NoError(t, c.CreateRepo("in"))
+	require.NoError(t, c.CreatePipeline("copy", "", []string{"sh"}, []string{"cp pfs/in/* pfs/out/"},
+		nil, client.NewPFSInput("in", "/*"), "", false))
+	t.Cleanup(func() { c.DeletePipeline("copy", true) })
+	commit, err := c.StartCommit("in", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "file", strings.NewReader("foo")))
+	require.NoError(t, c.FinishCommit("in", "master", commit.ID))
+
+	require.NoErrorWithinT(t, 2*time.Minute, func() error {
+		_, err := c.WaitCommit("copy", "master", commit.ID)
+		return err
+	})
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(client.NewCommit("copy", "master", commit.ID), "file", &buf))
+	require.Equal(t, "foo", buf.String())
+}
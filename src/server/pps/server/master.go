@@ -8,12 +8,10 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dlock"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
-	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/middleware/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
@@ -33,8 +31,7 @@ var (
 		"Unschedulable":    true,
 	}
 
-	zero     int32 // used to turn down RCs in scaleDownWorkersForPipeline
-	falseVal bool  // used to delete RCs in deletePipelineResources and restartPipeline()
+	falseVal bool // used to delete RCs in deletePipelineResources and restartPipeline()
 )
 
 type eventType int
@@ -122,6 +119,10 @@ func (a *apiServer) master() {
 		log.Infof("PPS master: launching master process")
 		m.masterCtx = ctx
 		m.run()
+		if r, ok := a.workerRuntime.(*localWorkerRuntime); ok {
+			// whichever PPS master runs next will start workers itself
+			r.stopAllWorkers()
+		}
 		return errors.Wrapf(ctx.Err(), "ppsMaster.Run() exited unexpectedly")
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
 		log.Errorf("PPS master: error running the master process: %v; retrying in %v", err, d)
@@ -230,54 +231,9 @@ func (m *ppsMaster) deletePipelineResources(pipelineName string) (retErr error)
 	// Same for cancelCrashingMonitor
 	m.cancelCrashingMonitor(pipelineName)
 
-	kubeClient := m.a.env.GetKubeClient()
-	namespace := m.a.namespace
-
-	// Delete any services associated with op.pipeline
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipelineName)
-	opts := &metav1.DeleteOptions{
-		OrphanDependents: &falseVal,
-	}
-	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list services")
-	}
-	for _, service := range services.Items {
-		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete service %q", service.Name)
-			}
-		}
-	}
-
-	// Delete any secrets associated with op.pipeline
-	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list secrets")
-	}
-	for _, secret := range secrets.Items {
-		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
-			}
-		}
-	}
-
-	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
+	// Delete the pipeline's workers, which will cause pollPipelines to stop
 	// polling it.
-	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
-	if err != nil {
-		return errors.Wrapf(err, "could not list RCs")
-	}
-	for _, rc := range rcs.Items {
-		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
-			if !errutil.IsNotFoundError(err) {
-				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
-			}
-		}
-	}
-
-	return nil
+	return m.a.workerRuntime.deleteWorkers(ctx, pipelineName)
 }
 
 // setPipelineState is a PPS-master-specific helper that wraps
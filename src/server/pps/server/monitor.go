@@ -19,7 +19,10 @@ package server
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -27,11 +30,13 @@ import (
 	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
@@ -45,6 +50,12 @@ import (
 const crashingBackoff = time.Second * 15
 const scaleUpInterval = time.Second * 30
 
+// urlInputStateBranch is the branch of a url input's repo that records the
+// state of the objects in its url, so that unchanged objects aren't copied
+// again. It's kept off of master so that it isn't part of any datum.
+const urlInputStateBranch = "url_input_state"
+const urlInputStateFile = "state.json"
+
 //////////////////////////////////////////////////////////////////////////////
 //                     Locking Functions                                    //
 // - These functions lock monitorCancelsMu in order to start or stop a      //
@@ -190,6 +201,14 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 					backoff.NotifyCtx(ctx, "cron for "+in.Cron.Name))
 			})
 		}
+		if in.URL != nil {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return m.makeURLCommits(ctx, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(ctx, "url input "+in.URL.Name))
+			})
+		}
 		return nil
 	})
 	if pipelineInfo.Details.Autoscaling {
@@ -341,10 +360,7 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 							return err
 						}
 						if nClaims < nTasks {
-							kubeClient := m.a.env.GetKubeClient()
-							namespace := m.a.namespace
-							rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-							scale, err := rc.GetScale(pipelineInfo.Details.WorkerRc, metav1.GetOptions{})
+							rcs, err := m.a.workerRuntime.listWorkers(ctx, pipeline)
 							n := nTasks
 							if n > int64(pipelineInfo.Details.ParallelismSpec.Constant) {
 								n = int64(pipelineInfo.Details.ParallelismSpec.Constant)
@@ -352,9 +368,17 @@ func (m *ppsMaster) monitorPipeline(ctx context.Context, pipelineInfo *pps.Pipel
 							if err != nil {
 								return err
 							}
-							if int64(scale.Spec.Replicas) < n {
-								scale.Spec.Replicas = int32(n)
-								if _, err := rc.UpdateScale(pipelineInfo.Details.WorkerRc, scale); err != nil {
+							var rc *workerGroup
+							for _, g := range rcs {
+								if g.name == pipelineInfo.Details.WorkerRc {
+									rc = g
+								}
+							}
+							if rc == nil {
+								return errRCNotFound
+							}
+							if int64(rc.replicas) < n {
+								if err := m.a.workerRuntime.scaleWorkers(ctx, rc.name, int32(n)); err != nil {
 									return err
 								}
 							}
@@ -481,3 +505,148 @@ func (m *ppsMaster) getLatestCronTime(ctx context.Context, in *pps.Input) (time.
 	}
 	return latestTime, nil
 }
+
+// urlObjectState is the size and ETag of an object in a url input's prefix
+// when it was last copied into the input's repo.
+type urlObjectState struct {
+	Size int64  `json:"size"`
+	ETag string `json:"etag"`
+}
+
+// urlInputPath returns the path in a url input's repo that the object at name
+// is copied to. Object storage lists every object whose name starts with
+// prefix, so objects that aren't the prefix itself or under it as a
+// directory (e.g. "dirx/a" for the prefix "dir") are reported as not part of
+// the input.
+func urlInputPath(prefix, name string) (string, bool) {
+	dir := strings.TrimSuffix(prefix, "/")
+	switch {
+	case dir == "":
+		return path.Join("/", name), true
+	case name == dir:
+		return path.Join("/", path.Base(name)), true
+	case strings.HasPrefix(name, dir+"/"):
+		return path.Join("/", strings.TrimPrefix(name, dir+"/")), true
+	default:
+		return "", false
+	}
+}
+
+// urlTick copies the objects under 'in's url that changed since 'state' was
+// recorded into 'in's repo, and returns the state of the objects that are
+// now in the repo. No commit is made if nothing changed.
+func urlTick(pachClient *client.APIClient, objClient obj.Client, prefix string, in *pps.URLInput, state map[string]urlObjectState) (map[string]urlObjectState, error) {
+	ctx := pachClient.Ctx()
+	newState := make(map[string]urlObjectState)
+	changed := make(map[string]string)
+	if err := objClient.WalkInfo(ctx, prefix, func(info *obj.ObjectInfo) error {
+		p, ok := urlInputPath(prefix, info.Name)
+		if !ok {
+			return nil
+		}
+		objState := urlObjectState{Size: info.Size, ETag: info.ETag}
+		if prev, ok := state[p]; !ok || prev != objState {
+			changed[p] = info.Name
+		}
+		newState[p] = objState
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var removed []string
+	if in.DeleteRemoved {
+		for p := range state {
+			if _, ok := newState[p]; !ok {
+				removed = append(removed, p)
+			}
+		}
+	}
+	if len(changed) == 0 && len(removed) == 0 {
+		return newState, nil
+	}
+	if err := pachClient.WithModifyFileClient(
+		client.NewRepo(in.Repo).NewCommit("master", ""),
+		func(m client.ModifyFile) error {
+			for _, p := range removed {
+				if err := m.DeleteFile(p); err != nil {
+					return err
+				}
+			}
+			for p, name := range changed {
+				if err := miscutil.WithPipe(func(w io.Writer) error {
+					return objClient.Get(ctx, name, w)
+				}, func(r io.Reader) error {
+					return m.PutFile(p, r)
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+	// The state is written after the data, so if writing it fails the next
+	// tick copies the same objects again rather than skipping them.
+	data, err := json.Marshal(newState)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := pachClient.PutFile(client.NewCommit(in.Repo, urlInputStateBranch, ""),
+		urlInputStateFile, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return newState, nil
+}
+
+// makeURLCommits keeps a single url input's repo in sync with its url. It's a
+// helper function called by monitorPipeline.
+func (m *ppsMaster) makeURLCommits(ctx context.Context, in *pps.Input) error {
+	schedule, err := cron.ParseStandard(in.URL.Spec)
+	if err != nil {
+		return err // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	url, err := obj.ParseURL(in.URL.URL)
+	if err != nil {
+		return err // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url, false)
+	if err != nil {
+		return err
+	}
+	pachClient := m.a.env.GetPachClient(ctx)
+	state, err := getURLInputState(pachClient, in.URL)
+	if err != nil {
+		return err
+	}
+	for {
+		// sync right away, so that changes made while the monitor wasn't
+		// running are picked up without waiting for the next tick
+		state, err = urlTick(pachClient, objClient, url.Object, in.URL, state)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(time.Until(schedule.Next(time.Now()))):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// getURLInputState is a helper used by m.makeURLCommits. It returns the state
+// of the objects that were in 'in's url as of the last commit to 'in's repo,
+// or an empty state if nothing has been copied yet.
+func getURLInputState(pachClient *client.APIClient, in *pps.URLInput) (map[string]urlObjectState, error) {
+	state := make(map[string]urlObjectState)
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(client.NewCommit(in.Repo, urlInputStateBranch, ""), urlInputStateFile, &buf); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(buf.Bytes(), &state); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return state, nil
+}
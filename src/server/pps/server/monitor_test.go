@@ -0,0 +1,112 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestURLInputPath(t *testing.T) {
+	check := func(expected, prefix, name string) {
+		p, ok := urlInputPath(prefix, name)
+		require.True(t, ok)
+		require.Equal(t, expected, p)
+	}
+	check("/a/b", "dir", "dir/a/b")
+	check("/a/b", "dir/", "dir/a/b")
+	check("/a", "", "a")
+	// the prefix names a single object
+	check("/b", "dir/b", "dir/b")
+	// objects whose names share the prefix without a slash aren't in the input
+	_, ok := urlInputPath("dir", "dirx/a")
+	require.False(t, ok)
+}
+
+func TestURLTick(t *testing.T) {
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+	c := env.PachClient
+	require.NoError(t, c.CreateRepo("in"))
+	root := t.TempDir()
+	objClient, err := obj.NewLocalClient(root)
+	require.NoError(t, err)
+	writeObject := func(name, data string) {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+		require.NoError(t, ioutil.WriteFile(p, []byte(data), 0600))
+	}
+	removeObject := func(name string) {
+		require.NoError(t, os.Remove(filepath.Join(root, name)))
+	}
+	master := client.NewCommit("in", "master", "")
+	files := func() map[string]string {
+		fis, err := c.ListFileAll(master, "/")
+		require.NoError(t, err)
+		contents := make(map[string]string)
+		for _, fi := range fis {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(master, fi.File.Path, &buf))
+			contents[fi.File.Path] = buf.String()
+		}
+		return contents
+	}
+	paths := func(state map[string]urlObjectState) []string {
+		var ps []string
+		for p := range state {
+			ps = append(ps, p)
+		}
+		sort.Strings(ps)
+		return ps
+	}
+	head := func() string {
+		bi, err := c.InspectBranch("in", "master")
+		require.NoError(t, err)
+		return bi.Head.ID
+	}
+	in := &pps.URLInput{Repo: "in", DeleteRemoved: true}
+
+	writeObject("dir/a", "1")
+	writeObject("dir/b", "2")
+	writeObject("dirx/c", "3")
+	state, err := urlTick(c, objClient, "dir", in, make(map[string]urlObjectState))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a", "/b"}, paths(state))
+	require.Equal(t, map[string]string{"/a": "1", "/b": "2"}, files())
+
+	// Nothing changed, so no commit is made.
+	commitID := head()
+	state, err = urlTick(c, objClient, "dir", in, state)
+	require.NoError(t, err)
+	require.Equal(t, commitID, head())
+
+	// Changed objects are copied again and removed ones are deleted.
+	writeObject("dir/a", "11")
+	removeObject("dir/b")
+	state, err = urlTick(c, objClient, "dir", in, state)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a"}, paths(state))
+	require.Equal(t, map[string]string{"/a": "11"}, files())
+
+	// The state that's recorded is the state that was returned.
+	recorded, err := getURLInputState(c, in)
+	require.NoError(t, err)
+	require.Equal(t, state, recorded)
+
+	// Without delete_removed, removed objects stay in the repo.
+	in.DeleteRemoved = false
+	removeObject("dir/a")
+	writeObject("dir/d", "4")
+	state, err = urlTick(c, objClient, "dir", in, state)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/d"}, paths(state))
+	require.Equal(t, map[string]string{"/a": "11", "/d": "4"}, files())
+}
@@ -22,8 +22,6 @@ import (
 
 	opentracing "github.com/opentracing/opentracing-go"
 	log "github.com/sirupsen/logrus"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type rcExpectation byte
@@ -55,7 +53,7 @@ type pipelineOp struct {
 	// master's context, and cancelled at the end of step())
 	ctx          context.Context
 	pipelineInfo *pps.PipelineInfo
-	rc           *v1.ReplicationController
+	rc           *workerGroup
 }
 
 var (
@@ -266,32 +264,27 @@ func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 		tracing.FinishAnySpan(span)
 	}(span)
 
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, op.pipelineInfo.Pipeline.Name)
-
 	// count error types separately, so that this only errors if the pipeline is
 	// stuck and not changing
 	var notFoundErrCount, unexpectedErrCount, staleErrCount, tooManyErrCount,
 		otherErrCount int
 	return backoff.RetryNotify(func() error {
 		// List all RCs, so stale RCs from old pipelines are noticed and deleted
-		rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(
-			metav1.ListOptions{LabelSelector: selector})
-		if err != nil && !errutil.IsNotFoundError(err) {
+		rcs, err := op.m.a.workerRuntime.listWorkers(op.ctx, op.pipelineInfo.Pipeline.Name)
+		if err != nil {
 			return err
 		}
-		if len(rcs.Items) == 0 {
+		if len(rcs) == 0 {
 			op.rc = nil
 			return errRCNotFound
 		}
 
-		op.rc = &rcs.Items[0]
+		op.rc = rcs[0]
 		switch {
-		case len(rcs.Items) > 1:
+		case len(rcs) > 1:
 			// select stale RC if possible, so that we delete it in restartPipeline
-			for i := range rcs.Items {
-				op.rc = &rcs.Items[i]
+			for i := range rcs {
+				op.rc = rcs[i]
 				if !op.rcIsFresh() {
 					break
 				}
@@ -349,10 +342,10 @@ func (op *pipelineOp) rcIsFresh() bool {
 	}
 
 	// establish current RC properties
-	rcName := op.rc.ObjectMeta.Name
-	rcPachVersion := op.rc.ObjectMeta.Annotations[pachVersionAnnotation]
-	rcAuthTokenHash := op.rc.ObjectMeta.Annotations[hashedAuthTokenAnnotation]
-	rcPipelineVersion := op.rc.ObjectMeta.Annotations[pipelineVersionAnnotation]
+	rcName := op.rc.name
+	rcPachVersion := op.rc.annotations[pachVersionAnnotation]
+	rcAuthTokenHash := op.rc.annotations[hashedAuthTokenAnnotation]
+	rcPipelineVersion := op.rc.annotations[pipelineVersionAnnotation]
 	switch {
 	case rcAuthTokenHash != hashAuthToken(op.pipelineInfo.AuthToken):
 		log.Errorf("PPS master: auth token in %q is stale %s != %s",
@@ -391,7 +384,7 @@ func (op *pipelineOp) setPipelineState(state pps.PipelineState, reason string) e
 // createPipelineResources creates the RC and any services for op's pipeline.
 func (op *pipelineOp) createPipelineResources() error {
 	log.Infof("PPS master: creating resources for pipeline %q", op.pipelineInfo.Pipeline.Name)
-	if err := op.m.a.createWorkerSvcAndRc(op.ctx, op.pipelineInfo); err != nil {
+	if err := op.m.a.workerRuntime.createWorkers(op.ctx, op.pipelineInfo); err != nil {
 		if errors.As(err, &noValidOptionsErr{}) {
 			// these errors indicate invalid pipelineInfo, don't retry
 			return stepError{
@@ -410,7 +403,7 @@ func (op *pipelineOp) createPipelineResources() error {
 // Note: this is called by every run through step(), so must be idempotent
 func (op *pipelineOp) startPipelineMonitor() {
 	op.m.startMonitor(op.pipelineInfo)
-	op.pipelineInfo.Details.WorkerRc = op.rc.ObjectMeta.Name
+	op.pipelineInfo.Details.WorkerRc = op.rc.name
 }
 
 func (op *pipelineOp) startCrashingPipelineMonitor() {
@@ -471,22 +464,11 @@ func (op *pipelineOp) deletePipelineResources() error {
 	return nil
 }
 
-// updateRC is a helper for {scaleUp,scaleDown}Pipeline. It includes all of the
-// logic for writing an updated RC spec to kubernetes, and updating/retrying if
-// k8s rejects the write. It presents a strange API, since the the RC being
-// updated is already available to the caller in op.rc, but update() may be
-// called muliple times if the k8s write fails. It may be helpful to think of
-// the rc passed to update() as mutable, while op.rc is immutable.
-func (op *pipelineOp) updateRC(update func(rc *v1.ReplicationController)) error {
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-
-	newRC := *op.rc
-	// Apply op's update to rc
-	update(&newRC)
-	// write updated RC to k8s
-	if _, err := rc.Update(&newRC); err != nil {
+// scaleRC is a helper for {scaleUp,scaleDown}Pipeline. It sets the number of
+// workers in op.rc through the PPS master's worker runtime, and marks any
+// failure as retriable.
+func (op *pipelineOp) scaleRC(replicas int32) error {
+	if err := op.m.a.workerRuntime.scaleWorkers(op.ctx, op.rc.name, replicas); err != nil {
 		return newRetriableError(err, "error updating RC")
 	}
 	return nil
@@ -513,17 +495,13 @@ func (op *pipelineOp) scaleUpPipeline() (retErr error) {
 	}
 
 	// update pipeline RC
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas > 0 {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = new(int32)
-		if op.pipelineInfo.Details.Autoscaling {
-			*rc.Spec.Replicas = 1
-		} else {
-			*rc.Spec.Replicas = int32(parallelism)
-		}
-	})
+	if op.rc.replicas > 0 {
+		return nil // prior attempt succeeded
+	}
+	if op.pipelineInfo.Details.Autoscaling {
+		return op.scaleRC(1)
+	}
+	return op.scaleRC(int32(parallelism))
 }
 
 // scaleDownPipeline edits the RC associated with op's pipeline & spins down the
@@ -540,12 +518,10 @@ func (op *pipelineOp) scaleDownPipeline() (retErr error) {
 		tracing.FinishAnySpan(span)
 	}()
 
-	return op.updateRC(func(rc *v1.ReplicationController) {
-		if rc.Spec.Replicas != nil && *op.rc.Spec.Replicas == 0 {
-			return // prior attempt succeeded
-		}
-		rc.Spec.Replicas = &zero
-	})
+	if op.rc.replicas == 0 {
+		return nil // prior attempt succeeded
+	}
+	return op.scaleRC(0)
 }
 
 // restartPipeline updates the RC/service associated with op's pipeline, and
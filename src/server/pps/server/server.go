@@ -40,7 +40,11 @@ func NewAPIServer(
 		peerPort:              env.Config().PeerPort,
 		gcPercent:             env.Config().GCPercent,
 	}
-	apiServer.validateKube()
+	workerRuntime, err := newWorkerRuntime(apiServer)
+	if err != nil {
+		return nil, err
+	}
+	apiServer.workerRuntime = workerRuntime
 	go apiServer.master()
 	return apiServer, nil
 }
@@ -70,6 +74,7 @@ func NewSidecarAPIServer(
 		workerGrpcPort: workerGrpcPort,
 		peerPort:       peerPort,
 	}
+	apiServer.workerRuntime = &kubeWorkerRuntime{a: apiServer} // sidecars only run in kubernetes
 	go apiServer.ServeSidecarS3G()
 	return apiServer, nil
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -24,9 +25,11 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	kube_err "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	kube_watch "k8s.io/apimachinery/pkg/watch"
 )
 
 const (
@@ -510,6 +513,20 @@ func (a *apiServer) getWorkerOptions(pipelineInfo *pps.PipelineInfo) (*workerOpt
 		)
 	}
 
+	if egress := pipelineInfo.Details.Egress; egress != nil && egress.SQLDatabase != nil && egress.SQLDatabase.Secret != nil {
+		workerEnv = append(workerEnv, v1.EnvVar{
+			Name: client.PPSEgressSQLPasswordEnv,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: egress.SQLDatabase.Secret.Name,
+					},
+					Key: egress.SQLDatabase.Secret.Key,
+				},
+			},
+		})
+	}
+
 	var volumes []v1.Volume
 	var volumeMounts []v1.VolumeMount
 	for _, secret := range transform.Secrets {
@@ -678,10 +695,11 @@ func (a *apiServer) createWorkerPachctlSecret(ctx context.Context, pipelineInfo
 	return nil
 }
 
-// noValidOptions error may be returned by createWorkerSvcAndRc to indicate that
-// getWorkerOptions returned an error to it (getWorkerOptions does not return
-// noValidOptions). This is a mechanism for createWorkerSvcAndRc to signal to
-// its caller not to retry
+// noValidOptions error may be returned by createWorkerSvcAndRc (or any other
+// workerRuntime's createWorkers) to indicate that getWorkerOptions returned an
+// error to it (getWorkerOptions does not return noValidOptions), or that the
+// pipeline can't be run by the runtime. This is a mechanism for
+// createWorkerSvcAndRc to signal to its caller not to retry
 type noValidOptionsErr struct {
 	error
 }
@@ -810,3 +828,191 @@ func (a *apiServer) createWorkerSvcAndRc(ctx context.Context, pipelineInfo *pps.
 
 	return nil
 }
+
+// kubeWorkerRuntime is the default workerRuntime. It runs each pipeline's
+// workers as pods managed by a kubernetes replication controller.
+type kubeWorkerRuntime struct {
+	a *apiServer
+}
+
+func (r *kubeWorkerRuntime) createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	return r.a.createWorkerSvcAndRc(ctx, pipelineInfo)
+}
+
+func (r *kubeWorkerRuntime) listWorkers(ctx context.Context, pipeline string) ([]*workerGroup, error) {
+	selector := "suite=pachyderm," + pipelineNameLabel
+	if pipeline != "" {
+		selector = fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	}
+	rcs, err := r.a.env.GetKubeClient().CoreV1().ReplicationControllers(r.a.namespace).List(
+		metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var result []*workerGroup
+	for _, rc := range rcs.Items {
+		pipeline, ok := rc.Labels[pipelineNameLabel]
+		if !ok {
+			return nil, errors.Errorf("%q label missing from rc %s", pipelineNameLabel, rc.Name)
+		}
+		group := &workerGroup{
+			name:        rc.Name,
+			pipeline:    pipeline,
+			annotations: rc.Annotations,
+		}
+		if rc.Spec.Replicas != nil {
+			group.replicas = *rc.Spec.Replicas
+		}
+		result = append(result, group)
+	}
+	return result, nil
+}
+
+func (r *kubeWorkerRuntime) scaleWorkers(ctx context.Context, name string, replicas int32) error {
+	rc := r.a.env.GetKubeClient().CoreV1().ReplicationControllers(r.a.namespace)
+	scale, err := rc.GetScale(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	_, err = rc.UpdateScale(name, scale)
+	return err
+}
+
+func (r *kubeWorkerRuntime) deleteWorkers(ctx context.Context, pipeline string) error {
+	kubeClient := r.a.env.GetKubeClient()
+	namespace := r.a.namespace
+
+	// Delete any services associated with the pipeline
+	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	opts := &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}
+	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list services")
+	}
+	for _, service := range services.Items {
+		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete service %q", service.Name)
+			}
+		}
+	}
+
+	// Delete any secrets associated with the pipeline
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list secrets")
+	}
+	for _, secret := range secrets.Items {
+		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
+			}
+		}
+	}
+
+	// Finally, delete the pipeline's RC, which will cause pollPipelines to stop
+	// polling it.
+	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list RCs")
+	}
+	for _, rc := range rcs.Items {
+		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// watchWorkers creates a kubernetes watch, and for each event:
+//   1) Checks if the event concerns a Pod
+//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
+//   3) Checks if the Pod is failing
+// If all three conditions are met, then it calls 'crash' for the pipeline (in
+// 'pipelineName')
+func (r *kubeWorkerRuntime) watchWorkers(ctx context.Context, crash func(pipeline string, version uint64, reason string) error) error {
+	kubePipelineWatch, err := r.a.env.GetKubeClient().CoreV1().Pods(r.a.namespace).Watch(
+		metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+				map[string]string{
+					"component": "worker",
+				})),
+			Watch: true,
+		})
+	if err != nil {
+		return errors.Wrap(err, "failed to watch kubernetes pods")
+	}
+	defer kubePipelineWatch.Stop()
+WatchLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-kubePipelineWatch.ResultChan():
+			// if we get an error we restart the watch
+			if event.Type == kube_watch.Error {
+				return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
+			} else if event.Type == "" {
+				// k8s watches seem to sometimes get stuck in a loop returning events
+				// with Type = "". We treat these as errors as otherwise we get an
+				// endless stream of them and can't do anything.
+				return errors.New("error while watching kubernetes pods: empty event type")
+			}
+			pod, ok := event.Object.(*v1.Pod)
+			if !ok {
+				continue // irrelevant event
+			}
+			if pod.Status.Phase == v1.PodFailed {
+				log.Errorf("pod failed because: %s", pod.Status.Message)
+			}
+			crashPipeline := func(reason string) error {
+				pipelineName := pod.ObjectMeta.Annotations[pipelineNameLabel]
+				pipelineVersion, err := strconv.ParseUint(pod.ObjectMeta.Annotations[pipelineVersionAnnotation], 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "couldn't find pipeline rc version")
+				}
+				return crash(pipelineName, pipelineVersion, reason)
+			}
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
+					if err := crashPipeline(status.State.Waiting.Message); err != nil {
+						return errors.Wrap(err, "error moving pipeline to CRASHING")
+					}
+					continue WatchLoop
+				}
+			}
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == v1.PodScheduled &&
+					condition.Status != v1.ConditionTrue && failures[condition.Reason] {
+					if err := crashPipeline(condition.Message); err != nil {
+						return errors.Wrap(err, "error moving pipeline to CRASHING")
+					}
+					continue WatchLoop
+				}
+			}
+		}
+	}
+}
+
+func (r *kubeWorkerRuntime) serviceIP(ctx context.Context, pipelineInfo *pps.PipelineInfo) (string, error) {
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	service, err := r.a.env.GetKubeClient().CoreV1().Services(r.a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return service.Spec.ClusterIP, nil
+}
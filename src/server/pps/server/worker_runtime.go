@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// workerGroup is the set of workers running a single version of a pipeline.
+// In kubernetes, this corresponds to the pipeline's RC.
+type workerGroup struct {
+	name        string            // e.g. ppsutil.PipelineRcName()
+	pipeline    string            // the pipeline that the workers belong to
+	annotations map[string]string // see getWorkerOptions
+	replicas    int32             // the number of workers requested
+}
+
+// workerRuntime is the interface through which the PPS master starts, scales
+// and stops pipeline workers. The PPS master tracks the state of each
+// pipeline in the database, and uses a workerRuntime to bring the pipeline's
+// workers in line with that state.
+type workerRuntime interface {
+	// createWorkers creates a worker group for 'pipelineInfo' (along with any
+	// supporting resources, such as services) with zero workers. It's not an
+	// error if the group already exists.
+	createWorkers(ctx context.Context, pipelineInfo *pps.PipelineInfo) error
+
+	// listWorkers returns the worker groups belonging to 'pipeline', or to all
+	// pipelines if 'pipeline' is empty.
+	listWorkers(ctx context.Context, pipeline string) ([]*workerGroup, error)
+
+	// scaleWorkers sets the number of workers in the worker group 'name'.
+	scaleWorkers(ctx context.Context, name string, replicas int32) error
+
+	// deleteWorkers stops all of the workers belonging to 'pipeline', and
+	// deletes their worker groups and any supporting resources.
+	deleteWorkers(ctx context.Context, pipeline string) error
+
+	// watchWorkers blocks until 'ctx' is cancelled or an error occurs, and
+	// calls 'crash' whenever the workers for a pipeline fail in a way that
+	// retrying won't fix (e.g. their image can't be pulled).
+	watchWorkers(ctx context.Context, crash func(pipeline string, version uint64, reason string) error) error
+
+	// serviceIP returns the address at which the service exposed by
+	// 'pipelineInfo' can be reached, or "" if it can't be determined.
+	serviceIP(ctx context.Context, pipelineInfo *pps.PipelineInfo) (string, error)
+}
+
+// newWorkerRuntime returns the workerRuntime selected by pachd's
+// configuration.
+func newWorkerRuntime(a *apiServer) (workerRuntime, error) {
+	switch r := a.env.Config().WorkerRuntime; r {
+	case serviceenv.KubeWorkerRuntime, "":
+		a.validateKube()
+		return &kubeWorkerRuntime{a: a}, nil
+	case serviceenv.LocalWorkerRuntime:
+		return newLocalWorkerRuntime(a), nil
+	default:
+		return nil, errors.Errorf("unrecognized worker runtime %q (must be %q or %q)",
+			r, serviceenv.KubeWorkerRuntime, serviceenv.LocalWorkerRuntime)
+	}
+}
+
+// requireKube returns an error if pachd is not running pipeline workers in
+// kubernetes, for RPCs that can't be served without it.
+func (a *apiServer) requireKube(op string) error {
+	if _, ok := a.workerRuntime.(*kubeWorkerRuntime); !ok {
+		return errors.Errorf("%s is not supported by the %q worker runtime", op, a.env.Config().WorkerRuntime)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cmds
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -38,8 +39,14 @@ The following pachctl commands are supported in transactions:
   delete commit
   create branch
   delete branch
+  put file
+  copy file
+  delete file
   create pipeline
   update pipeline
+  delete pipeline
+  start pipeline
+  stop pipeline
 
 A transaction can be started with 'start transaction', after which the above
 commands will be stored in the transaction rather than immediately executed.
@@ -48,6 +55,7 @@ transaction' or cancelled with 'delete transaction'.`,
 	}
 	commands = append(commands, cmdutil.CreateDocsAlias(transactionDocs, "transaction", " transaction$"))
 
+	var owner string
 	listTransaction := &cobra.Command{
 		Short: "List transactions.",
 		Long:  "List transactions.",
@@ -57,7 +65,7 @@ transaction' or cancelled with 'delete transaction'.`,
 				return err
 			}
 			defer c.Close()
-			transactions, err := c.ListTransaction()
+			transactions, err := c.ListTransactionByOwner(owner)
 			if err != nil {
 				return err
 			}
@@ -79,13 +87,15 @@ transaction' or cancelled with 'delete transaction'.`,
 			return writer.Flush()
 		}),
 	}
+	listTransaction.Flags().StringVar(&owner, "owner", "", "Only list transactions started by this user.")
 	listTransaction.Flags().AddFlagSet(outputFlags)
 	listTransaction.Flags().AddFlagSet(timestampFlags)
 	commands = append(commands, cmdutil.CreateAlias(listTransaction, "list transaction"))
 
+	var ttl time.Duration
 	startTransaction := &cobra.Command{
 		Short: "Start a new transaction.",
-		Long:  "Start a new transaction.",
+		Long:  "Start a new transaction. The transaction is deleted if it hasn't been finished before its TTL passes.",
 		Run: cmdutil.RunFixedArgs(0, func([]string) error {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -100,7 +110,7 @@ transaction' or cancelled with 'delete transaction'.`,
 				return errors.Errorf("cannot start a new transaction, since transaction with ID %q already exists", txn.ID)
 			}
 
-			transaction, err := c.StartTransaction()
+			transaction, err := c.StartTransactionWithTTL(ttl)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
@@ -114,6 +124,7 @@ transaction' or cancelled with 'delete transaction'.`,
 			return nil
 		}),
 	}
+	startTransaction.Flags().DurationVar(&ttl, "ttl", 0, "How long the transaction may stay open before it is deleted (defaults to and may not exceed 24h).")
 	commands = append(commands, cmdutil.CreateAlias(startTransaction, "start transaction"))
 
 	stopTransaction := &cobra.Command{
@@ -177,6 +188,43 @@ transaction' or cancelled with 'delete transaction'.`,
 	}
 	commands = append(commands, cmdutil.CreateAlias(finishTransaction, "finish transaction"))
 
+	validateTransaction := &cobra.Command{
+		Use:   "{{alias}} [<transaction>]",
+		Short: "Check whether the currently active transaction would finish successfully.",
+		Long:  "Check whether the currently active transaction would finish successfully. The transaction is run without persisting any of its changes, and the request that would fail (if any) is reported.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			var txn *transaction.Transaction
+			if len(args) > 0 {
+				txn = &transaction.Transaction{ID: args[0]}
+			} else {
+				txn, err = requireActiveTransaction()
+				if err != nil {
+					return err
+				}
+			}
+
+			response, err := c.ValidateTransaction(txn)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return cmdutil.Encoder(output, os.Stdout).EncodeProto(response)
+			} else if output != "" {
+				return errors.New("cannot set --output (-o) without --raw")
+			}
+			pretty.PrintValidateTransactionResponse(txn, response)
+			return nil
+		}),
+	}
+	validateTransaction.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(validateTransaction, "validate transaction"))
+
 	deleteTransaction := &cobra.Command{
 		Use:   "{{alias}} [<transaction>]",
 		Short: "Cancel and delete an existing transaction.",
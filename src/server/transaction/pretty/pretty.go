@@ -6,6 +6,10 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/gogo/protobuf/types"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -16,7 +20,7 @@ import (
 
 const (
 	//TransactionHeader is the header for transactions.
-	TransactionHeader = "TRANSACTION\tSTARTED\tOPS\t\n"
+	TransactionHeader = "TRANSACTION\tOWNER\tSTARTED\tEXPIRES\tOPS\t\n"
 )
 
 // PrintableTransactionInfo wraps a transaction.TransactionInfo with the
@@ -30,21 +34,63 @@ type PrintableTransactionInfo struct {
 // device.
 func PrintTransactionInfo(w io.Writer, info *transaction.TransactionInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", info.Transaction.ID)
+	fmt.Fprintf(w, "%s\t", sprintOwner(info.Owner))
 	if fullTimestamps {
 		fmt.Fprintf(w, "%s\t", info.Started.String())
+		fmt.Fprintf(w, "%s\t", info.Expires.String())
 	} else {
 		fmt.Fprintf(w, "%s\t", pretty.Ago(info.Started))
+		fmt.Fprintf(w, "%s\t", sprintExpires(info.Expires))
 	}
 	fmt.Fprintf(w, "%d\n", len(info.Requests))
 }
 
+func sprintOwner(owner string) string {
+	if owner == "" {
+		return "-"
+	}
+	return owner
+}
+
+func sprintExpires(expires *types.Timestamp) string {
+	if expires == nil {
+		return "-"
+	}
+	t, err := types.TimestampFromProto(expires)
+	if err != nil {
+		return "-"
+	}
+	until := time.Until(t)
+	if until <= 0 {
+		return "expired"
+	}
+	return fmt.Sprintf("in %s", units.HumanDuration(until))
+}
+
+// PrintValidateTransactionResponse prints the result of validating a
+// transaction to stdout.
+func PrintValidateTransactionResponse(txn *transaction.Transaction, response *transaction.ValidateTransactionResponse) {
+	if response.Error == "" {
+		fmt.Printf("Transaction %s would succeed\n", txn.ID)
+		return
+	}
+	if response.FailedRequest > 0 {
+		fmt.Printf("Transaction %s would fail at request %d: %s\n", txn.ID, response.FailedRequest, response.Error)
+		return
+	}
+	fmt.Printf("Transaction %s would fail: %s\n", txn.ID, response.Error)
+}
+
 // PrintDetailedTransactionInfo prints detailed information about a transaction
 // to stdout.
 func PrintDetailedTransactionInfo(info *PrintableTransactionInfo) error {
 	template, err := template.New("TransactionInfo").Funcs(funcMap).Parse(
-		`ID: {{.Transaction.ID}}{{if .FullTimestamps}}
-Started: {{.Started}}{{else}}
-Started: {{prettyAgo .Started}}{{end}}
+		`ID: {{.Transaction.ID}}{{if .Owner}}
+Owner: {{.Owner}}{{end}}{{if .FullTimestamps}}
+Started: {{.Started}}{{if .Expires}}
+Expires: {{.Expires}}{{end}}{{else}}
+Started: {{prettyAgo .Started}}{{if .Expires}}
+Expires: {{prettyExpires .Expires}}{{end}}{{end}}
 Requests:
 {{transactionRequests .Requests .Responses}}
 `)
@@ -104,6 +150,10 @@ func sprintDeleteBranch(request *pfs.DeleteBranchRequest) string {
 	return fmt.Sprintf("delete branch %s%s", request.Branch, force)
 }
 
+func sprintAddFileSet(request *pfs.AddFileSetRequest) string {
+	return fmt.Sprintf("add fileset %s to commit %s", request.FileSetId, pfspretty.CompactPrintCommit(request.Commit))
+}
+
 func sprintUpdateJobState(request *pps.UpdateJobStateRequest) string {
 	state := func() string {
 		switch request.State {
@@ -135,6 +185,25 @@ func sprintCreatePipeline(request *pps.CreatePipelineRequest) string {
 	return fmt.Sprintf("%s pipeline %s", verb, request.Pipeline.Name)
 }
 
+func sprintDeletePipeline(request *pps.DeletePipelineRequest) string {
+	var flags string
+	if request.Force {
+		flags += " --force"
+	}
+	if request.KeepRepo {
+		flags += " --keep-repo"
+	}
+	return fmt.Sprintf("delete pipeline %s%s", request.Pipeline.Name, flags)
+}
+
+func sprintStopPipeline(request *pps.StopPipelineRequest) string {
+	return fmt.Sprintf("stop pipeline %s", request.Pipeline.Name)
+}
+
+func sprintStartPipeline(request *pps.StartPipelineRequest) string {
+	return fmt.Sprintf("start pipeline %s", request.Pipeline.Name)
+}
+
 func transactionRequests(
 	requests []*transaction.TransactionRequest,
 	responses []*transaction.TransactionResponse,
@@ -164,10 +233,18 @@ func transactionRequests(
 			line = sprintCreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			line = sprintDeleteBranch(request.DeleteBranch)
+		} else if request.AddFileSet != nil {
+			line = sprintAddFileSet(request.AddFileSet)
 		} else if request.UpdateJobState != nil {
 			line = sprintUpdateJobState(request.UpdateJobState)
 		} else if request.CreatePipeline != nil {
 			line = sprintCreatePipeline(request.CreatePipeline)
+		} else if request.DeletePipeline != nil {
+			line = sprintDeletePipeline(request.DeletePipeline)
+		} else if request.StopPipeline != nil {
+			line = sprintStopPipeline(request.StopPipeline)
+		} else if request.StartPipeline != nil {
+			line = sprintStartPipeline(request.StartPipeline)
 		} else {
 			line = "ERROR (unknown request type)"
 		}
@@ -179,6 +256,7 @@ func transactionRequests(
 
 var funcMap = template.FuncMap{
 	"prettyAgo":           pretty.Ago,
+	"prettyExpires":       sprintExpires,
 	"prettySize":          pretty.Size,
 	"transactionRequests": transactionRequests,
 }
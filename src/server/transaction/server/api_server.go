@@ -45,7 +45,7 @@ func (a *apiServer) StartTransaction(ctx context.Context, request *transaction.S
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	return a.driver.startTransaction(ctx)
+	return a.driver.startTransaction(ctx, request)
 }
 
 func (a *apiServer) InspectTransaction(ctx context.Context, request *transaction.InspectTransactionRequest) (response *transaction.TransactionInfo, retErr error) {
@@ -71,7 +71,7 @@ func (a *apiServer) ListTransaction(ctx context.Context, request *transaction.Li
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	transactions, err := a.driver.listTransaction(ctx)
+	transactions, err := a.driver.listTransaction(ctx, request.Owner)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +85,13 @@ func (a *apiServer) FinishTransaction(ctx context.Context, request *transaction.
 	return a.driver.finishTransaction(ctx, request.Transaction)
 }
 
+func (a *apiServer) ValidateTransaction(ctx context.Context, request *transaction.ValidateTransactionRequest) (response *transaction.ValidateTransactionResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.validateTransaction(ctx, request.Transaction)
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, request *transaction.DeleteAllRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -2,12 +2,16 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -20,7 +24,18 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/transaction"
 )
 
+const (
+	// defaultTTL is how long a transaction stays open if StartTransaction
+	// doesn't specify a TTL. It is also the maximum TTL, so that file sets
+	// added to a transaction (which are kept for 24 hours, see txnFileSetTTL
+	// in the PFS driver) outlive it.
+	defaultTTL = 24 * time.Hour
+	// expireInterval is how often expired transactions are deleted.
+	expireInterval = time.Minute
+)
+
 type driver struct {
+	env serviceenv.ServiceEnv
 	// txnEnv stores references to other pachyderm APIServer instances so we can
 	// make calls within the same transaction without serializing through RPCs
 	txnEnv       *txnenv.TransactionEnv
@@ -32,12 +47,14 @@ func newDriver(
 	env serviceenv.ServiceEnv,
 	txnEnv *txnenv.TransactionEnv,
 ) (*driver, error) {
-
-	return &driver{
+	d := &driver{
+		env:          env,
 		txnEnv:       txnEnv,
 		db:           env.GetDBClient(),
 		transactions: transactiondb.Transactions(env.GetDBClient(), env.GetPostgresListener()),
-	}, nil
+	}
+	go d.expireTransactions(env.Context())
+	return d, nil
 }
 
 func now() *types.Timestamp {
@@ -71,13 +88,37 @@ func (d *driver) batchTransaction(ctx context.Context, req []*transaction.Transa
 	return result, nil
 }
 
-func (d *driver) startTransaction(ctx context.Context) (*transaction.Transaction, error) {
+func (d *driver) startTransaction(ctx context.Context, request *transaction.StartTransactionRequest) (*transaction.Transaction, error) {
+	ttl := defaultTTL
+	if request.TTL != nil {
+		var err error
+		if ttl, err = types.DurationFromProto(request.TTL); err != nil {
+			return nil, err
+		}
+		if ttl <= 0 || ttl > defaultTTL {
+			return nil, errors.Errorf("transaction TTL must be positive and at most %v", defaultTTL)
+		}
+	}
+	var owner string
+	whoAmI, err := d.env.AuthServer().WhoAmI(ctx, &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return nil, err
+	} else if err == nil {
+		owner = whoAmI.Username
+	}
+	started := time.Now()
+	expires, err := types.TimestampProto(started.Add(ttl))
+	if err != nil {
+		return nil, err
+	}
 	info := &transaction.TransactionInfo{
 		Transaction: &transaction.Transaction{
 			ID: uuid.NewWithoutDashes(),
 		},
 		Requests: []*transaction.TransactionRequest{},
 		Started:  now(),
+		Owner:    owner,
+		Expires:  expires,
 	}
 
 	if err := dbutil.WithTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
@@ -105,11 +146,16 @@ func (d *driver) deleteTransaction(ctx context.Context, txn *transaction.Transac
 	})
 }
 
-func (d *driver) listTransaction(ctx context.Context) ([]*transaction.TransactionInfo, error) {
+// listTransaction lists all transactions, or only those started by owner if
+// it's set.
+func (d *driver) listTransaction(ctx context.Context, owner string) ([]*transaction.TransactionInfo, error) {
 	var result []*transaction.TransactionInfo
 	transactionInfo := &transaction.TransactionInfo{}
 	transactions := d.transactions.ReadOnly(ctx)
 	if err := transactions.List(transactionInfo, col.DefaultOptions(), func(string) error {
+		if owner != "" && transactionInfo.Owner != owner {
+			return nil
+		}
 		result = append(result, proto.Clone(transactionInfo).(*transaction.TransactionInfo))
 		return nil
 	}); err != nil {
@@ -121,7 +167,7 @@ func (d *driver) listTransaction(ctx context.Context) ([]*transaction.Transactio
 // deleteAll deletes all transactions from etcd except the currently running
 // transaction (if any).
 func (d *driver) deleteAll(ctx context.Context, sqlTx *sqlx.Tx, running *transaction.Transaction) error {
-	txns, err := d.listTransaction(ctx)
+	txns, err := d.listTransaction(ctx, "")
 	if err != nil {
 		return err
 	}
@@ -138,6 +184,78 @@ func (d *driver) deleteAll(ctx context.Context, sqlTx *sqlx.Tx, running *transac
 	return nil
 }
 
+// expired returns an error if the transaction's TTL has passed. Expired
+// transactions are deleted periodically, this catches the ones that haven't
+// been deleted yet.
+func expired(info *transaction.TransactionInfo) error {
+	if info.Expires == nil {
+		return nil
+	}
+	expires, err := types.TimestampFromProto(info.Expires)
+	if err != nil {
+		return err
+	}
+	if time.Now().After(expires) {
+		return errors.Errorf("transaction %s expired at %v", info.Transaction.ID, expires)
+	}
+	return nil
+}
+
+// expireTransactions deletes transactions whose TTL has passed until ctx is
+// canceled. Deleting a transaction is idempotent, so every pachd can run this.
+func (d *driver) expireTransactions(ctx context.Context) {
+	backoff.RetryUntilCancel(ctx, func() error {
+		ticker := time.NewTicker(expireInterval)
+		defer ticker.Stop()
+		for {
+			if err := d.deleteExpired(ctx); err != nil {
+				return err
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return errors.EnsureStack(ctx.Err())
+			}
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
+		log.Errorf("error deleting expired transactions: %v", err)
+		return nil
+	})
+}
+
+func (d *driver) deleteExpired(ctx context.Context) error {
+	txns, err := d.listTransaction(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, info := range txns {
+		if expired(info) == nil {
+			continue
+		}
+		if err := dbutil.WithTx(ctx, d.db, func(sqlTx *sqlx.Tx) error {
+			return d.transactions.ReadWrite(sqlTx).Delete(info.Transaction.ID)
+		}); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// requestFailedError is returned by runTransaction when one of the
+// transaction's requests fails.
+type requestFailedError struct {
+	index, total int
+	err          error
+}
+
+func (e *requestFailedError) Error() string {
+	return fmt.Sprintf("error running request %d of %d: %v", e.index, e.total, e.err)
+}
+
+func (e *requestFailedError) Unwrap() error {
+	return e.err
+}
+
 func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *transaction.TransactionInfo) (*transaction.TransactionInfo, error) {
 	result := proto.Clone(info).(*transaction.TransactionInfo)
 	for len(result.Responses) < len(result.Requests) {
@@ -167,18 +285,26 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			err = directTxn.CreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			err = directTxn.DeleteBranch(request.DeleteBranch)
+		} else if request.AddFileSet != nil {
+			err = directTxn.AddFileSet(request.AddFileSet)
 		} else if request.UpdateJobState != nil {
 			err = directTxn.UpdateJobState(request.UpdateJobState)
 		} else if request.StopJob != nil {
 			err = directTxn.StopJob(request.StopJob)
 		} else if request.CreatePipeline != nil {
 			err = directTxn.CreatePipeline(request.CreatePipeline)
+		} else if request.DeletePipeline != nil {
+			err = directTxn.DeletePipeline(request.DeletePipeline)
+		} else if request.StopPipeline != nil {
+			err = directTxn.StopPipeline(request.StopPipeline)
+		} else if request.StartPipeline != nil {
+			err = directTxn.StartPipeline(request.StartPipeline)
 		} else {
 			err = errors.New("unrecognized transaction request type")
 		}
 
 		if err != nil {
-			return result, errors.Wrapf(err, "error running request %d of %d", i+1, len(info.Requests))
+			return result, &requestFailedError{index: i + 1, total: len(info.Requests), err: err}
 		}
 	}
 	return result, nil
@@ -200,6 +326,30 @@ func (d *driver) finishTransaction(ctx context.Context, txn *transaction.Transac
 	})
 }
 
+// validateTransaction runs the transaction in a SQL transaction that is rolled
+// back, and reports whether (and at which request) finishing it would fail.
+func (d *driver) validateTransaction(ctx context.Context, txn *transaction.Transaction) (*transaction.ValidateTransactionResponse, error) {
+	info, err := d.inspectTransaction(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	if err := expired(info); err != nil {
+		return nil, err
+	}
+	response := &transaction.ValidateTransactionResponse{}
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		_, err := d.runTransaction(txnCtx, info)
+		return err
+	}); err != nil {
+		response.Error = err.Error()
+		var failed *requestFailedError
+		if errors.As(err, &failed) {
+			response.FailedRequest = int64(failed.index)
+		}
+	}
+	return response, nil
+}
+
 // Error to be returned when the transaction has been modified between our two sqlTx calls
 type transactionModifiedError struct{}
 
@@ -243,6 +393,9 @@ func (d *driver) updateTransaction(
 		if err := d.transactions.ReadWrite(txnCtx.SqlTx).Get(txn.ID, storedInfo); err != nil {
 			return err
 		}
+		if err := expired(storedInfo); err != nil {
+			return err
+		}
 		restarted := localInfo == nil || storedInfo.Version != localInfo.Version
 		if restarted {
 			// something has changed, reset our saved info
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
@@ -83,6 +84,72 @@ func TestTransactions(suite *testing.T) {
 		require.YesError(t, err)
 	})
 
+	suite.Run("TestValidateTransaction", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+
+		txnClient := env.PachClient.WithTransaction(txn)
+		require.NoError(t, txnClient.CreateRepo("foo"))
+		require.NoError(t, txnClient.CreateRepo("bar"))
+
+		response, err := env.PachClient.ValidateTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, "", response.Error)
+		require.Equal(t, int64(0), response.FailedRequest)
+
+		// Validating doesn't persist the transaction's changes
+		_, err = env.PachClient.InspectRepo("foo")
+		require.YesError(t, err)
+
+		// Create the second repo outside of the transaction, so it can't run
+		require.NoError(t, env.PachClient.CreateRepo("bar"))
+
+		response, err = env.PachClient.ValidateTransaction(txn)
+		require.NoError(t, err)
+		require.Matches(t, "already exists", response.Error)
+		require.Equal(t, int64(2), response.FailedRequest)
+
+		// The transaction is left untouched
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(info.Requests))
+	})
+
+	suite.Run("TestTransactionTTL", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+		_, err := env.PachClient.StartTransactionWithTTL(48 * time.Hour)
+		require.YesError(t, err)
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.NotNil(t, info.Expires)
+		// auth isn't active, so the transaction has no owner
+		require.Equal(t, "", info.Owner)
+
+		infos, err := env.PachClient.ListTransactionByOwner("")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(infos))
+		infos, err = env.PachClient.ListTransactionByOwner("robot:someone-else")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(infos))
+
+		txn, err = env.PachClient.StartTransactionWithTTL(time.Second)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Second)
+		require.YesError(t, env.PachClient.WithTransaction(txn).CreateRepo("foo"))
+		_, err = env.PachClient.ValidateTransaction(txn)
+		require.YesError(t, err)
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.YesError(t, err)
+	})
+
 	suite.Run("TestFailedAppend", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
@@ -469,6 +536,43 @@ func TestTransactions(suite *testing.T) {
 			}
 		}
 	})
+
+	suite.Run("TestModifyFile", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+		c := env.PachClient
+
+		require.NoError(t, c.CreateRepo("foo"))
+		require.NoError(t, c.PutFile(client.NewCommit("foo", "master", ""), "old", strings.NewReader("old")))
+
+		var commit *pfs.Commit
+		info, err := c.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+			var err error
+			commit, err = txnClient.StartCommit("foo", "master")
+			require.NoError(t, err)
+			require.NoError(t, txnClient.PutFile(commit, "new", strings.NewReader("new")))
+			require.NoError(t, txnClient.DeleteFile(commit, "old"))
+			// directories can't be deleted within a transaction
+			require.YesError(t, txnClient.DeleteFile(commit, "dir", client.WithRecursiveDeleteFile()))
+			require.NoError(t, txnClient.FinishCommit("foo", "master", ""))
+			// nothing is visible until the transaction is finished
+			_, err = c.InspectFile(client.NewCommit("foo", "master", ""), "new")
+			require.YesError(t, err)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(info.Requests))
+		require.NotNil(t, info.Requests[1].AddFileSet)
+
+		commitInfo, err := c.InspectCommit("foo", "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commit.ID, commitInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(commitInfo.Commit, "new", &buf))
+		require.Equal(t, "new", buf.String())
+		_, err = c.InspectFile(commitInfo.Commit, "old")
+		require.YesError(t, err)
+	})
 }
 
 func TestCreatePipelineTransaction(t *testing.T) {
@@ -502,3 +606,77 @@ func TestCreatePipelineTransaction(t *testing.T) {
 	require.NoError(t, c.GetFile(commitInfo.Commit, "foo", &buf))
 	require.Equal(t, "bar", buf.String())
 }
+
+func TestReplacePipelineTransaction(t *testing.T) {
+	c := testutil.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	repo := testutil.UniqueString("in")
+	pipelineA := testutil.UniqueString("pipelineA")
+	pipelineB := testutil.UniqueString("pipelineB")
+	pipelineC := testutil.UniqueString("pipelineC")
+	createPipeline := func(c *client.APIClient, pipeline, input string) error {
+		return c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out", input)},
+			&pps.ParallelismSpec{Constant: 1},
+			client.NewPFSInput(input, "/"),
+			"master",
+			false,
+		)
+	}
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, createPipeline(c, pipelineA, repo))
+
+	// Replace A with B and C, where C reads from B
+	_, err := c.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		require.NoError(t, txnClient.DeletePipeline(pipelineA, false))
+		require.NoError(t, createPipeline(txnClient, pipelineB, repo))
+		require.NoError(t, createPipeline(txnClient, pipelineC, pipelineB))
+		// nothing changes until the transaction is finished
+		_, err := c.InspectPipeline(pipelineA, false)
+		require.NoError(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = c.InspectPipeline(pipelineA, false)
+	require.YesError(t, err)
+	_, err = c.InspectRepo(pipelineA)
+	require.YesError(t, err)
+
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, c.PutFile(commit, "foo", strings.NewReader("bar")))
+	commitInfo, err := c.WaitCommit(pipelineC, "master", "")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(commitInfo.Commit, "foo", &buf))
+	require.Equal(t, "bar", buf.String())
+
+	// Stop both pipelines at once
+	info, err := c.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		require.NoError(t, builder.StopPipeline(pipelineB))
+		require.NoError(t, builder.StopPipeline(pipelineC))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(info.Requests))
+	for _, pipeline := range []string{pipelineB, pipelineC} {
+		pipelineInfo, err := c.InspectPipeline(pipeline, false)
+		require.NoError(t, err)
+		require.True(t, pipelineInfo.Stopped)
+	}
+
+	_, err = c.ExecuteInTransaction(func(txnClient *client.APIClient) error {
+		require.NoError(t, txnClient.StartPipeline(pipelineB))
+		require.NoError(t, txnClient.StartPipeline(pipelineC))
+		return nil
+	})
+	require.NoError(t, err)
+	for _, pipeline := range []string{pipelineB, pipelineC} {
+		pipelineInfo, err := c.InspectPipeline(pipeline, false)
+		require.NoError(t, err)
+		require.False(t, pipelineInfo.Stopped)
+	}
+}
@@ -70,3 +70,19 @@ dataFilters:
 	}
 	return matchesData
 }
+
+// UserCodeError is returned when the user code exits with an error, it
+// includes the end of what the user code wrote to stderr.
+type UserCodeError struct {
+	Err        error
+	StderrTail string
+}
+
+func (e *UserCodeError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *UserCodeError) Unwrap() error {
+	return e.Err
+}
@@ -6,11 +6,13 @@ import (
 	"bytes"
 	"context"
 	io "io"
+	"math"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -18,6 +20,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/miscutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
@@ -37,6 +40,8 @@ const (
 	PFSPrefix = "pfs"
 	// OutputPrefix is the prefix for the output path.
 	OutputPrefix = "out"
+	// ErrorsPrefix is the prefix for the records of quarantined datums.
+	ErrorsPrefix = "errors"
 	// TmpFileName is the name of the tmp file.
 	TmpFileName       = "tmp"
 	defaultNumRetries = 3
@@ -120,6 +125,7 @@ type Set struct {
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
 	stats                             *Stats
+	quarantine                        bool
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -153,22 +159,125 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 // TODO: Handle datum concurrency here, and potentially move symlinking here.
 func (s *Set) WithDatum(meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
-
-	var err error
-	for i := 0; i <= d.numRetries; i++ {
-		err = d.withData(func() (retErr error) {
+	d.meta.Attempts = nil
+	for i := 0; ; i++ {
+		var final bool
+		err := d.withData(func() (retErr error) {
+			start := time.Now()
 			defer func() {
-				if retErr == nil || i == d.numRetries {
+				d.meta.Attempts = append(d.meta.Attempts, newAttempt(start, retErr))
+				final = retErr == nil || i == d.numRetries || !d.retryable(retErr)
+				if final {
 					retErr = d.finish(retErr)
 				}
 			}()
 			return cb(d)
 		})
-		if err == nil {
-			return nil
+		if err == nil || final || i >= d.numRetries {
+			return err
+		}
+		if err := d.backoff(i); err != nil {
+			return err
 		}
 	}
-	return err
+}
+
+// retryable returns whether a failed attempt should be retried according to
+// the retry policy of the datum.
+func (d *Datum) retryable(err error) bool {
+	policy := d.retryPolicy
+	if policy == nil {
+		return true
+	}
+	code, oomKilled := exitStatus(err)
+	if policy.RetryOOMKilledOnly {
+		return oomKilled
+	}
+	for _, c := range policy.FailFastExitCodes {
+		if c == code {
+			return false
+		}
+	}
+	if len(policy.RetryExitCodes) == 0 {
+		return true
+	}
+	for _, c := range policy.RetryExitCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff waits before the retry that follows the given (0 indexed) attempt.
+func (d *Datum) backoff(attempt int) error {
+	policy := d.retryPolicy
+	if policy == nil || policy.InitialBackoff == nil {
+		return nil
+	}
+	backoff, err := types.DurationFromProto(policy.InitialBackoff)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	maxBackoff := time.Duration(math.MaxInt64)
+	if policy.MaxBackoff != nil {
+		if maxBackoff, err = types.DurationFromProto(policy.MaxBackoff); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	ctx := context.Background()
+	if d.set.pachClient != nil {
+		ctx = d.set.pachClient.Ctx()
+	}
+	select {
+	case <-time.After(backoff):
+		return nil
+	case <-ctx.Done():
+		return errors.EnsureStack(ctx.Err())
+	}
+}
+
+func newAttempt(start time.Time, runErr error) *pps.DatumAttempt {
+	attempt := &pps.DatumAttempt{
+		Duration: types.DurationProto(time.Since(start)),
+	}
+	if started, err := types.TimestampProto(start); err == nil {
+		attempt.Started = started
+	}
+	if runErr != nil {
+		attempt.Error = runErr.Error()
+		attempt.ExitCode, attempt.OOMKilled = exitStatus(runErr)
+		userCodeErr := &common.UserCodeError{}
+		if errors.As(runErr, &userCodeErr) {
+			attempt.StderrTail = userCodeErr.StderrTail
+		}
+	}
+	return attempt
+}
+
+// exitStatus returns the exit code of the user code that produced err, or -1
+// if it didn't exit normally, and whether it was killed by the kernel. An exit
+// code of 137 is also considered killed, since that is how shells report a
+// child that was killed with SIGKILL.
+func exitStatus(err error) (int32, bool) {
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		return -1, false
+	}
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	if !ok {
+		return int32(exitErr.ExitCode()), false
+	}
+	if status.Signaled() {
+		return -1, status.Signal() == syscall.SIGKILL
+	}
+	return int32(status.ExitStatus()), status.ExitStatus() == 128+int(syscall.SIGKILL)
 }
 
 // Datum manages a datum.
@@ -178,6 +287,7 @@ type Datum struct {
 	meta             *Meta
 	storageRoot      string
 	numRetries       int
+	retryPolicy      *pps.DatumRetryPolicy
 	recoveryCallback func(context.Context) error
 	timeout          time.Duration
 }
@@ -216,6 +326,11 @@ func (d *Datum) finish(err error) (retErr error) {
 	}()
 	if err != nil {
 		d.handleFailed(err)
+		if d.set.quarantine && d.meta.State == State_FAILED {
+			if err := d.uploadErrorRecord(); err != nil {
+				return err
+			}
+		}
 		return d.uploadMetaOutput()
 	}
 	d.set.stats.Processed++
@@ -235,6 +350,31 @@ func (d *Datum) handleFailed(err error) {
 	}
 }
 
+// uploadErrorRecord writes a record of a quarantined datum's failure to the
+// meta output.
+func (d *Datum) uploadErrorRecord() error {
+	if d.set.metaOutputClient == nil {
+		return nil
+	}
+	record := &pps.DatumInfo{
+		Datum: &pps.Datum{
+			Job: d.meta.Job,
+			ID:  d.ID,
+		},
+		State:    pps.DatumState_FAILED,
+		Attempts: d.meta.Attempts,
+	}
+	for _, input := range d.meta.Inputs {
+		record.Data = append(record.Data, input.FileInfo)
+	}
+	marshaler := &jsonpb.Marshaler{Indent: "  "}
+	buf := &bytes.Buffer{}
+	if err := marshaler.Marshal(buf, record); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return d.set.metaOutputClient.PutFile(path.Join(ErrorsPrefix, d.ID), buf, client.WithDatumPutFile(d.ID))
+}
+
 func (d *Datum) withData(cb func() error) (retErr error) {
 	// Setup and defer cleanup of pfs directory.
 	if err := os.MkdirAll(path.Join(d.PFSStorageRoot(), OutputPrefix), 0777); err != nil {
@@ -480,6 +620,9 @@ func NewDeleter(metaFileWalker fileWalkerFunc, metaOutputClient, pfsOutputClient
 		if err := metaOutputClient.DeleteFile(path.Join(PFSPrefix, ID)+"/", tagOption); err != nil {
 			return err
 		}
+		if err := metaOutputClient.DeleteFile(path.Join(ErrorsPrefix, ID), tagOption); err != nil {
+			return err
+		}
 		// Delete the content output by the datum.
 		outputDir := "/" + path.Join(PFSPrefix, ID, OutputPrefix)
 		files, err := metaFileWalker(outputDir)
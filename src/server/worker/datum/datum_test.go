@@ -1,5 +1,50 @@
 package datum
 
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	exitErr := func(script string) error {
+		err := exec.Command("sh", "-c", script).Run()
+		require.YesError(t, err)
+		return errors.EnsureStack(err)
+	}
+	code, oomKilled := exitStatus(exitErr("exit 2"))
+	require.Equal(t, int32(2), code)
+	require.False(t, oomKilled)
+	code, oomKilled = exitStatus(exitErr("kill -9 $$"))
+	require.Equal(t, int32(-1), code)
+	require.True(t, oomKilled)
+	code, oomKilled = exitStatus(exitErr("exit 137"))
+	require.Equal(t, int32(137), code)
+	require.True(t, oomKilled)
+	code, oomKilled = exitStatus(errors.New("not an exit error"))
+	require.Equal(t, int32(-1), code)
+	require.False(t, oomKilled)
+
+	d := &Datum{}
+	require.True(t, d.retryable(exitErr("exit 1")))
+	d.retryPolicy = &pps.DatumRetryPolicy{
+		RetryExitCodes:    []int32{1, 2},
+		FailFastExitCodes: []int32{3},
+	}
+	require.True(t, d.retryable(exitErr("exit 2")))
+	require.False(t, d.retryable(exitErr("exit 3")))
+	require.False(t, d.retryable(exitErr("exit 4")))
+	d.retryPolicy = &pps.DatumRetryPolicy{FailFastExitCodes: []int32{3}}
+	require.True(t, d.retryable(exitErr("exit 4")))
+	require.False(t, d.retryable(exitErr("exit 3")))
+	d.retryPolicy = &pps.DatumRetryPolicy{RetryOOMKilledOnly: true}
+	require.True(t, d.retryable(exitErr("kill -9 $$")))
+	require.False(t, d.retryable(exitErr("exit 1")))
+}
+
 // TODO: This test needs to be reworked.
 //func TestSet(t *testing.T) {
 //	t.Parallel()
@@ -2,17 +2,29 @@ package datum
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"path"
-	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
-	"github.com/cevaris/ordered_map"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/itchyny/gojq"
 	glob "github.com/pachyderm/ohmyglob"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
 	"github.com/pachyderm/pachyderm/v2/src/internal/stream"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -51,12 +63,30 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	branch := pi.input.Branch
 	commit := pi.input.Commit
 	pattern := pi.input.Glob
+	include, err := newPFSFilter(pi.input)
+	if err != nil {
+		return err
+	}
+	contentKey, err := newContentKeyFunc(pi.pachClient, pi.input.ContentKey)
+	if err != nil {
+		return err
+	}
 	return pi.pachClient.GlobFile(client.NewCommit(repo, branch, commit), pattern, func(fi *pfs.FileInfo) error {
 		g := glob.MustCompile(pi.input.Glob, '/')
 		// Remove the trailing slash to support glob replace on directory paths.
 		p := strings.TrimRight(fi.File.Path, "/")
+		if !include(p, fi) {
+			return nil
+		}
 		joinOn := g.Replace(p, pi.input.JoinOn)
 		groupBy := g.Replace(p, pi.input.GroupBy)
+		if contentKey != nil {
+			key, err := contentKey(fi)
+			if err != nil {
+				return err
+			}
+			joinOn, groupBy = key, key
+		}
 		return cb(&Meta{
 			Inputs: []*common.Input{
 				&common.Input{
@@ -75,6 +105,186 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	})
 }
 
+// newPFSFilter returns a function that reports whether a path matched by the
+// glob of a PFS input should produce a datum, based on the exclusion patterns
+// and size bounds of the input.
+func newPFSFilter(input *pps.PFSInput) (func(string, *pfs.FileInfo) bool, error) {
+	var fullPath, baseName []*glob.Glob
+	for _, pattern := range input.Exclude {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid exclude pattern %q", pattern)
+		}
+		if strings.Contains(pattern, "/") {
+			fullPath = append(fullPath, g)
+		} else {
+			baseName = append(baseName, g)
+		}
+	}
+	modifiedAfter, modifiedBefore, err := modifiedBounds(input)
+	if err != nil {
+		return nil, err
+	}
+	return func(p string, fi *pfs.FileInfo) bool {
+		for _, g := range fullPath {
+			if g.Match(p) {
+				return false
+			}
+		}
+		for _, g := range baseName {
+			if g.Match(path.Base(p)) {
+				return false
+			}
+		}
+		if input.MinSizeBytes != 0 && fi.SizeBytes < input.MinSizeBytes {
+			return false
+		}
+		if input.MaxSizeBytes != 0 && fi.SizeBytes > input.MaxSizeBytes {
+			return false
+		}
+		if modifiedAfter != nil || modifiedBefore != nil {
+			if fi.Committed == nil {
+				return false
+			}
+			committed, err := types.TimestampFromProto(fi.Committed)
+			if err != nil {
+				return false
+			}
+			if modifiedAfter != nil && committed.Before(*modifiedAfter) {
+				return false
+			}
+			if modifiedBefore != nil && committed.After(*modifiedBefore) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// modifiedBounds returns the modification time bounds of a PFS input, which
+// are nil if they're unset.
+func modifiedBounds(input *pps.PFSInput) (after, before *time.Time, _ error) {
+	if input.ModifiedAfter != nil {
+		t, err := types.TimestampFromProto(input.ModifiedAfter)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid 'modified_after'")
+		}
+		after = &t
+	}
+	if input.ModifiedBefore != nil {
+		t, err := types.TimestampFromProto(input.ModifiedBefore)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid 'modified_before'")
+		}
+		before = &t
+	}
+	if after != nil && before != nil && after.After(*before) {
+		return nil, nil, errors.Errorf("input 'modified_after' (%v) cannot be later than 'modified_before' (%v)", *after, *before)
+	}
+	return after, before, nil
+}
+
+// newContentKeyFunc returns a function that reads the join / group key from
+// the contents of a file, as described by ck. It returns nil if ck is nil.
+func newContentKeyFunc(pachClient *client.APIClient, ck *pps.ContentKey) (func(*pfs.FileInfo) (string, error), error) {
+	if ck == nil {
+		return nil, nil
+	}
+	if ck.JSONPath != "" {
+		code, err := compileJSONPath(ck.JSONPath)
+		if err != nil {
+			return nil, err
+		}
+		return func(fi *pfs.FileInfo) (string, error) {
+			if fi.FileType != pfs.FileType_FILE {
+				return "", errors.Errorf("cannot read a content key from %v, it is not a file", fi.File.Path)
+			}
+			buf := &bytes.Buffer{}
+			if err := pachClient.GetFile(fi.File.Commit, fi.File.Path, buf); err != nil {
+				return "", err
+			}
+			// Decode numbers as json.Number so that large integer keys aren't
+			// rounded to float64.
+			dec := json.NewDecoder(buf)
+			dec.UseNumber()
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return "", errors.Wrapf(err, "could not parse %v as JSON", fi.File.Path)
+			}
+			if dec.More() {
+				return "", errors.Errorf("could not parse %v as JSON: unexpected data after the top-level value", fi.File.Path)
+			}
+			result, ok := code.Run(v).Next()
+			if !ok || result == nil {
+				return "", errors.Errorf("%q selects nothing in %v", ck.JSONPath, fi.File.Path)
+			}
+			if err, ok := result.(error); ok {
+				return "", errors.Wrapf(err, "could not evaluate %q on %v", ck.JSONPath, fi.File.Path)
+			}
+			if key, ok := result.(string); ok {
+				return key, nil
+			}
+			key, err := json.Marshal(result)
+			return string(key), errors.EnsureStack(err)
+		}, nil
+	}
+	if ck.Line < 0 || ck.Field < 0 {
+		return nil, errors.Errorf("content key line and field cannot be negative")
+	}
+	delimiter := ck.Delimiter
+	if delimiter == "" {
+		delimiter = ","
+	}
+	return func(fi *pfs.FileInfo) (string, error) {
+		if fi.FileType != pfs.FileType_FILE {
+			return "", errors.Errorf("cannot read a content key from %v, it is not a file", fi.File.Path)
+		}
+		// Only the beginning of the file is read, so cancel the rest of the
+		// transfer when done.
+		ctx, cancel := context.WithCancel(pachClient.Ctx())
+		defer cancel()
+		r, err := pachClient.WithCtx(ctx).GetFileReader(fi.File.Commit, fi.File.Path)
+		if err != nil {
+			return "", err
+		}
+		br := bufio.NewReader(r)
+		var line string
+		for i := int64(0); i <= ck.Line; i++ {
+			line, err = br.ReadString('\n')
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					return "", errors.EnsureStack(err)
+				}
+				// The last line may not end with a newline.
+				if line == "" {
+					return "", errors.Errorf("%v has no line %d", fi.File.Path, ck.Line)
+				}
+			}
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if ck.Field == 0 {
+			return line, nil
+		}
+		fields := strings.Split(line, delimiter)
+		if int64(len(fields)) < ck.Field {
+			return "", errors.Errorf("line %d of %v has no field %d", ck.Line, fi.File.Path, ck.Field)
+		}
+		return fields[ck.Field-1], nil
+	}, nil
+}
+
+func compileJSONPath(jsonPath string) (*gojq.Code, error) {
+	query, err := gojq.Parse(jsonPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid content key JSON path %q", jsonPath)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid content key JSON path %q", jsonPath)
+	}
+	return code, nil
+}
+
 type unionIterator struct {
 	iterators []Iterator
 }
@@ -143,6 +353,16 @@ func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) Iterato
 	})
 }
 
+func newURLIterator(pachClient *client.APIClient, input *pps.URLInput) Iterator {
+	return newPFSIterator(pachClient, &pps.PFSInput{
+		Name:   input.Name,
+		Repo:   input.Repo,
+		Branch: "master",
+		Commit: input.Commit,
+		Glob:   input.Glob,
+	})
+}
+
 // Hasher is the standard interface for a datum hasher.
 type Hasher interface {
 	// Hash computes the datum hash based on the inputs.
@@ -217,12 +437,14 @@ func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
 	}
 }
 
-// TODO: Improve the scalability (in-memory operation for now).
-// Probably should take advantage of PFS filesets for this.
+// joinIterator computes a join with an external sorted merge, the inputs are
+// spilled into a temporary fileset keyed by their join_on value and then read
+// back key by key, so only the inputs for a single key are held in memory.
+// The keys are emitted in the order they were first seen.
 type joinIterator struct {
 	pachClient *client.APIClient
 	iterators  []Iterator
-	metas      []*Meta
+	fileSet    *keyedFileSet
 }
 
 func newJoinIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterator, error) {
@@ -236,47 +458,16 @@ func newJoinIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterato
 		}
 		ji.iterators = append(ji.iterators, di)
 	}
+	ji.fileSet = newKeyedFileSet(pachClient, ji.computeJoin)
 	return ji, nil
 }
 
 func (ji *joinIterator) Iterate(cb func(*Meta) error) error {
-	if ji.metas == nil {
-		if err := ji.computeJoin(); err != nil {
-			return err
-		}
-	}
-	for _, meta := range ji.metas {
-		if err := cb(meta); err != nil {
-			return err
-		}
-	}
-	return nil
-
-}
-
-func (ji *joinIterator) computeJoin() error {
-	om := ordered_map.NewOrderedMap()
-	for i, di := range ji.iterators {
-		if err := di.Iterate(func(meta *Meta) error {
-			for _, input := range meta.Inputs {
-				tupleI, ok := om.Get(input.JoinOn)
-				var tuple [][]*common.Input
-				if !ok {
-					tuple = make([][]*common.Input, len(ji.iterators))
-				} else {
-					tuple = tupleI.([][]*common.Input)
-				}
-				tuple[i] = append(tuple[i], input)
-				om.Set(input.JoinOn, tuple)
-			}
-			return nil
-		}); err != nil {
-			return err
-		}
+	fileSetID, err := ji.fileSet.get()
+	if err != nil {
+		return err
 	}
-	iter := om.IterFunc()
-	for kv, ok := iter(); ok; kv, ok = iter() {
-		tuple := kv.Value.([][]*common.Input)
+	return iterateKeyed(ji.pachClient, fileSetID, len(ji.iterators), func(_ int64, tuple [][]*common.Input) error {
 		missing := false
 		var filteredTuple [][]*common.Input
 		for _, inputs := range tuple {
@@ -291,14 +482,33 @@ func (ji *joinIterator) computeJoin() error {
 		if missing {
 			tuple = filteredTuple
 		}
-		if err := newCrossListIterator(tuple).Iterate(func(meta *Meta) error {
-			ji.metas = append(ji.metas, meta)
-			return nil
-		}); err != nil {
-			return err
+		return newCrossListIterator(tuple).Iterate(cb)
+	})
+}
+
+func (ji *joinIterator) computeJoin(renewer *renew.StringSet) (string, error) {
+	fileSetID, err := spillKeyed(ji.pachClient, func(add func(string, int, *common.Input) error) error {
+		for i, di := range ji.iterators {
+			if err := di.Iterate(func(meta *Meta) error {
+				for _, input := range meta.Inputs {
+					if err := add(input.JoinOn, i, input); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
-	return nil
+	// The sorted fileset is only needed until it has been reordered.
+	renewer.Add(fileSetID)
+	defer renewer.Remove(fileSetID)
+	return orderKeyed(ji.pachClient, renewer, fileSetID, len(ji.iterators))
 }
 
 func newCrossListIterator(crossInputs [][]*common.Input) Iterator {
@@ -330,12 +540,12 @@ func (li *listIterator) Iterate(cb func(*Meta) error) error {
 	return nil
 }
 
-// TODO: Improve the scalability (in-memory operation for now).
-// Probably should take advantage of PFS filesets for this.
+// groupIterator computes groups with an external sorted merge, in the same
+// way as joinIterator, keyed by the group_by value of the inputs.
 type groupIterator struct {
 	pachClient *client.APIClient
 	iterators  []Iterator
-	metas      []*Meta
+	fileSet    *keyedFileSet
 }
 
 func newGroupIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterator, error) {
@@ -349,48 +559,201 @@ func newGroupIterator(pachClient *client.APIClient, inputs []*pps.Input) (Iterat
 		}
 		gi.iterators = append(gi.iterators, di)
 	}
+	gi.fileSet = newKeyedFileSet(pachClient, gi.computeGroup)
 	return gi, nil
 }
 
 func (gi *groupIterator) Iterate(cb func(*Meta) error) error {
-	if gi.metas == nil {
-		if err := gi.computeGroup(); err != nil {
-			return err
-		}
+	fileSetID, err := gi.fileSet.get()
+	if err != nil {
+		return err
 	}
-	for _, meta := range gi.metas {
-		if err := cb(meta); err != nil {
-			return err
+	return iterateKeyed(gi.pachClient, fileSetID, 1, func(_ int64, tuple [][]*common.Input) error {
+		return cb(&Meta{
+			Inputs: tuple[0],
+		})
+	})
+}
+
+func (gi *groupIterator) computeGroup(renewer *renew.StringSet) (string, error) {
+	fileSetID, err := spillKeyed(gi.pachClient, func(add func(string, int, *common.Input) error) error {
+		for _, di := range gi.iterators {
+			if err := di.Iterate(func(meta *Meta) error {
+				for _, input := range meta.Inputs {
+					if err := add(input.GroupBy, 0, input); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return "", err
 	}
-	return nil
+	renewer.Add(fileSetID)
+	return fileSetID, nil
+}
+
+// keyedFileSet is a keyed fileset that is computed the first time it's needed
+// and then reused, so that an iterator which is iterated repeatedly (e.g. the
+// inner input of a cross) only spills its inputs once. The fileset is renewed
+// until the client's context is done.
+type keyedFileSet struct {
+	pachClient *client.APIClient
+	compute    func(*renew.StringSet) (string, error)
+
+	mu        sync.Mutex
+	renewer   *renew.StringSet
+	fileSetID string
+}
 
+func newKeyedFileSet(pachClient *client.APIClient, compute func(*renew.StringSet) (string, error)) *keyedFileSet {
+	return &keyedFileSet{
+		pachClient: pachClient,
+		compute:    compute,
+	}
 }
 
-func (gi *groupIterator) computeGroup() error {
-	groupMap := make(map[string][]*common.Input)
-	keys := make([]string, 0, len(gi.iterators))
-	for _, di := range gi.iterators {
-		if err := di.Iterate(func(meta *Meta) error {
-			for _, input := range meta.Inputs {
-				groupDatum, ok := groupMap[input.GroupBy]
-				if !ok {
-					keys = append(keys, input.GroupBy)
+func (kfs *keyedFileSet) get() (string, error) {
+	kfs.mu.Lock()
+	defer kfs.mu.Unlock()
+	if kfs.fileSetID != "" {
+		return kfs.fileSetID, nil
+	}
+	if kfs.renewer == nil {
+		kfs.renewer = renew.NewStringSet(kfs.pachClient.Ctx(), client.DefaultTTL, func(ctx context.Context, id string, ttl time.Duration) error {
+			return kfs.pachClient.WithCtx(ctx).RenewFileSet(id, ttl)
+		})
+	}
+	fileSetID, err := kfs.compute(kfs.renewer)
+	if err != nil {
+		return "", err
+	}
+	kfs.fileSetID = fileSetID
+	return fileSetID, nil
+}
+
+// spillKeyed writes the inputs added by cb to a temporary fileset and returns
+// its ID. Each input is written to /<key>/<index>/<sequence number>, so
+// iterating the fileset in path order yields the inputs grouped by key, then
+// by index, in the order they were added.
+func spillKeyed(pachClient *client.APIClient, cb func(add func(key string, index int, input *common.Input) error) error) (string, error) {
+	resp, err := pachClient.WithCreateFileSetClient(func(mf client.ModifyFile) error {
+		var seq int64
+		return cb(func(key string, index int, input *common.Input) error {
+			data, err := proto.Marshal(input)
+			if err != nil {
+				return errors.EnsureStack(err)
+			}
+			p := path.Join("/", encodeKey(key), fmt.Sprintf("%08d", index), fmt.Sprintf("%016d", seq))
+			seq++
+			return mf.PutFile(p, bytes.NewReader(data))
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.FileSetId, nil
+}
+
+// orderKeyed rewrites a fileset written by spillKeyed so that its keys are
+// iterated in the order they were first added rather than in sorted order.
+// The ID of the new fileset is returned and added to renewer.
+func orderKeyed(pachClient *client.APIClient, renewer *renew.StringSet, fileSetID string, n int) (string, error) {
+	orderedID, err := spillKeyed(pachClient, func(add func(string, int, *common.Input) error) error {
+		return iterateKeyed(pachClient, fileSetID, n, func(first int64, tuple [][]*common.Input) error {
+			key := fmt.Sprintf("%016d", first)
+			for i, inputs := range tuple {
+				for _, input := range inputs {
+					if err := add(key, i, input); err != nil {
+						return err
+					}
 				}
-				groupMap[input.GroupBy] = append(groupDatum, input)
 			}
 			return nil
-		}); err != nil {
-			return err
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	renewer.Add(orderedID)
+	return orderedID, nil
+}
+
+// iterateKeyed iterates over a fileset written by spillKeyed, calling cb once
+// per key with the sequence number of the first input added for that key and
+// the inputs for that key, indexed by the index they were added with
+// (0 <= index < n).
+func iterateKeyed(pachClient *client.APIClient, fileSetID string, n int, cb func(int64, [][]*common.Input) error) error {
+	r, err := pachClient.GetFileTAR(client.NewRepo(client.FileSetsRepoName).NewCommit("", fileSetID), "/*/*/*")
+	if err != nil {
+		return err
+	}
+	var key string
+	var first int64
+	var tuple [][]*common.Input
+	flush := func() error {
+		if tuple == nil {
+			return nil
 		}
+		defer func() { tuple = nil }()
+		return cb(first, tuple)
 	}
-	sort.Strings(keys)
-	for _, key := range keys {
-		gi.metas = append(gi.metas, &Meta{
-			Inputs: groupMap[key],
-		})
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if pfsserver.IsFileNotFoundErr(err) || errors.Is(err, io.EOF) {
+				return flush()
+			}
+			return err
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		dir := path.Dir(path.Clean(hdr.Name))
+		index, err := strconv.Atoi(path.Base(dir))
+		if err != nil || index < 0 || index >= n {
+			return errors.Errorf("unexpected path in keyed fileset: %v", hdr.Name)
+		}
+		seq, err := strconv.ParseInt(path.Base(hdr.Name), 10, 64)
+		if err != nil {
+			return errors.Errorf("unexpected path in keyed fileset: %v", hdr.Name)
+		}
+		if k := path.Base(path.Dir(dir)); k != key {
+			if err := flush(); err != nil {
+				return err
+			}
+			key = k
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		input := &common.Input{}
+		if err := proto.Unmarshal(data, input); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if tuple == nil {
+			tuple = make([][]*common.Input, n)
+			first = seq
+		}
+		if seq < first {
+			first = seq
+		}
+		tuple[index] = append(tuple[index], input)
 	}
-	return nil
+}
+
+// encodeKey encodes a join or group key as a path component. Hex encoding
+// preserves the ordering of keys and avoids '/' in the path, the prefix keeps
+// the component non-empty for the empty key.
+func encodeKey(key string) string {
+	return "k" + hex.EncodeToString([]byte(key))
 }
 
 // Merge merges multiple datum iterators (key is datum ID).
@@ -484,6 +847,8 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		}
 	case input.Cron != nil:
 		iterator = newCronIterator(pachClient, input.Cron)
+	case input.URL != nil:
+		iterator = newURLIterator(pachClient, input.URL)
 	default:
 		return nil, errors.Errorf("unrecognized input type: %v", input)
 	}
@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dockertestenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 func TestIterators(t *testing.T) {
@@ -48,6 +51,53 @@ func TestIterators(t *testing.T) {
 		validateDI(t, pfs1, "/foo11", "/foo21", "/foo31", "/foo41")
 		validateDI(t, pfs2, "/foo12", "/foo2", "/foo22", "/foo32", "/foo42")
 	})
+	// PFS inputs with exclusions and size bounds.
+	t.Run("Exclude", func(t *testing.T) {
+		in := client.NewPFSInput(dataRepo, "/foo?1")
+		in.Pfs.Commit = commit.ID
+		in.Pfs.Exclude = []string{"foo2*", "/foo4?"}
+		pfs, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs, "/foo11", "/foo31")
+	})
+	t.Run("SizeBounds", func(t *testing.T) {
+		in := client.NewPFSInput(dataRepo, "/foo?1")
+		in.Pfs.Commit = commit.ID
+		in.Pfs.MaxSizeBytes = int64(len("input"))
+		pfs, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs, "/foo11", "/foo21", "/foo31", "/foo41")
+		in.Pfs.MinSizeBytes = int64(len("input")) + 1
+		in.Pfs.MaxSizeBytes = 0
+		pfs, err = NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs)
+	})
+	t.Run("ModifiedBounds", func(t *testing.T) {
+		fi, err := c.InspectFile(commit, "/foo11")
+		require.NoError(t, err)
+		committed, err := types.TimestampFromProto(fi.Committed)
+		require.NoError(t, err)
+		in := client.NewPFSInput(dataRepo, "/foo?1")
+		in.Pfs.Commit = commit.ID
+		in.Pfs.ModifiedAfter = fi.Committed
+		in.Pfs.ModifiedBefore = fi.Committed
+		pfs, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs, "/foo11", "/foo21", "/foo31", "/foo41")
+		in.Pfs.ModifiedAfter, err = types.TimestampProto(committed.Add(time.Second))
+		require.NoError(t, err)
+		in.Pfs.ModifiedBefore = nil
+		pfs, err = NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs)
+		in.Pfs.ModifiedAfter = nil
+		in.Pfs.ModifiedBefore, err = types.TimestampProto(committed.Add(-time.Second))
+		require.NoError(t, err)
+		pfs, err = NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, pfs)
+	})
 	// Union input.
 	in3 := client.NewUnionInput(in1, in2)
 	t.Run("Union", func(t *testing.T) {
@@ -352,6 +402,169 @@ func TestIterators(t *testing.T) {
 //	)
 //}
 
+func TestContentKeyIterators(t *testing.T) {
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+	c := env.PachClient
+	jsonRepo := tu.UniqueString(t.Name() + "_json")
+	require.NoError(t, c.CreateRepo(jsonRepo))
+	jsonCommit, err := c.StartCommit(jsonRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(jsonCommit, "/a1.json", strings.NewReader(`{"user": {"id": 1}}`)))
+	require.NoError(t, c.PutFile(jsonCommit, "/a2.json", strings.NewReader(`{"user": {"id": 2}}`)))
+	// 2^53 + 1 can't be represented exactly as a float64.
+	require.NoError(t, c.PutFile(jsonCommit, "/a3.json", strings.NewReader(`{"user": {"id": 9007199254740993}}`)))
+	require.NoError(t, c.FinishCommit(jsonRepo, jsonCommit.Branch.Name, jsonCommit.ID))
+	csvRepo := tu.UniqueString(t.Name() + "_csv")
+	require.NoError(t, c.CreateRepo(csvRepo))
+	csvCommit, err := c.StartCommit(csvRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(csvCommit, "/b1.csv", strings.NewReader("id,value\n2,foo\n")))
+	require.NoError(t, c.PutFile(csvCommit, "/b2.csv", strings.NewReader("id,value\n1,bar")))
+	require.NoError(t, c.PutFile(csvCommit, "/b3.csv", strings.NewReader("id,value\n3,baz\n")))
+	require.NoError(t, c.PutFile(csvCommit, "/b4.csv", strings.NewReader("id,value\n9007199254740993,qux\n")))
+	require.NoError(t, c.FinishCommit(csvRepo, csvCommit.Branch.Name, csvCommit.ID))
+
+	jsonInput := client.NewPFSInput(jsonRepo, "/*")
+	jsonInput.Pfs.Commit = jsonCommit.ID
+	jsonInput.Pfs.ContentKey = &pps.ContentKey{JSONPath: ".user.id"}
+	csvInput := client.NewPFSInput(csvRepo, "/*")
+	csvInput.Pfs.Commit = csvCommit.ID
+	csvInput.Pfs.ContentKey = &pps.ContentKey{Line: 1, Field: 1}
+	t.Run("Join", func(t *testing.T) {
+		join, err := NewIterator(c, client.NewJoinInput(jsonInput, csvInput))
+		require.NoError(t, err)
+		validateDI(t, join, "/a1.json/b2.csv", "/a2.json/b1.csv", "/a3.json/b4.csv")
+	})
+	t.Run("Group", func(t *testing.T) {
+		headerInput := client.NewPFSInput(csvRepo, "/*")
+		headerInput.Pfs.Commit = csvCommit.ID
+		headerInput.Pfs.ContentKey = &pps.ContentKey{}
+		group, err := NewIterator(c, client.NewGroupInput(headerInput))
+		require.NoError(t, err)
+		validateDI(t, group, "/b1.csv/b2.csv/b3.csv/b4.csv")
+	})
+	t.Run("MissingLine", func(t *testing.T) {
+		in := client.NewPFSInput(csvRepo, "/*")
+		in.Pfs.Commit = csvCommit.ID
+		in.Pfs.ContentKey = &pps.ContentKey{Line: 2}
+		pfs, err := NewIterator(c, in)
+		require.NoError(t, err)
+		require.YesError(t, pfs.Iterate(func(*Meta) error { return nil }))
+	})
+}
+
+// TestJoinGroupOrder tests that joins emit their datums in the order their
+// keys were first seen and groups in key order, on every iteration.
+func TestJoinGroupOrder(t *testing.T) {
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+	c := env.PachClient
+	dataRepo := tu.UniqueString(t.Name() + "_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for _, p := range []string{"/a-3", "/b-1", "/c-2"} {
+		require.NoError(t, c.PutFile(commit, p, strings.NewReader("input")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	in1 := client.NewPFSInputOpts("", dataRepo, "", "/(?)-(?)", "$2", "$2", false, false, nil)
+	in1.Pfs.Commit = commit.ID
+	in2 := client.NewPFSInputOpts("", dataRepo, "", "/(?)-(?)", "$2", "$2", false, false, nil)
+	in2.Pfs.Commit = commit.ID
+	t.Run("Join", func(t *testing.T) {
+		join, err := NewIterator(c, client.NewJoinInput(in1, in2))
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			require.Equal(t, []string{"/a-3/a-3", "/b-1/b-1", "/c-2/c-2"}, collectDI(t, join))
+		}
+	})
+	t.Run("Group", func(t *testing.T) {
+		group, err := NewIterator(c, client.NewGroupInput(in1))
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			require.Equal(t, []string{"/b-1", "/c-2", "/a-3"}, collectDI(t, group))
+		}
+	})
+}
+
+// TestCrossKeyedSpillsOnce tests that a join or group nested under a cross
+// only spills its inputs once, rather than once per datum of the outer input.
+func TestCrossKeyedSpillsOnce(t *testing.T) {
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, dockertestenv.NewTestDBConfig(t))
+
+	c := env.PachClient
+	dataRepo := tu.UniqueString(t.Name() + "_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for _, p := range []string{"/a-1", "/b-2", "/c-3"} {
+		require.NoError(t, c.PutFile(commit, p, strings.NewReader("input")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit.Branch.Name, commit.ID))
+	in1 := client.NewPFSInputOpts("", dataRepo, "", "/(?)-(?)", "$2", "$2", false, false, nil)
+	in1.Pfs.Commit = commit.ID
+	in2 := client.NewPFSInputOpts("", dataRepo, "", "/(?)-(?)", "$2", "$2", false, false, nil)
+	in2.Pfs.Commit = commit.ID
+	outer, err := NewIterator(c, in1)
+	require.NoError(t, err)
+	t.Run("Join", func(t *testing.T) {
+		di, err := newJoinIterator(c, []*pps.Input{in1, in2})
+		require.NoError(t, err)
+		ji := di.(*joinIterator)
+		counts := countIterations(ji.iterators)
+		require.Equal(t, 9, len(collectDI(t, &crossIterator{iterators: []Iterator{outer, ji}})))
+		for _, count := range counts {
+			require.Equal(t, 1, *count)
+		}
+	})
+	t.Run("Group", func(t *testing.T) {
+		di, err := newGroupIterator(c, []*pps.Input{in1})
+		require.NoError(t, err)
+		gi := di.(*groupIterator)
+		counts := countIterations(gi.iterators)
+		require.Equal(t, 9, len(collectDI(t, &crossIterator{iterators: []Iterator{outer, gi}})))
+		for _, count := range counts {
+			require.Equal(t, 1, *count)
+		}
+	})
+}
+
+type countingIterator struct {
+	Iterator
+	count int
+}
+
+func (ci *countingIterator) Iterate(cb func(*Meta) error) error {
+	ci.count++
+	return ci.Iterator.Iterate(cb)
+}
+
+// countIterations wraps each of iterators so that the number of times it's
+// iterated is counted, and returns the counts.
+func countIterations(iterators []Iterator) []*int {
+	var counts []*int
+	for i, di := range iterators {
+		ci := &countingIterator{Iterator: di}
+		iterators[i] = ci
+		counts = append(counts, &ci.count)
+	}
+	return counts
+}
+
+func collectDI(t testing.TB, di Iterator) []string {
+	t.Helper()
+	var datums []string
+	require.NoError(t, di.Iterate(func(meta *Meta) error {
+		datums = append(datums, computeKey(meta))
+		return nil
+	}))
+	return datums
+}
+
 func validateDI(t testing.TB, di Iterator, datums ...string) {
 	t.Helper()
 	datumMap := make(map[string]struct{})
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 // SetOption configures a set.
@@ -33,6 +34,14 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithQuarantine records the failure of each datum that fails in the meta
+// output, so that the job can succeed regardless.
+func WithQuarantine() SetOption {
+	return func(s *Set) {
+		s.quarantine = true
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -43,6 +52,17 @@ func WithRetry(numRetries int) Option {
 	}
 }
 
+// WithRetryPolicy sets the retry policy, which takes precedence over
+// WithRetry if it specifies a maximum number of attempts.
+func WithRetryPolicy(policy *pps.DatumRetryPolicy) Option {
+	return func(d *Datum) {
+		d.retryPolicy = policy
+		if policy.MaxAttempts > 0 {
+			d.numRetries = int(policy.MaxAttempts) - 1
+		}
+	}
+}
+
 // WithRecoveryCallback sets the recovery callback.
 func WithRecoveryCallback(cb func(context.Context) error) Option {
 	return func(d *Datum) {
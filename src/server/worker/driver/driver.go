@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -327,8 +328,9 @@ func (d *driver) RunUserCode(
 	if d.pipelineInfo.Details.Transform.Stdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Details.Transform.Stdin, "\n") + "\n")
 	}
+	stderrTail := &tailBuffer{size: userCodeStderrTailBytes}
 	cmd.Stdout = logger.WithUserCode()
-	cmd.Stderr = logger.WithUserCode()
+	cmd.Stderr = io.MultiWriter(logger.WithUserCode(), stderrTail)
 	cmd.Env = environ
 	if d.uid != nil && d.gid != nil {
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
@@ -377,11 +379,36 @@ func (d *driver) RunUserCode(
 				}
 			}
 		}
-		return errors.EnsureStack(err)
+		return &common.UserCodeError{
+			Err:        errors.EnsureStack(err),
+			StderrTail: stderrTail.String(),
+		}
 	}
 	return nil
 }
 
+// userCodeStderrTailBytes is how much of the end of the user code's stderr is
+// kept for reporting failures.
+const userCodeStderrTailBytes = 4096
+
+// tailBuffer is an io.Writer that keeps the last size bytes written to it.
+type tailBuffer struct {
+	size int
+	buf  []byte
+}
+
+func (tb *tailBuffer) Write(data []byte) (int, error) {
+	tb.buf = append(tb.buf, data...)
+	if len(tb.buf) > tb.size {
+		tb.buf = append(tb.buf[:0:0], tb.buf[len(tb.buf)-tb.size:]...)
+	}
+	return len(data), nil
+}
+
+func (tb *tailBuffer) String() string {
+	return string(tb.buf)
+}
+
 func (d *driver) RunUserErrorHandlingCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
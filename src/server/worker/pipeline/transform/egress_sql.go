@@ -0,0 +1,274 @@
+package transform
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// sqlDialect captures the differences between the databases that output can
+// be egressed to.
+type sqlDialect struct {
+	driver      string
+	quote       func(string) string
+	placeholder func(int) string
+}
+
+var (
+	postgresDialect = &sqlDialect{
+		driver: "pgx",
+		quote: func(ident string) string {
+			return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+		},
+		placeholder: func(i int) string {
+			return fmt.Sprintf("$%d", i+1)
+		},
+	}
+	mysqlDialect = &sqlDialect{
+		driver: "mysql",
+		quote: func(ident string) string {
+			return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+		},
+		placeholder: func(int) string {
+			return "?"
+		},
+	}
+)
+
+// openEgressDB connects to the database at rawURL with password.
+func openEgressDB(rawURL, password string) (*sqlx.DB, *sqlDialect, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error parsing database url")
+	}
+	switch u.Scheme {
+	case "postgres", "postgresql":
+		if password != "" {
+			u.User = url.UserPassword(u.User.Username(), password)
+		}
+		db, err := sqlx.Open(postgresDialect.driver, u.String())
+		return db, postgresDialect, errors.EnsureStack(err)
+	case "mysql":
+		cfg, err := mysqlConfig(u, password)
+		if err != nil {
+			return nil, nil, err
+		}
+		db, err := sqlx.Open(mysqlDialect.driver, cfg.FormatDSN())
+		return db, mysqlDialect, errors.EnsureStack(err)
+	default:
+		return nil, nil, errors.Errorf("unsupported database %q, must be one of {postgres,mysql}", u.Scheme)
+	}
+}
+
+// mysqlConfig converts a mysql:// URL into a driver config. The query
+// parameters of the URL (e.g. tls, parseTime, charset) are parsed as DSN
+// parameters, and password, if it's set, takes precedence over the password
+// in the URL.
+func mysqlConfig(u *url.URL, password string) (*mysql.Config, error) {
+	cfg, err := mysql.ParseDSN(fmt.Sprintf("tcp(%s)/?%s", u.Host, u.RawQuery))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing database url")
+	}
+	cfg.User = u.User.Username()
+	cfg.Passwd, _ = u.User.Password()
+	if password != "" {
+		cfg.Passwd = password
+	}
+	cfg.DBName = strings.TrimPrefix(u.Path, "/")
+	return cfg, nil
+}
+
+// egressLoadsTable is created next to each egress table and records the
+// output commits that have been loaded into it. A commit is recorded in the
+// same transaction that loads it, so a restarted egress can tell whether the
+// load already happened.
+const egressLoadsTable = "pachyderm_egress_loads"
+
+// egressDataError is returned for files that can't be loaded as the
+// configured format. Retrying the egress can't fix them, so the job is failed.
+type egressDataError struct {
+	error
+}
+
+func (e egressDataError) Unwrap() error {
+	return e.error
+}
+
+func isEgressDataError(err error) bool {
+	return errors.As(err, &egressDataError{})
+}
+
+// egressSQL loads the files in commit into the table described by egress, in
+// a single database transaction. Commits that have already been loaded into
+// the table are skipped.
+func egressSQL(pachClient *client.APIClient, commit *pfs.Commit, egress *pps.SQLDatabaseEgress, password string) (retErr error) {
+	ctx := pachClient.Ctx()
+	db, dialect, err := openEgressDB(egress.URL, password)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	qualify := func(name string) string {
+		if egress.Schema != "" {
+			return dialect.quote(egress.Schema) + "." + dialect.quote(name)
+		}
+		return dialect.quote(name)
+	}
+	table, loads := qualify(egress.Table), qualify(egressLoadsTable)
+	// Created outside of the load's transaction, since DDL commits implicitly in MySQL.
+	if _, err := db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		table_name VARCHAR(255) NOT NULL,
+		output_commit VARCHAR(255) NOT NULL,
+		PRIMARY KEY (table_name, output_commit)
+	)`, loads)); err != nil {
+		return errors.EnsureStack(err)
+	}
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer tx.Rollback()
+	loadKey := []interface{}{egress.Table, commit.Branch.Repo.Name + "@" + commit.ID}
+	var loaded int
+	if err := tx.GetContext(ctx, &loaded, fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE table_name = %s AND output_commit = %s",
+		loads, dialect.placeholder(0), dialect.placeholder(1)), loadKey...); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if loaded > 0 {
+		return nil
+	}
+	if egress.Mode == pps.SQLDatabaseEgress_REPLACE {
+		// DELETE rather than TRUNCATE, since TRUNCATE commits implicitly in MySQL.
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	columns := egress.FileFormat.Columns
+	quoted := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = dialect.quote(column)
+		placeholders[i] = dialect.placeholder(i)
+	}
+	stmt, err := tx.PreparexContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		table, strings.Join(quoted, ", "), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer stmt.Close()
+	if err := pachClient.WalkFile(commit, "/", func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		r, err := pachClient.GetFileReader(commit, fi.File.Path)
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(readSQLRows(r, egress.FileFormat, func(row []interface{}) error {
+			_, err := stmt.ExecContext(ctx, row...)
+			return errors.EnsureStack(err)
+		}), "error loading %s", fi.File.Path)
+	}); err != nil && !pfsserver.IsFileNotFoundErr(err) {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (table_name, output_commit) VALUES (%s, %s)",
+		loads, dialect.placeholder(0), dialect.placeholder(1)), loadKey...); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(tx.Commit())
+}
+
+// readSQLRows calls cb with the values for format's columns from each record
+// in r.
+func readSQLRows(r io.Reader, format *pps.SQLDatabaseEgress_FileFormat, cb func([]interface{}) error) error {
+	switch format.Type {
+	case pps.SQLDatabaseEgress_FileFormat_CSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = len(format.Columns)
+		for i := 0; ; i++ {
+			record, err := cr.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				var parseErr *csv.ParseError
+				if errors.As(err, &parseErr) {
+					return egressDataError{err}
+				}
+				return errors.EnsureStack(err)
+			}
+			if i == 0 && format.Header {
+				continue
+			}
+			row := make([]interface{}, len(record))
+			for i, field := range record {
+				row[i] = field
+			}
+			if err := cb(row); err != nil {
+				return err
+			}
+		}
+	case pps.SQLDatabaseEgress_FileFormat_JSON:
+		dec := json.NewDecoder(r)
+		dec.UseNumber()
+		for {
+			var record map[string]interface{}
+			if err := dec.Decode(&record); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				var syntaxErr *json.SyntaxError
+				var typeErr *json.UnmarshalTypeError
+				if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+					return egressDataError{err}
+				}
+				return errors.EnsureStack(err)
+			}
+			row := make([]interface{}, len(format.Columns))
+			for i, column := range format.Columns {
+				value, err := sqlValue(record[column])
+				if err != nil {
+					return err
+				}
+				row[i] = value
+			}
+			if err := cb(row); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("unsupported file format %v", format.Type)
+	}
+}
+
+// sqlValue converts a decoded JSON value to a value that can be inserted.
+// Missing keys and nulls are inserted as NULL, and nested objects and arrays
+// as their JSON encoding.
+func sqlValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		data, err := json.Marshal(v)
+		return string(data), errors.EnsureStack(err)
+	}
+}
@@ -3,6 +3,7 @@ package transform
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -264,6 +265,12 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 		reason := fmt.Sprintf("inputs failed: %s", strings.Join(failed, ", "))
 		return reg.failJob(pj, reason)
 	}
+	// The inputs are finished, so their metadata can no longer change.
+	metadata, err := commitSetMetadata(pj.driver.PachClient(), pj.ji.Job.ID)
+	if err != nil {
+		return err
+	}
+	pj.ji.Metadata = metadata
 	pj.ji.State = pps.JobState_JOB_RUNNING
 	return pj.writeJobInfo()
 }
@@ -395,6 +402,10 @@ func (reg *registry) processDatums(ctx context.Context, pj *pendingJob, master *
 		return err
 	}
 	if stats.FailedID != "" {
+		if pj.driver.PipelineInfo().Details.QuarantineFailedDatums {
+			pj.logger.Logf("%v datums failed and were quarantined, see /%v in the meta commit", stats.Failed, datum.ErrorsPrefix)
+			return nil
+		}
 		if err := reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedID)); err != nil {
 			return err
 		}
@@ -445,8 +456,39 @@ func deserializeDatumSet(any *types.Any) (*DatumSet, error) {
 }
 
 func (reg *registry) processJobEgressing(pj *pendingJob) error {
-	url := pj.ji.Details.Egress.URL
-	err := pj.driver.PachClient().GetFileURL(pj.commitInfo.Commit, "/", url)
+	pachClient := pj.driver.PachClient()
+	egress := pj.ji.Details.Egress
+	if egress.SQLDatabase != nil {
+		if err := egressSQL(pachClient, pj.commitInfo.Commit, egress.SQLDatabase, os.Getenv(client.PPSEgressSQLPasswordEnv)); err != nil {
+			if isEgressDataError(err) {
+				return reg.failJob(pj, fmt.Sprintf("egress failed: %v", err))
+			}
+			return err
+		}
+		return reg.succeedJob(pj)
+	}
+	var opts []client.GetFileOption
+	if egress.Incremental {
+		// The base is recorded before anything is written, so that a restarted
+		// egress diffs against the same commit.
+		if pj.ji.EgressBase == nil {
+			base, err := lastEgressedCommit(pachClient, pj.ji, pj.commitInfo)
+			if err != nil {
+				return err
+			}
+			if base != nil {
+				pj.ji.EgressBase = base
+				if err := pj.writeJobInfo(); err != nil {
+					return err
+				}
+			}
+		}
+		if pj.ji.EgressBase != nil {
+			pj.logger.Logf("egressing changes since commit %s", pj.ji.EgressBase.ID)
+			opts = append(opts, client.WithSinceGetFile(pj.ji.EgressBase, egress.DeleteRemoved))
+		}
+	}
+	err := pachClient.GetFileURL(pj.commitInfo.Commit, "/", egress.URL, opts...)
 	// file not found means the commit is empty, nothing to egress
 	if err != nil && !pfsserver.IsFileNotFoundErr(err) {
 		return err
@@ -454,6 +496,62 @@ func (reg *registry) processJobEgressing(pj *pendingJob) error {
 	return reg.succeedJob(pj)
 }
 
+// lastEgressedCommit returns the closest ancestor of the job's output commit
+// that was egressed to the same URL, or nil if the contents of the URL cannot
+// be derived from an ancestor, in which case the whole output commit should be
+// egressed.
+func lastEgressedCommit(pachClient *client.APIClient, jobInfo *pps.JobInfo, commitInfo *pfs.CommitInfo) (*pfs.Commit, error) {
+	for parent := commitInfo.ParentCommit; parent != nil; {
+		parentJobInfo, err := pachClient.InspectJob(jobInfo.Job.Pipeline.Name, parent.ID, true)
+		if err != nil {
+			if errutil.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		switch parentJobInfo.State {
+		case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FINISHING:
+			if egress := parentJobInfo.Details.Egress; egress != nil && egress.URL == jobInfo.Details.Egress.URL {
+				return parent, nil
+			}
+			return nil, nil
+		case pps.JobState_JOB_FAILURE:
+			// Failed jobs are never egressed.
+		default:
+			// Killed jobs may have been partially egressed.
+			return nil, nil
+		}
+		parentCommitInfo, err := pachClient.InspectCommit(parent.Branch.Repo.Name, parent.Branch.Name, parent.ID)
+		if err != nil {
+			return nil, err
+		}
+		parent = parentCommitInfo.ParentCommit
+	}
+	return nil, nil
+}
+
+// commitSetMetadata merges the metadata of the user commits in a commit set,
+// which are the commits that triggered the jobs in it.
+func commitSetMetadata(pachClient *client.APIClient, id string) (map[string]string, error) {
+	commitInfos, err := pachClient.InspectCommitSet(id)
+	if err != nil {
+		return nil, err
+	}
+	var metadata map[string]string
+	for _, ci := range commitInfos {
+		if ci.Origin.Kind != pfs.OriginKind_USER {
+			continue
+		}
+		for k, v := range ci.Metadata {
+			if metadata == nil {
+				metadata = make(map[string]string)
+			}
+			metadata[k] = v
+		}
+	}
+	return metadata, nil
+}
+
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	waitCommit := func(name string, commit *pfs.Commit) error {
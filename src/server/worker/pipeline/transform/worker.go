@@ -78,12 +78,15 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	// Setup file operation client for output meta commit.
 	resp, err := pachClient.WithCreateFileSetClient(func(mfMeta client.ModifyFile) error {
 		// Setup file operation client for output PFS commit.
-		resp, err := pachClient.WithCreateFileSetClient(func(mfPFS client.ModifyFile) (retErr error) {
+		resp, err := pachClient.WithCreateFileSetClientForCommit(datumSet.OutputCommit, func(mfPFS client.ModifyFile) (retErr error) {
 			opts := []datum.SetOption{
 				datum.WithMetaOutput(mfMeta),
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
 			}
+			if driver.PipelineInfo().Details.QuarantineFailedDatums {
+				opts = append(opts, datum.WithQuarantine())
+			}
 			// Setup datum set for processing.
 			return datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
 				di := datum.NewFileSetIterator(pachClient, datumSet.FileSetId)
@@ -104,6 +107,9 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 					if driver.PipelineInfo().Details.DatumTries > 0 {
 						opts = append(opts, datum.WithRetry(int(driver.PipelineInfo().Details.DatumTries)-1))
 					}
+					if driver.PipelineInfo().Details.DatumRetryPolicy != nil {
+						opts = append(opts, datum.WithRetryPolicy(driver.PipelineInfo().Details.DatumRetryPolicy))
+					}
 					if driver.PipelineInfo().Details.Transform.ErrCmd != nil {
 						opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
 							return driver.RunUserErrorHandlingCode(runCtx, logger, env)
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"strconv"
@@ -94,7 +95,14 @@ func Conns(ctx context.Context, pipelineRcName string, etcdClient *etcd.Client,
 		}
 		ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", wIP, workerGrpcPort),
+		// Workers normally register only their IP and all listen on
+		// workerGrpcPort, but workers sharing a host (i.e. run by the local
+		// worker runtime) each register an address with their own port
+		addr := wIP
+		if _, _, err := net.SplitHostPort(wIP); err != nil {
+			addr = net.JoinHostPort(wIP, strconv.Itoa(int(workerGrpcPort)))
+		}
+		conn, err := grpc.DialContext(ctx, addr,
 			append(client.DefaultDialOptions(), grpc.WithInsecure())...)
 		if err != nil {
 			return nil, err
@@ -71,19 +71,24 @@ var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
 
 type TransactionRequest struct {
 	// Exactly one of these fields should be set
-	CreateRepo           *pfs.CreateRepoRequest      `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
-	DeleteRepo           *pfs.DeleteRepoRequest      `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
-	StartCommit          *pfs.StartCommitRequest     `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
-	FinishCommit         *pfs.FinishCommitRequest    `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
-	SquashCommitSet      *pfs.SquashCommitSetRequest `protobuf:"bytes,5,opt,name=squash_commit_set,json=squashCommitSet,proto3" json:"squash_commit_set,omitempty"`
-	CreateBranch         *pfs.CreateBranchRequest    `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
-	DeleteBranch         *pfs.DeleteBranchRequest    `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
-	UpdateJobState       *pps.UpdateJobStateRequest  `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
-	CreatePipeline       *pps.CreatePipelineRequest  `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
-	StopJob              *pps.StopJobRequest         `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	CreateRepo      *pfs.CreateRepoRequest      `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
+	DeleteRepo      *pfs.DeleteRepoRequest      `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
+	StartCommit     *pfs.StartCommitRequest     `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
+	FinishCommit    *pfs.FinishCommitRequest    `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
+	SquashCommitSet *pfs.SquashCommitSetRequest `protobuf:"bytes,5,opt,name=squash_commit_set,json=squashCommitSet,proto3" json:"squash_commit_set,omitempty"`
+	CreateBranch    *pfs.CreateBranchRequest    `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
+	DeleteBranch    *pfs.DeleteBranchRequest    `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
+	UpdateJobState  *pps.UpdateJobStateRequest  `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
+	CreatePipeline  *pps.CreatePipelineRequest  `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
+	StopJob         *pps.StopJobRequest         `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
+	// Adds a file set, uploaded beforehand with CreateFileSet, to a commit.
+	AddFileSet           *pfs.AddFileSetRequest     `protobuf:"bytes,11,opt,name=add_file_set,json=addFileSet,proto3" json:"add_file_set,omitempty"`
+	DeletePipeline       *pps.DeletePipelineRequest `protobuf:"bytes,12,opt,name=delete_pipeline,json=deletePipeline,proto3" json:"delete_pipeline,omitempty"`
+	StopPipeline         *pps.StopPipelineRequest   `protobuf:"bytes,13,opt,name=stop_pipeline,json=stopPipeline,proto3" json:"stop_pipeline,omitempty"`
+	StartPipeline        *pps.StartPipelineRequest  `protobuf:"bytes,14,opt,name=start_pipeline,json=startPipeline,proto3" json:"start_pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
@@ -189,6 +194,34 @@ func (m *TransactionRequest) GetStopJob() *pps.StopJobRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetAddFileSet() *pfs.AddFileSetRequest {
+	if m != nil {
+		return m.AddFileSet
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeletePipeline() *pps.DeletePipelineRequest {
+	if m != nil {
+		return m.DeletePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStopPipeline() *pps.StopPipelineRequest {
+	if m != nil {
+		return m.StopPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStartPipeline() *pps.StartPipelineRequest {
+	if m != nil {
+		return m.StartPipeline
+	}
+	return nil
+}
+
 type TransactionResponse struct {
 	// At most, one of these fields should be set (most responses are empty)
 	Commit               *pfs.Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -285,14 +318,20 @@ func (m *Transaction) GetID() string {
 }
 
 type TransactionInfo struct {
-	Transaction          *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Requests             []*TransactionRequest  `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
-	Responses            []*TransactionResponse `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
-	Started              *types.Timestamp       `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
-	Version              uint64                 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Transaction *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Requests    []*TransactionRequest  `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Responses   []*TransactionResponse `protobuf:"bytes,3,rep,name=responses,proto3" json:"responses,omitempty"`
+	Started     *types.Timestamp       `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	Version     uint64                 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// The principal that started the transaction, empty if auth was not
+	// activated at the time.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The time after which the transaction is deleted if it hasn't been
+	// finished.
+	Expires              *types.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
@@ -363,6 +402,20 @@ func (m *TransactionInfo) GetVersion() uint64 {
 	return 0
 }
 
+func (m *TransactionInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetExpires() *types.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
 type TransactionInfos struct {
 	TransactionInfo      []*TransactionInfo `protobuf:"bytes,1,rep,name=transaction_info,json=transactionInfo,proto3" json:"transaction_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -458,9 +511,12 @@ func (m *BatchTransactionRequest) GetRequests() []*TransactionRequest {
 }
 
 type StartTransactionRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// How long the transaction may stay open before it is deleted, defaults to
+	// (and may not exceed) 24 hours.
+	TTL                  *types.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *StartTransactionRequest) Reset()         { *m = StartTransactionRequest{} }
@@ -496,6 +552,13 @@ func (m *StartTransactionRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartTransactionRequest proto.InternalMessageInfo
 
+func (m *StartTransactionRequest) GetTTL() *types.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return nil
+}
+
 type InspectTransactionRequest struct {
 	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -591,6 +654,8 @@ func (m *DeleteTransactionRequest) GetTransaction() *Transaction {
 }
 
 type ListTransactionRequest struct {
+	// If set, only transactions started by this principal are returned.
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -629,6 +694,13 @@ func (m *ListTransactionRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListTransactionRequest proto.InternalMessageInfo
 
+func (m *ListTransactionRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type FinishTransactionRequest struct {
 	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -676,6 +748,112 @@ func (m *FinishTransactionRequest) GetTransaction() *Transaction {
 	return nil
 }
 
+type ValidateTransactionRequest struct {
+	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ValidateTransactionRequest) Reset()         { *m = ValidateTransactionRequest{} }
+func (m *ValidateTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateTransactionRequest) ProtoMessage()    {}
+func (*ValidateTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_284c03442be38d9f, []int{12}
+}
+func (m *ValidateTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateTransactionRequest.Merge(m, src)
+}
+func (m *ValidateTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateTransactionRequest proto.InternalMessageInfo
+
+func (m *ValidateTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type ValidateTransactionResponse struct {
+	// The error that finishing the transaction would currently produce, empty
+	// if it would succeed.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// The 1-based index of the request that failed, 0 if the failure wasn't
+	// caused by a particular request.
+	FailedRequest        int64    `protobuf:"varint,2,opt,name=failed_request,json=failedRequest,proto3" json:"failed_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateTransactionResponse) Reset()         { *m = ValidateTransactionResponse{} }
+func (m *ValidateTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateTransactionResponse) ProtoMessage()    {}
+func (*ValidateTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_284c03442be38d9f, []int{13}
+}
+func (m *ValidateTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateTransactionResponse.Merge(m, src)
+}
+func (m *ValidateTransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateTransactionResponse proto.InternalMessageInfo
+
+func (m *ValidateTransactionResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ValidateTransactionResponse) GetFailedRequest() int64 {
+	if m != nil {
+		return m.FailedRequest
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DeleteAllRequest)(nil), "transaction_v2.DeleteAllRequest")
 	proto.RegisterType((*TransactionRequest)(nil), "transaction_v2.TransactionRequest")
@@ -689,65 +867,80 @@ func init() {
 	proto.RegisterType((*DeleteTransactionRequest)(nil), "transaction_v2.DeleteTransactionRequest")
 	proto.RegisterType((*ListTransactionRequest)(nil), "transaction_v2.ListTransactionRequest")
 	proto.RegisterType((*FinishTransactionRequest)(nil), "transaction_v2.FinishTransactionRequest")
+	proto.RegisterType((*ValidateTransactionRequest)(nil), "transaction_v2.ValidateTransactionRequest")
+	proto.RegisterType((*ValidateTransactionResponse)(nil), "transaction_v2.ValidateTransactionResponse")
 }
 
 func init() { proto.RegisterFile("transaction/transaction.proto", fileDescriptor_284c03442be38d9f) }
 
 var fileDescriptor_284c03442be38d9f = []byte{
-	// 840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xde, 0x64, 0xbb, 0x9b, 0xcd, 0x49, 0xbb, 0xc9, 0x0e, 0x28, 0xf5, 0x7a, 0xd5, 0x6c, 0x64,
-	0x44, 0x59, 0x6e, 0x6c, 0x35, 0x70, 0x85, 0x54, 0x60, 0xd3, 0xd2, 0x2a, 0x11, 0x17, 0x95, 0x53,
-	0x84, 0x76, 0x25, 0x1a, 0xfc, 0x33, 0x4e, 0x8c, 0x12, 0xcf, 0xd4, 0x33, 0x89, 0xd4, 0x37, 0xe0,
-	0x35, 0x10, 0x2f, 0xc3, 0x25, 0x4f, 0x80, 0x50, 0x9e, 0x04, 0x79, 0x66, 0xec, 0xd8, 0xce, 0x4f,
-	0x8b, 0xba, 0x77, 0x9e, 0x73, 0xce, 0xf7, 0xf9, 0x3b, 0x3f, 0x73, 0x34, 0xf0, 0x88, 0xc7, 0x4e,
-	0xc4, 0x1c, 0x8f, 0x87, 0x24, 0xb2, 0x72, 0xdf, 0x26, 0x8d, 0x09, 0x27, 0xe8, 0x34, 0x67, 0x1a,
-	0x2f, 0x7b, 0xfa, 0xc5, 0x84, 0x90, 0xc9, 0x0c, 0x5b, 0xc2, 0xeb, 0x2e, 0x02, 0x0b, 0xcf, 0x29,
-	0x7f, 0x27, 0x83, 0xf5, 0xcb, 0xb2, 0x93, 0x87, 0x73, 0xcc, 0xb8, 0x33, 0xa7, 0x2a, 0xe0, 0xd3,
-	0x09, 0x99, 0x10, 0xf1, 0x69, 0x25, 0x5f, 0xca, 0xfa, 0x80, 0x06, 0xcc, 0xa2, 0x01, 0xcb, 0x8e,
-	0x94, 0x59, 0x94, 0xaa, 0xa3, 0x81, 0xa0, 0xf5, 0x1c, 0xcf, 0x30, 0xc7, 0xd7, 0xb3, 0x99, 0x8d,
-	0xdf, 0x2e, 0x30, 0xe3, 0xc6, 0x1f, 0x47, 0x80, 0x5e, 0xaf, 0x85, 0x29, 0x33, 0xfa, 0x06, 0x1a,
-	0x5e, 0x8c, 0x1d, 0x8e, 0xc7, 0x31, 0xa6, 0x44, 0xab, 0x74, 0x2b, 0x57, 0x8d, 0xde, 0xb9, 0x49,
-	0x03, 0x36, 0x5e, 0xf6, 0xcc, 0x67, 0xc2, 0x65, 0x63, 0x4a, 0x54, 0xbc, 0x0d, 0x5e, 0x66, 0x4a,
-	0xb0, 0xbe, 0xf8, 0x8d, 0xc4, 0x56, 0x8b, 0x58, 0xa9, 0xa0, 0x80, 0xf5, 0x33, 0x13, 0x7a, 0x0a,
-	0xf7, 0x19, 0x77, 0x62, 0x3e, 0xf6, 0xc8, 0x7c, 0x1e, 0x72, 0xed, 0x50, 0x80, 0xf5, 0x14, 0x3c,
-	0x4a, 0x7c, 0xcf, 0x84, 0x2b, 0x45, 0x37, 0xd8, 0xda, 0x86, 0xbe, 0x87, 0x07, 0x41, 0x18, 0x85,
-	0x6c, 0x9a, 0xe2, 0xef, 0x09, 0xfc, 0x45, 0x8a, 0x7f, 0x21, 0x9c, 0x45, 0x82, 0xfb, 0x41, 0xce,
-	0x88, 0x86, 0x70, 0xc6, 0xde, 0x2e, 0x9c, 0x8c, 0x61, 0xcc, 0x30, 0xd7, 0x8e, 0x04, 0x4b, 0x27,
-	0x53, 0x21, 0x02, 0x24, 0x60, 0x84, 0x33, 0xa2, 0x26, 0x2b, 0xda, 0x13, 0x35, 0xaa, 0x88, 0x6e,
-	0xec, 0x44, 0xde, 0x54, 0x3b, 0x2e, 0xaa, 0x91, 0x65, 0xec, 0x0b, 0x5f, 0xa6, 0xc6, 0xcb, 0x19,
-	0x13, 0x06, 0x55, 0x4a, 0xc5, 0x50, 0x2b, 0x32, 0xc8, 0x62, 0x96, 0x18, 0xfc, 0x9c, 0x11, 0xbd,
-	0x84, 0xd6, 0x82, 0xfa, 0x89, 0x86, 0xdf, 0x88, 0x3b, 0x66, 0xdc, 0xe1, 0x58, 0x3b, 0x11, 0x24,
-	0x8f, 0x4c, 0x4a, 0x05, 0xc9, 0x4f, 0xc2, 0x3f, 0x24, 0xee, 0x88, 0x8b, 0x16, 0x4a, 0x9a, 0xd3,
-	0x45, 0xc1, 0x8c, 0x5e, 0x40, 0x53, 0x25, 0x43, 0x43, 0x8a, 0x67, 0x61, 0x84, 0xb5, 0x7a, 0x91,
-	0x47, 0xa6, 0xf3, 0x4a, 0x79, 0x33, 0x1e, 0xaf, 0x60, 0x46, 0x4f, 0xe0, 0x84, 0x71, 0x42, 0x13,
-	0x39, 0x1a, 0x08, 0x82, 0x76, 0x4a, 0x30, 0xe2, 0x84, 0x0e, 0x89, 0x9b, 0x22, 0x6b, 0x4c, 0x9e,
-	0x8d, 0xa7, 0xf0, 0x49, 0x61, 0x44, 0x19, 0x25, 0x11, 0xc3, 0xe8, 0x31, 0x1c, 0xab, 0x2e, 0xcb,
-	0xf1, 0x3c, 0xcd, 0xea, 0x2a, 0xfb, 0xab, 0xbc, 0xc6, 0xe7, 0xd0, 0xc8, 0xc1, 0x51, 0x1b, 0xaa,
-	0xa1, 0x2f, 0x20, 0xf5, 0xfe, 0xf1, 0xea, 0x9f, 0xcb, 0xea, 0xe0, 0xb9, 0x5d, 0x0d, 0x7d, 0xe3,
-	0xcf, 0x2a, 0x34, 0x73, 0x71, 0x83, 0x28, 0x48, 0xc6, 0xb1, 0x91, 0xbb, 0xb5, 0xea, 0x3f, 0x17,
-	0x66, 0xf1, 0x26, 0x9b, 0x79, 0x71, 0xf9, 0x78, 0xf4, 0x2d, 0x9c, 0xc4, 0x32, 0x19, 0xa6, 0x55,
-	0xbb, 0x87, 0x57, 0x8d, 0x9e, 0xb1, 0x0f, 0xab, 0xf2, 0xce, 0x30, 0xe8, 0x1a, 0xea, 0xb1, 0xca,
-	0x96, 0x69, 0x87, 0x82, 0xe0, 0xb3, 0xbd, 0x04, 0x32, 0xd6, 0x5e, 0xa3, 0xd0, 0xd7, 0x50, 0x13,
-	0x17, 0x04, 0xfb, 0xea, 0x2e, 0xe8, 0xa6, 0x5c, 0x2d, 0x66, 0xba, 0x5a, 0xcc, 0xd7, 0xe9, 0x6a,
-	0xb1, 0xd3, 0x50, 0xa4, 0x41, 0x6d, 0x89, 0x63, 0x96, 0xe4, 0x9c, 0xcc, 0xfe, 0x3d, 0x3b, 0x3d,
-	0x1a, 0x6f, 0xa0, 0x55, 0x2a, 0x12, 0x43, 0x43, 0x68, 0xe5, 0x45, 0x85, 0x51, 0x90, 0x6c, 0x8c,
-	0x44, 0xed, 0xe5, 0x1e, 0xb5, 0x09, 0xd6, 0x6e, 0xf2, 0xa2, 0xc1, 0xb8, 0x81, 0x87, 0x7d, 0x87,
-	0x7b, 0xd3, 0x2d, 0x3b, 0x29, 0x5f, 0xcd, 0xca, 0xff, 0xaf, 0xa6, 0x71, 0x0e, 0x0f, 0xc5, 0xfe,
-	0xd8, 0x0c, 0x32, 0x6e, 0xe1, 0x7c, 0x10, 0x31, 0x8a, 0xbd, 0x2d, 0xce, 0x8f, 0x1c, 0x02, 0xe3,
-	0x06, 0x34, 0x79, 0x4d, 0xef, 0x9e, 0x5a, 0x83, 0xf6, 0x8f, 0x21, 0xdb, 0x96, 0xd0, 0x0d, 0x68,
-	0x72, 0xd7, 0xdd, 0xf9, 0x4f, 0x7b, 0xbf, 0x1f, 0xc1, 0xe1, 0xf5, 0xab, 0x01, 0x7a, 0x03, 0xad,
-	0x72, 0xa7, 0xd0, 0x17, 0x65, 0x96, 0x1d, 0xbd, 0xd4, 0xdf, 0x37, 0x18, 0xc6, 0x01, 0xba, 0x85,
-	0x56, 0xb9, 0x5d, 0x9b, 0xfc, 0x3b, 0x1a, 0xaa, 0xef, 0x4b, 0xc7, 0x38, 0x40, 0x2e, 0xa0, 0xcd,
-	0x7e, 0xa3, 0x2f, 0xcb, 0xa0, 0x9d, 0x33, 0xf1, 0x21, 0xfa, 0x7f, 0x86, 0xb3, 0x8d, 0xbe, 0xa3,
-	0xab, 0x32, 0x6e, 0xd7, 0x68, 0xe8, 0xed, 0x8d, 0x7b, 0xfa, 0x43, 0xf2, 0x3e, 0x30, 0x0e, 0xd0,
-	0x2f, 0xd0, 0x2c, 0x75, 0x1d, 0x3d, 0x2e, 0xd3, 0x6e, 0x1f, 0x0b, 0xbd, 0xfb, 0x1e, 0xd9, 0xcc,
-	0x38, 0x40, 0xbf, 0xc2, 0xd9, 0xc6, 0xe8, 0x6c, 0xea, 0xde, 0x35, 0x5d, 0x1f, 0x52, 0x99, 0x97,
-	0x50, 0xcf, 0xde, 0x21, 0xa8, 0xbb, 0xbd, 0x22, 0xeb, 0x27, 0xca, 0xee, 0x4a, 0xf4, 0xbf, 0xfb,
-	0x6b, 0xd5, 0xa9, 0xfc, 0xbd, 0xea, 0x54, 0xfe, 0x5d, 0x75, 0x2a, 0xb7, 0x4f, 0x26, 0x21, 0x9f,
-	0x2e, 0x5c, 0xd3, 0x23, 0x73, 0x8b, 0x3a, 0xde, 0xf4, 0x9d, 0x8f, 0xe3, 0xfc, 0xd7, 0xb2, 0x67,
-	0xb1, 0xd8, 0xcb, 0xbf, 0xcc, 0xdc, 0x63, 0x41, 0xf9, 0xd5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc8, 0x02, 0x52, 0x48, 0xbb, 0x09, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x6e, 0x92, 0x6d, 0xd3, 0x9e, 0x34, 0x3f, 0x9d, 0xad, 0xba, 0x5e, 0x97, 0x4d, 0x2b, 0xa3,
+	0x5d, 0x0a, 0x48, 0x8e, 0x36, 0xec, 0x15, 0x68, 0x81, 0xa6, 0xa5, 0xab, 0x56, 0x2b, 0xb1, 0x72,
+	0x0b, 0x68, 0x8b, 0xd8, 0xe0, 0xd8, 0xe3, 0xc4, 0xc8, 0xb1, 0x67, 0x3d, 0x93, 0xc2, 0x3e, 0x14,
+	0xef, 0xc1, 0x25, 0x4f, 0xb0, 0x42, 0x79, 0x01, 0xee, 0xb9, 0x42, 0xf3, 0x63, 0xc7, 0x76, 0x92,
+	0xb6, 0x88, 0xde, 0x79, 0xce, 0x39, 0xdf, 0xe7, 0x6f, 0xce, 0x7c, 0x73, 0xe2, 0xc0, 0x23, 0x16,
+	0xdb, 0x21, 0xb5, 0x1d, 0xe6, 0x47, 0x61, 0x27, 0xf3, 0x6c, 0x92, 0x38, 0x62, 0x11, 0x6a, 0x64,
+	0x42, 0xfd, 0xab, 0xae, 0xde, 0x1e, 0x46, 0xd1, 0x30, 0xc0, 0x1d, 0x91, 0x1d, 0x4c, 0xbc, 0x8e,
+	0x3b, 0x89, 0xed, 0x59, 0xbd, 0xbe, 0x5b, 0xcc, 0xe3, 0x31, 0x61, 0xef, 0x54, 0x72, 0xaf, 0x98,
+	0x64, 0xfe, 0x18, 0x53, 0x66, 0x8f, 0x89, 0x2a, 0xd8, 0x1e, 0x46, 0xc3, 0x48, 0x3c, 0x76, 0xf8,
+	0x93, 0x8a, 0xd6, 0x89, 0x47, 0x3b, 0xc4, 0xa3, 0xe9, 0x92, 0xd0, 0x0e, 0x21, 0x6a, 0x69, 0x20,
+	0x68, 0x1d, 0xe3, 0x00, 0x33, 0x7c, 0x18, 0x04, 0x16, 0x7e, 0x3b, 0xc1, 0x94, 0x19, 0xbf, 0x57,
+	0x01, 0x5d, 0xcc, 0x84, 0xab, 0x30, 0xfa, 0x1c, 0x6a, 0x4e, 0x8c, 0x6d, 0x86, 0xfb, 0x31, 0x26,
+	0x91, 0x56, 0xda, 0x2f, 0x1d, 0xd4, 0xba, 0x0f, 0x4d, 0xe2, 0xd1, 0xfe, 0x55, 0xd7, 0x3c, 0x12,
+	0x29, 0x0b, 0x93, 0x48, 0xd5, 0x5b, 0xe0, 0xa4, 0x21, 0x8e, 0x75, 0xc5, 0x6b, 0x24, 0xb6, 0x9c,
+	0xc7, 0x4a, 0x05, 0x39, 0xac, 0x9b, 0x86, 0xd0, 0x73, 0xd8, 0xa4, 0xcc, 0x8e, 0x59, 0xdf, 0x89,
+	0xc6, 0x63, 0x9f, 0x69, 0x15, 0x01, 0xd6, 0x13, 0xf0, 0x39, 0xcf, 0x1d, 0x89, 0x54, 0x82, 0xae,
+	0xd1, 0x59, 0x0c, 0x7d, 0x0d, 0x75, 0xcf, 0x0f, 0x7d, 0x3a, 0x4a, 0xf0, 0xf7, 0x04, 0x7e, 0x37,
+	0xc1, 0x9f, 0x88, 0x64, 0x9e, 0x60, 0xd3, 0xcb, 0x04, 0xd1, 0x19, 0x6c, 0xd1, 0xb7, 0x13, 0x3b,
+	0x65, 0xe8, 0x53, 0xcc, 0xb4, 0x55, 0xc1, 0xd2, 0x4e, 0x55, 0x88, 0x02, 0x09, 0x38, 0xc7, 0x29,
+	0x51, 0x93, 0xe6, 0xe3, 0x5c, 0x8d, 0x6a, 0xe2, 0x20, 0xb6, 0x43, 0x67, 0xa4, 0xad, 0xe5, 0xd5,
+	0xc8, 0x36, 0xf6, 0x44, 0x2e, 0x55, 0xe3, 0x64, 0x82, 0x9c, 0x41, 0xb5, 0x52, 0x31, 0x54, 0xf3,
+	0x0c, 0xb2, 0x99, 0x05, 0x06, 0x37, 0x13, 0x44, 0x2f, 0xa0, 0x35, 0x21, 0x2e, 0xd7, 0xf0, 0x4b,
+	0x34, 0xe8, 0x53, 0x66, 0x33, 0xac, 0xad, 0x0b, 0x92, 0x47, 0x26, 0x21, 0x82, 0xe4, 0x3b, 0x91,
+	0x3f, 0x8b, 0x06, 0xe7, 0x4c, 0x1c, 0xa1, 0xa4, 0x69, 0x4c, 0x72, 0x61, 0x74, 0x02, 0x4d, 0xb5,
+	0x19, 0xe2, 0x13, 0x1c, 0xf8, 0x21, 0xd6, 0x36, 0xf2, 0x3c, 0x72, 0x3b, 0xaf, 0x54, 0x36, 0xe5,
+	0x71, 0x72, 0x61, 0xf4, 0x14, 0xd6, 0x29, 0x8b, 0x08, 0x97, 0xa3, 0x81, 0x20, 0xd8, 0x49, 0x08,
+	0xce, 0x59, 0x44, 0xce, 0xa2, 0x41, 0x82, 0xac, 0x52, 0xb9, 0x46, 0x5f, 0xc0, 0xa6, 0xed, 0xba,
+	0x7d, 0xcf, 0x0f, 0xb0, 0x38, 0x8e, 0x5a, 0xde, 0x51, 0x87, 0xae, 0x7b, 0xe2, 0x07, 0x38, 0x73,
+	0x12, 0x60, 0xa7, 0x21, 0xae, 0x5b, 0xb5, 0x30, 0xd5, 0xbd, 0x99, 0xd7, 0x2d, 0x9b, 0x38, 0xa7,
+	0xdb, 0xcd, 0x85, 0xf9, 0x51, 0x08, 0xdd, 0x29, 0x4b, 0x3d, 0x39, 0x8a, 0x99, 0xf8, 0x22, 0xc7,
+	0x26, 0xcd, 0x04, 0xd1, 0x11, 0x34, 0xa4, 0xb7, 0x53, 0x8a, 0x86, 0xa0, 0xf8, 0x60, 0x46, 0x61,
+	0xc7, 0xac, 0xc8, 0x51, 0xa7, 0xd9, 0xa8, 0xf1, 0x1c, 0xee, 0xe7, 0xae, 0x2b, 0x25, 0x51, 0x48,
+	0x31, 0x7a, 0x02, 0x6b, 0xca, 0xf1, 0xf2, 0xaa, 0x36, 0x52, 0x8f, 0x89, 0xa8, 0xa5, 0xb2, 0xc6,
+	0x63, 0xa8, 0x65, 0xe0, 0x68, 0x07, 0xca, 0xbe, 0x2b, 0x20, 0x1b, 0xbd, 0xb5, 0xe9, 0xfb, 0xbd,
+	0xf2, 0xe9, 0xb1, 0x55, 0xf6, 0x5d, 0xe3, 0xef, 0x32, 0x34, 0x33, 0x75, 0xa7, 0xa1, 0xc7, 0xaf,
+	0x66, 0x2d, 0x33, 0xe1, 0xd4, 0x7b, 0x76, 0xcd, 0xfc, 0xd4, 0x33, 0xb3, 0xe2, 0xb2, 0xf5, 0xe8,
+	0x4b, 0x58, 0x8f, 0xe5, 0x96, 0xa8, 0x56, 0xde, 0xaf, 0x1c, 0xd4, 0xba, 0xc6, 0x75, 0x58, 0xb5,
+	0xfb, 0x14, 0x83, 0x0e, 0x61, 0x23, 0x56, 0xbb, 0xa5, 0x5a, 0x45, 0x10, 0x7c, 0x78, 0x2d, 0x81,
+	0xac, 0xb5, 0x66, 0x28, 0xf4, 0x0c, 0xaa, 0xa2, 0x99, 0xd8, 0x55, 0x73, 0x41, 0x37, 0xe5, 0x98,
+	0x35, 0x93, 0x31, 0x6b, 0x5e, 0x24, 0x63, 0xd6, 0x4a, 0x4a, 0x91, 0x06, 0xd5, 0x2b, 0x1c, 0x53,
+	0xbe, 0x67, 0x3e, 0x07, 0xee, 0x59, 0xc9, 0x12, 0x6d, 0xc3, 0x6a, 0xf4, 0x6b, 0x88, 0x63, 0x71,
+	0xaf, 0x37, 0x2c, 0xb9, 0xe0, 0x6f, 0xc1, 0xbf, 0x11, 0x3f, 0xc6, 0x54, 0xab, 0xde, 0xfc, 0x16,
+	0x55, 0x6a, 0xbc, 0x81, 0x56, 0xa1, 0xe1, 0x14, 0x9d, 0x41, 0x2b, 0xbb, 0x41, 0x3f, 0xf4, 0xf8,
+	0x24, 0xe6, 0x3b, 0xdf, 0xbb, 0x66, 0xe7, 0x1c, 0x6b, 0x35, 0x59, 0x3e, 0x60, 0xbc, 0x86, 0x07,
+	0x3d, 0x9b, 0x39, 0xa3, 0x05, 0xb3, 0x3e, 0x7b, 0x32, 0xa5, 0xff, 0x7e, 0x32, 0xc6, 0xb7, 0xf0,
+	0x40, 0x38, 0x77, 0x01, 0xf5, 0x33, 0xa8, 0x30, 0x16, 0xa4, 0x3f, 0x1f, 0xc5, 0x3e, 0x1c, 0xab,
+	0x5f, 0xc4, 0x5e, 0x75, 0xfa, 0x7e, 0xaf, 0x72, 0x71, 0xf1, 0xd2, 0xe2, 0xe5, 0xc6, 0x25, 0x3c,
+	0x3c, 0x0d, 0x29, 0xc1, 0xce, 0x22, 0xca, 0xff, 0x67, 0x43, 0xe3, 0x35, 0x68, 0xf2, 0xbe, 0xdf,
+	0x3d, 0xb5, 0x09, 0x3b, 0x2f, 0x7d, 0xba, 0x48, 0x73, 0x6a, 0x94, 0x52, 0xc6, 0x28, 0x5c, 0x8a,
+	0xfc, 0x3d, 0xba, 0x7b, 0x29, 0x3f, 0x82, 0xfe, 0xbd, 0x1d, 0xf8, 0x7c, 0x80, 0xdf, 0x3d, 0xf9,
+	0x25, 0xec, 0x2e, 0x24, 0x57, 0xa3, 0x68, 0x1b, 0x56, 0x71, 0x1c, 0x47, 0xe9, 0x66, 0xc5, 0x02,
+	0x3d, 0x86, 0x86, 0x67, 0xfb, 0x01, 0x76, 0xfb, 0xca, 0x37, 0xe2, 0xbb, 0xa0, 0x62, 0xd5, 0x65,
+	0x54, 0x49, 0xeb, 0xfe, 0xb3, 0x0a, 0x95, 0xc3, 0x57, 0xa7, 0xe8, 0x0d, 0xb4, 0x8a, 0x76, 0x45,
+	0x1f, 0x15, 0x15, 0x2e, 0x31, 0xb4, 0x7e, 0xd3, 0xed, 0x30, 0x56, 0xd0, 0x25, 0xb4, 0x8a, 0x9e,
+	0x9d, 0xe7, 0x5f, 0xe2, 0x6a, 0xfd, 0xba, 0x56, 0x19, 0x2b, 0x68, 0x00, 0x68, 0xde, 0xbe, 0xe8,
+	0xe3, 0x22, 0x68, 0xa9, 0xc5, 0x6f, 0xa3, 0xff, 0x07, 0xd8, 0x9a, 0xb3, 0x31, 0x3a, 0x28, 0xe2,
+	0x96, 0x39, 0x5d, 0xdf, 0x99, 0xbb, 0x8a, 0xdf, 0xf0, 0x8f, 0x4f, 0x63, 0x05, 0xfd, 0x04, 0xcd,
+	0x82, 0x89, 0xd1, 0x93, 0x22, 0xed, 0x62, 0x97, 0xeb, 0xfb, 0x37, 0xc8, 0xa6, 0xc6, 0x0a, 0xfa,
+	0x19, 0xb6, 0xe6, 0x3c, 0x3f, 0xaf, 0x7b, 0xd9, 0xb5, 0xb8, 0x4d, 0x67, 0x08, 0xdc, 0x5f, 0xe0,
+	0x4e, 0xf4, 0x49, 0x11, 0xb9, 0xfc, 0x7e, 0xe8, 0x9f, 0xde, 0xaa, 0x56, 0xda, 0xdd, 0x58, 0x41,
+	0x2f, 0x60, 0x23, 0xfd, 0xac, 0x46, 0xfb, 0x8b, 0xcf, 0x60, 0xf6, 0xc5, 0xbd, 0xbc, 0xf7, 0xbd,
+	0xaf, 0xfe, 0x98, 0xb6, 0x4b, 0x7f, 0x4e, 0xdb, 0xa5, 0xbf, 0xa6, 0xed, 0xd2, 0xe5, 0xd3, 0xa1,
+	0xcf, 0x46, 0x93, 0x81, 0xe9, 0x44, 0xe3, 0x0e, 0xb1, 0x9d, 0xd1, 0x3b, 0x17, 0xc7, 0xd9, 0xa7,
+	0xab, 0x6e, 0x87, 0xc6, 0x4e, 0xf6, 0x8f, 0xc8, 0x60, 0x4d, 0x50, 0x7e, 0xf6, 0xef, 0x00, 0x88,
+	0xbe, 0xb7, 0xaa, 0xaa, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -769,6 +962,7 @@ type APIClient interface {
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	ListTransaction(ctx context.Context, in *ListTransactionRequest, opts ...grpc.CallOption) (*TransactionInfos, error)
 	FinishTransaction(ctx context.Context, in *FinishTransactionRequest, opts ...grpc.CallOption) (*TransactionInfo, error)
+	ValidateTransaction(ctx context.Context, in *ValidateTransactionRequest, opts ...grpc.CallOption) (*ValidateTransactionResponse, error)
 	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
@@ -834,6 +1028,15 @@ func (c *aPIClient) FinishTransaction(ctx context.Context, in *FinishTransaction
 	return out, nil
 }
 
+func (c *aPIClient) ValidateTransaction(ctx context.Context, in *ValidateTransactionRequest, opts ...grpc.CallOption) (*ValidateTransactionResponse, error) {
+	out := new(ValidateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/transaction_v2.API/ValidateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/transaction_v2.API/DeleteAll", in, out, opts...)
@@ -852,6 +1055,7 @@ type APIServer interface {
 	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*types.Empty, error)
 	ListTransaction(context.Context, *ListTransactionRequest) (*TransactionInfos, error)
 	FinishTransaction(context.Context, *FinishTransactionRequest) (*TransactionInfo, error)
+	ValidateTransaction(context.Context, *ValidateTransactionRequest) (*ValidateTransactionResponse, error)
 	DeleteAll(context.Context, *DeleteAllRequest) (*types.Empty, error)
 }
 
@@ -877,6 +1081,9 @@ func (*UnimplementedAPIServer) ListTransaction(ctx context.Context, req *ListTra
 func (*UnimplementedAPIServer) FinishTransaction(ctx context.Context, req *FinishTransactionRequest) (*TransactionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinishTransaction not implemented")
 }
+func (*UnimplementedAPIServer) ValidateTransaction(ctx context.Context, req *ValidateTransactionRequest) (*ValidateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTransaction not implemented")
+}
 func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
@@ -993,6 +1200,24 @@ func _API_FinishTransaction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ValidateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ValidateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/transaction_v2.API/ValidateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ValidateTransaction(ctx, req.(*ValidateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAllRequest)
 	if err := dec(in); err != nil {
@@ -1039,6 +1264,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinishTransaction",
 			Handler:    _API_FinishTransaction_Handler,
 		},
+		{
+			MethodName: "ValidateTransaction",
+			Handler:    _API_ValidateTransaction_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -1099,6 +1328,54 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StartPipeline != nil {
+		{
+			size, err := m.StartPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StopPipeline != nil {
+		{
+			size, err := m.StopPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DeletePipeline != nil {
+		{
+			size, err := m.DeletePipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AddFileSet != nil {
+		{
+			size, err := m.AddFileSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.StopJob != nil {
 		{
 			size, err := m.StopJob.MarshalToSizedBuffer(dAtA[:i])
@@ -1319,6 +1596,25 @@ func (m *TransactionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Expires != nil {
+		{
+			size, err := m.Expires.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Version != 0 {
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Version))
 		i--
@@ -1485,6 +1781,18 @@ func (m *StartTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TTL != nil {
+		{
+			size, err := m.TTL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1590,6 +1898,13 @@ func (m *ListTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1632,39 +1947,117 @@ func (m *FinishTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransaction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidateTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DeleteAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidateTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidateTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreateRepo != nil {
-		l = m.CreateRepo.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FailedRequest != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.FailedRequest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeleteAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateRepo != nil {
+		l = m.CreateRepo.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.DeleteRepo != nil {
 		l = m.DeleteRepo.Size()
 		n += 1 + l + sovTransaction(uint64(l))
@@ -1701,6 +2094,22 @@ func (m *TransactionRequest) Size() (n int) {
 		l = m.StopJob.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.AddFileSet != nil {
+		l = m.AddFileSet.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeletePipeline != nil {
+		l = m.DeletePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StopPipeline != nil {
+		l = m.StopPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartPipeline != nil {
+		l = m.StartPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1768,6 +2177,14 @@ func (m *TransactionInfo) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovTransaction(uint64(m.Version))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Expires != nil {
+		l = m.Expires.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1816,6 +2233,10 @@ func (m *StartTransactionRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.TTL != nil {
+		l = m.TTL.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1860,6 +2281,10 @@ func (m *ListTransactionRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1882,6 +2307,41 @@ func (m *FinishTransactionRequest) Size() (n int) {
 	return n
 }
 
+func (m *ValidateTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.FailedRequest != 0 {
+		n += 1 + sovTransaction(uint64(m.FailedRequest))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTransaction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2328,6 +2788,150 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFileSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddFileSet == nil {
+				m.AddFileSet = &pfs.AddFileSetRequest{}
+			}
+			if err := m.AddFileSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePipeline == nil {
+				m.DeletePipeline = &pps.DeletePipelineRequest{}
+			}
+			if err := m.DeletePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopPipeline == nil {
+				m.StopPipeline = &pps.StopPipelineRequest{}
+			}
+			if err := m.StopPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartPipeline == nil {
+				m.StartPipeline = &pps.StartPipelineRequest{}
+			}
+			if err := m.StartPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -2708,26 +3312,94 @@ func (m *TransactionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expires == nil {
+				m.Expires = &types.Timestamp{}
+			}
+			if err := m.Expires.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *TransactionInfos) Unmarshal(dAtA []byte) error {
@@ -2929,6 +3601,42 @@ func (m *StartTransactionRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: StartTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TTL == nil {
+				m.TTL = &types.Duration{}
+			}
+			if err := m.TTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -3154,6 +3862,38 @@ func (m *ListTransactionRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ListTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -3263,6 +4003,195 @@ func (m *FinishTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidateTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+			}
+			m.FailedRequest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedRequest |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransaction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0